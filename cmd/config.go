@@ -0,0 +1,159 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"go.mercari.io/yo/internal"
+	"go.mercari.io/yo/models"
+	"gopkg.in/yaml.v2"
+)
+
+// defaultConfigFile is the configuration file read from the current directory
+// unless --config is given.
+const defaultConfigFile = "yo.yaml"
+
+// config is the configuration file of yo. The keys other than custom-types and
+// inflection-rules are the names of the flags such as package and
+// exclude-tables, whose values are overridden by the flags given on the
+// command line.
+type config struct {
+	Flags map[string]interface{} `yaml:",inline"`
+
+	// CustomTypes is the custom types in the format of --custom-types-file.
+	CustomTypes *models.CustomTypes `yaml:"custom-types"`
+
+	// InflectionRules is the inflection rules in the format of
+	// --inflection-rule-file.
+	InflectionRules []internal.InflectRule `yaml:"inflection-rules"`
+}
+
+// loadConfig reads the configuration file given by --config, or yo.yaml in the
+// current directory if it exists, and sets the flags of cmd not given on the
+// command line and the custom types and the inflection rules of opts by it.
+func loadConfig(cmd *cobra.Command, opts *internal.ArgType) error {
+	path := opts.ConfigFile
+	if path == "" {
+		if _, err := os.Stat(defaultConfigFile); err != nil {
+			return nil
+		}
+		path = defaultConfigFile
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file failed: %v", err)
+	}
+	var c config
+	if err := yaml.UnmarshalStrict(b, &c); err != nil {
+		return fmt.Errorf("parse config file %s failed: %v", path, err)
+	}
+
+	names := make([]string, 0, len(c.Flags))
+	for name := range c.Flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// the flags are marked as changed by setting them, so that the ones given
+	// on the command line are found first
+	flags := cmd.Flags()
+	var unset []string
+	for _, name := range names {
+		f := flags.Lookup(name)
+		if f == nil || name == "config" || name == "help" {
+			return fmt.Errorf("unknown key '%s' in config file %s", name, path)
+		}
+		if !f.Changed {
+			unset = append(unset, name)
+		}
+	}
+	for _, name := range unset {
+		values, err := configValues(c.Flags[name])
+		if err != nil {
+			return fmt.Errorf("invalid value of '%s' in config file %s: %v", name, path, err)
+		}
+		for _, v := range values {
+			if err := flags.Set(name, v); err != nil {
+				return fmt.Errorf("invalid value of '%s' in config file %s: %v", name, path, err)
+			}
+		}
+	}
+
+	if c.CustomTypes != nil && !flags.Changed("custom-types-file") {
+		opts.CustomTypes = c.CustomTypes
+	}
+	if c.InflectionRules != nil && !flags.Changed("inflection-rule-file") {
+		opts.InflectionRules = c.InflectionRules
+	}
+
+	return nil
+}
+
+// configValues returns v of the configuration file as the values of a flag.
+// The elements of a list are the values of a repeated flag, and the entries of
+// a map are the values of a flag of key=value pairs.
+func configValues(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			s, err := configScalar(e)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	case map[interface{}]interface{}:
+		values := make([]string, 0, len(v))
+		for key, e := range v {
+			s, err := configScalar(e)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, fmt.Sprintf("%v=%s", key, s))
+		}
+		sort.Strings(values)
+		return values, nil
+	}
+
+	s, err := configScalar(v)
+	if err != nil {
+		return nil, err
+	}
+	return []string{s}, nil
+}
+
+// configScalar returns the scalar v of the configuration file as a string.
+func configScalar(v interface{}) (string, error) {
+	switch v.(type) {
+	case []interface{}, map[interface{}]interface{}:
+		return "", fmt.Errorf("nested value %v", v)
+	}
+	return fmt.Sprint(v), nil
+}
@@ -27,11 +27,19 @@ import (
 var createTemplatePath string
 
 var createTemplateCmd = &cobra.Command{
-	Use:     "create-template",
-	Short:   "yo create-template generates default template files ",
-	Example: `yo create-template --template-path templates`,
+	Use:   "create-template [TEMPLATE...]",
+	Short: "yo create-template generates default template files ",
+	Long: `yo create-template generates default template files.
+
+The templates given by the arguments such as type.go.tpl are generated if any,
+so that they override the built-in ones and the others are used as is.`,
+	Example: `  # generate all the templates
+  yo create-template --template-path templates
+
+  # generate only the template of the tables
+  yo create-template --template-path templates type.go.tpl`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return generator.CopyDefaultTemplates(createTemplatePath)
+		return generator.CopyDefaultTemplates(createTemplatePath, args...)
 	},
 }
 
@@ -0,0 +1,81 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.mercari.io/yo/loaders"
+	"go.mercari.io/yo/yo"
+)
+
+var dumpDDLOut string
+
+var dumpDDLCmd = &cobra.Command{
+	Use:   "dump-ddl PROJECT_NAME INSTANCE_NAME DATABASE_NAME",
+	Short: "yo dump-ddl dumps the schema of the database as normalized ddl.",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 3 {
+			return fmt.Errorf("must specify 3 arguments")
+		}
+		return nil
+	},
+	Example: `  # Dump the schema of the database to schema.sql
+  yo dump-ddl $SPANNER_PROJECT_NAME $SPANNER_INSTANCE_NAME $SPANNER_DATABASE_NAME -o schema.sql
+
+  # Generate models under models directory from the dumped schema
+  yo generate schema.sql --from-ddl -o models
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spannerClient, err := yo.NewSpannerClient(context.Background(), &yo.Config{
+			Project:  args[0],
+			Instance: args[1],
+			Database: args[2],
+		})
+		if err != nil {
+			return fmt.Errorf("error: %v", err)
+		}
+		defer spannerClient.Close()
+
+		// the file is written only if the whole schema is dumped
+		var buf bytes.Buffer
+		if err := loaders.NewSpannerLoader(spannerClient).DumpDDL(&buf); err != nil {
+			return fmt.Errorf("dump ddl failed: %v", err)
+		}
+		if dumpDDLOut == "" || dumpDDLOut == "-" {
+			_, err = os.Stdout.Write(buf.Bytes())
+			return err
+		}
+		if err := ioutil.WriteFile(dumpDDLOut, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("error: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	dumpDDLCmd.Flags().StringVarP(&dumpDDLOut, "out", "o", "", "output file, or - of the standard output (default -)")
+	rootCmd.AddCommand(dumpDDLCmd)
+}
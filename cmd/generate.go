@@ -20,12 +20,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
-	"go.mercari.io/yo/generator"
 	"go.mercari.io/yo/internal"
 	"go.mercari.io/yo/loaders"
+	"go.mercari.io/yo/yo"
 )
 
 var (
@@ -34,9 +37,26 @@ var (
 		Use:   "generate",
 		Short: "yo generate generates Go code from ddl file.",
 		Args: func(cmd *cobra.Command, args []string) error {
+			// the config file is loaded before validating the arguments,
+			// which may be given by it such as ddl-path
+			if err := loadConfig(cmd, &generateOpts); err != nil {
+				return err
+			}
+			if len(args) == 0 && len(generateOpts.DDLPaths) != 0 {
+				return nil
+			}
 			if l := len(args); l != 1 && l != 3 {
 				return fmt.Errorf("must specify 1 or 3 arguments")
 			}
+			if len(args) != 1 && generateOpts.Loader != "" {
+				return fmt.Errorf("--loader requires 1 argument of the source")
+			}
+			if len(args) == 3 && len(generateOpts.DDLPaths) != 0 {
+				return fmt.Errorf("--ddl-path cannot be used with the database")
+			}
+			if len(args) != 3 && len(generateOpts.EmulatorDDLPaths) != 0 {
+				return fmt.Errorf("--emulator-ddl requires the database")
+			}
 			return nil
 		},
 		Example: `  # Generate models from ddl under models directory
@@ -45,9 +65,21 @@ var (
   # Generate models from ddl under models directory with custom types
   yo generate schema.sql --from-ddl -o models --custom-types-file custom_column_types.yml
 
+  # Generate models under models directory from ddl split into migration files
+  yo generate --ddl-path migrations/ -o models
+
+  # Generate models under models directory from ddl of the standard input
+  gcloud spanner databases ddl describe $SPANNER_DATABASE_NAME | yo generate - --from-ddl -o models
+
   # Generate models under models directory
   yo generate $SPANNER_PROJECT_NAME $SPANNER_INSTANCE_NAME $SPANNER_DATABASE_NAME -o models
 
+  # Generate models under models directory from ddl applied to a database on the emulator
+  SPANNER_EMULATOR_HOST=localhost:9010 yo generate test-project test-instance test-database --emulator-ddl schema.sql -o models
+
+  # Generate models under models directory from a PostgreSQL-dialect database
+  yo generate $SPANNER_PROJECT_NAME $SPANNER_INSTANCE_NAME $SPANNER_DATABASE_NAME --dialect postgresql -o models
+
   # Generate models under models directory with custom types
   yo generate $SPANNER_PROJECT_NAME $SPANNER_INSTANCE_NAME $SPANNER_DATABASE_NAME -o models --custom-types-file custom_column_types.yml
 `,
@@ -56,53 +88,39 @@ var (
 				return err
 			}
 
-			inflector, err := internal.NewInflector(generateOpts.InflectionRuleFile)
+			if !generateOpts.Watch {
+				_, err := yo.Generate(context.Background(), generateOpts)
+				return err
+			}
+
+			if !generateOpts.FromDDL {
+				return fmt.Errorf("--watch requires --from-ddl")
+			}
+			paths := ddlPaths(&generateOpts)
+			files, err := loaders.DDLFiles(paths...)
 			if err != nil {
-				return fmt.Errorf("load inflection rule failed: %v", err)
+				return err
 			}
-			var loader *internal.TypeLoader
-			if generateOpts.FromDDL {
-				spannerLoader, err := loaders.NewSpannerLoaderFromDDL(args[0])
-				if err != nil {
-					return fmt.Errorf("error: %v", err)
-				}
-				loader = internal.NewTypeLoader(spannerLoader, inflector)
-			} else {
-				spannerClient, err := connectSpanner(&rootOpts)
-				if err != nil {
-					return fmt.Errorf("error: %v", err)
+			for _, f := range files {
+				if f == "-" {
+					return fmt.Errorf("--watch cannot read the ddl from the standard input")
 				}
-				spannerLoader := loaders.NewSpannerLoader(spannerClient)
-				loader = internal.NewTypeLoader(spannerLoader, inflector)
 			}
-
-			// load custom type definitions
 			if generateOpts.CustomTypesFile != "" {
-				if err := loader.LoadCustomTypes(generateOpts.CustomTypesFile); err != nil {
-					return fmt.Errorf("load custom types file failed: %v", err)
-				}
+				files = append(files, generateOpts.CustomTypesFile)
 			}
-
-			// load defs into type map
-			tableMap, ixMap, err := loader.LoadSchema(&generateOpts)
-			if err != nil {
-				return fmt.Errorf("error: %v", err)
+			if generateOpts.ProtoDescriptorsFile != "" {
+				files = append(files, generateOpts.ProtoDescriptorsFile)
 			}
-
-			g := generator.NewGenerator(loader, inflector, generator.GeneratorOption{
-				PackageName:        generateOpts.Package,
-				Tags:               generateOpts.Tags,
-				TemplatePath:       generateOpts.TemplatePath,
-				CustomTypePackage:  generateOpts.CustomTypePackage,
-				FilenameSuffix:     generateOpts.Suffix,
-				SingleFile:         generateOpts.SingleFile,
-				Filename:           generateOpts.Filename,
-				FilenameUnderscore: generateOpts.FilenameUnderscore,
-				Path:               generateOpts.Path,
+			watchFiles(files, func() error {
+				start := time.Now()
+				res, err := yo.Generate(context.Background(), generateOpts)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("regenerated %d tables from %s in %v\n", len(res.Tables), strings.Join(paths, ", "), time.Since(start).Round(time.Millisecond))
+				return nil
 			})
-			if err := g.Generate(tableMap, ixMap); err != nil {
-				return fmt.Errorf("error: %v", err)
-			}
 
 			return nil
 		},
@@ -111,6 +129,19 @@ var (
 
 func init() {
 	generateCmd.Flags().BoolVar(&generateOpts.FromDDL, "from-ddl", false, "toggle using ddl file")
+	generateCmd.Flags().StringArrayVar(&generateOpts.DDLPaths, "ddl-path", nil, "ddl file, directory of .sql files, glob pattern of files or - of the standard input, which are concatenated in lexical order (implies --from-ddl)")
+	generateCmd.Flags().StringVar(&generateOpts.Loader, "loader", "", "name of the loader loading the schema from the argument, which is json or one registered by a program running yo as a library")
+	generateCmd.Flags().BoolVar(&generateOpts.Watch, "watch", false, "regenerate when the ddl file or the custom types file changes")
 	setRootOpts(generateCmd, &generateOpts)
 	rootCmd.AddCommand(generateCmd)
 }
+
+// ddlPaths returns the paths of the ddl files given by the argument and
+// --ddl-path.
+func ddlPaths(opts *internal.ArgType) []string {
+	var paths []string
+	if opts.DDLFilepath != "" {
+		paths = append(paths, opts.DDLFilepath)
+	}
+	return append(paths, opts.DDLPaths...)
+}
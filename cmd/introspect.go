@@ -0,0 +1,100 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.mercari.io/yo/internal"
+	"go.mercari.io/yo/yo"
+)
+
+var (
+	introspectOpts   = internal.ArgType{}
+	introspectFormat string
+	introspectOut    string
+)
+
+var introspectCmd = &cobra.Command{
+	Use:   "introspect PROJECT_NAME INSTANCE_NAME DATABASE_NAME | DDL",
+	Short: "yo introspect dumps the tables, the columns and the indexes of the schema loaded by yo.",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && len(introspectOpts.DDLPaths) != 0 {
+			return nil
+		}
+		if l := len(args); l != 1 && l != 3 {
+			return fmt.Errorf("must specify 1 or 3 arguments")
+		}
+		if len(args) != 1 && introspectOpts.Loader != "" {
+			return fmt.Errorf("--loader requires 1 argument of the source")
+		}
+		if len(args) == 3 && len(introspectOpts.DDLPaths) != 0 {
+			return fmt.Errorf("--ddl-path cannot be used with the database")
+		}
+		return nil
+	},
+	Example: `  # Dump the schema of the database to schema.json
+  yo introspect $SPANNER_PROJECT_NAME $SPANNER_INSTANCE_NAME $SPANNER_DATABASE_NAME -o schema.json
+
+  # Dump the schema of ddl to the standard output
+  yo introspect schema.sql --from-ddl
+
+  # Generate models under models directory from the dumped schema
+  yo generate schema.json --loader json -o models
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 3 {
+			introspectOpts.Project = args[0]
+			introspectOpts.Instance = args[1]
+			introspectOpts.Database = args[2]
+		} else if len(args) == 1 {
+			introspectOpts.DDLFilepath = args[0]
+		}
+
+		// the file is written only if the whole schema is dumped
+		var buf bytes.Buffer
+		if err := yo.Introspect(context.Background(), introspectOpts, introspectFormat, &buf); err != nil {
+			return err
+		}
+		if introspectOut == "" || introspectOut == "-" {
+			_, err := os.Stdout.Write(buf.Bytes())
+			return err
+		}
+		if err := ioutil.WriteFile(introspectOut, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("error: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	introspectCmd.Flags().StringVar(&introspectFormat, "format", yo.FormatJSON, "format of the dumped schema, which is json")
+	introspectCmd.Flags().StringVarP(&introspectOut, "out", "o", "", "output file, or - of the standard output (default -)")
+	introspectCmd.Flags().BoolVar(&introspectOpts.FromDDL, "from-ddl", false, "toggle using ddl file")
+	introspectCmd.Flags().StringArrayVar(&introspectOpts.DDLPaths, "ddl-path", nil, "ddl file, directory of .sql files, glob pattern of files or - of the standard input, which are concatenated in lexical order (implies --from-ddl)")
+	introspectCmd.Flags().StringVar(&introspectOpts.Loader, "loader", "", "name of the loader loading the schema from the argument, which is json or one registered by a program running yo as a library")
+	introspectCmd.Flags().StringVar(&introspectOpts.Dialect, "dialect", internal.DialectGoogleSQL, "SQL dialect of the database (googlesql or postgresql)")
+	rootCmd.AddCommand(introspectCmd)
+}
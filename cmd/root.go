@@ -23,20 +23,19 @@ import (
 	"context"
 	"fmt"
 	"os"
-	pathpkg "path"
 	"runtime/debug"
 	"strings"
+	"text/template"
 
-	"cloud.google.com/go/spanner"
 	"github.com/spf13/cobra"
 	"go.mercari.io/yo/generator"
 	"go.mercari.io/yo/internal"
-	"go.mercari.io/yo/loaders"
+	"go.mercari.io/yo/models"
+	"go.mercari.io/yo/yo"
 )
 
 const (
-	defaultSuffix = ".yo.go"
-	exampleUsage  = `
+	exampleUsage = `
   # Generate models under models directory
   yo $SPANNER_PROJECT_NAME $SPANNER_INSTANCE_NAME $SPANNER_DATABASE_NAME -o models
 
@@ -53,6 +52,9 @@ var (
 		Use:   "yo PROJECT_NAME INSTANCE_NAME DATABASE_NAME",
 		Short: "yo is a command-line tool to generate Go code for Google Cloud Spanner.",
 		Args: func(cmd *cobra.Command, args []string) error {
+			if err := loadConfig(cmd, &rootOpts); err != nil {
+				return err
+			}
 			if len(args) != 3 {
 				return fmt.Errorf("must specify 3 arguments")
 			}
@@ -64,46 +66,8 @@ var (
 				return err
 			}
 
-			spannerClient, err := connectSpanner(&rootOpts)
-			if err != nil {
-				return fmt.Errorf("error: %v", err)
-			}
-			spannerLoader := loaders.NewSpannerLoader(spannerClient)
-			inflector, err := internal.NewInflector(rootOpts.InflectionRuleFile)
-			if err != nil {
-				return fmt.Errorf("load inflection rule failed: %v", err)
-			}
-			loader := internal.NewTypeLoader(spannerLoader, inflector)
-
-			// load custom type definitions
-			if rootOpts.CustomTypesFile != "" {
-				if err := loader.LoadCustomTypes(rootOpts.CustomTypesFile); err != nil {
-					return fmt.Errorf("load custom types file failed: %v", err)
-				}
-			}
-
-			// load defs into type map
-			tableMap, ixMap, err := loader.LoadSchema(&rootOpts)
-			if err != nil {
-				return fmt.Errorf("error: %v", err)
-			}
-
-			g := generator.NewGenerator(loader, inflector, generator.GeneratorOption{
-				PackageName:        rootOpts.Package,
-				Tags:               rootOpts.Tags,
-				TemplatePath:       rootOpts.TemplatePath,
-				CustomTypePackage:  rootOpts.CustomTypePackage,
-				FilenameSuffix:     rootOpts.Suffix,
-				SingleFile:         rootOpts.SingleFile,
-				Filename:           rootOpts.Filename,
-				FilenameUnderscore: rootOpts.FilenameUnderscore,
-				Path:               rootOpts.Path,
-			})
-			if err := g.Generate(tableMap, ixMap); err != nil {
-				return fmt.Errorf("error: %v", err)
-			}
-
-			return nil
+			_, err := yo.Generate(context.Background(), rootOpts)
+			return err
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -115,6 +79,27 @@ func Execute() error {
 	return rootCmd.Execute()
 }
 
+// hook is the hook set by SetHook.
+var hook models.Hook
+
+// SetHook sets the hook which post-processes the schema before the code is
+// generated by Execute. It allows a program to run yo as a library with its
+// own transformations of the tables, the columns and the indexes.
+func SetHook(h models.Hook) {
+	hook = h
+}
+
+// templateFuncs is the template functions set by SetTemplateFuncs.
+var templateFuncs template.FuncMap
+
+// SetTemplateFuncs sets the extra functions available in the templates of the
+// code generated by Execute, which override the built-in functions of the same
+// names. It allows a program to run yo as a library with its own helpers in
+// the templates of --template-path.
+func SetTemplateFuncs(funcs template.FuncMap) {
+	templateFuncs = funcs
+}
+
 func init() {
 	setRootOpts(rootCmd, &rootOpts)
 }
@@ -122,17 +107,51 @@ func init() {
 func setRootOpts(cmd *cobra.Command, opts *internal.ArgType) {
 	cmd.Flags().StringVar(&opts.CustomTypesFile, "custom-types-file", "", "custom table field type definition file")
 	cmd.Flags().StringVarP(&opts.Out, "out", "o", "", "output path or file name")
-	cmd.Flags().StringVar(&opts.Suffix, "suffix", defaultSuffix, "output file suffix")
+	cmd.Flags().StringVar(&opts.Suffix, "suffix", yo.DefaultSuffix, "output file suffix")
 	cmd.Flags().BoolVar(&opts.SingleFile, "single-file", false, "toggle single file output")
 	cmd.Flags().BoolVar(&opts.FilenameUnderscore, "underscore", false, "toggle underscores in file names")
+	cmd.Flags().BoolVar(&opts.Tests, "tests", false, "generate round-trip tests of the tables")
+	cmd.Flags().BoolVar(&opts.DML, "dml", false, "generate DML statements to insert, update and delete the rows")
+	cmd.Flags().BoolVar(&opts.Hooks, "hooks", false, "generate hooks called by the writes of the rows")
+	cmd.Flags().BoolVar(&opts.OTel, "otel", false, "trace the generated reads and writes by OpenTelemetry spans")
+	cmd.Flags().BoolVar(&opts.Metrics, "metrics", false, "report the calls, errors and latencies of the generated reads and writes to a metrics recorder")
+	cmd.Flags().BoolVar(&opts.Stores, "stores", false, "generate interfaces of the reads and writes of the tables to mock them, with their implementations by a client")
+	cmd.Flags().BoolVar(&opts.NoCommitTimestamp, "no-commit-timestamp", false, "disable writing the commit timestamps into the columns having allow_commit_timestamp")
+	cmd.Flags().BoolVar(&opts.NoForceIndex, "no-force-index", false, "omit FORCE_INDEX hints of finders by indexes to let the optimizer choose")
 	cmd.Flags().StringVarP(&opts.Package, "package", "p", "", "package name used in generated Go code")
 	cmd.Flags().StringVar(&opts.CustomTypePackage, "custom-type-package", "", "Go package name to use for custom or unknown types")
+	cmd.Flags().StringToStringVar(&opts.Groups, "group", nil, "subpackages which tables are generated into by the prefix of the table names (e.g. billing_=billing)")
+	cmd.Flags().BoolVar(&opts.GroupBySchema, "group-by-schema", false, "generate the tables in named schemas into the subpackages named by the schemas")
+	cmd.Flags().StringToStringVar(&opts.CustomTypeImports, "custom-type-imports", nil, "import paths of packages of custom types by alias (e.g. types=example.com/types)")
+	cmd.Flags().StringToStringVar(&opts.ColumnTypes, "column-types", nil, "Go types of the fields of columns overriding the custom types, optionally qualified by the import paths (e.g. Users.Settings=example.com/mypkg.Settings)")
 	cmd.Flags().StringArrayVar(&opts.TargetTables, "target-tables", nil, "tables to include from the generated Go code")
 	cmd.Flags().StringArrayVar(&opts.IgnoreFields, "ignore-fields", nil, "fields to exclude from the generated Go code")
 	cmd.Flags().StringArrayVar(&opts.IgnoreTables, "ignore-tables", nil, "tables to exclude from the generated Go code")
-	cmd.Flags().StringVar(&opts.TemplatePath, "template-path", "", "user supplied template path")
+	cmd.Flags().StringArrayVar(&opts.Tables, "tables", nil, "glob patterns or regular expressions enclosed in slashes such as /^users?$/ of tables to include in the generated Go code")
+	cmd.Flags().StringArrayVar(&opts.ExcludeTables, "exclude-tables", nil, "glob patterns or regular expressions enclosed in slashes such as /^users?$/ of tables to exclude from the generated Go code")
+	cmd.Flags().StringArrayVar(&opts.Include, "include", nil, "alias of --tables")
+	cmd.Flags().StringArrayVar(&opts.Exclude, "exclude", nil, "alias of --exclude-tables")
+	cmd.Flags().MarkDeprecated("include", "use --tables instead")
+	cmd.Flags().MarkDeprecated("exclude", "use --exclude-tables instead")
+	cmd.Flags().StringArrayVar(&opts.Initialisms, "initialisms", nil, "initialisms kept upper case in the generated names in addition to the common ones such as ID, URL and API (e.g. SKU)")
+	cmd.Flags().BoolVar(&opts.NoSingularize, "no-singularize", false, "keep the table names plural in the names of the generated types")
+	cmd.Flags().StringToStringVar(&opts.Names, "names", nil, "names of the generated types of tables and fields of columns overriding the derived ones (e.g. Users=Account,Users.SKU=StockKeepingUnit)")
+	cmd.Flags().BoolVar(&opts.OmitFinderOrder, "omit-finder-order", false, "omit ORDER BY of finders by a prefix of the index key")
+	cmd.Flags().BoolVar(&opts.CheckEnums, "check-enums", false, "generate string enums of STRING columns constrained by CHECK (column IN (...))")
+	cmd.Flags().StringVar(&opts.Dialect, "dialect", internal.DialectGoogleSQL, "SQL dialect of the database (googlesql or postgresql)")
+	cmd.Flags().StringVar(&opts.DateType, "date-type", internal.DateTypeCivil, "Go type of DATE columns (civil.Date or time.Time)")
+	cmd.Flags().BoolVar(&opts.NullablePointers, "nullable-pointers", false, "generate nullable columns as pointers such as *string instead of spanner.NullString")
+	cmd.Flags().StringVar(&opts.ProtoDescriptorsFile, "proto-descriptors-file", "", "FileDescriptorSet of the proto bundle to type PROTO and ENUM columns")
+	cmd.Flags().StringToStringVar(&opts.ProtoPackages, "proto-packages", nil, "import paths of Go packages of proto types by proto package (e.g. examples.music=example.com/musicpb)")
+	cmd.Flags().StringVar(&opts.SpannerClientVersion, "spanner-client-version", "", "version of cloud.google.com/go/spanner used by generated code such as v1.45.0 (default latest)")
+	cmd.Flags().StringVar(&opts.JSONTagCase, "json-tag-case", generator.JSONTagCaseAsIs, "naming convention of json tags of struct fields (as-is, snake or camel)")
+	cmd.Flags().StringVar(&opts.TemplatePath, "template-path", "", "user supplied template path whose templates override the built-in ones")
 	cmd.Flags().StringVar(&opts.Tags, "tags", "", "build tags to add to package header")
+	cmd.Flags().StringVar(&opts.Header, "header", "", "template of the header of generated files")
+	cmd.Flags().StringVar(&opts.HeaderFile, "header-file", "", "file of the template of the header of generated files")
 	cmd.Flags().StringVar(&opts.InflectionRuleFile, "inflection-rule-file", "", "custom inflection rule file")
+	cmd.Flags().StringVar(&opts.ConfigFile, "config", "", "config file whose keys are the names of the flags, overridden by the flags (default yo.yaml if it exists)")
+	cmd.Flags().StringArrayVar(&opts.EmulatorDDLPaths, "emulator-ddl", nil, "ddl file, directory of .sql files or glob pattern of files applied to the database recreated on the emulator of SPANNER_EMULATOR_HOST before loading the schema")
 
 	helpFn := cmd.HelpFunc()
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
@@ -141,98 +160,21 @@ func setRootOpts(cmd *cobra.Command, opts *internal.ArgType) {
 	})
 }
 
+// processArgs sets the source of the schema given by argv and the library
+// options to args, and prepares args for the generation.
 func processArgs(args *internal.ArgType, argv []string) error {
 	if len(argv) == 3 {
-		rootOpts.Project = argv[0]
-		rootOpts.Instance = argv[1]
-		rootOpts.Database = argv[2]
-	} else {
-		rootOpts.DDLFilepath = argv[0]
+		args.Project = argv[0]
+		args.Instance = argv[1]
+		args.Database = argv[2]
+	} else if len(argv) == 1 {
+		args.DDLFilepath = argv[0]
 	}
 
-	path := ""
-	filename := ""
-
-	cwd, err := os.Getwd()
-	if err != nil {
-		return err
-	}
-
-	// determine out path
-	if args.Out == "" {
-		path = cwd
-	} else {
-		// determine what to do with Out
-		fi, err := os.Stat(args.Out)
-		if err == nil && fi.IsDir() {
-			// out is directory
-			path = args.Out
-		} else if err == nil && !fi.IsDir() {
-			// file exists (will truncate later)
-			path = pathpkg.Dir(args.Out)
-			filename = pathpkg.Base(args.Out)
-
-			// error if not split was set, but destination is not a directory
-			if !args.SingleFile {
-				return fmt.Errorf("output path is not directory")
-			}
-		} else if _, ok := err.(*os.PathError); ok {
-			// path error (ie, file doesn't exist yet)
-			path = pathpkg.Dir(args.Out)
-			filename = pathpkg.Base(args.Out)
-
-			// error if split was set, but dest doesn't exist
-			if !args.SingleFile {
-				return fmt.Errorf("output path must be a directory and already exist when not writing to a single file")
-			}
-		} else {
-			return err
-		}
-	}
-
-	// check template path
-	if args.TemplatePath != "" {
-		info, err := os.Stat(args.TemplatePath)
-		if err != nil {
-			return err
-		}
-		if !info.IsDir() {
-			return fmt.Errorf("template path is not directory")
-		}
-	}
-
-	// fix path
-	if path == "." {
-		path = cwd
-	}
-
-	// determine package name
-	if args.Package == "" {
-		args.Package = pathpkg.Base(path)
-	}
-
-	// determine filename if not previously set
-	if filename == "" {
-		filename = args.Package + args.Suffix
-	}
-
-	args.Path = path
-	args.Filename = filename
-
-	return nil
-}
-
-func connectSpanner(args *internal.ArgType) (*spanner.Client, error) {
-	ctx := context.Background()
-
-	databaseName := fmt.Sprintf("projects/%s/instances/%s/databases/%s",
-		args.Project, args.Instance, args.Database)
-	spannerClient, err := spanner.NewClient(ctx, databaseName)
-	if err != nil {
-		return nil, err
-	}
+	args.Hook = hook
+	args.TemplateFuncs = templateFuncs
 
-	return spannerClient, nil
+	return yo.Prepare(args)
 }
 
 func versionInfo() string {
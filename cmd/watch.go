@@ -0,0 +1,82 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// watchInterval is the interval of checking the watched files for changes.
+const watchInterval = 500 * time.Millisecond
+
+// watchFiles runs run, and runs it again whenever any of files changes. The
+// changes are debounced, so run is called after the files have been left
+// unchanged for watchInterval. Errors of run are printed, and do not stop
+// watching so that the next change can fix them. It never returns.
+func watchFiles(files []string, run func() error) {
+	runOnce := func() {
+		if err := run(); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		}
+	}
+
+	runOnce()
+	fmt.Printf("watching %s for changes\n", strings.Join(files, ", "))
+
+	last := fileStates(files)
+	for {
+		time.Sleep(watchInterval)
+		if fileStates(files) == last {
+			continue
+		}
+
+		// wait for the rapid edits to settle
+		for {
+			cur := fileStates(files)
+			if cur == last {
+				break
+			}
+			last = cur
+			time.Sleep(watchInterval)
+		}
+
+		runOnce()
+	}
+}
+
+// fileStates returns a summary of the modification times and the sizes of
+// files, which differs when any of them changes. Missing files are included
+// as such, since editors may replace a file by renaming.
+func fileStates(files []string) string {
+	var b strings.Builder
+	for _, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			fmt.Fprintf(&b, "%s: missing\n", f)
+			continue
+		}
+		fmt.Fprintf(&b, "%s: %d %d\n", f, fi.ModTime().UnixNano(), fi.Size())
+	}
+
+	return b.String()
+}
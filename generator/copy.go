@@ -20,16 +20,31 @@
 package generator
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 
+	"github.com/jessevdk/go-assets"
 	"go.mercari.io/yo/tplbin"
 )
 
-// CopyDefaultTemplates copies default templete files to dir.
-func CopyDefaultTemplates(dir string) error {
-	for _, tf := range tplbin.Assets.Files {
+// CopyDefaultTemplates copies default templete files to dir. Only the files of
+// names are copied if any.
+func CopyDefaultTemplates(dir string, names ...string) error {
+	files := tplbin.Assets.Files
+	if len(names) != 0 {
+		files = make(map[string]*assets.File, len(names))
+		for _, name := range names {
+			tf, ok := tplbin.Assets.Files[name]
+			if !ok {
+				return fmt.Errorf("unknown template %s", name)
+			}
+			files[name] = tf
+		}
+	}
+
+	for _, tf := range files {
 		if err := func() (err error) {
 			file, err := os.OpenFile(filepath.Join(dir, tf.Name()), os.O_RDWR|os.O_CREATE, 0666)
 			if err != nil {
@@ -25,8 +25,6 @@ import (
 	"strings"
 	"text/template"
 
-	"github.com/kenshaw/snaker"
-
 	"go.mercari.io/yo/internal"
 	"go.mercari.io/yo/models"
 )
@@ -56,9 +54,40 @@ func (a *Generator) newTemplateFuncs() template.FuncMap {
 		"customfieldcount":  a.customfieldcount,
 		"goparamname":       a.goparamname,
 		"customtypeparam":   a.customtypeparam,
+		"spanvalue":         a.spanvalue,
+		"customvalue":       a.customvalue,
+		"customjson":        a.customjson,
+		"hasjsontypes":      a.hasjsontypes,
+		"hasconversions":    a.hasconversions,
+		"hassoftdelete":     a.hassoftdelete,
 		"tolower":           a.tolower,
 		"nullcheck":         a.nullcheck,
 		"pluralize":         a.pluralize,
+		"keyprefixes":       a.keyprefixes,
+		"keyranges":         a.keyranges,
+		"iscomparable":      a.iscomparable,
+		"orderby":           a.orderby,
+		"indexkey":          a.indexkey,
+		"jsontag":           a.jsontag,
+		"fieldtag":          a.fieldtag,
+		"testvalue":         a.testvalue,
+		"ancestors":         a.ancestors,
+		"orphan":            a.orphan,
+		"dml":               a.dmlEnabled,
+		"hooks":             a.hooksEnabled,
+		"otel":              a.otelEnabled,
+		"databoost":         a.dataBoostSupported,
+		"batchwrite":        a.batchWriteSupported,
+		"metrics":           a.metricsEnabled,
+		"stores":            a.storesEnabled,
+		"committsfields":    a.committsfields,
+		"autocommitts":      a.autoCommitTimestamp,
+		"forceindex":        a.forceindex,
+		"indexhint":         a.indexhint,
+		"postgresql":        a.postgresql,
+		"param":             a.param,
+		"paramname":         a.paramname,
+		"escapedtable":      a.escapedtable,
 	}
 }
 
@@ -79,7 +108,7 @@ func ignoreFromMultiTypes(ignoreNames []interface{}) map[string]bool {
 	return ignore
 }
 
-// retype checks typ against known types, and prefixing
+// retype checks typ against known types and the enums, and prefixing
 // customTypePackage (if applicable).
 func (a *Generator) retype(typ string) string {
 	if strings.Contains(typ, ".") {
@@ -87,12 +116,16 @@ func (a *Generator) retype(typ string) string {
 	}
 
 	prefix := ""
-	for strings.HasPrefix(typ, "[]") {
-		typ = typ[2:]
-		prefix = prefix + "[]"
+	for strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "*") {
+		n := 1
+		if typ[0] == '[' {
+			n = 2
+		}
+		prefix = prefix + typ[:n]
+		typ = typ[n:]
 	}
 
-	if _, ok := KnownTypeMap[typ]; !ok {
+	if _, ok := KnownTypeMap[typ]; !ok && !a.enums[typ] {
 		pkg := a.customTypePackage
 		if pkg != "" {
 			pkg = pkg + "."
@@ -150,7 +183,7 @@ func (a *Generator) shortname(typ string, scopeConflicts ...interface{}) string
 	if v, ok = ShortNameTypeMap[typ]; !ok {
 		// calc the short name
 		u := []string{}
-		for _, s := range strings.Split(strings.ToLower(snaker.CamelToSnake(typ)), "_") {
+		for _, s := range strings.Split(strings.ToLower(a.initialisms.CamelToSnake(typ)), "_") {
 			if len(s) > 0 && s != "id" {
 				u = append(u, s[:1])
 			}
@@ -239,7 +272,7 @@ func (a *Generator) escapedcolnames(fields []*internal.Field, ignoreNames ...int
 		if i != 0 {
 			str = str + ", "
 		}
-		str = str + internal.EscapeColumnName(a.colname(f.Col))
+		str = str + a.escapeident(a.colname(f.Col))
 		i++
 	}
 	return str
@@ -264,7 +297,7 @@ func (a *Generator) colnamesquery(fields []*internal.Field, sep string, ignoreNa
 		if i != 0 {
 			str = str + sep
 		}
-		str = str + internal.EscapeColumnName(a.colname(f.Col)) + " = " + a.loader.NthParam(i)
+		str = str + a.escapeident(a.colname(f.Col)) + " = " + a.loader.NthParam(i)
 		i++
 	}
 
@@ -341,7 +374,7 @@ func (a *Generator) fieldnames(fields []*internal.Field, prefix string, ignoreNa
 		}
 
 		if f.CustomType != "" {
-			str = str + f.Type + "(" + prefix + "." + f.Name + ")"
+			str = str + a.spanvalue(f, prefix+"."+f.Name)
 		} else {
 			str = str + prefix + "." + f.Name
 		}
@@ -511,7 +544,7 @@ func (a *Generator) gocustomparamlist(fields []*internal.Field, addPrefix bool,
 		} else {
 			// raw type conversion for custom type
 			if f.CustomType != "" {
-				s = a.retype(f.Type) + "(" + s + ")"
+				s = a.spanvalue(f, s)
 			}
 		}
 
@@ -558,7 +591,30 @@ func (a *Generator) colname(col *models.Column) string {
 
 // escapedcolname returns the ColumnName of col. It is escaped for query.
 func (a *Generator) escapedcolname(col *models.Column) string {
-	return internal.EscapeColumnName(col.ColumnName)
+	return a.escapeident(col.ColumnName)
+}
+
+// escapedtable returns the name of table escaped for query. The names in named
+// schemas are escaped by the parts.
+func (a *Generator) escapedtable(table string) string {
+	if !a.postgresql() {
+		return table
+	}
+	parts := strings.Split(table, ".")
+	for i, p := range parts {
+		parts[i] = a.escapeident(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// escapeident escapes the identifier name for query in the SQL dialect of the
+// database. The double quotes of the PostgreSQL dialect are escaped again to
+// be embedded in Go string literals.
+func (a *Generator) escapeident(name string) string {
+	if !a.postgresql() {
+		return internal.EscapeColumnName(name)
+	}
+	return strings.ReplaceAll(internal.EscapePGIdentifier(name), `"`, `\"`)
 }
 
 // hascolumn takes a list of fields and determines if field with the specified
@@ -607,9 +663,96 @@ func (a *Generator) customtypeparam(name string) string {
 	return "c" + name
 }
 
+// typeConversions is the functions of yo_db converting the values of the Go
+// types of columns, which cannot be converted by the conversion of Go.
+var typeConversions = map[[2]string]string{
+	{"civil.Date", "time.Time"}:                  "yoDateToTime",
+	{"time.Time", "civil.Date"}:                  "yoTimeToDate",
+	{"spanner.NullDate", "spanner.NullTime"}:     "yoNullDateToTime",
+	{"spanner.NullTime", "spanner.NullDate"}:     "yoNullTimeToDate",
+	{"[]civil.Date", "[]time.Time"}:              "yoDatesToTimes",
+	{"[]time.Time", "[]civil.Date"}:              "yoTimesToDates",
+	{"*civil.Date", "*time.Time"}:                "yoDatePtrToTime",
+	{"*time.Time", "*civil.Date"}:                "yoTimePtrToDate",
+	{"[]spanner.NullDate", "[]spanner.NullTime"}: "yoNullDatesToTimes",
+	{"[]spanner.NullTime", "[]spanner.NullDate"}: "yoNullTimesToDates",
+}
+
+// spanvalue returns a Go expression converting expr of the custom type of f
+// into the Go type of the column.
+func (a *Generator) spanvalue(f *internal.Field, expr string) string {
+	if a.customjson(f) {
+		if strings.HasPrefix(f.CustomType, "*") {
+			return f.Type + "{Value: " + expr + ", Valid: " + expr + " != nil}"
+		}
+		return f.Type + "{Value: " + expr + ", Valid: true}"
+	}
+	if fn, ok := typeConversions[[2]string{f.CustomType, f.Type}]; ok {
+		return fn + "(" + expr + ")"
+	}
+
+	return f.Type + "(" + expr + ")"
+}
+
+// customvalue returns a Go expression converting expr of the Go type of the
+// column into the custom type of f.
+func (a *Generator) customvalue(f *internal.Field, expr string) string {
+	if fn, ok := typeConversions[[2]string{f.Type, f.CustomType}]; ok {
+		return fn + "(" + expr + ")"
+	}
+
+	return a.retype(f.CustomType) + "(" + expr + ")"
+}
+
+// customjson reports whether f is a JSON column read and written as the custom
+// type, which is marshaled into and unmarshaled from the JSON value. A nil
+// pointer of the custom type is NULL.
+func (a *Generator) customjson(f *internal.Field) bool {
+	return f.CustomType != "" && (f.Type == "spanner.NullJSON" || f.Type == "spanner.PGJsonB")
+}
+
+// hasjsontypes reports whether any field of the tables is a JSON column of a
+// custom type.
+func (a *Generator) hasjsontypes(tableMap map[string]*internal.Type) bool {
+	for _, t := range tableMap {
+		for _, f := range t.Fields {
+			if a.customjson(f) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hassoftdelete reports whether any of the tables has a soft delete column.
+func (a *Generator) hassoftdelete(tableMap map[string]*internal.Type) bool {
+	for _, t := range tableMap {
+		if t.SoftDeleteField != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasconversions reports whether any field of the tables is converted by the
+// functions of typeConversions.
+func (a *Generator) hasconversions(tableMap map[string]*internal.Type) bool {
+	for _, t := range tableMap {
+		for _, f := range t.Fields {
+			if _, ok := typeConversions[[2]string{f.Type, f.CustomType}]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // goparamname make the first word of name to lowercase
 func (a *Generator) goparamname(name string) string {
-	ns := strings.Split(snaker.CamelToSnake(name), "_")
+	ns := strings.Split(a.initialisms.CamelToSnake(name), "_")
 	name = strings.ToLower(ns[0]) + name[len(ns[0]):]
 
 	// check go reserved names
@@ -633,11 +776,17 @@ func (a *Generator) nullcheck(field *internal.Field) string {
 	case "spanner.NullInt64",
 		"spanner.NullString",
 		"spanner.NullFloat64",
+		"spanner.NullFloat32",
 		"spanner.NullBool",
 		"spanner.NullTime",
-		"spanner.NullDate":
+		"spanner.NullDate",
+		"spanner.NullInterval",
+		"spanner.NullUUID":
 		return fmt.Sprintf("%s.IsNull()", paramName)
 	}
+	if strings.HasPrefix(field.Type, "*") {
+		return fmt.Sprintf("%s == nil", paramName)
+	}
 
 	return fmt.Sprintf("yo, ok := %s.(yoIsNull); ok && yo.IsNull()", paramName)
 }
@@ -646,3 +795,321 @@ func (a *Generator) nullcheck(field *internal.Field) string {
 func (a *Generator) pluralize(s string) string {
 	return a.inflector.Pluralize(s)
 }
+
+// keyprefixes returns the proper prefixes of fields from the shortest one.
+//
+// Used to generate reads by a prefix of the primary key. The empty prefix is
+// never returned because it would read a whole table.
+func (a *Generator) keyprefixes(fields []*internal.Field) [][]*internal.Field {
+	var prefixes [][]*internal.Field
+	for i := 1; i < len(fields); i++ {
+		prefixes = append(prefixes, fields[:i])
+	}
+
+	return prefixes
+}
+
+// keyRange is a range reader of a table, which reads the rows by a prefix of
+// the primary key and a range of the next column of the primary key.
+type keyRange struct {
+	Name   string
+	Prefix []*internal.Field
+	Column *internal.OrderField
+}
+
+// keyranges returns the range readers of t for each prefix of the primary key
+// shorter than it, including the empty one.
+func (a *Generator) keyranges(t *internal.Type) []*keyRange {
+	var ranges []*keyRange
+	for i, f := range t.PrimaryKeyOrder {
+		name := "Read" + t.Name
+		if i > 0 {
+			name += "By"
+			for _, p := range t.PrimaryKeyFields[:i] {
+				name += p.Name
+			}
+		}
+		ranges = append(ranges, &keyRange{Name: name + "Range", Prefix: t.PrimaryKeyFields[:i], Column: f})
+	}
+
+	return ranges
+}
+
+// iscomparable determines if the column of field can be compared by ordering
+// operators in a query. ARRAY and JSON columns are not comparable.
+func (a *Generator) iscomparable(field *internal.Field) bool {
+	dt := field.Col.DataType
+	if a.postgresql() {
+		return !strings.HasSuffix(dt, "[]") && dt != "jsonb"
+	}
+	return !strings.HasPrefix(dt, "ARRAY<") && dt != "JSON"
+}
+
+// orderby creates an ORDER BY clause of fields. It returns an empty string if
+// fields is empty.
+func (a *Generator) orderby(fields []*internal.OrderField) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	terms := make([]string, 0, len(fields))
+	for _, f := range fields {
+		term := a.escapeident(a.colname(f.Field.Col))
+		if f.Desc {
+			term += " DESC"
+		}
+		terms = append(terms, term)
+	}
+
+	return "ORDER BY " + strings.Join(terms, ", ")
+}
+
+// indexkey returns the columns of the index key with the directions as in the
+// DDL for doc comments, such as "A, B DESC".
+func (a *Generator) indexkey(fields []*internal.OrderField) string {
+	terms := make([]string, 0, len(fields))
+	for _, f := range fields {
+		term := a.colname(f.Field.Col)
+		if f.Desc {
+			term += " DESC"
+		}
+		terms = append(terms, term)
+	}
+
+	return strings.Join(terms, ", ")
+}
+
+// jsontag returns the JSON field name of col in the JSON tag case of the
+// generator.
+func (a *Generator) jsontag(col *models.Column) string {
+	switch a.jsonTagCase {
+	case JSONTagCaseSnake:
+		return strings.ToLower(a.initialisms.CamelToSnake(col.ColumnName))
+	case JSONTagCaseCamel:
+		name := a.initialisms.ForceCamelIdentifier(col.ColumnName)
+		ns := strings.Split(a.initialisms.CamelToSnake(name), "_")
+		return strings.ToLower(ns[0]) + name[len(ns[0]):]
+	}
+
+	return col.ColumnName
+}
+
+// fieldtag returns the struct tag of the field f, which is the spanner tag of
+// the column, the JSON tag and the tags of the custom types file. The JSON tag
+// of the custom types file replaces the generated one.
+func (a *Generator) fieldtag(f *internal.Field) string {
+	json := strconv.Quote(a.jsontag(f.Col))
+	var tags []string
+	for _, t := range f.Tags {
+		if t.Key == "json" {
+			json = strconv.Quote(t.Value)
+			continue
+		}
+		tags = append(tags, t.Key+":"+strconv.Quote(t.Value))
+	}
+
+	return strings.Join(append([]string{`spanner:"` + f.Col.ColumnName + `"`, "json:" + json}, tags...), " ")
+}
+
+// testValues is the values of the Go types of columns in the round-trip tests.
+var testValues = map[string]string{
+	"bool":                 "true",
+	"string":               `"a"`,
+	"int64":                "1",
+	"float64":              "1.5",
+	"float32":              "1.5",
+	"[]byte":               `[]byte("a")`,
+	"time.Time":            "time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)",
+	"civil.Date":           "civil.Date{Year: 2000, Month: 1, Day: 1}",
+	"big.Rat":              "*big.NewRat(1, 2)",
+	"spanner.Interval":     "spanner.Interval{Months: 1, Days: 2, Nanos: big.NewInt(3)}",
+	"uuid.UUID":            `uuid.MustParse("00000000-0000-4000-8000-000000000001")`,
+	"spanner.NullBool":     "spanner.NullBool{Bool: true, Valid: true}",
+	"spanner.NullString":   `spanner.NullString{StringVal: "a", Valid: true}`,
+	"spanner.NullInt64":    "spanner.NullInt64{Int64: 1, Valid: true}",
+	"spanner.NullFloat64":  "spanner.NullFloat64{Float64: 1.5, Valid: true}",
+	"spanner.NullFloat32":  "spanner.NullFloat32{Float32: 1.5, Valid: true}",
+	"spanner.NullTime":     "spanner.NullTime{Time: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}",
+	"spanner.NullDate":     "spanner.NullDate{Date: civil.Date{Year: 2000, Month: 1, Day: 1}, Valid: true}",
+	"spanner.NullNumeric":  "spanner.NullNumeric{Numeric: *big.NewRat(1, 2), Valid: true}",
+	"spanner.NullJSON":     `spanner.NullJSON{Value: map[string]interface{}{"a": "b"}, Valid: true}`,
+	"spanner.NullInterval": "spanner.NullInterval{Interval: spanner.Interval{Months: 1, Days: 2, Nanos: big.NewInt(3)}, Valid: true}",
+	"spanner.NullUUID":     `spanner.NullUUID{UUID: uuid.MustParse("00000000-0000-4000-8000-000000000001"), Valid: true}`,
+	"spanner.PGNumeric":    `spanner.PGNumeric{Numeric: "0.5", Valid: true}`,
+	"spanner.PGJsonB":      `spanner.PGJsonB{Value: map[string]interface{}{"a": "b"}, Valid: true}`,
+}
+
+// testvalue returns a Go expression of a non-null value of field for the
+// round-trip tests. It returns an empty string for the fields which keep the
+// zero values, which are the generated columns and the custom types except the
+// enums and the ones converted by typeConversions.
+func (a *Generator) testvalue(field *internal.Field) string {
+	if field.Col.IsGenerated {
+		return ""
+	}
+
+	if field.Enum != nil {
+		return field.Enum.Values[0].Name
+	}
+
+	typ := field.Type
+	if field.CustomType != "" {
+		if _, ok := typeConversions[[2]string{field.Type, field.CustomType}]; !ok {
+			return ""
+		}
+		typ = field.CustomType
+	} else if field.Col.DataType == field.Col.ColumnName {
+		// enum
+		return testValues["string"]
+	}
+	if strings.HasPrefix(typ, "*") {
+		// the pointers of --nullable-pointers
+		v, ok := testValues[typ[1:]]
+		if !ok {
+			return ""
+		}
+		return fmt.Sprintf("func() %s { v := %s(%s); return &v }()", typ, typ[1:], v)
+	}
+	if strings.HasPrefix(typ, "[]") && typ != "[]byte" {
+		v, ok := testValues[typ[2:]]
+		if !ok {
+			return ""
+		}
+		if n := field.Col.VectorLength; n > 0 {
+			// vector columns take exactly vector_length elements
+			return fmt.Sprintf("func() %s { v := make(%s, %d); for i := range v { v[i] = %s }; return v }()", typ, typ, n, v)
+		}
+		return typ + "{" + v + "}"
+	}
+
+	return testValues[typ]
+}
+
+// dmlEnabled reports whether the DML statements of the rows are generated.
+func (a *Generator) dmlEnabled() bool {
+	return a.dml
+}
+
+// hooksEnabled reports whether the hooks of the writes of the rows are
+// generated.
+func (a *Generator) hooksEnabled() bool {
+	return a.hooks
+}
+
+// otelEnabled reports whether the generated reads and writes are traced by
+// OpenTelemetry spans.
+func (a *Generator) otelEnabled() bool {
+	return a.otel
+}
+
+// dataBoostClientVersion is the first version of cloud.google.com/go/spanner
+// whose ReadOptions and QueryOptions have DataBoostEnabled.
+const dataBoostClientVersion = "v1.47.0"
+
+// dataBoostSupported reports whether the partitioned reads and queries are
+// generated with the option to execute them by Data Boost.
+func (a *Generator) dataBoostSupported() bool {
+	return internal.ClientSupports(a.clientVersion, dataBoostClientVersion)
+}
+
+// batchWriteClientVersion is the version of cloud.google.com/go/spanner from
+// which the writes by the BatchWrite API are generated, which has
+// Client.BatchWrite and MutationGroup.
+const batchWriteClientVersion = "v1.53.0"
+
+// batchWriteSupported reports whether the writes of the mutation groups by
+// the BatchWrite API are generated.
+func (a *Generator) batchWriteSupported() bool {
+	return internal.ClientSupports(a.clientVersion, batchWriteClientVersion)
+}
+
+// metricsEnabled reports whether the generated reads and writes report their
+// metrics to YOMetricsRecorder.
+func (a *Generator) metricsEnabled() bool {
+	return a.metrics
+}
+
+// storesEnabled reports whether the interfaces of the reads and writes of the
+// tables are generated with their implementations by a client.
+func (a *Generator) storesEnabled() bool {
+	return a.stores
+}
+
+// autoCommitTimestamp reports whether spanner.CommitTimestamp is written into
+// the columns having the allow_commit_timestamp option.
+func (a *Generator) autoCommitTimestamp() bool {
+	return !a.noCommitTimestamp
+}
+
+// forceindex returns the FORCE_INDEX hint of index for the finders, or an
+// empty string if the hints are disabled to let the optimizer choose.
+func (a *Generator) forceindex(index string) string {
+	if a.noForceIndex {
+		return ""
+	}
+	return a.indexhint(index)
+}
+
+// indexhint returns the FORCE_INDEX hint of index in the SQL dialect of the
+// database.
+func (a *Generator) indexhint(index string) string {
+	if a.postgresql() {
+		return " /*@ FORCE_INDEX=" + index + " */"
+	}
+	return "@{FORCE_INDEX=" + index + "}"
+}
+
+// postgresql reports whether the database is in the PostgreSQL dialect, whose
+// queries are generated with the positional parameters such as $1.
+func (a *Generator) postgresql() bool {
+	return a.dialect == internal.DialectPostgreSQL
+}
+
+// param returns the placeholder of the 0-based ith parameter of a query.
+func (a *Generator) param(i int) string {
+	return a.loader.NthParam(i)
+}
+
+// paramname returns the name of the 0-based ith parameter of a query in the
+// Params of spanner.Statement.
+func (a *Generator) paramname(i int) string {
+	if a.postgresql() {
+		return fmt.Sprintf("p%d", i+1)
+	}
+	return fmt.Sprintf("param%d", i)
+}
+
+// committsfields returns the fields of the columns having the
+// allow_commit_timestamp option.
+func (a *Generator) committsfields(fields []*internal.Field) []*internal.Field {
+	var res []*internal.Field
+	for _, f := range fields {
+		if strings.EqualFold(f.Col.Options["allow_commit_timestamp"], "true") {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// ancestors returns the tables which t is interleaved in from the root.
+func (a *Generator) ancestors(t *internal.Type) []*internal.Type {
+	var types []*internal.Type
+	for p := t.Parent; p != nil; p = p.Parent {
+		types = append([]*internal.Type{p}, types...)
+	}
+
+	return types
+}
+
+// orphan determines if any of the tables which t is interleaved in is not
+// generated.
+func (a *Generator) orphan(t *internal.Type) bool {
+	for ; t != nil; t = t.Parent {
+		if t.Table.ParentTableName != "" && t.Parent == nil {
+			return true
+		}
+	}
+
+	return false
+}
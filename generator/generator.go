@@ -23,10 +23,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"go/token"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"text/template"
@@ -45,19 +47,81 @@ type Loader interface {
 	NthParam(i int) string
 }
 
+// JSON tag cases of the generated struct fields.
+const (
+	JSONTagCaseAsIs  = "as-is"
+	JSONTagCaseSnake = "snake"
+	JSONTagCaseCamel = "camel"
+)
+
+// ValidateCustomTypeImports validates the import aliases and the import paths
+// of the custom types given by the user.
+func ValidateCustomTypeImports(imports map[string]string) error {
+	for alias, path := range imports {
+		if !token.IsIdentifier(alias) {
+			return fmt.Errorf("invalid import alias '%s'", alias)
+		}
+		if path == "" {
+			return fmt.Errorf("import path of '%s' is empty", alias)
+		}
+	}
+	return nil
+}
+
+// ValidateJSONTagCase validates the JSON tag case given by the user.
+func ValidateJSONTagCase(c string) error {
+	switch c {
+	case "", JSONTagCaseAsIs, JSONTagCaseSnake, JSONTagCaseCamel:
+		return nil
+	}
+	return fmt.Errorf("unknown json tag case '%s', must be one of %s, %s or %s", c, JSONTagCaseAsIs, JSONTagCaseSnake, JSONTagCaseCamel)
+}
+
 type GeneratorOption struct {
 	PackageName        string
 	Tags               string
+	Header             string
+	Source             string
 	TemplatePath       string
 	CustomTypePackage  string
+	CustomTypeImports  map[string]string
 	FilenameSuffix     string
 	SingleFile         bool
 	Filename           string
 	FilenameUnderscore bool
 	Path               string
+	JSONTagCase        string
+	Tests              bool
+	DML                bool
+	NoCommitTimestamp  bool
+	NoForceIndex       bool
+	Hooks              bool
+	OTel               bool
+	Metrics            bool
+	Stores             bool
+	Groups             map[string]string
+	GroupBySchema      bool
+	Dialect            string
+
+	// ClientVersion is the version of cloud.google.com/go/spanner used by the
+	// generated code, which is the latest if empty.
+	ClientVersion string
+
+	// Initialisms is the initialisms kept upper case in the generated names,
+	// which are the common ones if nil.
+	Initialisms *snaker.Initialisms
+
+	// TemplateFuncs is the extra functions available in the templates, which
+	// override the built-in functions of the same names.
+	TemplateFuncs template.FuncMap
 }
 
 func NewGenerator(loader Loader, inflector internal.Inflector, opt GeneratorOption) *Generator {
+	initialisms := opt.Initialisms
+	if initialisms == nil {
+		initialisms = snaker.DefaultInitialisms
+	}
+
 	return &Generator{
 		loader:             loader,
 		inflector:          inflector,
@@ -65,12 +129,30 @@ func NewGenerator(loader Loader, inflector internal.Inflector, opt GeneratorOpti
 		nameConflictSuffix: "z",
 		packageName:        opt.PackageName,
 		tags:               opt.Tags,
+		header:             opt.Header,
+		source:             opt.Source,
 		customTypePackage:  opt.CustomTypePackage,
+		customTypeImports:  opt.CustomTypeImports,
 		filenameSuffix:     opt.FilenameSuffix,
 		singleFile:         opt.SingleFile,
 		filename:           opt.Filename,
 		filenameUnderscore: opt.FilenameUnderscore,
 		path:               opt.Path,
+		jsonTagCase:        opt.JSONTagCase,
+		tests:              opt.Tests,
+		dml:                opt.DML,
+		noCommitTimestamp:  opt.NoCommitTimestamp,
+		noForceIndex:       opt.NoForceIndex,
+		hooks:              opt.Hooks,
+		otel:               opt.OTel,
+		metrics:            opt.Metrics,
+		stores:             opt.Stores,
+		groups:             opt.Groups,
+		groupBySchema:      opt.GroupBySchema,
+		dialect:            opt.Dialect,
+		clientVersion:      opt.ClientVersion,
+		templateFuncs:      opt.TemplateFuncs,
+		initialisms:        initialisms,
 		files:              make(map[string]*os.File),
 	}
 }
@@ -88,39 +170,101 @@ type Generator struct {
 
 	packageName        string
 	tags               string
+	header             string
+	source             string
 	customTypePackage  string
+	customTypeImports  map[string]string
 	filenameSuffix     string
 	singleFile         bool
 	filename           string
 	filenameUnderscore bool
 	path               string
+	jsonTagCase        string
+	tests              bool
+	dml                bool
+	noCommitTimestamp  bool
+	noForceIndex       bool
+	hooks              bool
+	otel               bool
+	metrics            bool
+	stores             bool
+	groups             map[string]string
+	groupBySchema      bool
+	dialect            string
+	clientVersion      string
+	templateFuncs      template.FuncMap
+	initialisms        *snaker.Initialisms
+
+	// enums is the names of the enums of the tables being generated, which
+	// are defined in the generated package.
+	enums map[string]bool
 
 	nameConflictSuffix string
 }
 
 func (g *Generator) newTemplateSet() *templateSet {
+	funcs := g.newTemplateFuncs()
+	for name, fn := range g.templateFuncs {
+		funcs[name] = fn
+	}
+
 	return &templateSet{
-		funcs: g.newTemplateFuncs(),
+		funcs: funcs,
 		l:     g.templateLoader,
 		tpls:  map[string]*template.Template{},
 	}
 }
 
-// TemplateLoader loads templates from the specified name.
+// TemplateLoader loads templates from the specified name. The templates in
+// the template path override the built-in ones, and the others fall back to
+// the built-in ones.
 func (g *Generator) templateLoader(name string) ([]byte, error) {
-	// no template path specified
-	if g.templatePath == "" {
-		f, err := templates.Assets.Open(name)
-		if err != nil {
-			return nil, err
+	if g.templatePath != "" {
+		b, err := ioutil.ReadFile(path.Join(g.templatePath, name))
+		if !os.IsNotExist(err) {
+			return b, err
 		}
-		return ioutil.ReadAll(f)
 	}
 
-	return ioutil.ReadFile(path.Join(g.templatePath, name))
+	f, err := templates.Assets.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(f)
+}
+
+// UnknownTemplates returns the template files in the template path dir which
+// do not override any of the built-in templates, such as misspelled ones.
+func UnknownTemplates(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var unknown []string
+	for _, fi := range files {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != ".tpl" {
+			continue
+		}
+		if _, ok := templates.Assets.Files[fi.Name()]; !ok {
+			unknown = append(unknown, fi.Name())
+		}
+	}
+	return unknown, nil
 }
 
 func (g *Generator) Generate(tableMap map[string]*internal.Type, ixMap map[string]*internal.Index) error {
+	if len(g.groups) != 0 || g.groupBySchema {
+		return g.generateGroups(tableMap, ixMap)
+	}
+
+	g.enums = make(map[string]bool)
+	for _, t := range tableMap {
+		for _, e := range t.Enums {
+			g.enums[e.Name] = true
+		}
+	}
+
 	// generate table templates
 	for _, t := range tableMap {
 		if err := g.ExecuteTemplate(TypeTemplate, t.Name, "", t); err != nil {
@@ -130,20 +274,51 @@ func (g *Generator) Generate(tableMap map[string]*internal.Type, ixMap map[strin
 
 	// generate index templates
 	for _, ix := range ixMap {
-		if err := g.ExecuteTemplate(IndexTemplate, ix.Type.Name, ix.Index.IndexName, ix); err != nil {
+		sub := ix.Index.IndexName
+		if ix.IsPrefix {
+			// prefix finders follow the finder of the whole index key
+			sub += "/" + ix.FuncName
+		}
+		if err := g.ExecuteTemplate(IndexTemplate, ix.Type.Name, sub, ix); err != nil {
+			return err
+		}
+	}
+
+	// generate change stream templates
+	changeStreams := changeStreamsOf(tableMap)
+	for _, cs := range changeStreams {
+		if err := g.ExecuteTemplate(ChangeStreamTemplate, cs.Name, "", cs); err != nil {
 			return err
 		}
 	}
 
+	imports, err := g.imports(tableMap)
+	if err != nil {
+		return err
+	}
+
 	ds := &basicDataSet{
-		Package:  g.packageName,
-		TableMap: tableMap,
+		Package:       g.packageName,
+		Imports:       imports,
+		TableMap:      tableMap,
+		ChangeStreams: changeStreams,
 	}
 
 	if err := g.ExecuteTemplate(YOTemplate, "yo_db", "", ds); err != nil {
 		return err
 	}
 
+	if g.tests {
+		for _, t := range tableMap {
+			if err := g.ExecuteTemplate(TypeTestTemplate, t.Name, "", t); err != nil {
+				return err
+			}
+		}
+		if err := g.ExecuteTemplate(YOTestTemplate, "yo_db", "", ds); err != nil {
+			return err
+		}
+	}
+
 	if err := g.writeTypes(ds); err != nil {
 		return err
 	}
@@ -151,6 +326,75 @@ func (g *Generator) Generate(tableMap map[string]*internal.Type, ixMap map[strin
 	return nil
 }
 
+// changeStreamsOf returns the change streams watching the tables of tableMap
+// sorted by the names. The watched tables of the change streams are limited to
+// the ones in tableMap.
+func changeStreamsOf(tableMap map[string]*internal.Type) []*internal.ChangeStream {
+	seen := make(map[*internal.ChangeStream]bool)
+	var res []*internal.ChangeStream
+	for _, t := range tableMap {
+		for _, cs := range t.ChangeStreams {
+			if seen[cs] {
+				continue
+			}
+			seen[cs] = true
+
+			v := *cs
+			v.Types = nil
+			for _, wt := range cs.Types {
+				if tableMap[wt.Table.TableName] == wt {
+					v.Types = append(v.Types, wt)
+				}
+			}
+			res = append(res, &v)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+// imports returns the imports of the custom types and the proto types sorted by
+// the aliases. The packages of the types qualified by the import paths are
+// imported by their names.
+func (g *Generator) imports(tableMap map[string]*internal.Type) ([]*Import, error) {
+	paths := make(map[string]string, len(g.customTypeImports))
+	for alias, path := range g.customTypeImports {
+		paths[alias] = path
+	}
+	for _, t := range tableMap {
+		for _, f := range t.Fields {
+			if f.TypeImport != "" {
+				alias := path.Base(f.TypeImport)
+				if p, ok := paths[alias]; ok && p != f.TypeImport {
+					return nil, fmt.Errorf("package '%s' of proto types is imported from both '%s' and '%s'", alias, p, f.TypeImport)
+				}
+				paths[alias] = f.TypeImport
+			}
+			if f.CustomTypeImport == "" {
+				continue
+			}
+			alias := path.Base(f.CustomTypeImport)
+			if p, ok := paths[alias]; ok && p != f.CustomTypeImport {
+				return nil, fmt.Errorf("package '%s' of custom types is imported from both '%s' and '%s'", alias, p, f.CustomTypeImport)
+			}
+			paths[alias] = f.CustomTypeImport
+		}
+	}
+
+	imports := make([]*Import, 0, len(paths))
+	for alias, path := range paths {
+		imports = append(imports, &Import{Alias: alias, Path: path})
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		return imports[i].Alias < imports[j].Alias
+	})
+
+	return imports, nil
+}
+
 // getFile builds the filepath from the TBuf information, and retrieves the
 // file from files. If the built filename is not already defined, then it calls
 // the os.OpenFile with the correct parameters depending on the state of args.
@@ -161,10 +405,13 @@ func (g *Generator) getFile(ds *basicDataSet, t *TBuf) (*os.File, error) {
 	case g.singleFile:
 		filename = g.filename
 	case g.filenameUnderscore:
-		filename = snaker.CamelToSnake(t.Name) + g.filenameSuffix
+		filename = g.initialisms.CamelToSnake(t.Name) + g.filenameSuffix
 	default:
 		filename = strings.ToLower(t.Name) + g.filenameSuffix
 	}
+	if t.TemplateType.IsTest() {
+		filename = strings.TrimSuffix(filename, ".go") + "_test.go"
+	}
 	filename = path.Join(g.path, filename)
 
 	// lookup file
@@ -194,7 +441,13 @@ func (g *Generator) getFile(ds *basicDataSet, t *TBuf) (*os.File, error) {
 	}
 
 	// execute
-	if err := g.newTemplateSet().Execute(f, "yo_package.go.tpl", ds); err != nil {
+	header, err := g.renderHeader(ds, t)
+	if err != nil {
+		return nil, err
+	}
+	pds := *ds
+	pds.Header = header
+	if err := g.newTemplateSet().Execute(f, "yo_package.go.tpl", &pds); err != nil {
 		return nil, err
 	}
 
@@ -204,6 +457,55 @@ func (g *Generator) getFile(ds *basicDataSet, t *TBuf) (*os.File, error) {
 	return f, nil
 }
 
+// generatedCodeRegexp matches the comment which marks a file as generated.
+var generatedCodeRegexp = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+// headerData is the data of the header template.
+type headerData struct {
+	Package string
+	Table   string
+	Source  string
+}
+
+// renderHeader renders the header template given by the user for the file of
+// t. It returns "" to use the default header if no header template is given.
+// The comment marking the file as generated is added if the header does not
+// have it.
+func (g *Generator) renderHeader(ds *basicDataSet, t *TBuf) (string, error) {
+	if g.header == "" {
+		return "", nil
+	}
+
+	tpl, err := template.New("header").Parse(g.header)
+	if err != nil {
+		return "", fmt.Errorf("invalid header template: %v", err)
+	}
+
+	data := &headerData{
+		Package: ds.Package,
+		Source:  g.source,
+	}
+	if !g.singleFile {
+		for _, typ := range ds.TableMap {
+			if typ.Name == t.Name {
+				data.Table = typ.Table.TableName
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("invalid header template: %v", err)
+	}
+
+	header := strings.TrimRight(buf.String(), "\n")
+	if !generatedCodeRegexp.MatchString(header) {
+		header = "// Code generated by yo. DO NOT EDIT.\n" + header
+	}
+
+	return header, nil
+}
+
 // importsOptions is the same as x/tools/cmd/goimports options except Fragment.
 var importsOptions = &imports.Options{
 	TabWidth:  8,
@@ -0,0 +1,133 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"go.mercari.io/yo/internal"
+)
+
+// ValidateGroups validates the groups of tables given by the user, which map
+// the prefixes of the table names to the subpackages.
+func ValidateGroups(groups map[string]string) error {
+	for prefix, pkg := range groups {
+		if prefix == "" {
+			return fmt.Errorf("prefix of group '%s' is empty", pkg)
+		}
+		if !token.IsIdentifier(pkg) {
+			return fmt.Errorf("invalid package name '%s' of group '%s'", pkg, prefix)
+		}
+	}
+	return nil
+}
+
+// groupOf returns the subpackage of the table, which is given by the longest
+// prefix of the table name. If no prefix matches, the tables in named schemas
+// are grouped by the lower-cased schemas with groupBySchema. It returns "" if
+// the table is in no group.
+func (g *Generator) groupOf(table string) string {
+	var prefix string
+	for p := range g.groups {
+		if strings.HasPrefix(table, p) && len(p) > len(prefix) {
+			prefix = p
+		}
+	}
+	if prefix == "" && g.groupBySchema {
+		if schema, _, ok := strings.Cut(table, "."); ok {
+			return strings.ToLower(schema)
+		}
+	}
+	return g.groups[prefix]
+}
+
+// generateGroups generates the tables in the groups into the subpackages and
+// the other tables into the package of the generator.
+func (g *Generator) generateGroups(tableMap map[string]*internal.Type, ixMap map[string]*internal.Index) error {
+	tableMaps := make(map[string]map[string]*internal.Type)
+	for name, t := range tableMap {
+		pkg := g.groupOf(name)
+		if tableMaps[pkg] == nil {
+			tableMaps[pkg] = make(map[string]*internal.Type)
+		}
+		tableMaps[pkg][name] = t
+	}
+
+	ixMaps := make(map[string]map[string]*internal.Index)
+	for key, ix := range ixMap {
+		pkg := g.groupOf(ix.Type.Table.TableName)
+		if ixMaps[pkg] == nil {
+			ixMaps[pkg] = make(map[string]*internal.Index)
+		}
+		ixMaps[pkg][key] = ix
+	}
+
+	pkgs := make([]string, 0, len(tableMaps))
+	for pkg := range tableMaps {
+		if pkg != "" && !token.IsIdentifier(pkg) {
+			return fmt.Errorf("invalid package name '%s' of the tables of a named schema", pkg)
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	for _, pkg := range pkgs {
+		// the tables interleaved in or referencing tables of other packages
+		// cannot refer to them
+		for _, t := range tableMaps[pkg] {
+			if t.Parent != nil && g.groupOf(t.Parent.Table.TableName) != pkg {
+				t.Parent = nil
+			}
+
+			var fks []*internal.ForeignKey
+			for _, fk := range t.ForeignKeys {
+				if g.groupOf(fk.RefType.Table.TableName) == pkg {
+					fks = append(fks, fk)
+				}
+			}
+			t.ForeignKeys = fks
+		}
+
+		sub := *g
+		sub.groups = nil
+		sub.groupBySchema = false
+		sub.files = make(map[string]*os.File)
+		sub.generated = nil
+		if pkg != "" {
+			sub.packageName = pkg
+			sub.path = path.Join(g.path, pkg)
+			sub.filename = pkg + g.filenameSuffix
+			if err := os.MkdirAll(sub.path, 0o755); err != nil {
+				return err
+			}
+		}
+
+		if err := sub.Generate(tableMaps[pkg], ixMaps[pkg]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
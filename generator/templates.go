@@ -33,9 +33,14 @@ type TemplateType uint
 const (
 	TypeTemplate TemplateType = iota
 	IndexTemplate
+	ChangeStreamTemplate
 
 	// always last
 	YOTemplate
+
+	// the templates of the test files
+	TypeTestTemplate
+	YOTestTemplate
 )
 
 // String returns the name for the associated template type.
@@ -48,12 +53,23 @@ func (tt TemplateType) String() string {
 		s = "type"
 	case IndexTemplate:
 		s = "index"
+	case ChangeStreamTemplate:
+		s = "changestream"
+	case TypeTestTemplate:
+		s = "type_test"
+	case YOTestTemplate:
+		s = "yo_db_test"
 	default:
 		panic("unknown TemplateType")
 	}
 	return s
 }
 
+// IsTest determines if the template generates a test file.
+func (tt TemplateType) IsTest() bool {
+	return tt == TypeTestTemplate || tt == YOTestTemplate
+}
+
 var (
 	// KnownTypeMap is the collection of known Go types.
 	KnownTypeMap = map[string]bool{
@@ -114,7 +130,18 @@ var (
 // basicDataSet is used for template data for yo_db and yo_package.
 type basicDataSet struct {
 	Package  string
+	Header   string
+	Imports  []*Import
 	TableMap map[string]*internal.Type
+
+	// ChangeStreams is the change streams watching the tables of TableMap.
+	ChangeStreams []*internal.ChangeStream
+}
+
+// Import is an import of the package referred by the custom types.
+type Import struct {
+	Alias string
+	Path  string
 }
 
 // templateSet is a set of templates.
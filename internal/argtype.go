@@ -19,6 +19,12 @@
 
 package internal
 
+import (
+	"text/template"
+
+	"go.mercari.io/yo/models"
+)
+
 // ArgType is the type that specifies the command line arguments.
 type ArgType struct {
 	// Project is the GCP project string
@@ -33,6 +39,10 @@ type ArgType struct {
 	// CustomTypesFile is the path for custom table field type definition file (xx.yml)
 	CustomTypesFile string
 
+	// CustomTypes is the custom table field type definitions given by the
+	// config file, which are overridden by CustomTypesFile.
+	CustomTypes *models.CustomTypes
+
 	// Out is the output path. If Out is a file, then that will be used as the
 	// path. If Out is a directory, then the output file will be
 	// Out/<$CWD>.yo.go
@@ -51,6 +61,15 @@ type ArgType struct {
 	// CustomTypePackage is the Go package name to use for unknown types.
 	CustomTypePackage string
 
+	// ColumnTypes is the map of the columns qualified by the tables such as
+	// Users.Settings to the Go types of the fields, which override the custom
+	// types.
+	ColumnTypes map[string]string
+
+	// CustomTypeImports is the map of the import aliases to the import paths
+	// of the packages referred by the custom types.
+	CustomTypeImports map[string]string
+
 	// TargetTables allows the user to specify table names which should be
 	// handled by yo in the generated code.
 	TargetTables []string
@@ -63,6 +82,71 @@ type ArgType struct {
 	// handled by yo in the generated code.
 	IgnoreTables []string
 
+	// Tables allows the user to specify patterns of table names which should
+	// be handled by yo in the generated code, which are regular expressions if
+	// enclosed in slashes such as /^users?$/ or glob patterns otherwise. All
+	// tables are handled if empty.
+	Tables []string
+
+	// ExcludeTables allows the user to specify patterns of table names which
+	// should not be handled by yo in the generated code in the same format as
+	// Tables.
+	ExcludeTables []string
+
+	// Include and Exclude are the deprecated aliases of Tables and
+	// ExcludeTables, whose patterns are added to them.
+	Include []string
+	Exclude []string
+
+	// OmitFinderOrder disables ORDER BY of the index finders which read rows
+	// by a prefix of the index key.
+	OmitFinderOrder bool
+
+	// JSONTagCase is the naming convention of the JSON tags of the generated
+	// struct fields.
+	JSONTagCase string
+
+	// Initialisms is the initialisms such as SKU kept upper case in the
+	// generated names in addition to the common ones such as ID and URL.
+	Initialisms []string
+
+	// NoSingularize toggles keeping the table names plural in the names of
+	// the generated types.
+	NoSingularize bool
+
+	// Names is the map of the tables such as Users and the columns qualified
+	// by the tables such as Users.SKU to the names of the generated types and
+	// fields, which override the names derived from them.
+	Names map[string]string
+
+	// CheckEnums toggles typing the columns constrained by
+	// CHECK (column IN (...)) as string enums.
+	CheckEnums bool
+
+	// Dialect is the SQL dialect of the database, which is either
+	// DialectGoogleSQL or DialectPostgreSQL.
+	Dialect string
+
+	// DateType is the Go type of DATE columns, which is either DateTypeCivil
+	// or DateTypeTime.
+	DateType string
+
+	// NullablePointers toggles typing the nullable scalar columns by the
+	// pointers such as *string instead of spanner.NullString.
+	NullablePointers bool
+
+	// SpannerClientVersion is the version of cloud.google.com/go/spanner which
+	// the generated code is compiled with.
+	SpannerClientVersion string
+
+	// ProtoDescriptorsFile is the path of the FileDescriptorSet of the proto
+	// bundle, which tells the kinds and the Go packages of the proto types.
+	ProtoDescriptorsFile string
+
+	// ProtoPackages is the map of the proto packages to the import paths of
+	// the Go packages of the proto types.
+	ProtoPackages map[string]string
+
 	// TemplatePath is the path to use the user supplied templates instead of
 	// the built in versions.
 	TemplatePath string
@@ -70,16 +154,93 @@ type ArgType struct {
 	// Tags is the list of build tags to add to generated Go files.
 	Tags string
 
+	// Header is the template of the header of generated Go files, which
+	// replaces the default header.
+	Header string
+
+	// HeaderFile is the path of the file of the header template.
+	HeaderFile string
+
+	// Groups is the map of the prefixes of the table names to the
+	// subpackages which the tables are generated into.
+	Groups map[string]string
+
+	// GroupBySchema toggles generating the tables in named schemas into the
+	// subpackages named by the schemas unless they are in Groups.
+	GroupBySchema bool
+
+	// Tests toggles the generation of the round-trip tests of the tables.
+	Tests bool
+
+	// DML toggles the generation of the DML statements of the rows, which
+	// are run by the Update of read-write transactions.
+	DML bool
+
+	// Hooks toggles the generation of the hooks called by the writes of the
+	// rows.
+	Hooks bool
+
+	// OTel toggles the OpenTelemetry spans of the generated reads and writes.
+	OTel bool
+
+	// Metrics toggles the metrics of the generated reads and writes, with the
+	// Prometheus implementation of the recorder.
+	Metrics bool
+
+	// Stores toggles the interfaces of the reads and writes of the tables,
+	// which are implemented by a Spanner client, so that they can be mocked.
+	Stores bool
+
+	// NoCommitTimestamp disables setting spanner.CommitTimestamp to the
+	// columns having the allow_commit_timestamp option on writes.
+	NoCommitTimestamp bool
+
+	// NoForceIndex omits the FORCE_INDEX hints of the finders by indexes.
+	NoForceIndex bool
+
 	Path               string
 	Filename           string
 	FilenameUnderscore bool
 
-	// DDLFilepath is the filepath of the ddl file.
+	// DDLFilepath is the filepath of the ddl file, or the source of the
+	// loader of Loader.
 	DDLFilepath string
 
+	// Loader is the name of the loader registered by loaders.Register, which
+	// loads the schema from DDLFilepath instead of the database or the ddl.
+	Loader string
+
+	// DDLPaths is the paths of the ddl files given by --ddl-path in addition
+	// to DDLFilepath, each of which is a file, a directory or a glob pattern.
+	DDLPaths []string
+
+	// EmulatorDDLPaths is the paths of the ddl files applied to the database
+	// recreated on the Spanner emulator before loading the schema from it.
+	EmulatorDDLPaths []string
+
 	// FromDDL indicates generating from ddl file or not.
 	FromDDL bool
 
+	// Watch toggles regenerating whenever the ddl file changes.
+	Watch bool
+
 	// InflectionRuleFile is custom inflection rule file.
 	InflectionRuleFile string
+
+	// InflectionRules is the custom inflection rules given by the config
+	// file, which are overridden by InflectionRuleFile.
+	InflectionRules []InflectRule
+
+	// ConfigFile is the path of the config file, whose values are
+	// overridden by the flags. yo.yaml in the current directory is read if
+	// empty and it exists.
+	ConfigFile string
+
+	// Hook is the hook which post-processes the schema before the code is
+	// generated, which is given by the program running yo as a library.
+	Hook models.Hook
+
+	// TemplateFuncs is the extra functions available in the templates, which
+	// are given by the program running yo as a library.
+	TemplateFuncs template.FuncMap
 }
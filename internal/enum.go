@@ -0,0 +1,113 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package internal
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// loadEnums types the NOT NULL STRING columns constrained by
+// CHECK (column IN (...)) as string enums, which are named by the type and the
+// field such as OrderStatus. The columns stay strings if the names of the
+// enums or their values conflict.
+func loadEnums(tableMap map[string]*Type) {
+	tables := make([]string, 0, len(tableMap))
+	names := make(map[string]bool, len(tableMap))
+	for name, t := range tableMap {
+		tables = append(tables, name)
+		names[t.Name] = true
+	}
+	sort.Strings(tables)
+
+	for _, table := range tables {
+		t := tableMap[table]
+		for _, f := range t.Fields {
+			if f.CustomType != "" || f.Type != "string" || len(f.Col.EnumValues) == 0 {
+				continue
+			}
+
+			name := t.Name + f.Name
+			if names[name] {
+				fmt.Fprintf(os.Stderr, "warning: enum %s of %s.%s conflicts with another type, the column is generated as string\n", name, table, f.Col.ColumnName)
+				continue
+			}
+
+			e, err := newEnum(name, f)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "warning: %v, the column %s.%s is generated as string\n", err, table, f.Col.ColumnName)
+				continue
+			}
+			names[name] = true
+			f.Enum, f.CustomType = e, name
+			t.Enums = append(t.Enums, e)
+		}
+	}
+}
+
+// newEnum creates the enum of the values of f named name.
+func newEnum(name string, f *Field) (*Enum, error) {
+	e := &Enum{Name: name, Field: f}
+	constants := make(map[string]string, len(f.Col.EnumValues))
+	for _, v := range f.Col.EnumValues {
+		n := enumValueName(v)
+		if n == "" {
+			return nil, fmt.Errorf("value '%s' of enum %s has no name", v, name)
+		}
+		if prev, ok := constants[n]; ok {
+			if prev == v {
+				continue
+			}
+			return nil, fmt.Errorf("values '%s' and '%s' of enum %s have the same name", prev, v, name)
+		}
+		constants[n] = v
+		e.Values = append(e.Values, &EnumValue{Name: name + n, Value: v})
+	}
+
+	return e, nil
+}
+
+// enumValueName returns the name of the constant of an enum value in
+// CamelCase, such as InProgress for IN_PROGRESS, in-progress and inProgress.
+// It is empty if v has no letters or digits.
+func enumValueName(v string) string {
+	if strings.ToUpper(v) == v {
+		v = strings.ToLower(v)
+	}
+
+	var b strings.Builder
+	upper := true
+	for _, r := range v {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package internal
+
+import (
+	"sort"
+
+	"go.mercari.io/yo/models"
+)
+
+// hookLoader is a loader which serves the schema loaded from loader and
+// post-processed by a hook.
+type hookLoader struct {
+	loaderImpl
+	tables map[string]*models.TableSchema
+	schema *models.Schema
+
+	// names is the names of the tables in loader by the names after the
+	// hook.
+	names map[string]string
+}
+
+// newHookLoader loads the whole schema from loader, and applies hook to it.
+func newHookLoader(loader loaderImpl, hook models.Hook) (*hookLoader, error) {
+	tableList, err := loader.TableList()
+	if err != nil {
+		return nil, err
+	}
+	viewList, err := loader.ViewList()
+	if err != nil {
+		return nil, err
+	}
+	tableList = append(tableList, viewList...)
+	sort.Slice(tableList, func(i, j int) bool {
+		return tableList[i].TableName < tableList[j].TableName
+	})
+
+	schema := &models.Schema{}
+	for _, t := range tableList {
+		ts := &models.TableSchema{Table: t, IndexColumns: make(map[string][]*models.IndexColumn)}
+		if ts.Columns, err = loader.ColumnList(t.TableName); err != nil {
+			return nil, err
+		}
+		if ts.Indexes, err = loader.IndexList(t.TableName); err != nil {
+			return nil, err
+		}
+		for _, ix := range append([]*models.Index{{IndexName: "PRIMARY_KEY"}}, ts.Indexes...) {
+			if ts.IndexColumns[ix.IndexName], err = loader.IndexColumnList(t.TableName, ix.IndexName); err != nil {
+				return nil, err
+			}
+		}
+		schema.Tables = append(schema.Tables, ts)
+	}
+
+	orig := make(map[*models.TableSchema]string, len(schema.Tables))
+	for _, ts := range schema.Tables {
+		orig[ts] = ts.Table.TableName
+	}
+
+	if err := hook(schema); err != nil {
+		return nil, err
+	}
+
+	// the tables are looked up by the names after the hook, which may rename
+	// or remove them
+	l := &hookLoader{
+		loaderImpl: loader,
+		tables:     make(map[string]*models.TableSchema, len(schema.Tables)),
+		schema:     schema,
+		names:      make(map[string]string, len(schema.Tables)),
+	}
+	for _, ts := range schema.Tables {
+		l.tables[ts.Table.TableName] = ts
+		if name, ok := orig[ts]; ok {
+			l.names[ts.Table.TableName] = name
+		}
+	}
+
+	return l, nil
+}
+
+func (l *hookLoader) TableList() ([]*models.Table, error) {
+	var tables []*models.Table
+	for _, ts := range l.schema.Tables {
+		if !ts.Table.IsView {
+			tables = append(tables, ts.Table)
+		}
+	}
+	return tables, nil
+}
+
+func (l *hookLoader) ViewList() ([]*models.Table, error) {
+	var views []*models.Table
+	for _, ts := range l.schema.Tables {
+		if ts.Table.IsView {
+			views = append(views, ts.Table)
+		}
+	}
+	return views, nil
+}
+
+func (l *hookLoader) ColumnList(table string) ([]*models.Column, error) {
+	return l.tables[table].Columns, nil
+}
+
+func (l *hookLoader) IndexList(table string) ([]*models.Index, error) {
+	return l.tables[table].Indexes, nil
+}
+
+func (l *hookLoader) IndexColumnList(table, index string) ([]*models.IndexColumn, error) {
+	return l.tables[table].IndexColumns[index], nil
+}
+
+// ViewDependencies returns the tables which view depends on if loader knows
+// them. The dependencies are the ones before the hook.
+func (l *hookLoader) ViewDependencies(view string) ([]string, error) {
+	vl, ok := l.loaderImpl.(viewDependencyLoader)
+	name, known := l.names[view]
+	if !ok || !known {
+		return nil, nil
+	}
+	return vl.ViewDependencies(name)
+}
+
+// SearchIndexList returns the search indexes of table if loader knows them.
+func (l *hookLoader) SearchIndexList(table string) ([]*models.SearchIndex, error) {
+	sl, ok := l.loaderImpl.(searchIndexLoader)
+	name, known := l.names[table]
+	if !ok || !known {
+		return nil, nil
+	}
+	return sl.SearchIndexList(name)
+}
+
+// VectorIndexList returns the vector indexes of table if loader knows them.
+func (l *hookLoader) VectorIndexList(table string) ([]*models.VectorIndex, error) {
+	vl, ok := l.loaderImpl.(vectorIndexLoader)
+	name, known := l.names[table]
+	if !ok || !known {
+		return nil, nil
+	}
+	return vl.VectorIndexList(name)
+}
+
+// ChangeStreamList returns the change streams if loader knows them. The
+// watched tables are renamed by the hook.
+func (l *hookLoader) ChangeStreamList() ([]*models.ChangeStream, error) {
+	cl, ok := l.loaderImpl.(changeStreamLoader)
+	if !ok {
+		return nil, nil
+	}
+
+	streams, err := cl.ChangeStreamList()
+	if err != nil {
+		return nil, err
+	}
+
+	renamed := make(map[string]string, len(l.names))
+	for after, before := range l.names {
+		renamed[before] = after
+	}
+
+	var res []*models.ChangeStream
+	for _, stream := range streams {
+		v := *stream
+		v.TableNames = nil
+		for _, table := range stream.TableNames {
+			// the tables removed by the hook are not watched
+			if name, ok := renamed[table]; ok {
+				v.TableNames = append(v.TableNames, name)
+			}
+		}
+		res = append(res, &v)
+	}
+	return res, nil
+}
+
+// ForeignKeyList returns the foreign keys of table if loader knows them. The
+// referenced tables are renamed by the hook.
+func (l *hookLoader) ForeignKeyList(table string) ([]*models.ForeignKey, error) {
+	fl, ok := l.loaderImpl.(foreignKeyLoader)
+	name, known := l.names[table]
+	if !ok || !known {
+		return nil, nil
+	}
+
+	fks, err := fl.ForeignKeyList(name)
+	if err != nil {
+		return nil, err
+	}
+
+	renamed := make(map[string]string, len(l.names))
+	for after, before := range l.names {
+		renamed[before] = after
+	}
+
+	var res []*models.ForeignKey
+	for _, fk := range fks {
+		ref, ok := renamed[fk.RefTableName]
+		if !ok {
+			// the referenced table is removed by the hook
+			continue
+		}
+		v := *fk
+		v.RefTableName = ref
+		res = append(res, &v)
+	}
+	return res, nil
+}
@@ -49,13 +49,22 @@ func (i *RuleInflector) Pluralize(s string) string {
 	return inflection.Plural(s)
 }
 
-func NewInflector(ruleFile string) (Inflector, error) {
-	if ruleFile == "" {
+// NewInflector returns the inflector with the irregular rules of ruleFile,
+// or rules if ruleFile is empty, or the default inflector if there are
+// neither.
+func NewInflector(ruleFile string, rules ...InflectRule) (Inflector, error) {
+	if ruleFile == "" && len(rules) == 0 {
 		return &DefaultInflector{}, nil
 	}
-	err := registerRule(ruleFile)
-	if err != nil {
-		return nil, err
+	if ruleFile != "" {
+		err := registerRule(ruleFile)
+		if err != nil {
+			return nil, err
+		}
+		return &RuleInflector{}, nil
+	}
+	for _, irr := range rules {
+		inflection.AddIrregular(irr.Singuler, irr.Plural)
 	}
 	return &RuleInflector{}, nil
 }
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewInflectorRuleFileOverridesRules(t *testing.T) {
+	ruleFile := filepath.Join(t.TempDir(), "rules.yml")
+	if err := os.WriteFile(ruleFile, []byte("- singular: yoquux\n  plural: yoquuxen\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	inflector, err := NewInflector(ruleFile, InflectRule{Singuler: "yofoo", Plural: "yofooi"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		singular string
+		plural   string
+	}{
+		{singular: "yoquux", plural: "yoquuxen"},
+		{singular: "yofoo", plural: "yofoos"},
+	}
+	for _, tt := range tests {
+		if got := inflector.Pluralize(tt.singular); got != tt.plural {
+			t.Errorf("error. want:%v got:%v", tt.plural, got)
+		}
+	}
+}
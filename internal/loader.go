@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/kenshaw/snaker"
@@ -36,21 +37,59 @@ type loaderImpl interface {
 	ParseType(string, bool) (int, string, string)
 	ValidCustomType(string, string) bool
 	TableList() ([]*models.Table, error)
+	ViewList() ([]*models.Table, error)
 	ColumnList(string) ([]*models.Column, error)
 	IndexList(string) ([]*models.Index, error)
 	IndexColumnList(string, string) ([]*models.IndexColumn, error)
 }
 
+// viewDependencyLoader is implemented by loaders which know the tables a view
+// depends on.
+type viewDependencyLoader interface {
+	ViewDependencies(string) ([]string, error)
+}
+
+// searchIndexLoader is implemented by loaders which know the search indexes of
+// a table.
+type searchIndexLoader interface {
+	SearchIndexList(string) ([]*models.SearchIndex, error)
+}
+
+// vectorIndexLoader is implemented by loaders which know the vector indexes of
+// a table.
+type vectorIndexLoader interface {
+	VectorIndexList(string) ([]*models.VectorIndex, error)
+}
+
+// foreignKeyLoader is implemented by loaders which know the foreign keys of
+// a table.
+type foreignKeyLoader interface {
+	ForeignKeyList(string) ([]*models.ForeignKey, error)
+}
+
+// changeStreamLoader is implemented by loaders which know the change streams.
+type changeStreamLoader interface {
+	ChangeStreamList() ([]*models.ChangeStream, error)
+}
+
 func NewTypeLoader(l loaderImpl, i Inflector) *TypeLoader {
-	return &TypeLoader{loader: l, inflector: i}
+	return &TypeLoader{Initialisms: snaker.DefaultInitialisms, loader: l, inflector: i}
 }
 
 // TypeLoader provides a common Loader implementation used by the built in
 // schema/query loaders.
 type TypeLoader struct {
 	CustomTypes *models.CustomTypes
-	loader      loaderImpl
-	inflector   Inflector
+
+	// Hook post-processes the schema before the types are loaded from it.
+	Hook models.Hook
+
+	// Initialisms is the initialisms kept upper case in the names of the
+	// types, the fields and the functions.
+	Initialisms *snaker.Initialisms
+
+	loader    loaderImpl
+	inflector Inflector
 }
 
 // NthParam satisifies Loader's NthParam.
@@ -68,6 +107,15 @@ func (tl *TypeLoader) Mask() string {
 func (tl *TypeLoader) LoadSchema(args *ArgType) (map[string]*Type, map[string]*Index, error) {
 	var err error
 
+	// apply the hook once even if the schema is loaded again
+	if _, ok := tl.loader.(*hookLoader); !ok && tl.Hook != nil {
+		hl, err := newHookLoader(tl.loader, tl.Hook)
+		if err != nil {
+			return nil, nil, fmt.Errorf("hook failed: %v", err)
+		}
+		tl.loader = hl
+	}
+
 	// load tables
 	tableMap, err := tl.LoadTable(args)
 	if err != nil {
@@ -82,6 +130,26 @@ func (tl *TypeLoader) LoadSchema(args *ArgType) (map[string]*Type, map[string]*I
 
 	setIndexesToTables(tableMap, ixMap)
 
+	// load search indexes
+	if err := tl.LoadSearchIndexes(tableMap); err != nil {
+		return nil, nil, err
+	}
+
+	// load vector indexes
+	if err := tl.LoadVectorIndexes(tableMap); err != nil {
+		return nil, nil, err
+	}
+
+	// load foreign keys
+	if err := tl.LoadForeignKeys(tableMap); err != nil {
+		return nil, nil, err
+	}
+
+	// load change streams
+	if err := tl.LoadChangeStreams(tableMap); err != nil {
+		return nil, nil, err
+	}
+
 	return tableMap, ixMap, nil
 }
 
@@ -89,12 +157,36 @@ func (tl *TypeLoader) LoadSchema(args *ArgType) (map[string]*Type, map[string]*I
 func (tl *TypeLoader) LoadTable(args *ArgType) (map[string]*Type, error) {
 	var err error
 
+	include, err := compileTableFilters(append(append([]string(nil), args.Tables...), args.Include...))
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := compileTableFilters(append(append([]string(nil), args.ExcludeTables...), args.Exclude...))
+	if err != nil {
+		return nil, err
+	}
+
 	// load tables
 	tableList, err := tl.loader.TableList()
 	if err != nil {
 		return nil, err
 	}
 
+	// load views
+	viewList, err := tl.loader.ViewList()
+	if err != nil {
+		return nil, err
+	}
+	tableList = append(tableList, viewList...)
+
+	// interleaved tables by parent
+	children := make(map[string][]string)
+	for _, ti := range tableList {
+		if ti.ParentTableName != "" {
+			children[ti.ParentTableName] = append(children[ti.ParentTableName], ti.TableName)
+		}
+	}
+
 	// tables
 	tableMap := make(map[string]*Type)
 	for _, ti := range tableList {
@@ -123,16 +215,28 @@ func (tl *TypeLoader) LoadTable(args *ArgType) (map[string]*Type, error) {
 			}
 		}
 
+		if !matchTableFilters(ti.TableName, include, exclude) {
+			ignore = true
+		}
+
 		if ignore {
 			continue
 		}
 
-		// create template
+		// create template, prefixing the name with the schema if the
+		// table is in a named schema
 		typeTpl := &Type{
-			Name:   SingularizeIdentifier(tl.inflector, ti.TableName),
-			Schema: "",
-			Fields: []*Field{},
-			Table:  ti,
+			Name:              SingularizeIdentifier(tl.inflector, tl.Initialisms, strings.Replace(ti.TableName, ".", "_", 1)),
+			Schema:            ti.Schema,
+			Fields:            []*Field{},
+			Table:             ti,
+			InterleavedTables: interleavedTables(children, ti.TableName),
+		}
+		if args.NoSingularize {
+			typeTpl.Name = tl.Initialisms.ForceCamelIdentifier(strings.Replace(ti.TableName, ".", "_", 1))
+		}
+		if name, ok := args.Names[ti.TableName]; ok {
+			typeTpl.Name = name
 		}
 
 		// process columns
@@ -144,32 +248,114 @@ func (tl *TypeLoader) LoadTable(args *ArgType) (map[string]*Type, error) {
 		if err := tl.loadPrimaryKeys(typeTpl); err != nil {
 			return nil, err
 		}
+		if len(typeTpl.PrimaryKeyFields) == 0 && !ti.IsView {
+			fmt.Fprintf(os.Stderr, "warning: table %s has no primary key, keyed reads and mutations are not generated\n", ti.TableName)
+		}
 
 		tableMap[ti.TableName] = typeTpl
 	}
 
+	for _, typeTpl := range tableMap {
+		typeTpl.Parent = tableMap[typeTpl.Table.ParentTableName]
+	}
+
+	if args.CheckEnums {
+		loadEnums(tableMap)
+	}
+
+	if err := tl.warnExcludedViewDependencies(tableMap); err != nil {
+		return nil, err
+	}
+
 	// validate custom type tables
 	if tl.CustomTypes != nil {
 		for _, customTable := range tl.CustomTypes.Tables {
-			_, ok := tableMap[customTable.Name]
+			t, ok := tableMap[customTable.Name]
 			if !ok {
 				return nil, fmt.Errorf("unknown custom type table: %s", customTable.Name)
 			}
+			if len(customTable.PrimaryKey) != 0 && !t.Table.IsView {
+				return nil, fmt.Errorf("primary key of table '%s' cannot be declared, only views can declare it", customTable.Name)
+			}
+			if customTable.VersionColumn != "" {
+				if err := loadVersionField(args, t, customTable.VersionColumn); err != nil {
+					return nil, err
+				}
+			}
+			if customTable.SoftDeleteColumn != "" {
+				if err := loadSoftDeleteField(t, customTable.SoftDeleteColumn); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
 	return tableMap, nil
 }
 
+// interleavedTables returns the descendants of the table in post-order, so
+// that the rows of the descendants can be deleted before their parents.
+func interleavedTables(children map[string][]string, table string) []string {
+	cs := append([]string(nil), children[table]...)
+	sort.Strings(cs)
+
+	var tables []string
+	for _, c := range cs {
+		tables = append(tables, interleavedTables(children, c)...)
+		tables = append(tables, c)
+	}
+
+	return tables
+}
+
+// warnExcludedViewDependencies warns views which depend on tables excluded
+// from the generation.
+func (tl *TypeLoader) warnExcludedViewDependencies(tableMap map[string]*Type) error {
+	vl, ok := tl.loader.(viewDependencyLoader)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(tableMap))
+	for name := range tableMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		deps, err := vl.ViewDependencies(name)
+		if err != nil {
+			return err
+		}
+		for _, dep := range deps {
+			if _, ok := tableMap[dep]; !ok {
+				fmt.Fprintf(os.Stderr, "warning: view %s depends on table %s which is excluded from the generation\n", name, dep)
+			}
+		}
+	}
+
+	return nil
+}
+
 // loadPrimaryKeys loads primary key fields
 func (tl *TypeLoader) loadPrimaryKeys(typeTpl *Type) error {
 	// reorder primary keys
 	indexCols, err := tl.loader.IndexColumnList(typeTpl.Table.TableName, "PRIMARY_KEY")
 	if err != nil {
-		panic(err)
+		return err
+	}
+	sort.SliceStable(indexCols, func(i, j int) bool {
+		return indexCols[i].SeqNo < indexCols[j].SeqNo
+	})
+	if cols := tl.viewPrimaryKey(typeTpl.Table.TableName); len(cols) != 0 {
+		indexCols = nil
+		for i, c := range cols {
+			indexCols = append(indexCols, &models.IndexColumn{SeqNo: i + 1, ColumnName: c})
+		}
 	}
 
 	var fields []*Field
+	var pageFields []*OrderField
 	for _, idx := range indexCols {
 		var field *Field
 		for _, f := range typeTpl.Fields {
@@ -179,16 +365,24 @@ func (tl *TypeLoader) loadPrimaryKeys(typeTpl *Type) error {
 			}
 		}
 
+		if field == nil && !typeTpl.columns[idx.ColumnName] {
+			return fmt.Errorf("primary key of table '%s' refers to unknown column '%s'", typeTpl.Table.TableName, idx.ColumnName)
+		}
 		if field == nil {
 			return fmt.Errorf("primary key column is not found in column list: table=%v column=%v",
 				typeTpl.Name, idx.ColumnName,
 			)
 		}
 		fields = append(fields, field)
+		pageFields = append(pageFields, &OrderField{Field: field, Desc: idx.Desc})
 	}
 
-	typeTpl.PrimaryKey = fields[0] // backward compatibility
+	if len(fields) != 0 {
+		typeTpl.PrimaryKey = fields[0] // backward compatibility
+	}
 	typeTpl.PrimaryKeyFields = fields
+	typeTpl.PrimaryKeyOrder = pageFields
+	typeTpl.PageFields = comparablePageFields(pageFields)
 	return nil
 }
 
@@ -207,6 +401,34 @@ func (tl *TypeLoader) tableCustomTypes(table string) map[string]string {
 	return columnTypes
 }
 
+// tableFieldTags finds the struct tags of the fields of the table declared in
+// the custom types file.
+func (tl *TypeLoader) tableFieldTags(table string) map[string]string {
+	if tl.CustomTypes != nil {
+		for _, v := range tl.CustomTypes.Tables {
+			if v.Name == table {
+				return v.Tags
+			}
+		}
+	}
+
+	return nil
+}
+
+// viewPrimaryKey returns the primary key of the view declared in the custom
+// types file, or nil if it is not declared.
+func (tl *TypeLoader) viewPrimaryKey(view string) []string {
+	if tl.CustomTypes != nil {
+		for _, v := range tl.CustomTypes.Tables {
+			if v.Name == view {
+				return v.PrimaryKey
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadColumns loads schema table/view columns.
 func (tl *TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 	var err error
@@ -217,22 +439,41 @@ func (tl *TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 		return err
 	}
 
+	typeTpl.columns = make(map[string]bool, len(columnList))
+	for _, column := range columnList {
+		typeTpl.columns[column.ColumnName] = true
+	}
+
 	columnTypes := tl.tableCustomTypes(typeTpl.Table.TableName)
 
 	// validate custom type columns
 	if columnTypes != nil {
-		columnSet := map[string]struct{}{}
-		for _, column := range columnList {
-			columnSet[column.ColumnName] = struct{}{}
-		}
-
 		for k, _ := range columnTypes {
-			if _, ok := columnSet[k]; !ok {
+			if !typeTpl.columns[k] {
 				return fmt.Errorf("unknown custom type column %s in the table %s", k, typeTpl.Table.TableName)
 			}
 		}
 	}
 
+	fieldTags := tl.tableFieldTags(typeTpl.Table.TableName)
+	for k := range fieldTags {
+		if !typeTpl.columns[k] {
+			return fmt.Errorf("unknown column %s of the tags in the table %s", k, typeTpl.Table.TableName)
+		}
+	}
+
+	// validate the columns of the column types and the names of the table
+	for k := range args.ColumnTypes {
+		if table, column, _ := splitTableColumn(k); table == typeTpl.Table.TableName && !typeTpl.columns[column] {
+			return fmt.Errorf("unknown column %s of the column types in the table %s", column, table)
+		}
+	}
+	for k := range args.Names {
+		if table, column, _ := splitTableColumn(k); table == typeTpl.Table.TableName && !typeTpl.columns[column] {
+			return fmt.Errorf("unknown column %s of the names in the table %s", column, table)
+		}
+	}
+
 	// process columns
 	for _, c := range columnList {
 		ignore := false
@@ -260,24 +501,78 @@ func (tl *TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 
 		// set col info
 		f := &Field{
-			Name: snaker.ForceCamelIdentifier(c.ColumnName),
+			Name: tl.Initialisms.ForceCamelIdentifier(c.ColumnName),
 			// Name: c.ColumnName,
 			Col: c,
 		}
+		if c.FieldName != "" {
+			f.Name = c.FieldName
+		}
+		if name, ok := args.Names[typeTpl.Table.TableName+"."+c.ColumnName]; ok {
+			f.Name = name
+		}
 
 		f.Len, f.NilType, f.Type = tl.loader.ParseType(c.DataType, !c.NotNull)
+		f.TypeImport, f.Type = splitGoType(f.Type)
+		// nullable scalar columns are read and written as the pointers,
+		// which are nil if NULL
+		if args.NullablePointers {
+			if t, ok := nullablePointerTypes[f.Type]; ok {
+				f.NilType, f.Type = "nil", t
+			}
+		}
 
-		// set custom type
+		// DATE columns are read and written as civil.Date, and converted
+		// from and to time.Time like the custom types
+		if args.DateType == DateTypeTime {
+			if t, ok := dateTimeTypes[f.Type]; ok {
+				f.CustomType = t
+			}
+		}
+
+		// set custom type annotated in the schema, which is overridden by the
+		// custom types file
+		if c.CustomType != "" && tl.loader.ValidCustomType(c.DataType, c.CustomType) {
+			f.CustomTypeImport, f.CustomType = splitGoType(c.CustomType)
+		}
 		if columnTypes != nil {
 			if t, ok := columnTypes[c.ColumnName]; ok && tl.loader.ValidCustomType(c.DataType, t) {
-				f.CustomType = t
+				f.CustomTypeImport, f.CustomType = splitGoType(t)
+			}
+		}
+
+		if tag, ok := fieldTags[c.ColumnName]; ok {
+			f.Tags, err = parseStructTag(tag)
+			if err != nil {
+				return fmt.Errorf("invalid tags of the column %s in the table %s: %v", c.ColumnName, typeTpl.Table.TableName, err)
 			}
 		}
 
+		// the column types override the custom types of the column
+		if t, ok := args.ColumnTypes[typeTpl.Table.TableName+"."+c.ColumnName]; ok {
+			if !tl.loader.ValidCustomType(c.DataType, t) {
+				return fmt.Errorf("invalid type %s of the column %s in the table %s", t, c.ColumnName, typeTpl.Table.TableName)
+			}
+			f.CustomTypeImport, f.CustomType = splitGoType(t)
+		}
+
 		// append col to template fields
 		typeTpl.Fields = append(typeTpl.Fields, f)
 	}
 
+	if p := typeTpl.Table.RowDeletionPolicy; p != nil {
+		if !typeTpl.columns[p.ColumnName] {
+			return fmt.Errorf("unknown column %s of the row deletion policy of the table %s", p.ColumnName, typeTpl.Table.TableName)
+		}
+		// ExpiresAt is generated only for time.Time, spanner.NullTime and
+		// *time.Time,
+		// and not if it conflicts with a field
+		f := findField(typeTpl.Fields, p.ColumnName)
+		if f != nil && f.CustomType == "" && !hasFieldName(typeTpl.Fields, "ExpiresAt") {
+			typeTpl.TTLField = f
+		}
+	}
+
 	return nil
 }
 
@@ -287,6 +582,11 @@ func (tl *TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map
 
 	ixMap := map[string]*Index{}
 	for _, t := range tableMap {
+		// views do not support the Read API used by the index finders
+		if t.Table.IsView {
+			continue
+		}
+
 		// load table indexes
 		err = tl.LoadTableIndexes(args, t, ixMap)
 		if err != nil {
@@ -294,9 +594,483 @@ func (tl *TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map
 		}
 	}
 
+	removeConflictingIndexPrefixes(ixMap)
+	setIndexPages(ixMap)
+
 	return ixMap, nil
 }
 
+// comparablePageFields returns fields if the rows can be paged by them. NULL
+// is not comparable with the last key of a page, so it returns nil if any of
+// them is nullable.
+func comparablePageFields(fields []*OrderField) []*OrderField {
+	for _, f := range fields {
+		if !f.Field.Col.NotNull {
+			return nil
+		}
+	}
+
+	return fields
+}
+
+// setIndexPages sets the page readers of the indexes, which read the rows by
+// the index key followed by the rest of the primary key so that the rows are
+// totally ordered. The page readers whose names conflict with another one of
+// the same table, such as of a unique and a non-unique index of the same
+// columns, are not generated.
+func setIndexPages(ixMap map[string]*Index) {
+	keys := make([]string, 0, len(ixMap))
+	for key, ix := range ixMap {
+		if !ix.IsPrefix {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	names := make(map[string]bool)
+	for _, key := range keys {
+		ix := ixMap[key]
+		if ix.Type.PageFields == nil {
+			continue
+		}
+
+		fields := append([]*OrderField{}, ix.KeyFields...)
+		seen := make(map[*Field]bool)
+		for _, f := range fields {
+			seen[f.Field] = true
+		}
+		for _, f := range ix.Type.PageFields {
+			if !seen[f.Field] {
+				fields = append(fields, f)
+			}
+		}
+		fields = comparablePageFields(fields)
+
+		name := ix.Type.Name + "." + ix.PageFuncName
+		if fields == nil || names[name] {
+			continue
+		}
+		names[name] = true
+		ix.PageFields = fields
+	}
+}
+
+// indexRowFields returns the fields of the index key, the storing columns and
+// the primary key without duplicates.
+func indexRowFields(ixTpl *Index) []*Field {
+	var fields []*Field
+	seen := make(map[*Field]bool)
+	for _, fs := range [][]*Field{ixTpl.Fields, ixTpl.StoringFields, ixTpl.Type.PrimaryKeyFields} {
+		for _, f := range fs {
+			if !seen[f] {
+				seen[f] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+
+	return fields
+}
+
+// buildIndexPrefixes builds finders which read rows by each proper prefix of
+// the index key. The rows are ordered by the rest of the index key unless
+// OmitFinderOrder is specified.
+func (tl *TypeLoader) buildIndexPrefixes(args *ArgType, ixTpl *Index) []*Index {
+	var prefixes []*Index
+	for i := 1; i < len(ixTpl.Fields); i++ {
+		ix := *ixTpl.Index
+		ix.IsUnique = false
+
+		prefixTpl := &Index{
+			Schema:        ixTpl.Schema,
+			Type:          ixTpl.Type,
+			Fields:        ixTpl.Fields[:i],
+			StoringFields: ixTpl.StoringFields,
+			Index:         &ix,
+			IsPrefix:      true,
+			KeyFields:     ixTpl.KeyFields,
+		}
+		for _, f := range prefixTpl.Fields {
+			if !f.Col.NotNull {
+				prefixTpl.NullableFields = append(prefixTpl.NullableFields, f)
+			}
+		}
+		if !args.OmitFinderOrder {
+			prefixTpl.OrderFields = ixTpl.KeyFields[i:]
+		}
+		prefixTpl.FuncName = tl.buildIndexFuncName(prefixTpl)
+
+		prefixes = append(prefixes, prefixTpl)
+	}
+
+	return prefixes
+}
+
+// removeConflictingIndexPrefixes removes prefix finders whose name conflicts
+// with another finder of the same table. Finders of whole index keys take
+// precedence over prefix finders.
+func removeConflictingIndexPrefixes(ixMap map[string]*Index) {
+	names := make(map[string]bool)
+	var prefixKeys []string
+	for key, ix := range ixMap {
+		if ix.IsPrefix {
+			prefixKeys = append(prefixKeys, key)
+			continue
+		}
+		names[ix.Type.Name+"."+ix.FuncName] = true
+	}
+	sort.Strings(prefixKeys)
+
+	for _, key := range prefixKeys {
+		name := ixMap[key].Type.Name + "." + ixMap[key].FuncName
+		if names[name] {
+			delete(ixMap, key)
+			continue
+		}
+		names[name] = true
+	}
+}
+
+// LoadSearchIndexes loads the search indexes of the tables. A search finder is
+// built per TOKENLIST column of the search indexes, which orders the rows by
+// SCORE if the column is tokenized for full-text search.
+func (tl *TypeLoader) LoadSearchIndexes(tableMap map[string]*Type) error {
+	sl, ok := tl.loader.(searchIndexLoader)
+	if !ok {
+		return nil
+	}
+
+	for _, typeTpl := range tableMap {
+		if typeTpl.Table.IsView {
+			continue
+		}
+
+		indexes, err := sl.SearchIndexList(typeTpl.Table.TableName)
+		if err != nil {
+			return err
+		}
+
+		names := make(map[string]bool)
+	indexLoop:
+		for _, ix := range indexes {
+			var fields []*Field
+			for _, col := range ix.PartitionColumns {
+				f := findField(typeTpl.Fields, col)
+				if f == nil && !typeTpl.columns[col] {
+					return fmt.Errorf("search index '%s' of table '%s' refers to unknown column '%s'", ix.IndexName, typeTpl.Table.TableName, col)
+				}
+				if f == nil {
+					fmt.Fprintf(os.Stderr, "warning: search index %s of table %s is partitioned by ignored column %s, search finders are not generated\n", ix.IndexName, typeTpl.Table.TableName, col)
+					continue indexLoop
+				}
+				fields = append(fields, f)
+			}
+
+			for _, col := range ix.Columns {
+				funcName := tl.inflector.Pluralize(typeTpl.Name) + "By" + tl.Initialisms.ForceCamelIdentifier(col)
+				if names[funcName] {
+					// the column is in multiple search indexes
+					funcName += "Using" + tl.Initialisms.ForceCamelIdentifier(strings.Replace(ix.IndexName, ".", "_", 1))
+				}
+				names[funcName] = true
+
+				var score bool
+				for _, c := range ix.FullTextColumns {
+					score = score || c == col
+				}
+
+				typeTpl.SearchIndexes = append(typeTpl.SearchIndexes, &SearchIndex{
+					FuncName:        funcName,
+					Type:            typeTpl,
+					Index:           ix,
+					Column:          col,
+					Score:           score,
+					PartitionFields: fields,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// vectorDistances is the approximate distance functions by the distance types
+// of vector indexes. The rows are nearer if the values are smaller except for
+// DOT_PRODUCT.
+var vectorDistances = map[string]struct {
+	funcName string
+	desc     bool
+}{
+	"COSINE":      {"APPROX_COSINE_DISTANCE", false},
+	"EUCLIDEAN":   {"APPROX_EUCLIDEAN_DISTANCE", false},
+	"DOT_PRODUCT": {"APPROX_DOT_PRODUCT", true},
+}
+
+// LoadVectorIndexes loads the vector indexes of the tables. A nearest neighbor
+// finder is built per vector index. The finders search 1% of the leaves of the
+// indexes, which is 10 of the 1000 leaves by default.
+func (tl *TypeLoader) LoadVectorIndexes(tableMap map[string]*Type) error {
+	vl, ok := tl.loader.(vectorIndexLoader)
+	if !ok {
+		return nil
+	}
+
+	for _, typeTpl := range tableMap {
+		if typeTpl.Table.IsView {
+			continue
+		}
+
+		indexes, err := vl.VectorIndexList(typeTpl.Table.TableName)
+		if err != nil {
+			return err
+		}
+
+		names := make(map[string]bool)
+		for _, ix := range indexes {
+			f := findField(typeTpl.Fields, ix.ColumnName)
+			if f == nil && !typeTpl.columns[ix.ColumnName] {
+				return fmt.Errorf("vector index '%s' of table '%s' refers to unknown column '%s'", ix.IndexName, typeTpl.Table.TableName, ix.ColumnName)
+			}
+			if f == nil {
+				fmt.Fprintf(os.Stderr, "warning: vector index %s of table %s is on ignored column %s, nearest neighbor finders are not generated\n", ix.IndexName, typeTpl.Table.TableName, ix.ColumnName)
+				continue
+			}
+
+			distanceType := strings.ToUpper(strings.Trim(ix.Options["distance_type"], `'"`))
+			distance, ok := vectorDistances[distanceType]
+			if !ok {
+				return fmt.Errorf("vector index '%s' of table '%s' has unknown distance type '%s'", ix.IndexName, typeTpl.Table.TableName, distanceType)
+			}
+
+			numLeaves := int64(1000)
+			if v, ok := ix.Options["num_leaves"]; ok {
+				n, err := strconv.ParseInt(v, 10, 64)
+				if err != nil || n <= 0 {
+					return fmt.Errorf("vector index '%s' of table '%s' has invalid num_leaves '%s'", ix.IndexName, typeTpl.Table.TableName, v)
+				}
+				numLeaves = n
+			}
+
+			// FLOAT32 is read as FLOAT64 by older clients
+			vectorType := "[]float64"
+			if strings.HasSuffix(strings.ToLower(f.Type), "float32") {
+				vectorType = "[]float32"
+			}
+
+			funcName := tl.inflector.Pluralize(typeTpl.Name) + "By" + tl.Initialisms.ForceCamelIdentifier(ix.ColumnName)
+			if names[funcName] {
+				// the column is in multiple vector indexes
+				funcName += "Using" + tl.Initialisms.ForceCamelIdentifier(strings.Replace(ix.IndexName, ".", "_", 1))
+			}
+			names[funcName] = true
+
+			typeTpl.VectorIndexes = append(typeTpl.VectorIndexes, &VectorIndex{
+				FuncName:          funcName,
+				Type:              typeTpl,
+				Index:             ix,
+				Field:             f,
+				VectorType:        vectorType,
+				DistanceFunc:      distance.funcName,
+				Desc:              distance.desc,
+				NumLeavesToSearch: (numLeaves + 99) / 100,
+			})
+		}
+	}
+
+	return nil
+}
+
+// LoadForeignKeys loads the foreign keys of the tables to the generated
+// tables.
+func (tl *TypeLoader) LoadForeignKeys(tableMap map[string]*Type) error {
+	fl, ok := tl.loader.(foreignKeyLoader)
+	if !ok {
+		return nil
+	}
+
+	for _, typeTpl := range tableMap {
+		if typeTpl.Table.IsView {
+			continue
+		}
+
+		fks, err := fl.ForeignKeyList(typeTpl.Table.TableName)
+		if err != nil {
+			return err
+		}
+
+	fkLoop:
+		for _, fk := range fks {
+			refTpl, ok := tableMap[fk.RefTableName]
+			if !ok {
+				continue
+			}
+
+			v := &ForeignKey{Type: typeTpl, RefType: refTpl, ForeignKey: fk}
+			for i, col := range fk.ColumnNames {
+				f := findField(typeTpl.Fields, col)
+				rf := findField(refTpl.Fields, fk.RefColumnNames[i])
+				if f == nil || rf == nil {
+					fmt.Fprintf(os.Stderr, "warning: foreign key %s of table %s refers to ignored columns, the accessors are not generated\n", fk.ConstraintName, typeTpl.Table.TableName)
+					continue fkLoop
+				}
+				v.Fields = append(v.Fields, f)
+				v.RefFields = append(v.RefFields, rf)
+			}
+			typeTpl.ForeignKeys = append(typeTpl.ForeignKeys, v)
+		}
+
+		// the accessors of the foreign keys to the same table, or to the
+		// parent which has the accessor of the interleaved rows, are named
+		// by the columns as well
+		refs := make(map[*Type]int)
+		for _, fk := range typeTpl.ForeignKeys {
+			refs[fk.RefType]++
+		}
+		for _, fk := range typeTpl.ForeignKeys {
+			var by string
+			for _, f := range fk.Fields {
+				by += f.Name
+			}
+
+			fk.FetchName = "Fetch" + fk.RefType.Name
+			fk.ListName = "List" + tl.inflector.Pluralize(typeTpl.Name)
+			if refs[fk.RefType] > 1 {
+				fk.FetchName += "By" + by
+			}
+			if refs[fk.RefType] > 1 || typeTpl.Table.ParentTableName == fk.RefType.Table.TableName {
+				fk.ListName += "By" + by
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangeStreams loads the change streams watching the generated tables.
+// The change streams watching no generated table are skipped.
+func (tl *TypeLoader) LoadChangeStreams(tableMap map[string]*Type) error {
+	cl, ok := tl.loader.(changeStreamLoader)
+	if !ok {
+		return nil
+	}
+
+	streams, err := cl.ChangeStreamList()
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range streams {
+		cs := &ChangeStream{
+			Name:         tl.Initialisms.ForceCamelIdentifier(strings.Replace(stream.Name, ".", "_", 1)),
+			ChangeStream: stream,
+		}
+		if stream.All {
+			for _, typeTpl := range tableMap {
+				if !typeTpl.Table.IsView {
+					cs.Types = append(cs.Types, typeTpl)
+				}
+			}
+		} else {
+			for _, name := range stream.TableNames {
+				if typeTpl, ok := tableMap[name]; ok {
+					cs.Types = append(cs.Types, typeTpl)
+				}
+			}
+		}
+		if len(cs.Types) == 0 {
+			continue
+		}
+
+		sort.Slice(cs.Types, func(i, j int) bool {
+			return cs.Types[i].Name < cs.Types[j].Name
+		})
+		for _, typeTpl := range cs.Types {
+			typeTpl.ChangeStreams = append(typeTpl.ChangeStreams, cs)
+		}
+	}
+
+	return nil
+}
+
+// hasFieldName reports whether a field of fields is named name.
+func hasFieldName(fields []*Field, name string) bool {
+	for _, f := range fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// loadVersionField sets the field of the version column of the optimistic
+// locking of the table declared in the custom types file. The version column
+// is an INT64 column incremented by the updates, or a TIMESTAMP column having
+// allow_commit_timestamp written with the commit timestamps.
+func loadVersionField(args *ArgType, t *Type, column string) error {
+	table := t.Table.TableName
+	if t.Table.IsView {
+		return fmt.Errorf("version column of view '%s' cannot be declared, only tables can declare it", table)
+	}
+	if !args.DML {
+		return fmt.Errorf("version column of table '%s' requires --dml", table)
+	}
+
+	f := findField(t.Fields, column)
+	if f == nil {
+		return fmt.Errorf("version column '%s' of table '%s' is unknown or ignored", column, table)
+	}
+	for _, pk := range t.PrimaryKeyFields {
+		if pk == f {
+			return fmt.Errorf("version column '%s' of table '%s' cannot be in the primary key", column, table)
+		}
+	}
+
+	commitTS := strings.EqualFold(f.Col.Options["allow_commit_timestamp"], "true")
+	if f.Col.IsGenerated || !f.Col.NotNull || f.CustomType != "" ||
+		!(f.Type == "int64" || f.Type == "time.Time" && commitTS) {
+		return fmt.Errorf("version column '%s' of table '%s' must be INT64 NOT NULL, or TIMESTAMP NOT NULL having allow_commit_timestamp", column, table)
+	}
+
+	t.VersionField = f
+	return nil
+}
+
+// loadSoftDeleteField sets the field of the soft delete column of the table
+// declared in the custom types file. The soft delete column is a nullable
+// TIMESTAMP column, which is NULL unless the row is deleted.
+func loadSoftDeleteField(t *Type, column string) error {
+	table := t.Table.TableName
+	if t.Table.IsView || len(t.PrimaryKeyFields) == 0 {
+		return fmt.Errorf("soft delete column of '%s' cannot be declared, only tables having a primary key can declare it", table)
+	}
+	if hasFieldName(t.Fields, "IsDeleted") {
+		return fmt.Errorf("soft delete column of table '%s' conflicts with field IsDeleted", table)
+	}
+
+	f := findField(t.Fields, column)
+	if f == nil {
+		return fmt.Errorf("soft delete column '%s' of table '%s' is unknown or ignored", column, table)
+	}
+	if f.Col.IsPrimaryKey || f.Col.IsGenerated || f.Col.NotNull || f.CustomType != "" ||
+		!(f.Type == "spanner.NullTime" || f.Type == "*time.Time") {
+		return fmt.Errorf("soft delete column '%s' of table '%s' must be a nullable TIMESTAMP column other than the primary key and the generated columns", column, table)
+	}
+
+	t.SoftDeleteField = f
+	return nil
+}
+
+// findField returns the field of the column, or nil if the column is not
+// generated.
+func findField(fields []*Field, column string) *Field {
+	for _, f := range fields {
+		if f.Col.ColumnName == column {
+			return f
+		}
+	}
+	return nil
+}
+
 // LoadTableIndexes loads schema index definitions per table.
 func (tl *TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[string]*Index) error {
 	var err error
@@ -315,7 +1089,7 @@ func (tl *TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[s
 
 		// create index template
 		ixTpl := &Index{
-			Schema: "",
+			Schema: typeTpl.Schema,
 			Type:   typeTpl,
 			Fields: []*Field{},
 			Index:  ix,
@@ -330,7 +1104,21 @@ func (tl *TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[s
 		// build func name
 		ixTpl.FuncName = tl.buildIndexFuncName(ixTpl)
 
+		// the page reader lists the rows whatever the index is unique
+		by := strings.TrimPrefix(ixTpl.FuncName, tl.inflector.Pluralize(typeTpl.Name))
+		if ix.IsUnique {
+			by = strings.TrimPrefix(ixTpl.FuncName, typeTpl.Name)
+		}
+		ixTpl.PageFuncName = "List" + tl.inflector.Pluralize(typeTpl.Name) + by + "Page"
+
+		ixTpl.RowName = tl.Initialisms.ForceCamelIdentifier(strings.Replace(ix.IndexName, ".", "_", 1)) + "Row"
+		ixTpl.RowFields = indexRowFields(ixTpl)
+
 		ixMap[typeTpl.Table.TableName+"_"+ix.IndexName] = ixTpl
+
+		for _, prefixTpl := range tl.buildIndexPrefixes(args, ixTpl) {
+			ixMap[typeTpl.Table.TableName+"_"+ix.IndexName+"_"+prefixTpl.FuncName] = prefixTpl
+		}
 	}
 
 	return nil
@@ -366,6 +1154,14 @@ func (tl *TypeLoader) LoadIndexColumns(args *ArgType, ixTpl *Index) error {
 		return err
 	}
 
+	// order the storing columns and the key columns by their own sequences
+	sort.SliceStable(indexCols, func(i, j int) bool {
+		if indexCols[i].Storing != indexCols[j].Storing {
+			return indexCols[i].Storing
+		}
+		return indexCols[i].SeqNo < indexCols[j].SeqNo
+	})
+
 	// process index columns
 	for _, ic := range indexCols {
 		var field *Field
@@ -379,7 +1175,11 @@ func (tl *TypeLoader) LoadIndexColumns(args *ArgType, ixTpl *Index) error {
 			}
 		}
 
+		if field == nil && !ixTpl.Type.columns[ic.ColumnName] {
+			return fmt.Errorf("index '%s' of table '%s' refers to unknown column '%s'", ixTpl.Index.IndexName, ixTpl.Type.Table.TableName, ic.ColumnName)
+		}
 		if field == nil {
+			// ignored by the user
 			continue
 		}
 
@@ -388,6 +1188,7 @@ func (tl *TypeLoader) LoadIndexColumns(args *ArgType, ixTpl *Index) error {
 			ixTpl.StoringFields = append(ixTpl.StoringFields, field)
 		} else {
 			ixTpl.Fields = append(ixTpl.Fields, field)
+			ixTpl.KeyFields = append(ixTpl.KeyFields, &OrderField{Field: field, Desc: ic.Desc})
 		}
 		if !field.Col.NotNull {
 			ixTpl.NullableFields = append(ixTpl.NullableFields, field)
@@ -417,6 +1218,9 @@ func (tl *TypeLoader) LoadCustomTypes(path string) error {
 func setIndexesToTables(tableMap map[string]*Type, ixMap map[string]*Index) {
 	indexes := make([]*Index, 0, len(ixMap))
 	for _, ix := range ixMap {
+		if ix.IsPrefix {
+			continue
+		}
 		indexes = append(indexes, ix)
 	}
 	sort.Slice(indexes, func(i, j int) bool {
@@ -1,10 +1,13 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"go.mercari.io/yo/models"
+	"gopkg.in/yaml.v2"
 )
 
 func Test_setIndexesToTables(t *testing.T) {
@@ -113,6 +116,33 @@ func Test_setIndexesToTables(t *testing.T) {
 				"TableB": 2,
 			},
 		},
+		{
+			table: map[string]*Type{
+				"TableA": &Type{
+					Indexes: []*Index{},
+				},
+			},
+			ix: map[string]*Index{
+				"TableA_Index1": &Index{
+					Type: &Type{
+						Table: &models.Table{
+							TableName: "TableA",
+						},
+					},
+				},
+				"TableA_Index1_TablesByA": &Index{
+					Type: &Type{
+						Table: &models.Table{
+							TableName: "TableA",
+						},
+					},
+					IsPrefix: true,
+				},
+			},
+			result: map[string]int{
+				"TableA": 1,
+			},
+		},
 	}
 
 	for i, tt := range tests {
@@ -126,3 +156,1148 @@ func Test_setIndexesToTables(t *testing.T) {
 		})
 	}
 }
+
+func Test_interleavedTables(t *testing.T) {
+	children := map[string][]string{
+		"Users":     {"Orders", "Addresses"},
+		"Orders":    {"OrderItems"},
+		"Addresses": nil,
+	}
+
+	tests := []struct {
+		table  string
+		result []string
+	}{
+		{table: "Users", result: []string{"Addresses", "OrderItems", "Orders"}},
+		{table: "Orders", result: []string{"OrderItems"}},
+		{table: "OrderItems", result: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.table, func(t *testing.T) {
+			got := interleavedTables(children, tt.table)
+			if fmt.Sprint(got) != fmt.Sprint(tt.result) {
+				t.Errorf("error. want:%v got:%v", tt.result, got)
+			}
+		})
+	}
+}
+
+type testLoader struct {
+	loaderImpl
+	columns       []*models.Column
+	indexColumns  map[string][]*models.IndexColumn
+	uniqueIndexes map[string]bool
+	indexErr      error
+}
+
+func (l *testLoader) TableList() ([]*models.Table, error) {
+	return []*models.Table{{TableName: "Users", Type: "BASE TABLE", ManualPk: true}}, nil
+}
+
+func (l *testLoader) ViewList() ([]*models.Table, error) {
+	return nil, nil
+}
+
+func (l *testLoader) ColumnList(string) ([]*models.Column, error) {
+	return l.columns, nil
+}
+
+func (l *testLoader) ParseType(dt string, nullable bool) (int, string, string) {
+	if dt == "DATE" {
+		if nullable {
+			return 0, "spanner.NullDate{}", "spanner.NullDate"
+		}
+		return 0, "civil.Date{}", "civil.Date"
+	}
+	if dt == "INT64" && !nullable {
+		return 0, "0", "int64"
+	}
+	if dt == "TIMESTAMP" {
+		if nullable {
+			return 0, "spanner.NullTime{}", "spanner.NullTime"
+		}
+		return 0, "time.Time{}", "time.Time"
+	}
+	return 0, `""`, "string"
+}
+
+func (l *testLoader) ValidCustomType(string, string) bool {
+	return true
+}
+
+func (l *testLoader) IndexList(string) ([]*models.Index, error) {
+	var indexes []*models.Index
+	for name := range l.indexColumns {
+		if name != "PRIMARY_KEY" {
+			indexes = append(indexes, &models.Index{IndexName: name, IsUnique: l.uniqueIndexes[name]})
+		}
+	}
+	return indexes, nil
+}
+
+func (l *testLoader) IndexColumnList(_ string, index string) ([]*models.IndexColumn, error) {
+	if l.indexErr != nil {
+		return nil, l.indexErr
+	}
+	return l.indexColumns[index], nil
+}
+
+func TestLoadSchemaIndexColumnListError(t *testing.T) {
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	loader := &testLoader{
+		columns:  []*models.Column{{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true}},
+		indexErr: errors.New("view 'Users' selects ambiguous column 'UserID'"),
+	}
+	tl := NewTypeLoader(loader, inflector)
+
+	_, _, err = tl.LoadSchema(&ArgType{})
+	if err == nil || err.Error() != loader.indexErr.Error() {
+		t.Errorf("error. want:%v got:%v", loader.indexErr, err)
+	}
+}
+
+func TestLoadSchemaUnknownColumns(t *testing.T) {
+	columns := []*models.Column{
+		{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+		{ColumnName: "Name"},
+	}
+
+	tests := []struct {
+		name         string
+		indexColumns map[string][]*models.IndexColumn
+		errMsg       string
+	}{
+		{
+			name: "valid",
+			indexColumns: map[string][]*models.IndexColumn{
+				"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+				"UsersByName": {{SeqNo: 1, ColumnName: "Name"}, {SeqNo: 1, ColumnName: "UserID", Storing: true}},
+			},
+		},
+		{
+			name: "primary key",
+			indexColumns: map[string][]*models.IndexColumn{
+				"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserId"}},
+			},
+			errMsg: "primary key of table 'Users' refers to unknown column 'UserId'",
+		},
+		{
+			name: "index key",
+			indexColumns: map[string][]*models.IndexColumn{
+				"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+				"UsersByName": {{SeqNo: 1, ColumnName: "Nmae"}},
+			},
+			errMsg: "index 'UsersByName' of table 'Users' refers to unknown column 'Nmae'",
+		},
+		{
+			name: "storing",
+			indexColumns: map[string][]*models.IndexColumn{
+				"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+				"UsersByName": {{SeqNo: 1, ColumnName: "Name"}, {SeqNo: 1, ColumnName: "Age", Storing: true}},
+			},
+			errMsg: "index 'UsersByName' of table 'Users' refers to unknown column 'Age'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tl := NewTypeLoader(&testLoader{columns: columns, indexColumns: tt.indexColumns}, inflector)
+
+			_, _, err = tl.LoadSchema(&ArgType{})
+			if tt.errMsg == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errMsg {
+				t.Errorf("error. want:%q got:%v", tt.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaUniqueIndexes(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Email", NotNull: true},
+			{ColumnName: "Name", NotNull: true},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY":      {{SeqNo: 1, ColumnName: "UserID"}},
+			"UsersByEmail":     {{SeqNo: 1, ColumnName: "Email"}},
+			"UsersByNameEmail": {{SeqNo: 1, ColumnName: "Name"}, {SeqNo: 2, ColumnName: "Email"}},
+		},
+		uniqueIndexes: map[string]bool{"UsersByEmail": true, "UsersByNameEmail": true},
+	}
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, ixMap, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := map[string]bool{}
+	for _, ix := range ixMap {
+		result[ix.FuncName] = ix.Index.IsUnique
+	}
+	want := map[string]bool{
+		"UserByEmail":     true,
+		"UserByNameEmail": true,
+		"UsersByName":     false,
+	}
+	if fmt.Sprint(result) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, result)
+	}
+}
+
+func TestLoadIndexColumnsOrder(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Name", NotNull: true},
+			{ColumnName: "Email", NotNull: true},
+			{ColumnName: "Age", NotNull: true},
+			{ColumnName: "Country", NotNull: true},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+			"UsersByCountryName": {
+				{SeqNo: 2, ColumnName: "Name"},
+				{SeqNo: 2, ColumnName: "Email", Storing: true},
+				{SeqNo: 1, ColumnName: "Country"},
+				{SeqNo: 1, ColumnName: "Age", Storing: true},
+			},
+		},
+	}
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, ixMap, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ix := ixMap["Users_UsersByCountryName"]
+	if ix == nil {
+		t.Fatalf("index is not loaded: %v", ixMap)
+	}
+	var fields, storingFields []string
+	for _, f := range ix.Fields {
+		fields = append(fields, f.Col.ColumnName)
+	}
+	for _, f := range ix.StoringFields {
+		storingFields = append(storingFields, f.Col.ColumnName)
+	}
+	if want := []string{"Country", "Name"}; fmt.Sprint(fields) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, fields)
+	}
+	if want := []string{"Age", "Email"}; fmt.Sprint(storingFields) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, storingFields)
+	}
+}
+
+func TestLoadIndexPageFields(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "TenantID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Name", NotNull: true},
+			{ColumnName: "Email"},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY":  {{SeqNo: 1, ColumnName: "TenantID"}, {SeqNo: 2, ColumnName: "UserID", Desc: true}},
+			"UsersByName":  {{SeqNo: 1, ColumnName: "Name", Desc: true}, {SeqNo: 2, ColumnName: "TenantID"}},
+			"UsersByEmail": {{SeqNo: 1, ColumnName: "Email"}},
+		},
+	}
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tableMap, ixMap, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pageFields := func(fields []*OrderField) []string {
+		var res []string
+		for _, f := range fields {
+			res = append(res, fmt.Sprintf("%s:%v", f.Field.Col.ColumnName, f.Desc))
+		}
+		return res
+	}
+	if want, got := []string{"TenantID:false", "UserID:true"}, pageFields(tableMap["Users"].PageFields); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, got)
+	}
+
+	ix := ixMap["Users_UsersByName"]
+	if want, got := []string{"Name:true", "TenantID:false", "UserID:true"}, pageFields(ix.PageFields); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, got)
+	}
+	if want := "ListUsersByNameTenantIDPage"; ix.PageFuncName != want {
+		t.Errorf("error. want:%v got:%v", want, ix.PageFuncName)
+	}
+
+	// NULL of Email is not comparable with the last key of a page
+	if ix := ixMap["Users_UsersByEmail"]; ix.PageFields != nil {
+		t.Errorf("error. want no page fields, got:%v", pageFields(ix.PageFields))
+	}
+}
+
+func TestLoadPrimaryKeysOrder(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "OrderID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Amount", NotNull: true},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 2, ColumnName: "OrderID"}, {SeqNo: 1, ColumnName: "UserID"}},
+		},
+	}
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tableMap, _, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result []string
+	for _, f := range tableMap["Users"].PrimaryKeyFields {
+		result = append(result, f.Col.ColumnName)
+	}
+	want := []string{"UserID", "OrderID"}
+	if fmt.Sprint(result) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, result)
+	}
+}
+
+func TestLoadSchemaDateType(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", DataType: "STRING(36)", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Birthday", DataType: "DATE", NotNull: true},
+			{ColumnName: "Anniversary", DataType: "DATE"},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+		},
+	}
+
+	tests := []struct {
+		dateType string
+		want     map[string]string
+	}{
+		{
+			dateType: "",
+			want:     map[string]string{"UserID": "", "Birthday": "", "Anniversary": ""},
+		},
+		{
+			dateType: DateTypeCivil,
+			want:     map[string]string{"UserID": "", "Birthday": "", "Anniversary": ""},
+		},
+		{
+			dateType: DateTypeTime,
+			want:     map[string]string{"UserID": "", "Birthday": "time.Time", "Anniversary": "spanner.NullTime"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dateType, func(t *testing.T) {
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tableMap, _, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{DateType: tt.dateType})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result := map[string]string{}
+			for _, f := range tableMap["Users"].Fields {
+				result[f.Col.ColumnName] = f.CustomType
+			}
+			if fmt.Sprint(result) != fmt.Sprint(tt.want) {
+				t.Errorf("error. want:%v got:%v", tt.want, result)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaNullablePointers(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", DataType: "STRING(36)", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Birthday", DataType: "DATE", NotNull: true},
+			{ColumnName: "Anniversary", DataType: "DATE"},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+		},
+	}
+
+	tests := []struct {
+		dateType string
+		want     map[string]string
+	}{
+		{
+			dateType: DateTypeCivil,
+			want:     map[string]string{"UserID": "string", "Birthday": "civil.Date", "Anniversary": "*civil.Date"},
+		},
+		{
+			dateType: DateTypeTime,
+			want:     map[string]string{"UserID": "string", "Birthday": "civil.Date time.Time", "Anniversary": "*civil.Date *time.Time"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dateType, func(t *testing.T) {
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tableMap, _, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{DateType: tt.dateType, NullablePointers: true})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result := map[string]string{}
+			for _, f := range tableMap["Users"].Fields {
+				result[f.Col.ColumnName] = strings.TrimSpace(f.Type + " " + f.CustomType)
+			}
+			if fmt.Sprint(result) != fmt.Sprint(tt.want) {
+				t.Errorf("error. want:%v got:%v", tt.want, result)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaCheckEnums(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Status", NotNull: true, EnumValues: []string{"ACTIVE", "in-review", "Banned", "ACTIVE"}},
+			{ColumnName: "Plan", NotNull: true, EnumValues: []string{"FREE", "free"}},
+			{ColumnName: "Name", NotNull: true},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+		},
+	}
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tableMap, _, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{CheckEnums: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result []string
+	for _, e := range tableMap["Users"].Enums {
+		result = append(result, e.Field.CustomType)
+		for _, v := range e.Values {
+			result = append(result, v.Name+"="+v.Value)
+		}
+	}
+	want := []string{"UserStatus", "UserStatusActive=ACTIVE", "UserStatusInReview=in-review", "UserStatusBanned=Banned"}
+	if fmt.Sprint(result) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, result)
+	}
+}
+
+func TestLoadSchemaHook(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Name", NotNull: true},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+			"UsersByName": {{SeqNo: 1, ColumnName: "Name"}},
+		},
+	}
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tl := NewTypeLoader(l, inflector)
+	tl.Hook = func(s *models.Schema) error {
+		for _, ts := range s.Tables {
+			for _, c := range ts.Columns {
+				if c.ColumnName == "Name" {
+					c.FieldName = "DisplayName"
+				}
+			}
+			ts.Indexes = nil
+		}
+		return nil
+	}
+	tableMap, ixMap, err := tl.LoadSchema(&ArgType{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result []string
+	for _, f := range tableMap["Users"].Fields {
+		result = append(result, f.Name)
+	}
+	want := []string{"UserID", "DisplayName"}
+	if fmt.Sprint(result) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, result)
+	}
+	if len(ixMap) != 0 {
+		t.Errorf("indexes removed by the hook are loaded: %v", ixMap)
+	}
+}
+
+type foreignKeyTestLoader struct {
+	*testLoader
+	foreignKeys []*models.ForeignKey
+}
+
+func (l *foreignKeyTestLoader) ForeignKeyList(string) ([]*models.ForeignKey, error) {
+	return l.foreignKeys, nil
+}
+
+func TestLoadSchemaForeignKeys(t *testing.T) {
+	l := &foreignKeyTestLoader{
+		testLoader: &testLoader{
+			columns: []*models.Column{
+				{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true},
+				{ColumnName: "ReferrerID"},
+				{ColumnName: "InviterID"},
+				{ColumnName: "GroupID"},
+			},
+			indexColumns: map[string][]*models.IndexColumn{
+				"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+			},
+		},
+		foreignKeys: []*models.ForeignKey{
+			{ConstraintName: "FK_Referrer", ColumnNames: []string{"ReferrerID"}, RefTableName: "Users", RefColumnNames: []string{"UserID"}},
+			{ConstraintName: "FK_Inviter", ColumnNames: []string{"InviterID"}, RefTableName: "Users", RefColumnNames: []string{"UserID"}},
+			{ConstraintName: "FK_Group", ColumnNames: []string{"GroupID"}, RefTableName: "Groups", RefColumnNames: []string{"GroupID"}},
+		},
+	}
+	inflector, err := NewInflector("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tableMap, _, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result []string
+	for _, fk := range tableMap["Users"].ForeignKeys {
+		result = append(result, fk.FetchName+"/"+fk.ListName+"="+fk.Fields[0].Name+"->"+fk.RefFields[0].Name)
+	}
+	want := []string{
+		"FetchUserByReferrerID/ListUsersByReferrerID=ReferrerID->UserID",
+		"FetchUserByInviterID/ListUsersByInviterID=InviterID->UserID",
+	}
+	if fmt.Sprint(result) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, result)
+	}
+}
+
+type viewTestLoader struct {
+	*testLoader
+}
+
+func (l *viewTestLoader) TableList() ([]*models.Table, error) {
+	return nil, nil
+}
+
+func (l *viewTestLoader) ViewList() ([]*models.Table, error) {
+	return []*models.Table{{TableName: "UserOrders", Type: "VIEW", ManualPk: true, IsView: true}}, nil
+}
+
+func TestLoadSchemaViewPrimaryKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		loader     loaderImpl
+		primaryKey []string
+		want       []string
+		errMsg     string
+	}{
+		{
+			name:   "derived",
+			loader: &viewTestLoader{},
+			want:   []string{"UserID"},
+		},
+		{
+			name:       "declared",
+			loader:     &viewTestLoader{},
+			primaryKey: []string{"OrderID", "UserID"},
+			want:       []string{"OrderID", "UserID"},
+		},
+		{
+			name:       "declared for table",
+			loader:     &testLoader{},
+			primaryKey: []string{"OrderID"},
+			errMsg:     "primary key of table 'Users' cannot be declared",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := &testLoader{
+				columns: []*models.Column{
+					{ColumnName: "UserID", NotNull: true},
+					{ColumnName: "OrderID", NotNull: true},
+					{ColumnName: "Amount", NotNull: true},
+				},
+				indexColumns: map[string][]*models.IndexColumn{
+					"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+				},
+			}
+			var name string
+			switch l := tt.loader.(type) {
+			case *viewTestLoader:
+				l.testLoader = tl
+				name = "UserOrders"
+			case *testLoader:
+				*l = *tl
+				name = "Users"
+			}
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			loader := NewTypeLoader(tt.loader, inflector)
+			loader.CustomTypes = &models.CustomTypes{}
+			conf := fmt.Sprintf("tables:\n  - name: %s\n    primary_key: [%s]\n", name, strings.Join(tt.primaryKey, ", "))
+			if err := yaml.Unmarshal([]byte(conf), loader.CustomTypes); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tableMap, _, err := loader.LoadSchema(&ArgType{})
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var result []string
+			for _, f := range tableMap[name].PrimaryKeyFields {
+				result = append(result, f.Name)
+			}
+			if fmt.Sprint(result) != fmt.Sprint(tt.want) {
+				t.Errorf("error. want:%v got:%v", tt.want, result)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaVersionColumn(t *testing.T) {
+	tests := []struct {
+		name   string
+		column string
+		noDML  bool
+		want   string
+		errMsg string
+	}{
+		{
+			name:   "int64",
+			column: "Version",
+			want:   "Version",
+		},
+		{
+			name:   "commit timestamp",
+			column: "UpdatedAt",
+			want:   "UpdatedAt",
+		},
+		{
+			name:   "timestamp without allow_commit_timestamp",
+			column: "CreatedAt",
+			errMsg: "version column 'CreatedAt' of table 'Users' must be INT64 NOT NULL",
+		},
+		{
+			name:   "nullable",
+			column: "Revision",
+			errMsg: "version column 'Revision' of table 'Users' must be INT64 NOT NULL",
+		},
+		{
+			name:   "primary key",
+			column: "UserID",
+			errMsg: "version column 'UserID' of table 'Users' cannot be in the primary key",
+		},
+		{
+			name:   "unknown",
+			column: "Unknown",
+			errMsg: "version column 'Unknown' of table 'Users' is unknown or ignored",
+		},
+		{
+			name:   "without dml",
+			column: "Version",
+			noDML:  true,
+			errMsg: "version column of table 'Users' requires --dml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := &testLoader{
+				columns: []*models.Column{
+					{ColumnName: "UserID", DataType: "STRING(36)", NotNull: true, IsPrimaryKey: true},
+					{ColumnName: "Version", DataType: "INT64", NotNull: true},
+					{ColumnName: "Revision", DataType: "INT64"},
+					{ColumnName: "CreatedAt", DataType: "TIMESTAMP", NotNull: true},
+					{ColumnName: "UpdatedAt", DataType: "TIMESTAMP", NotNull: true, Options: map[string]string{"allow_commit_timestamp": "true"}},
+				},
+				indexColumns: map[string][]*models.IndexColumn{
+					"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+				},
+			}
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			loader := NewTypeLoader(tl, inflector)
+			loader.CustomTypes = &models.CustomTypes{}
+			conf := fmt.Sprintf("tables:\n  - name: Users\n    version_column: %s\n", tt.column)
+			if err := yaml.Unmarshal([]byte(conf), loader.CustomTypes); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tableMap, _, err := loader.LoadSchema(&ArgType{DML: !tt.noDML})
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			f := tableMap["Users"].VersionField
+			if f == nil || f.Name != tt.want {
+				t.Errorf("error. want:%v got:%v", tt.want, f)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaSoftDeleteColumn(t *testing.T) {
+	tests := []struct {
+		name   string
+		column string
+		want   string
+		errMsg string
+	}{
+		{
+			name:   "nullable timestamp",
+			column: "DeletedAt",
+			want:   "DeletedAt",
+		},
+		{
+			name:   "not null",
+			column: "CreatedAt",
+			errMsg: "soft delete column 'CreatedAt' of table 'Users' must be a nullable TIMESTAMP column",
+		},
+		{
+			name:   "not timestamp",
+			column: "Name",
+			errMsg: "soft delete column 'Name' of table 'Users' must be a nullable TIMESTAMP column",
+		},
+		{
+			name:   "unknown",
+			column: "Unknown",
+			errMsg: "soft delete column 'Unknown' of table 'Users' is unknown or ignored",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := &testLoader{
+				columns: []*models.Column{
+					{ColumnName: "UserID", DataType: "STRING(36)", NotNull: true, IsPrimaryKey: true},
+					{ColumnName: "Name", DataType: "STRING(MAX)"},
+					{ColumnName: "CreatedAt", DataType: "TIMESTAMP", NotNull: true},
+					{ColumnName: "DeletedAt", DataType: "TIMESTAMP"},
+				},
+				indexColumns: map[string][]*models.IndexColumn{
+					"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+				},
+			}
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			loader := NewTypeLoader(tl, inflector)
+			loader.CustomTypes = &models.CustomTypes{}
+			conf := fmt.Sprintf("tables:\n  - name: Users\n    soft_delete_column: %s\n", tt.column)
+			if err := yaml.Unmarshal([]byte(conf), loader.CustomTypes); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tableMap, _, err := loader.LoadSchema(&ArgType{})
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			f := tableMap["Users"].SoftDeleteField
+			if f == nil || f.Name != tt.want {
+				t.Errorf("error. want:%v got:%v", tt.want, f)
+			}
+		})
+	}
+}
+
+func TestMatchTableFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    []string
+	}{
+		{
+			name: "no filters",
+			want: []string{"Users", "UserItems", "Billing", "BillingArchive"},
+		},
+		{
+			name:    "glob",
+			include: []string{"User*"},
+			want:    []string{"Users", "UserItems"},
+		},
+		{
+			name:    "regexp",
+			include: []string{"/^Users?$/", "/^Billing/"},
+			exclude: []string{"/Archive$/"},
+			want:    []string{"Users", "Billing"},
+		},
+		{
+			name:    "unanchored regexp",
+			exclude: []string{"/Item/", "Billing*"},
+			want:    []string{"Users"},
+		},
+	}
+
+	tables := []string{"Users", "UserItems", "Billing", "BillingArchive"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			include, err := compileTableFilters(tt.include)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			exclude, err := compileTableFilters(tt.exclude)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, table := range tables {
+				if matchTableFilters(table, include, exclude) {
+					got = append(got, table)
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("error. want:%v got:%v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadTableDeprecatedFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		args *ArgType
+		want int
+	}{
+		{
+			name: "include",
+			args: &ArgType{Include: []string{"/^Users$/"}},
+			want: 1,
+		},
+		{
+			name: "include with tables",
+			args: &ArgType{Tables: []string{"Items"}, Include: []string{"User*"}},
+			want: 1,
+		},
+		{
+			name: "exclude",
+			args: &ArgType{Exclude: []string{"/^Users$/"}},
+			want: 0,
+		},
+		{
+			name: "exclude with exclude tables",
+			args: &ArgType{ExcludeTables: []string{"Items"}, Exclude: []string{"User*"}},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			l := &testLoader{columns: []*models.Column{{ColumnName: "UserID", NotNull: true, IsPrimaryKey: true}}}
+			tl := NewTypeLoader(l, inflector)
+
+			tables, err := tl.LoadTable(tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(tables) != tt.want {
+				t.Errorf("error. want:%v got:%v", tt.want, len(tables))
+			}
+		})
+	}
+}
+
+func TestCompileTableFiltersInvalid(t *testing.T) {
+	for _, p := range []string{"/(/", "[a-"} {
+		if _, err := compileTableFilters([]string{p}); err == nil {
+			t.Errorf("expect error of %q", p)
+		}
+	}
+}
+
+func TestLoadSchemaColumnTypes(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", DataType: "STRING(36)", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "Status", DataType: "STRING(MAX)", NotNull: true, CustomType: "Status"},
+			{ColumnName: "Settings", DataType: "JSON"},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		columnTypes map[string]string
+		want        map[string]string
+		wantImport  string
+		errMsg      string
+	}{
+		{
+			name: "none",
+			want: map[string]string{"UserID": "", "Status": "Status", "Settings": ""},
+		},
+		{
+			name: "override",
+			columnTypes: map[string]string{
+				"Users.Status":   "types.Status",
+				"Users.Settings": "example.com/mypkg.Settings",
+				"Others.Name":    "types.Name",
+			},
+			want:       map[string]string{"UserID": "", "Status": "types.Status", "Settings": "mypkg.Settings"},
+			wantImport: "example.com/mypkg",
+		},
+		{
+			name:        "unknown column",
+			columnTypes: map[string]string{"Users.Unknown": "types.Unknown"},
+			errMsg:      "unknown column Unknown of the column types in the table Users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tableMap, _, err := NewTypeLoader(l, inflector).LoadSchema(&ArgType{ColumnTypes: tt.columnTypes})
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result := map[string]string{}
+			for _, f := range tableMap["Users"].Fields {
+				result[f.Col.ColumnName] = f.CustomType
+			}
+			if fmt.Sprint(result) != fmt.Sprint(tt.want) {
+				t.Errorf("error. want:%v got:%v", tt.want, result)
+			}
+			if got := tableMap["Users"].Fields[2].CustomTypeImport; got != tt.wantImport {
+				t.Errorf("error. want:%v got:%v", tt.wantImport, got)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaNames(t *testing.T) {
+	l := &testLoader{
+		columns: []*models.Column{
+			{ColumnName: "UserID", DataType: "STRING(36)", NotNull: true, IsPrimaryKey: true},
+			{ColumnName: "ItemSku", DataType: "STRING(MAX)", NotNull: true},
+		},
+		indexColumns: map[string][]*models.IndexColumn{
+			"PRIMARY_KEY": {{SeqNo: 1, ColumnName: "UserID"}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		initialisms   []string
+		noSingularize bool
+		names         map[string]string
+		want          string
+		errMsg        string
+	}{
+		{
+			name:        "initialisms",
+			initialisms: []string{"SKU"},
+			want:        "User[UserID ItemSKU]",
+		},
+		{
+			name: "default",
+			want: "User[UserID ItemSku]",
+		},
+		{
+			name:          "no singularize",
+			noSingularize: true,
+			want:          "Users[UserID ItemSku]",
+		},
+		{
+			name:  "names",
+			names: map[string]string{"Users": "Account", "Users.ItemSku": "SKU"},
+			want:  "Account[UserID SKU]",
+		},
+		{
+			name:   "unknown column",
+			names:  map[string]string{"Users.Unknown": "Unknown"},
+			errMsg: "unknown column Unknown of the names in the table Users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inflector, err := NewInflector("")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			initialisms, err := NewInitialisms(tt.initialisms)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tl := NewTypeLoader(l, inflector)
+			tl.Initialisms = initialisms
+			tableMap, _, err := tl.LoadSchema(&ArgType{NoSingularize: tt.noSingularize, Names: tt.names})
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			typ := tableMap["Users"]
+			var fields []string
+			for _, f := range typ.Fields {
+				fields = append(fields, f.Name)
+			}
+			if got := typ.Name + fmt.Sprint(fields); got != tt.want {
+				t.Errorf("error. want:%v got:%v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseStructTag(t *testing.T) {
+	tests := []struct {
+		tag    string
+		want   []StructTag
+		errMsg string
+	}{
+		{
+			tag:  `validate:"required,email" db:"email"`,
+			want: []StructTag{{Key: "validate", Value: "required,email"}, {Key: "db", Value: "email"}},
+		},
+		{
+			tag:  ` json:"email,omitempty"  protobuf:"bytes,2,opt,name=email" `,
+			want: []StructTag{{Key: "json", Value: "email,omitempty"}, {Key: "protobuf", Value: "bytes,2,opt,name=email"}},
+		},
+		{
+			tag:  `pattern:"^\"[a-z]+\"$"`,
+			want: []StructTag{{Key: "pattern", Value: `^"[a-z]+"$`}},
+		},
+		{
+			tag:    `validate:required`,
+			errMsg: "invalid struct tag",
+		},
+		{
+			tag:    `validate:"required`,
+			errMsg: "invalid struct tag",
+		},
+		{
+			tag:    `spanner:"Email"`,
+			errMsg: "spanner tag cannot be given",
+		},
+		{
+			tag:    `db:"a" db:"b"`,
+			errMsg: "duplicate key db",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := parseStructTag(tt.tag)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("error. want:%v got:%v", tt.want, got)
+			}
+		})
+	}
+}
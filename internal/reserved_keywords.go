@@ -117,3 +117,109 @@ var reservedKeywords = map[string]struct{}{
 	"WITH":                 struct{}{},
 	"WITHIN":               struct{}{},
 }
+
+// pgReservedKeywords is the reserved keywords of the PostgreSQL dialect, which was created with
+// reference to https://www.postgresql.org/docs/current/sql-keywords-appendix.html
+var pgReservedKeywords = map[string]struct{}{
+	"ALL":               struct{}{},
+	"ANALYSE":           struct{}{},
+	"ANALYZE":           struct{}{},
+	"AND":               struct{}{},
+	"ANY":               struct{}{},
+	"ARRAY":             struct{}{},
+	"AS":                struct{}{},
+	"ASC":               struct{}{},
+	"ASYMMETRIC":        struct{}{},
+	"AUTHORIZATION":     struct{}{},
+	"BINARY":            struct{}{},
+	"BOTH":              struct{}{},
+	"CASE":              struct{}{},
+	"CAST":              struct{}{},
+	"CHECK":             struct{}{},
+	"COLLATE":           struct{}{},
+	"COLLATION":         struct{}{},
+	"COLUMN":            struct{}{},
+	"CONCURRENTLY":      struct{}{},
+	"CONSTRAINT":        struct{}{},
+	"CREATE":            struct{}{},
+	"CROSS":             struct{}{},
+	"CURRENT_CATALOG":   struct{}{},
+	"CURRENT_DATE":      struct{}{},
+	"CURRENT_ROLE":      struct{}{},
+	"CURRENT_SCHEMA":    struct{}{},
+	"CURRENT_TIME":      struct{}{},
+	"CURRENT_TIMESTAMP": struct{}{},
+	"CURRENT_USER":      struct{}{},
+	"DEFAULT":           struct{}{},
+	"DEFERRABLE":        struct{}{},
+	"DESC":              struct{}{},
+	"DISTINCT":          struct{}{},
+	"DO":                struct{}{},
+	"ELSE":              struct{}{},
+	"END":               struct{}{},
+	"EXCEPT":            struct{}{},
+	"FALSE":             struct{}{},
+	"FETCH":             struct{}{},
+	"FOR":               struct{}{},
+	"FOREIGN":           struct{}{},
+	"FREEZE":            struct{}{},
+	"FROM":              struct{}{},
+	"FULL":              struct{}{},
+	"GRANT":             struct{}{},
+	"GROUP":             struct{}{},
+	"HAVING":            struct{}{},
+	"ILIKE":             struct{}{},
+	"IN":                struct{}{},
+	"INITIALLY":         struct{}{},
+	"INNER":             struct{}{},
+	"INTERSECT":         struct{}{},
+	"INTO":              struct{}{},
+	"IS":                struct{}{},
+	"ISNULL":            struct{}{},
+	"JOIN":              struct{}{},
+	"LATERAL":           struct{}{},
+	"LEADING":           struct{}{},
+	"LEFT":              struct{}{},
+	"LIKE":              struct{}{},
+	"LIMIT":             struct{}{},
+	"LOCALTIME":         struct{}{},
+	"LOCALTIMESTAMP":    struct{}{},
+	"NATURAL":           struct{}{},
+	"NOT":               struct{}{},
+	"NOTNULL":           struct{}{},
+	"NULL":              struct{}{},
+	"OFFSET":            struct{}{},
+	"ON":                struct{}{},
+	"ONLY":              struct{}{},
+	"OR":                struct{}{},
+	"ORDER":             struct{}{},
+	"OUTER":             struct{}{},
+	"OVERLAPS":          struct{}{},
+	"PLACING":           struct{}{},
+	"PRIMARY":           struct{}{},
+	"REFERENCES":        struct{}{},
+	"RETURNING":         struct{}{},
+	"RIGHT":             struct{}{},
+	"SELECT":            struct{}{},
+	"SESSION_USER":      struct{}{},
+	"SIMILAR":           struct{}{},
+	"SOME":              struct{}{},
+	"SYMMETRIC":         struct{}{},
+	"SYSTEM_USER":       struct{}{},
+	"TABLE":             struct{}{},
+	"TABLESAMPLE":       struct{}{},
+	"THEN":              struct{}{},
+	"TO":                struct{}{},
+	"TRAILING":          struct{}{},
+	"TRUE":              struct{}{},
+	"UNION":             struct{}{},
+	"UNIQUE":            struct{}{},
+	"USER":              struct{}{},
+	"USING":             struct{}{},
+	"VARIADIC":          struct{}{},
+	"VERBOSE":           struct{}{},
+	"WHEN":              struct{}{},
+	"WHERE":             struct{}{},
+	"WINDOW":            struct{}{},
+	"WITH":              struct{}{},
+}
@@ -29,6 +29,27 @@ type Field struct {
 	NilType    string
 	Len        int
 	Col        *models.Column
+
+	// CustomTypeImport is the import path of the package of CustomType.
+	CustomTypeImport string
+
+	// TypeImport is the import path of the package of Type, which is the
+	// package of the proto types of PROTO and ENUM columns.
+	TypeImport string
+
+	// Enum is the string enum which is the CustomType of the field. It is nil
+	// unless the column is typed by its CHECK constraint.
+	Enum *Enum
+
+	// Tags is the struct tags of the field given by the custom types file,
+	// which are added to the spanner and json tags.
+	Tags []StructTag
+}
+
+// StructTag is a key and a value of a struct tag such as validate:"required".
+type StructTag struct {
+	Key   string
+	Value string
 }
 
 // Type is a template item for a type.
@@ -40,6 +61,53 @@ type Type struct {
 	Fields           []*Field
 	Table            *models.Table
 	Indexes          []*Index
+
+	// SearchIndexes is the search finders of the table.
+	SearchIndexes []*SearchIndex
+
+	// VectorIndexes is the nearest neighbor finders of the table.
+	VectorIndexes []*VectorIndex
+
+	// Enums is the string enums of the columns of the table.
+	Enums []*Enum
+
+	// ForeignKeys is the foreign keys of the table to the generated tables.
+	ForeignKeys []*ForeignKey
+
+	// ChangeStreams is the change streams watching the table.
+	ChangeStreams []*ChangeStream
+
+	// PageFields is the sort key of the page reader of the table, which is
+	// the primary key. It is nil if a column of the primary key is nullable.
+	PageFields []*OrderField
+
+	// PrimaryKeyOrder is the primary key with the directions.
+	PrimaryKeyOrder []*OrderField
+
+	// TTLField is the timestamp field of the row deletion policy of the
+	// table. It is nil if the table has no policy, the column is ignored or
+	// typed by a custom type, or a field is named ExpiresAt.
+	TTLField *Field
+
+	// VersionField is the version column of the optimistic locking of the
+	// table. It is nil unless declared in the custom types file.
+	VersionField *Field
+
+	// SoftDeleteField is the timestamp column marking the soft-deleted rows
+	// of the table. It is nil unless declared in the custom types file.
+	SoftDeleteField *Field
+
+	// Parent is the table which the table is interleaved in. It is nil if
+	// the parent is not generated.
+	Parent *Type
+
+	// InterleavedTables is the tables interleaved in the table, including
+	// the descendants. The descendants come before their parents.
+	InterleavedTables []string
+
+	// columns is the names of all columns of the table including the
+	// ignored ones.
+	columns map[string]bool
 }
 
 // Index is a template item for a index into a table.
@@ -51,4 +119,119 @@ type Index struct {
 	StoringFields  []*Field
 	NullableFields []*Field
 	Index          *models.Index
+
+	// RowName is the name of the struct of the index row.
+	RowName string
+
+	// RowFields is the fields of the index row, which are the index key, the
+	// storing columns and the primary key.
+	RowFields []*Field
+
+	// IsPrefix is true if the finder reads rows by a prefix of the index key.
+	IsPrefix bool
+
+	// OrderFields is the ORDER BY clause of the finder.
+	OrderFields []*OrderField
+
+	// PageFuncName is the name of the page reader of the index.
+	PageFuncName string
+
+	// PageFields is the sort key of the page reader of the index, which is
+	// the index key followed by the rest of the primary key. It is nil if
+	// the index has no page reader.
+	PageFields []*OrderField
+
+	// KeyFields is the whole index key with the directions, which is also
+	// the one of the index for a prefix finder.
+	KeyFields []*OrderField
+}
+
+// SearchIndex is a template item for a search finder, which searches a
+// TOKENLIST column of a search index.
+type SearchIndex struct {
+	FuncName string
+	Type     *Type
+	Index    *models.SearchIndex
+
+	// Column is the TOKENLIST column to search.
+	Column string
+
+	// Score is true if Column is tokenized for full-text search, and the
+	// rows are ordered by SCORE.
+	Score bool
+
+	// PartitionFields is the partition columns of the search index, which
+	// are given to the finder.
+	PartitionFields []*Field
+}
+
+// VectorIndex is a template item for a nearest neighbor finder, which finds
+// the rows by the approximate distance of the embedding column of a vector
+// index.
+type VectorIndex struct {
+	FuncName string
+	Type     *Type
+	Index    *models.VectorIndex
+
+	// Field is the embedding column.
+	Field *Field
+
+	// VectorType is the Go type of the vector given to the finder.
+	VectorType string
+
+	// DistanceFunc is the approximate distance function of the distance type
+	// of the index, and Desc is true if the greater values are nearer.
+	DistanceFunc string
+	Desc         bool
+
+	// NumLeavesToSearch is the num_leaves_to_search of the distance
+	// function.
+	NumLeavesToSearch int64
+}
+
+// ForeignKey is a template item for a foreign key, which generates the
+// accessors of the referenced row and the referencing rows.
+type ForeignKey struct {
+	Type       *Type
+	Fields     []*Field
+	RefType    *Type
+	RefFields  []*Field
+	ForeignKey *models.ForeignKey
+
+	// FetchName is the name of the method of Type reading the referenced
+	// row, and ListName is the name of the method of RefType reading the
+	// referencing rows.
+	FetchName string
+	ListName  string
+}
+
+// ChangeStream is a template item for a change stream, which generates the
+// consumer of its data change records.
+type ChangeStream struct {
+	Name         string
+	ChangeStream *models.ChangeStream
+
+	// Types is the generated tables watched by the change stream, sorted by
+	// the names.
+	Types []*Type
+}
+
+// Enum is a template item for a string enum of a column constrained by
+// CHECK (column IN (...)).
+type Enum struct {
+	Name   string
+	Field  *Field
+	Values []*EnumValue
+}
+
+// EnumValue is a value of an enum and the name of its constant.
+type EnumValue struct {
+	Name  string
+	Value string
+}
+
+// OrderField is a field in ORDER BY clause.
+type OrderField struct {
+	Field *Field
+	Desc  bool
 }
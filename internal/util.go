@@ -21,6 +21,10 @@ package internal
 
 import (
 	"fmt"
+	"go/token"
+	"path"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/kenshaw/snaker"
@@ -42,17 +46,39 @@ func reverseIndexRune(s string, r rune) int {
 	return -1
 }
 
+// NewInitialisms returns the initialisms kept upper case in the generated
+// names, which are the common ones such as ID and URL and initialisms such as
+// SKU in FindBySKU.
+func NewInitialisms(initialisms []string) (*snaker.Initialisms, error) {
+	ini := snaker.NewDefaultInitialisms()
+	if err := ini.Add(initialisms...); err != nil {
+		return nil, fmt.Errorf("invalid initialisms: %v", err)
+	}
+	return ini, nil
+}
+
+// ValidateNames validates the names of the types and the fields given by the
+// user, which must be exported Go identifiers.
+func ValidateNames(names map[string]string) error {
+	for k, name := range names {
+		if !token.IsIdentifier(name) || !token.IsExported(name) {
+			return fmt.Errorf("invalid name '%s' of '%s', must be an exported Go identifier", name, k)
+		}
+	}
+	return nil
+}
+
 // SinguralizeIdentifier will singularize a identifier, returning it in
-// CamelCase.
-func SingularizeIdentifier(in Inflector, s string) string {
+// CamelCase with the initialisms ini.
+func SingularizeIdentifier(in Inflector, ini *snaker.Initialisms, s string) string {
 	if i := reverseIndexRune(s, '_'); i != -1 {
 		s = s[:i] + "_" + in.Singularize(s[i+1:])
 	} else {
 		s = in.Singularize(s)
 	}
 
-	// return snaker.SnakeToCamelIdentifier(s)
-	return snaker.ForceCamelIdentifier(s)
+	// return ini.SnakeToCamelIdentifier(s)
+	return ini.ForceCamelIdentifier(s)
 }
 
 // EscapeColumnName will escape a column name if using reserved keyword as column name, returning it in
@@ -65,3 +91,279 @@ func EscapeColumnName(s string) string {
 	// return s if not reserved keyword
 	return s
 }
+
+// pgIdentifierRegexp matches the identifiers of PostgreSQL which are not
+// changed by folding them to lower case.
+var pgIdentifierRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+// EscapePGIdentifier will quote an identifier of a PostgreSQL-dialect query if it is a reserved
+// keyword or not in lower case, returning it surrounded by double quotes.
+func EscapePGIdentifier(s string) string {
+	if _, ok := pgReservedKeywords[strings.ToUpper(s)]; ok || !pgIdentifierRegexp.MatchString(s) {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	return s
+}
+
+// splitCustomType splits the custom type qualified by the import path such as
+// github.com/acme/types.Status into the import path and the type qualified by
+// the package name, which is assumed to be the last element of the import
+// path. The import path is empty if typ is not qualified by it.
+func splitCustomType(typ string) (string, string) {
+	if !strings.Contains(typ, "/") {
+		return "", typ
+	}
+
+	i := strings.LastIndexByte(typ, '.')
+	if i < strings.LastIndexByte(typ, '/') {
+		return "", typ
+	}
+
+	return typ[:i], path.Base(typ[:i]) + typ[i:]
+}
+
+// splitGoType splits the Go type qualified by the import path such as
+// *github.com/acme/musicpb.SingerInfo into the import path and the type
+// qualified by the package name in the same manner as splitCustomType. The
+// pointers and the slices of the type are kept.
+func splitGoType(typ string) (string, string) {
+	elem := strings.TrimLeft(typ, "[]*")
+	importPath, t := splitCustomType(elem)
+	return importPath, typ[:len(typ)-len(elem)] + t
+}
+
+// nullablePointerTypes maps the Go types of nullable scalar columns to the
+// pointer types which they are read and written as by --nullable-pointers.
+var nullablePointerTypes = map[string]string{
+	"spanner.NullString":  "*string",
+	"spanner.NullInt64":   "*int64",
+	"spanner.NullFloat64": "*float64",
+	"spanner.NullFloat32": "*float32",
+	"spanner.NullBool":    "*bool",
+	"spanner.NullTime":    "*time.Time",
+	"spanner.NullDate":    "*civil.Date",
+	"spanner.NullNumeric": "*big.Rat",
+}
+
+// Go types of DATE columns.
+const (
+	DateTypeCivil = "civil.Date"
+	DateTypeTime  = "time.Time"
+)
+
+// dateTimeTypes maps the Go types of DATE columns read as civil.Date to the
+// types of them read as time.Time.
+var dateTimeTypes = map[string]string{
+	"civil.Date":         "time.Time",
+	"spanner.NullDate":   "spanner.NullTime",
+	"*civil.Date":        "*time.Time",
+	"[]civil.Date":       "[]time.Time",
+	"[]spanner.NullDate": "[]spanner.NullTime",
+}
+
+// ValidateDateType validates the Go type of DATE columns given by the user.
+func ValidateDateType(typ string) error {
+	switch typ {
+	case "", DateTypeCivil, DateTypeTime:
+		return nil
+	}
+	return fmt.Errorf("unknown date type '%s', must be %s or %s", typ, DateTypeCivil, DateTypeTime)
+}
+
+// SQL dialects of databases.
+const (
+	DialectGoogleSQL  = "googlesql"
+	DialectPostgreSQL = "postgresql"
+)
+
+// ValidateDialect validates the SQL dialect of the database given by the user.
+func ValidateDialect(dialect string) error {
+	switch dialect {
+	case "", DialectGoogleSQL, DialectPostgreSQL:
+		return nil
+	}
+	return fmt.Errorf("unknown dialect '%s', must be %s or %s", dialect, DialectGoogleSQL, DialectPostgreSQL)
+}
+
+// ValidateColumnTypes validates the columns and the Go types of the column
+// types given by the user.
+func ValidateColumnTypes(columnTypes map[string]string) error {
+	for column, typ := range columnTypes {
+		if _, _, ok := splitTableColumn(column); !ok {
+			return fmt.Errorf("invalid column '%s' of column types, must be <table>.<column>", column)
+		}
+		if typ == "" {
+			return fmt.Errorf("type of the column '%s' is empty", column)
+		}
+	}
+	return nil
+}
+
+// splitTableColumn splits the column qualified by the table such as
+// Users.Settings into the table and the column. The table may be qualified by
+// the named schema.
+func splitTableColumn(s string) (string, string, bool) {
+	i := strings.LastIndexByte(s, '.')
+	if i <= 0 || i == len(s)-1 {
+		return "", "", false
+	}
+	return s[:i], s[i+1:], true
+}
+
+// parseStructTag parses the struct tag such as `validate:"required" db:"id"`
+// in the conventional format of reflect.StructTag. The spanner tag cannot be
+// given since it is the column of the field.
+func parseStructTag(tag string) ([]StructTag, error) {
+	if strings.Contains(tag, "`") {
+		return nil, fmt.Errorf("invalid struct tag %q", tag)
+	}
+
+	var tags []StructTag
+	keys := make(map[string]bool)
+	for s := strings.TrimLeft(tag, " "); s != ""; s = strings.TrimLeft(s, " ") {
+		i := 0
+		for i < len(s) && s[i] > ' ' && s[i] != ':' && s[i] != '"' && s[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(s) || s[i] != ':' || s[i+1] != '"' {
+			return nil, fmt.Errorf("invalid struct tag %q", tag)
+		}
+		key := s[:i]
+		s = s[i+1:]
+
+		// find the closing quote of the value
+		i = 1
+		for i < len(s) && s[i] != '"' {
+			if s[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(s) {
+			return nil, fmt.Errorf("invalid struct tag %q", tag)
+		}
+		value, err := strconv.Unquote(s[:i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid struct tag %q", tag)
+		}
+		s = s[i+1:]
+
+		if key == "spanner" {
+			return nil, fmt.Errorf("spanner tag cannot be given in struct tag %q", tag)
+		}
+		if keys[key] {
+			return nil, fmt.Errorf("duplicate key %s in struct tag %q", key, tag)
+		}
+		keys[key] = true
+		tags = append(tags, StructTag{Key: key, Value: value})
+	}
+
+	return tags, nil
+}
+
+// tableFilter is a pattern of table names, which is a regular expression if
+// it is enclosed in slashes such as /^users?$/, or a glob pattern otherwise.
+type tableFilter struct {
+	glob string
+	re   *regexp.Regexp
+}
+
+// match reports whether table matches the pattern of f. The regular
+// expressions match any part of table unless anchored.
+func (f *tableFilter) match(table string) bool {
+	if f.re != nil {
+		return f.re.MatchString(table)
+	}
+	ok, _ := path.Match(f.glob, table)
+	return ok
+}
+
+// compileTableFilters compiles the patterns of table filters.
+func compileTableFilters(patterns []string) ([]*tableFilter, error) {
+	var filters []*tableFilter
+	for _, p := range patterns {
+		if len(p) >= 2 && strings.HasPrefix(p, "/") && strings.HasSuffix(p, "/") {
+			re, err := regexp.Compile(p[1 : len(p)-1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid table filter %q: %v", p, err)
+			}
+			filters = append(filters, &tableFilter{re: re})
+			continue
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid table filter %q: %v", p, err)
+		}
+		filters = append(filters, &tableFilter{glob: p})
+	}
+
+	return filters, nil
+}
+
+// matchTableFilters reports whether table is selected by include and exclude
+// filters. If include is empty, every table not excluded is selected.
+func matchTableFilters(table string, include, exclude []*tableFilter) bool {
+	if len(include) != 0 {
+		matched := false
+		for _, f := range include {
+			if f.match(table) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	for _, f := range exclude {
+		if f.match(table) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseClientVersion parses the version of cloud.google.com/go/spanner
+// like v1.60.0 into its major, minor and patch versions.
+func ParseClientVersion(v string) ([3]int, bool) {
+	var ver [3]int
+	if !strings.HasPrefix(v, "v") {
+		return ver, false
+	}
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v[1:], ".")
+	if len(parts) > 3 {
+		return ver, false
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return ver, false
+		}
+		ver[i] = n
+	}
+	return ver, true
+}
+
+// ClientSupports reports whether the client version supports the feature
+// added in the version since. The latest version is assumed if version is
+// empty.
+func ClientSupports(version, since string) bool {
+	if version == "" {
+		return true
+	}
+	v, ok := ParseClientVersion(version)
+	if !ok {
+		return true
+	}
+	s, _ := ParseClientVersion(since)
+	for i := range v {
+		if v[i] != s[i] {
+			return v[i] > s[i]
+		}
+	}
+	return true
+}
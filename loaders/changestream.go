@@ -0,0 +1,48 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import "go.mercari.io/yo/models"
+
+// changeStreamIndex returns the index of the change stream, or -1 if it is
+// undefined.
+func changeStreamIndex(streams []*models.ChangeStream, name string) int {
+	for i, stream := range streams {
+		if stream.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// ChangeStreamList returns the change streams.
+func (s *SpannerLoaderFromDDL) ChangeStreamList() ([]*models.ChangeStream, error) {
+	var streams []*models.ChangeStream
+	for _, stream := range s.changeStreams {
+		v := *stream
+		v.TableNames = nil
+		for _, table := range stream.TableNames {
+			v.TableNames = append(v.TableNames, s.qualifiedName(table))
+		}
+		streams = append(streams, &v)
+	}
+
+	return streams, nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cloudspannerecosystem/memefish/ast"
+)
+
+// checkInValues returns the values of the columns constrained by the CHECK
+// constraints in the form of CHECK (column IN ('A', 'B', ...)) by column name.
+func checkInValues(constraints []*ast.TableConstraint) map[string][]string {
+	values := make(map[string][]string)
+	for _, tc := range constraints {
+		c, ok := tc.Constraint.(*ast.Check)
+		if !ok {
+			continue
+		}
+		if column, vals, ok := inListValues(c.Expr); ok {
+			addCheckInValues(values, column, vals)
+		}
+	}
+
+	return values
+}
+
+// inListValues returns the column and the values of expr in the form of
+// column IN ('A', 'B', ...).
+func inListValues(expr ast.Expr) (string, []string, bool) {
+	for {
+		p, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = p.Expr
+	}
+
+	in, ok := expr.(*ast.InExpr)
+	if !ok || in.Not {
+		return "", nil, false
+	}
+	column, ok := in.Left.(*ast.Ident)
+	if !ok {
+		return "", nil, false
+	}
+	cond, ok := in.Right.(*ast.ValuesInCondition)
+	if !ok {
+		return "", nil, false
+	}
+
+	var vals []string
+	for _, e := range cond.Exprs {
+		s, ok := e.(*ast.StringLiteral)
+		if !ok {
+			return "", nil, false
+		}
+		vals = append(vals, s.Value)
+	}
+
+	return column.Name, vals, true
+}
+
+// addCheckInValues adds vals of column to values. The values of a column
+// constrained by multiple constraints are the ones allowed by all of them.
+func addCheckInValues(values map[string][]string, column string, vals []string) {
+	prev, ok := values[column]
+	if !ok {
+		values[column] = vals
+		return
+	}
+
+	allowed := make(map[string]bool, len(vals))
+	for _, v := range vals {
+		allowed[v] = true
+	}
+	var both []string
+	for _, v := range prev {
+		if allowed[v] {
+			both = append(both, v)
+		}
+	}
+	values[column] = both
+}
+
+// checkInClauseRegexp matches the CHECK_CLAUSE of INFORMATION_SCHEMA in the
+// form of column IN (...).
+var checkInClauseRegexp = regexp.MustCompile("(?is)^`?([A-Za-z_][A-Za-z0-9_]*)`?\\s+IN\\s*\\((.*)\\)$")
+
+// parseCheckInClause returns the column and the values of clause in the form
+// of column IN ('A', 'B', ...), which is the CHECK_CLAUSE of a CHECK
+// constraint.
+func parseCheckInClause(clause string) (string, []string, bool) {
+	clause = strings.TrimSpace(clause)
+	for strings.HasPrefix(clause, "(") && strings.HasSuffix(clause, ")") {
+		clause = strings.TrimSpace(clause[1 : len(clause)-1])
+	}
+
+	m := checkInClauseRegexp.FindStringSubmatch(clause)
+	if m == nil {
+		return "", nil, false
+	}
+
+	var vals []string
+	list := strings.TrimSpace(m[2])
+	for {
+		v, rest, ok := scanStringLiteral(list)
+		if !ok {
+			return "", nil, false
+		}
+		vals = append(vals, v)
+
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			break
+		}
+		if rest[0] != ',' {
+			return "", nil, false
+		}
+		list = strings.TrimSpace(rest[1:])
+	}
+
+	return m[1], vals, true
+}
+
+// scanStringLiteral scans a quoted string literal at the beginning of s, and
+// returns the unescaped value and the rest of s.
+func scanStringLiteral(s string) (string, string, bool) {
+	if s == "" || (s[0] != '\'' && s[0] != '"') {
+		return "", "", false
+	}
+
+	quote := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case quote:
+			return b.String(), s[i+1:], true
+		case '\\':
+			i++
+			if i == len(s) {
+				return "", "", false
+			}
+		}
+		b.WriteByte(s[i])
+	}
+
+	return "", "", false
+}
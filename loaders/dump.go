@@ -0,0 +1,708 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"go.mercari.io/yo/internal"
+	"go.mercari.io/yo/models"
+	"google.golang.org/api/iterator"
+)
+
+// dumpSchema is the schema written by DumpDDL.
+type dumpSchema struct {
+	tables        []*dumpTable // the parents precede the interleaved tables
+	views         []*dumpView
+	changeStreams []*models.ChangeStream
+}
+
+// dumpTable is a table with its indexes and constraints.
+type dumpTable struct {
+	table         *models.Table
+	columns       []*dumpColumn
+	primaryKey    []*models.IndexColumn
+	onDelete      string // ON_DELETE_ACTION of an interleaved table
+	indexes       []*dumpIndex
+	foreignKeys   []*models.ForeignKey
+	searchIndexes []*models.SearchIndex
+	vectorIndexes []*models.VectorIndex
+}
+
+// dumpColumn is a column with the expressions which are not loaded into the
+// models.
+type dumpColumn struct {
+	*models.Column
+	defaultExpr    string // COLUMN_DEFAULT
+	generationExpr string // GENERATION_EXPRESSION
+	stored         bool   // IS_STORED of a generated column
+}
+
+// dumpIndex is a secondary index with its columns.
+type dumpIndex struct {
+	index   *models.Index
+	columns []*models.IndexColumn
+}
+
+// dumpView is a view with its query.
+type dumpView struct {
+	name     string
+	query    string // VIEW_DEFINITION
+	security string // SECURITY_TYPE
+}
+
+// DumpDDL writes the schema of the database as the DDL, which the DDL loader
+// loads into the same schema as s. The DDL is normalized so that the same
+// schema is always dumped to the same DDL: the statements are ordered by kind
+// and name, and the sequences and the identity columns are written with the
+// bit_reversed_positive kind.
+func (s *SpannerLoader) DumpDDL(w io.Writer) error {
+	schema, err := s.dumpSchema()
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, schema.ddl())
+	return err
+}
+
+// dumpSchema loads the schema to be dumped.
+func (s *SpannerLoader) dumpSchema() (*dumpSchema, error) {
+	tables, err := s.TableList()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].TableName < tables[j].TableName })
+	onDelete, err := spanOnDeleteActions(s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var dumpTables []*dumpTable
+	for _, t := range tables {
+		dt := &dumpTable{table: t, onDelete: onDelete[t.TableName]}
+
+		cols, err := s.ColumnList(t.TableName)
+		if err != nil {
+			return nil, err
+		}
+		exprs, err := spanColumnExprs(s.client, t.TableName)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cols {
+			dc := &dumpColumn{Column: c}
+			if e, ok := exprs[c.ColumnName]; ok {
+				dc.defaultExpr, dc.generationExpr, dc.stored = e.defaultExpr, e.generationExpr, e.stored
+			}
+			dt.columns = append(dt.columns, dc)
+		}
+		// the TOKENLIST columns are not loaded into the models
+		for _, e := range exprs {
+			if e.DataType == "TOKENLIST" {
+				dt.columns = append(dt.columns, e)
+			}
+		}
+		sort.Slice(dt.columns, func(i, j int) bool { return dt.columns[i].FieldOrdinal < dt.columns[j].FieldOrdinal })
+
+		if dt.primaryKey, err = s.IndexColumnList(t.TableName, "PRIMARY_KEY"); err != nil {
+			return nil, err
+		}
+		indexes, err := s.IndexList(t.TableName)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i].IndexName < indexes[j].IndexName })
+		for _, ix := range indexes {
+			ixCols, err := s.IndexColumnList(t.TableName, ix.IndexName)
+			if err != nil {
+				return nil, err
+			}
+			dt.indexes = append(dt.indexes, &dumpIndex{index: ix, columns: ixCols})
+		}
+		if dt.foreignKeys, err = s.ForeignKeyList(t.TableName); err != nil {
+			return nil, err
+		}
+		if dt.searchIndexes, err = s.SearchIndexList(t.TableName); err != nil {
+			return nil, err
+		}
+		if dt.vectorIndexes, err = s.VectorIndexList(t.TableName); err != nil {
+			return nil, err
+		}
+
+		dumpTables = append(dumpTables, dt)
+	}
+
+	views, err := spanViewDefinitions(s.client)
+	if err != nil {
+		return nil, err
+	}
+	changeStreams, err := s.ChangeStreamList()
+	if err != nil {
+		return nil, err
+	}
+
+	return &dumpSchema{
+		tables:        interleavedOrder(dumpTables),
+		views:         viewOrder(views),
+		changeStreams: changeStreams,
+	}, nil
+}
+
+// interleavedOrder orders the tables so that the parents precede the
+// interleaved tables. The order is kept otherwise.
+func interleavedOrder(tables []*dumpTable) []*dumpTable {
+	byName := make(map[string]*dumpTable, len(tables))
+	for _, t := range tables {
+		byName[t.table.TableName] = t
+	}
+
+	res := make([]*dumpTable, 0, len(tables))
+	visited := make(map[string]bool, len(tables))
+	var visit func(t *dumpTable)
+	visit = func(t *dumpTable) {
+		if visited[t.table.TableName] {
+			return
+		}
+		visited[t.table.TableName] = true
+		if p, ok := byName[t.table.ParentTableName]; ok {
+			visit(p)
+		}
+		res = append(res, t)
+	}
+	for _, t := range tables {
+		visit(t)
+	}
+
+	return res
+}
+
+// viewOrder orders the views so that the views precede the views selecting
+// from them. The order is kept otherwise.
+func viewOrder(views []*dumpView) []*dumpView {
+	byName := make(map[string]*dumpView, len(views))
+	for _, v := range views {
+		byName[v.name] = v
+	}
+
+	res := make([]*dumpView, 0, len(views))
+	visited := make(map[string]bool, len(views))
+	var visit func(v *dumpView)
+	visit = func(v *dumpView) {
+		if visited[v.name] {
+			return
+		}
+		visited[v.name] = true
+		for _, name := range viewReferences(v.query) {
+			if dep, ok := byName[name]; ok && dep != v {
+				visit(dep)
+			}
+		}
+		res = append(res, v)
+	}
+	for _, v := range views {
+		visit(v)
+	}
+
+	return res
+}
+
+// viewReferences returns the names which the query may refer to in the order
+// of appearance, which are the identifiers and the prefixes of the paths such
+// as music.Singers of music.Singers.Name.
+func viewReferences(query string) []string {
+	var names []string
+	s := &ddlScanner{src: query}
+	for s.pos < len(s.src) {
+		c := s.src[s.pos]
+		if c != '`' && !isIdentChar(c) {
+			if !s.skip() {
+				s.pos++
+			}
+			continue
+		}
+
+		name := s.ident()
+		names = append(names, name)
+		for s.pos+1 < len(s.src) && s.src[s.pos] == '.' && (s.src[s.pos+1] == '`' || isIdentChar(s.src[s.pos+1])) {
+			s.pos++
+			name += "." + s.ident()
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// protoTypeNameRegexp matches the PROTO and ENUM types in data types such as
+// ARRAY<PROTO<examples.music.SingerInfo>>.
+var protoTypeNameRegexp = regexp.MustCompile(`(?:PROTO|ENUM)<([^<>]+)>`)
+
+// ddl returns the statements of the schema.
+func (d *dumpSchema) ddl() string {
+	var stmts []string
+
+	schemas := make(map[string]bool)
+	protoTypes := make(map[string]bool)
+	sequences := make(map[string]bool)
+	for _, t := range d.tables {
+		if schema, _ := splitQualifiedName(t.table.TableName); schema != "" {
+			schemas[schema] = true
+		}
+		for _, c := range t.columns {
+			for _, m := range protoTypeNameRegexp.FindAllStringSubmatch(c.DataType, -1) {
+				protoTypes[m[1]] = true
+			}
+			if c.Sequence != "" {
+				sequences[c.Sequence] = true
+			}
+		}
+	}
+	for _, v := range d.views {
+		if schema, _ := splitQualifiedName(v.name); schema != "" {
+			schemas[schema] = true
+		}
+	}
+
+	for _, schema := range sortedKeys(schemas) {
+		stmts = append(stmts, "CREATE SCHEMA "+schema)
+	}
+	if len(protoTypes) != 0 {
+		stmts = append(stmts, "CREATE PROTO BUNDLE (\n  "+strings.Join(sortedKeys(protoTypes), ",\n  ")+"\n)")
+	}
+	for _, seq := range sortedKeys(sequences) {
+		stmts = append(stmts, fmt.Sprintf("CREATE SEQUENCE %s OPTIONS (sequence_kind = 'bit_reversed_positive')", seq))
+	}
+
+	for _, t := range d.tables {
+		stmts = append(stmts, t.ddl())
+		for _, ix := range t.indexes {
+			stmts = append(stmts, ix.ddl(t.table.TableName))
+		}
+		for _, ix := range t.searchIndexes {
+			stmts = append(stmts, searchIndexDDL(t.table.TableName, ix))
+		}
+		for _, ix := range t.vectorIndexes {
+			stmts = append(stmts, t.vectorIndexDDL(ix))
+		}
+	}
+
+	// the foreign keys are added after all the tables are created because
+	// they may refer to the tables created later
+	for _, t := range d.tables {
+		for _, fk := range t.foreignKeys {
+			stmts = append(stmts, foreignKeyDDL(t.table.TableName, fk))
+		}
+	}
+
+	for _, v := range d.views {
+		security := v.security
+		if security == "" {
+			security = "INVOKER"
+		}
+		stmts = append(stmts, fmt.Sprintf("CREATE VIEW %s SQL SECURITY %s AS %s", v.name, security, strings.TrimSpace(v.query)))
+	}
+
+	for _, cs := range d.changeStreams {
+		stmt := "CREATE CHANGE STREAM " + cs.Name
+		if cs.All {
+			stmt += " FOR ALL"
+		} else if len(cs.TableNames) != 0 {
+			stmt += " FOR " + strings.Join(cs.TableNames, ", ")
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	if len(stmts) == 0 {
+		return ""
+	}
+	return strings.Join(stmts, ";\n\n") + ";\n"
+}
+
+// ddl returns the CREATE TABLE statement of the table.
+func (t *dumpTable) ddl() string {
+	var defs []string
+	for _, c := range t.columns {
+		defs = append(defs, "  "+c.ddl())
+	}
+	for _, c := range t.columns {
+		if len(c.EnumValues) == 0 {
+			continue
+		}
+		vals := make([]string, len(c.EnumValues))
+		for i, v := range c.EnumValues {
+			vals[i] = quoteStringLiteral(v)
+		}
+		defs = append(defs, fmt.Sprintf("  CHECK (%s IN (%s))", internal.EscapeColumnName(c.ColumnName), strings.Join(vals, ", ")))
+	}
+
+	stmt := fmt.Sprintf("CREATE TABLE %s (\n%s\n) PRIMARY KEY (%s)", t.table.TableName, strings.Join(defs, ",\n"), keyColumns(t.primaryKey))
+	if t.table.ParentTableName != "" {
+		stmt += ",\n  INTERLEAVE IN PARENT " + t.table.ParentTableName
+		if t.onDelete != "" {
+			stmt += " ON DELETE " + t.onDelete
+		}
+	}
+	if p := t.table.RowDeletionPolicy; p != nil {
+		stmt += fmt.Sprintf(",\n  ROW DELETION POLICY (OLDER_THAN(%s, INTERVAL %d DAY))", internal.EscapeColumnName(p.ColumnName), p.NumDays)
+	}
+
+	return stmt
+}
+
+// ddl returns the definition of the column in CREATE TABLE.
+func (c *dumpColumn) ddl() string {
+	def := internal.EscapeColumnName(c.ColumnName) + " " + protoTypeNameRegexp.ReplaceAllString(c.DataType, "$1")
+	if c.VectorLength != 0 {
+		def += fmt.Sprintf("(vector_length=>%d)", c.VectorLength)
+	}
+	if c.NotNull {
+		def += " NOT NULL"
+	}
+
+	switch {
+	case c.generationExpr != "":
+		def += " AS (" + c.generationExpr + ")"
+		if c.stored {
+			def += " STORED"
+		}
+		if c.DataType == "TOKENLIST" {
+			def += " HIDDEN"
+		}
+	case c.IsIdentity:
+		def += " GENERATED BY DEFAULT AS IDENTITY (BIT_REVERSED_POSITIVE)"
+	case c.defaultExpr != "":
+		def += " DEFAULT (" + c.defaultExpr + ")"
+	case c.Sequence != "":
+		def += " DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE " + c.Sequence + "))"
+	}
+
+	if len(c.Options) != 0 {
+		var opts []string
+		for _, k := range sortedKeys(c.Options) {
+			v := c.Options[k]
+			if strings.EqualFold(v, "true") || strings.EqualFold(v, "false") {
+				v = strings.ToLower(v)
+			}
+			opts = append(opts, k+" = "+v)
+		}
+		def += " OPTIONS (" + strings.Join(opts, ", ") + ")"
+	}
+
+	return def
+}
+
+// ddl returns the CREATE INDEX statement of the index on table.
+func (ix *dumpIndex) ddl(table string) string {
+	stmt := "CREATE "
+	if ix.index.IsUnique {
+		stmt += "UNIQUE "
+	}
+	if ix.index.IsNullFiltered {
+		stmt += "NULL_FILTERED "
+	}
+	stmt += fmt.Sprintf("INDEX %s ON %s (%s)", ix.index.IndexName, table, keyColumns(ix.columns))
+
+	var storing []string
+	for _, c := range ix.columns {
+		if c.Storing {
+			storing = append(storing, internal.EscapeColumnName(c.ColumnName))
+		}
+	}
+	if len(storing) != 0 {
+		stmt += " STORING (" + strings.Join(storing, ", ") + ")"
+	}
+
+	return stmt
+}
+
+// keyColumns returns the key columns of the index in the key order.
+func keyColumns(cols []*models.IndexColumn) string {
+	var keys []*models.IndexColumn
+	for _, c := range cols {
+		if !c.Storing {
+			keys = append(keys, c)
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].SeqNo < keys[j].SeqNo })
+
+	parts := make([]string, len(keys))
+	for i, c := range keys {
+		parts[i] = internal.EscapeColumnName(c.ColumnName)
+		if c.Desc {
+			parts[i] += " DESC"
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// searchIndexDDL returns the CREATE SEARCH INDEX statement of the search index
+// on table.
+func searchIndexDDL(table string, ix *models.SearchIndex) string {
+	stmt := fmt.Sprintf("CREATE SEARCH INDEX %s ON %s (%s)", ix.IndexName, table, escapeColumnNames(ix.Columns))
+	if len(ix.StoringColumns) != 0 {
+		stmt += " STORING (" + escapeColumnNames(ix.StoringColumns) + ")"
+	}
+	if len(ix.PartitionColumns) != 0 {
+		stmt += " PARTITION BY " + escapeColumnNames(ix.PartitionColumns)
+	}
+	if len(ix.OrderColumns) != 0 {
+		stmt += " ORDER BY " + escapeColumnNames(ix.OrderColumns)
+	}
+
+	return stmt
+}
+
+// vectorIndexDDL returns the CREATE VECTOR INDEX statement of the vector index
+// on the table. The rows whose embeddings are NULL are excluded by the WHERE
+// clause as required if the embedding column is nullable.
+func (t *dumpTable) vectorIndexDDL(ix *models.VectorIndex) string {
+	column := internal.EscapeColumnName(ix.ColumnName)
+	stmt := fmt.Sprintf("CREATE VECTOR INDEX %s ON %s (%s)", ix.IndexName, t.table.TableName, column)
+	if len(ix.StoringColumns) != 0 {
+		stmt += " STORING (" + escapeColumnNames(ix.StoringColumns) + ")"
+	}
+	for _, c := range t.columns {
+		if c.ColumnName == ix.ColumnName && !c.NotNull {
+			stmt += " WHERE " + column + " IS NOT NULL"
+		}
+	}
+
+	if len(ix.Options) != 0 {
+		var opts []string
+		for _, k := range sortedKeys(ix.Options) {
+			v := ix.Options[k]
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil && !strings.HasPrefix(v, "'") && !strings.HasPrefix(v, `"`) {
+				v = quoteStringLiteral(v)
+			}
+			opts = append(opts, k+" = "+v)
+		}
+		stmt += " OPTIONS (" + strings.Join(opts, ", ") + ")"
+	}
+
+	return stmt
+}
+
+// foreignKeyDDL returns the ALTER TABLE statement adding the foreign key to
+// table.
+func foreignKeyDDL(table string, fk *models.ForeignKey) string {
+	stmt := "ALTER TABLE " + table + " ADD "
+	if fk.ConstraintName != "" {
+		_, name := splitQualifiedName(fk.ConstraintName)
+		stmt += "CONSTRAINT " + name + " "
+	}
+
+	return stmt + fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)", escapeColumnNames(fk.ColumnNames), fk.RefTableName, escapeColumnNames(fk.RefColumnNames))
+}
+
+// escapeColumnNames returns the comma separated list of the column names,
+// which are escaped if they are reserved keywords.
+func escapeColumnNames(names []string) string {
+	escaped := make([]string, len(names))
+	for i, name := range names {
+		escaped[i] = internal.EscapeColumnName(name)
+	}
+	return strings.Join(escaped, ", ")
+}
+
+// quoteStringLiteral returns s quoted as a string literal.
+func quoteStringLiteral(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `'`, `\'`, -1)
+	return "'" + s + "'"
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// spanColumnExprs runs a custom query, returning the columns of table with
+// the default values and the generation expressions by column name. The
+// TOKENLIST columns are returned only by their data type, ordinal position
+// and expressions.
+func spanColumnExprs(client *spanner.Client, table string) (map[string]*dumpColumn, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`COLUMN_NAME, ORDINAL_POSITION, SPANNER_TYPE, COLUMN_DEFAULT, GENERATION_EXPRESSION, IS_STORED ` +
+		`FROM INFORMATION_SCHEMA.COLUMNS ` +
+		`WHERE TABLE_SCHEMA = @schema AND TABLE_NAME = @table`
+
+	schema, name := splitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := make(map[string]*dumpColumn)
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var c models.Column
+		var ord int64
+		var columnDefault, generation, stored spanner.NullString
+		if err := row.ColumnByName("COLUMN_NAME", &c.ColumnName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ORDINAL_POSITION", &ord); err != nil {
+			return nil, err
+		}
+		c.FieldOrdinal = int(ord)
+		if err := row.ColumnByName("SPANNER_TYPE", &c.DataType); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("COLUMN_DEFAULT", &columnDefault); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("GENERATION_EXPRESSION", &generation); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("IS_STORED", &stored); err != nil {
+			return nil, err
+		}
+		c.IsGenerated = generation.Valid
+
+		res[c.ColumnName] = &dumpColumn{
+			Column:         &c,
+			defaultExpr:    columnDefault.StringVal,
+			generationExpr: generation.StringVal,
+			stored:         stored.StringVal == "YES",
+		}
+	}
+
+	return res, nil
+}
+
+// spanOnDeleteActions runs a custom query, returning the ON DELETE actions of
+// the interleaved tables by table name.
+func spanOnDeleteActions(client *spanner.Client) (map[string]string, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`TABLE_SCHEMA, TABLE_NAME, ON_DELETE_ACTION ` +
+		`FROM INFORMATION_SCHEMA.TABLES ` +
+		`WHERE TABLE_SCHEMA NOT IN ("INFORMATION_SCHEMA", "SPANNER_SYS") AND TABLE_TYPE = "BASE TABLE" ` +
+		`AND ON_DELETE_ACTION IS NOT NULL`
+
+	stmt := spanner.NewStatement(sqlstr)
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := make(map[string]string)
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var schema, name, action string
+		if err := row.ColumnByName("TABLE_SCHEMA", &schema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("TABLE_NAME", &name); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ON_DELETE_ACTION", &action); err != nil {
+			return nil, err
+		}
+		res[qualify(schema, name)] = action
+	}
+
+	return res, nil
+}
+
+// spanViewDefinitions runs a custom query, returning the views with their
+// queries in the order of the names.
+func spanViewDefinitions(client *spanner.Client) ([]*dumpView, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`TABLE_SCHEMA, TABLE_NAME, VIEW_DEFINITION, SECURITY_TYPE ` +
+		`FROM INFORMATION_SCHEMA.VIEWS ` +
+		`WHERE TABLE_SCHEMA NOT IN ("INFORMATION_SCHEMA", "SPANNER_SYS") ` +
+		`ORDER BY TABLE_SCHEMA, TABLE_NAME`
+
+	stmt := spanner.NewStatement(sqlstr)
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*dumpView{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var schema, name string
+		var security spanner.NullString
+		var v dumpView
+		if err := row.ColumnByName("TABLE_SCHEMA", &schema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("TABLE_NAME", &name); err != nil {
+			return nil, err
+		}
+		v.name = qualify(schema, name)
+		if err := row.ColumnByName("VIEW_DEFINITION", &v.query); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("SECURITY_TYPE", &security); err != nil {
+			return nil, err
+		}
+		v.security = security.StringVal
+
+		res = append(res, &v)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"github.com/cloudspannerecosystem/memefish/ast"
+	"go.mercari.io/yo/models"
+)
+
+// ForeignKeyList returns the foreign keys of the table, which are declared in
+// CREATE TABLE or added by ALTER TABLE.
+func (s *SpannerLoaderFromDDL) ForeignKeyList(name string) ([]*models.ForeignKey, error) {
+	table := s.tables[s.internalName(name)].createTable
+	if table == nil {
+		return nil, nil
+	}
+
+	var fks []*models.ForeignKey
+	for _, tc := range table.TableConstraints {
+		fk, ok := tc.Constraint.(*ast.ForeignKey)
+		if !ok {
+			continue
+		}
+
+		v := &models.ForeignKey{
+			RefTableName: s.qualifiedName(fk.ReferenceTable.Name),
+		}
+		if tc.Name != nil {
+			v.ConstraintName = s.qualifiedName(tc.Name.Name)
+		}
+		for _, c := range fk.Columns {
+			v.ColumnNames = append(v.ColumnNames, c.Name)
+		}
+		for _, c := range fk.ReferenceColumns {
+			v.RefColumnNames = append(v.RefColumnNames, c.Name)
+		}
+		fks = append(fks, v)
+	}
+
+	return fks, nil
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"go.mercari.io/yo/internal"
+	"go.mercari.io/yo/models"
+)
+
+func init() {
+	Register("json", func(source string) (Loader, error) {
+		return NewJSONLoader(source)
+	})
+}
+
+// JSONSchema is the schema in JSON dumped by DumpJSON, which is the tables
+// and the views with their columns and indexes in the model of the package
+// models. It is loaded by JSONLoader, and may be read by other tools.
+type JSONSchema struct {
+	Dialect       string                 `json:"dialect"`
+	Tables        []*JSONTable           `json:"tables"` // sorted by table_name
+	ChangeStreams []*models.ChangeStream `json:"change_streams,omitempty"`
+}
+
+// JSONTable is a table or a view of JSONSchema.
+type JSONTable struct {
+	models.TableSchema
+
+	SearchIndexes    []*models.SearchIndex `json:"search_indexes,omitempty"`
+	VectorIndexes    []*models.VectorIndex `json:"vector_indexes,omitempty"`
+	ForeignKeys      []*models.ForeignKey  `json:"foreign_keys,omitempty"`
+	ViewDependencies []string              `json:"view_dependencies,omitempty"`
+}
+
+// DumpJSON writes the schema loaded from l to w as JSONSchema in JSON. The
+// dialect is PostgreSQL for the loader of a PostgreSQL-dialect database, the
+// dialect of the loader if it has the method Dialect, or GoogleSQL otherwise.
+func DumpJSON(w io.Writer, l Loader) error {
+	schema, err := newJSONSchema(l)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// newJSONSchema loads the whole schema from l, including the details known by
+// the optional methods of l.
+func newJSONSchema(l Loader) (*JSONSchema, error) {
+	schema := &JSONSchema{Dialect: internal.DialectGoogleSQL}
+	switch l := l.(type) {
+	case *SpannerPGLoader:
+		schema.Dialect = internal.DialectPostgreSQL
+	case interface{ Dialect() string }:
+		schema.Dialect = l.Dialect()
+	}
+
+	tableList, err := l.TableList()
+	if err != nil {
+		return nil, err
+	}
+	viewList, err := l.ViewList()
+	if err != nil {
+		return nil, err
+	}
+	tableList = append(tableList, viewList...)
+	sort.Slice(tableList, func(i, j int) bool {
+		return tableList[i].TableName < tableList[j].TableName
+	})
+
+	for _, t := range tableList {
+		jt := &JSONTable{TableSchema: models.TableSchema{Table: t, IndexColumns: make(map[string][]*models.IndexColumn)}}
+		if jt.Columns, err = l.ColumnList(t.TableName); err != nil {
+			return nil, err
+		}
+		if jt.Indexes, err = l.IndexList(t.TableName); err != nil {
+			return nil, err
+		}
+		for _, ix := range append([]*models.Index{{IndexName: "PRIMARY_KEY"}}, jt.Indexes...) {
+			if jt.IndexColumns[ix.IndexName], err = l.IndexColumnList(t.TableName, ix.IndexName); err != nil {
+				return nil, err
+			}
+		}
+
+		if sl, ok := l.(interface {
+			SearchIndexList(string) ([]*models.SearchIndex, error)
+		}); ok {
+			if jt.SearchIndexes, err = sl.SearchIndexList(t.TableName); err != nil {
+				return nil, err
+			}
+		}
+		if vl, ok := l.(interface {
+			VectorIndexList(string) ([]*models.VectorIndex, error)
+		}); ok {
+			if jt.VectorIndexes, err = vl.VectorIndexList(t.TableName); err != nil {
+				return nil, err
+			}
+		}
+		if fl, ok := l.(interface {
+			ForeignKeyList(string) ([]*models.ForeignKey, error)
+		}); ok {
+			if jt.ForeignKeys, err = fl.ForeignKeyList(t.TableName); err != nil {
+				return nil, err
+			}
+		}
+		if vl, ok := l.(interface {
+			ViewDependencies(string) ([]string, error)
+		}); ok && t.IsView {
+			if jt.ViewDependencies, err = vl.ViewDependencies(t.TableName); err != nil {
+				return nil, err
+			}
+		}
+
+		schema.Tables = append(schema.Tables, jt)
+	}
+
+	if cl, ok := l.(interface {
+		ChangeStreamList() ([]*models.ChangeStream, error)
+	}); ok {
+		if schema.ChangeStreams, err = cl.ChangeStreamList(); err != nil {
+			return nil, err
+		}
+	}
+
+	return schema, nil
+}
+
+// JSONLoader is the loader of the schema of JSONSchema in JSON, which is
+// dumped by yo introspect --format json.
+type JSONLoader struct {
+	schema        *JSONSchema
+	tables        map[string]*JSONTable
+	clientVersion string
+	protoTypes    *ProtoTypes
+}
+
+// NewJSONLoader returns the loader of the schema in the JSON file of fpath, or
+// the standard input if fpath is -.
+func NewJSONLoader(fpath string) (*JSONLoader, error) {
+	var b []byte
+	var err error
+	if fpath == "-" {
+		b, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		b, err = ioutil.ReadFile(fpath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var schema JSONSchema
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return nil, fmt.Errorf("parse json schema %s failed: %v", fpath, err)
+	}
+	if schema.Dialect == "" {
+		schema.Dialect = internal.DialectGoogleSQL
+	}
+	if err := internal.ValidateDialect(schema.Dialect); err != nil {
+		return nil, err
+	}
+
+	l := &JSONLoader{schema: &schema, tables: make(map[string]*JSONTable, len(schema.Tables))}
+	for _, t := range schema.Tables {
+		if t.Table == nil {
+			return nil, fmt.Errorf("table of json schema %s is missing", fpath)
+		}
+		l.tables[t.Table.TableName] = t
+	}
+	return l, nil
+}
+
+// SetClientVersion sets the version of cloud.google.com/go/spanner which the
+// generated code is compiled with. The latest version is assumed if empty.
+func (s *JSONLoader) SetClientVersion(v string) {
+	s.clientVersion = v
+}
+
+// SetProtoTypes sets the proto types of the proto bundle, which tell the kinds
+// and the Go types of PROTO and ENUM columns.
+func (s *JSONLoader) SetProtoTypes(p *ProtoTypes) {
+	s.protoTypes = p
+}
+
+// Dialect returns the SQL dialect of the schema, which is either
+// internal.DialectGoogleSQL or internal.DialectPostgreSQL.
+func (s *JSONLoader) Dialect() string {
+	return s.schema.Dialect
+}
+
+func (s *JSONLoader) ParamN(n int) string {
+	if s.schema.Dialect == internal.DialectPostgreSQL {
+		return fmt.Sprintf("$%d", n+1)
+	}
+	return fmt.Sprintf("@param%d", n)
+}
+
+func (s *JSONLoader) MaskFunc() string {
+	return "?"
+}
+
+func (s *JSONLoader) ParseType(dt string, nullable bool) (int, string, string) {
+	if s.schema.Dialect == internal.DialectPostgreSQL {
+		return pgParseType(dt, nullable, s.clientVersion)
+	}
+	return spanParseType(dt, nullable, s.clientVersion, s.protoTypes)
+}
+
+func (s *JSONLoader) ValidCustomType(dataType string, customType string) bool {
+	return SpanValidateCustomType(dataType, customType)
+}
+
+func (s *JSONLoader) TableList() ([]*models.Table, error) {
+	var tables []*models.Table
+	for _, t := range s.schema.Tables {
+		if !t.Table.IsView {
+			tables = append(tables, t.Table)
+		}
+	}
+	return tables, nil
+}
+
+func (s *JSONLoader) ViewList() ([]*models.Table, error) {
+	var views []*models.Table
+	for _, t := range s.schema.Tables {
+		if t.Table.IsView {
+			views = append(views, t.Table)
+		}
+	}
+	return views, nil
+}
+
+func (s *JSONLoader) table(name string) (*JSONTable, error) {
+	t, ok := s.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown table %s", name)
+	}
+	return t, nil
+}
+
+func (s *JSONLoader) ColumnList(name string) ([]*models.Column, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.Columns, nil
+}
+
+func (s *JSONLoader) IndexList(name string) ([]*models.Index, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.Indexes, nil
+}
+
+func (s *JSONLoader) IndexColumnList(name string, index string) ([]*models.IndexColumn, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.IndexColumns[index], nil
+}
+
+func (s *JSONLoader) SearchIndexList(name string) ([]*models.SearchIndex, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.SearchIndexes, nil
+}
+
+func (s *JSONLoader) VectorIndexList(name string) ([]*models.VectorIndex, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.VectorIndexes, nil
+}
+
+func (s *JSONLoader) ForeignKeyList(name string) ([]*models.ForeignKey, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.ForeignKeys, nil
+}
+
+func (s *JSONLoader) ViewDependencies(name string) ([]string, error) {
+	t, err := s.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.ViewDependencies, nil
+}
+
+func (s *JSONLoader) ChangeStreamList() ([]*models.ChangeStream, error) {
+	return s.schema.ChangeStreams, nil
+}
@@ -22,6 +22,11 @@ package loaders
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
 
 	parser "github.com/cloudspannerecosystem/memefish"
 	"github.com/cloudspannerecosystem/memefish/ast"
@@ -29,52 +34,465 @@ import (
 	"go.mercari.io/yo/models"
 )
 
+// NewSpannerLoaderFromDDL loads the schema from the DDL of fpath, which is a
+// file, a directory or a glob pattern as DDLFiles.
 func NewSpannerLoaderFromDDL(fpath string) (*SpannerLoaderFromDDL, error) {
-	b, err := ioutil.ReadFile(fpath)
+	return NewSpannerLoaderFromDDLFiles(fpath)
+}
+
+// NewSpannerLoaderFromDDLFiles loads the schema from the DDL of all the files
+// of paths given by DDLFiles, which are concatenated in lexical order, such as
+// the migration files of a schema.
+func NewSpannerLoaderFromDDLFiles(paths ...string) (*SpannerLoaderFromDDL, error) {
+	ddl, files, err := ReadDDLFiles(paths...)
+	if err != nil {
+		return nil, err
+	}
+	fpath := strings.Join(files, ",")
+
+	buf, pre, err := preparseDDL(ddl)
 	if err != nil {
 		return nil, err
 	}
 
+	// the statements removed entirely by the extractors are applied by the
+	// positions as the statements renaming tables
+	var removed []int
+	for pos, t := range pre.columnAnnotations {
+		for column, a := range t.columns {
+			if a.sequence != "" && !pre.sequences[a.sequence] {
+				return nil, fmt.Errorf("sequence '%s' is undefined, but got the default value of column '%s' of table '%s'", a.sequence, column, t.table)
+			}
+			if a.protoType != "" && !pre.protoBundle[a.protoType] {
+				return nil, fmt.Errorf("proto type '%s' is not in the proto bundle, but got column '%s' of table '%s'", a.protoType, column, t.table)
+			}
+		}
+		if t.removed {
+			removed = append(removed, pos)
+		}
+	}
+	sort.Ints(removed)
+
 	tables := make(map[string]table)
 	ddls, err := (&parser.Parser{
 		Lexer: &parser.Lexer{
-			File: &token.File{FilePath: fpath, Buffer: string(b)},
+			File: &token.File{FilePath: fpath, Buffer: buf},
 		},
 	}).ParseDDLs()
 	if err != nil {
 		return nil, err
 	}
+	// renamed is the new names of the renamed tables by the old names
+	renamed := make(map[string]string)
+	applyRemoved := func(pos int) error {
+		for {
+			switch {
+			case len(pre.renames) > 0 && pre.renames[0].pos < pos && (len(removed) == 0 || pre.renames[0].pos < removed[0]):
+				r := pre.renames[0]
+				pre.renames = pre.renames[1:]
+				if err := renameTable(tables, r.from, r.to); err != nil {
+					return err
+				}
+				renamed[r.from] = r.to
+			case len(removed) > 0 && removed[0] < pos:
+				t := pre.columnAnnotations[removed[0]]
+				removed = removed[1:]
+				v, ok := tables[t.table]
+				if !ok || v.createTable == nil {
+					return fmt.Errorf("table '%s' is undefined, but got the TOKENLIST column added to it", t.table)
+				}
+				if v.columnAnnotations == nil {
+					v.columnAnnotations = make(map[string]*columnAnnotation)
+				}
+				for column, a := range t.columns {
+					v.columnAnnotations[column] = a
+				}
+				tables[t.table] = v
+			default:
+				return nil
+			}
+		}
+	}
+
 	for _, ddl := range ddls {
+		// the statements removed by the extractors are applied in the
+		// order of the statements as well
+		if err := applyRemoved(int(ddl.Pos())); err != nil {
+			return nil, err
+		}
+
 		switch val := ddl.(type) {
 		case *ast.CreateTable:
-			v := tables[val.Name.Name]
+			v, ok := tables[val.Name.Name]
+			if ok {
+				if pre.ifNotExists["TABLE "+val.Name.Name] {
+					continue
+				}
+				return nil, fmt.Errorf("table '%s' is already defined, but got '%s'", val.Name.Name, ddl.SQL())
+			}
 			v.createTable = val
+			if t := pre.columnAnnotations[int(val.Pos())]; t != nil {
+				v.columnAnnotations = t.columns
+			}
 			tables[val.Name.Name] = v
 		case *ast.CreateIndex:
 			v, ok := tables[val.TableName.Name]
 			if !ok {
 				return nil, fmt.Errorf("table '%s' is undefined, but got '%s'", val.TableName.Name, ddl.SQL())
 			}
+			if v.createView != nil {
+				// views are read only by queries, and cannot be indexed
+				return nil, fmt.Errorf("indexes cannot be created on view '%s', but got '%s'", val.TableName.Name, ddl.SQL())
+			}
+			if indexDefined(tables, val.Name.Name) {
+				if pre.ifNotExists["INDEX "+val.Name.Name] {
+					continue
+				}
+				return nil, fmt.Errorf("index '%s' is already defined, but got '%s'", val.Name.Name, ddl.SQL())
+			}
 			v.createIndexes = append(v.createIndexes, val)
 			tables[val.TableName.Name] = v
+		case *ast.CreateView:
+			v, ok := tables[val.Name.Name]
+			if ok && (v.createTable != nil || !val.OrReplace) {
+				return nil, fmt.Errorf("view '%s' is already defined, but got '%s'", val.Name.Name, ddl.SQL())
+			}
+			// CREATE OR REPLACE VIEW overwrites the prior definition
+			v.createView = val
+			v.viewColumns = pre.viewColumns[val.Name.Name]
+			tables[val.Name.Name] = v
 		case *ast.AlterTable:
-			if _, ok := val.TableAlteration.(*ast.AddTableConstraint); ok {
-				continue
+			// alterations are applied in the order of the statements so
+			// that the final schema is generated
+			v, ok := tables[val.Name.Name]
+			if !ok || v.createTable == nil {
+				return nil, fmt.Errorf("table '%s' is undefined, but got '%s'", val.Name.Name, ddl.SQL())
+			}
+			if drop, ok := val.TableAlteration.(*ast.DropColumn); ok {
+				if a := v.columnAnnotations[drop.Name.Name]; a != nil && a.tokenList {
+					// TOKENLIST columns are not in the definition
+					delete(v.columnAnnotations, drop.Name.Name)
+					continue
+				}
+			}
+			if err := alterTable(v.createTable, val); err != nil {
+				return nil, err
+			}
+			v.columnAnnotations = alterColumnAnnotations(v.columnAnnotations, val, pre.columnAnnotations[int(val.Pos())])
+			tables[val.Name.Name] = v
+		case *ast.DropTable:
+			v, ok := tables[val.Name.Name]
+			if !ok || v.createTable == nil {
+				return nil, fmt.Errorf("table '%s' is undefined, but got '%s'", val.Name.Name, ddl.SQL())
+			}
+			delete(tables, val.Name.Name)
+		case *ast.DropView:
+			v, ok := tables[val.Name.Name]
+			if !ok || v.createView == nil {
+				return nil, fmt.Errorf("view '%s' is undefined, but got '%s'", val.Name.Name, ddl.SQL())
+			}
+			delete(tables, val.Name.Name)
+		case *ast.DropIndex:
+			if !dropIndex(tables, val.Name.Name) {
+				return nil, fmt.Errorf("index '%s' is undefined, but got '%s'", val.Name.Name, ddl.SQL())
+			}
+		}
+	}
+	if err := applyRemoved(len(buf) + 1); err != nil {
+		return nil, err
+	}
+
+	// the search indexes, the vector indexes and the change streams may refer
+	// to the tables by the names before the renames
+	resolve := func(name string) string {
+		for {
+			to, ok := renamed[name]
+			if !ok || tables[name].createTable != nil {
+				return name
+			}
+			name = to
+		}
+	}
+	for name, indexes := range pre.searchIndexes {
+		if to := resolve(name); to != name {
+			delete(pre.searchIndexes, name)
+			pre.searchIndexes[to] = append(pre.searchIndexes[to], indexes...)
+		}
+	}
+	for name, indexes := range pre.vectorIndexes {
+		if to := resolve(name); to != name {
+			delete(pre.vectorIndexes, name)
+			pre.vectorIndexes[to] = append(pre.vectorIndexes[to], indexes...)
+		}
+	}
+	for _, stream := range pre.changeStreams {
+		for i, name := range stream.TableNames {
+			stream.TableNames[i] = resolve(name)
+		}
+	}
+
+	for name, indexes := range pre.searchIndexes {
+		if tables[name].createTable == nil {
+			return nil, fmt.Errorf("table '%s' is undefined, but got search index '%s'", name, indexes[0].IndexName)
+		}
+		for _, index := range indexes {
+			for _, col := range index.Columns {
+				if a := tables[name].columnAnnotations[col]; a != nil && a.fullText {
+					index.FullTextColumns = append(index.FullTextColumns, col)
+				}
 			}
-			return nil, fmt.Errorf("stmt should be CreateTable, CreateIndex or AlterTableAddConstraint, but got '%s'", ddl.SQL())
 		}
 	}
 
-	return &SpannerLoaderFromDDL{tables: tables}, nil
+	for name, indexes := range pre.vectorIndexes {
+		if tables[name].createTable == nil {
+			return nil, fmt.Errorf("table '%s' is undefined, but got vector index '%s'", name, indexes[0].IndexName)
+		}
+	}
+
+	for _, stream := range pre.changeStreams {
+		for _, name := range stream.TableNames {
+			if tables[name].createTable == nil {
+				return nil, fmt.Errorf("table '%s' is undefined, but got change stream '%s'", name, stream.Name)
+			}
+		}
+	}
+
+	return &SpannerLoaderFromDDL{tables: tables, schemaNames: pre.schemaNames, searchIndexes: pre.searchIndexes, vectorIndexes: pre.vectorIndexes, changeStreams: pre.changeStreams}, nil
+}
+
+// alterTable applies the alteration of the ALTER TABLE statement to the
+// definition of the table.
+func alterTable(createTable *ast.CreateTable, alter *ast.AlterTable) error {
+	switch alt := alter.TableAlteration.(type) {
+	case *ast.AddColumn:
+		if columnIndex(createTable, alt.Column.Name.Name) >= 0 {
+			return fmt.Errorf("column '%s' of table '%s' is already defined, but got '%s'", alt.Column.Name.Name, createTable.Name.Name, alter.SQL())
+		}
+		createTable.Columns = append(createTable.Columns, alt.Column)
+	case *ast.DropColumn:
+		i := columnIndex(createTable, alt.Name.Name)
+		if i < 0 {
+			return fmt.Errorf("column '%s' of table '%s' is undefined, but got '%s'", alt.Name.Name, createTable.Name.Name, alter.SQL())
+		}
+		createTable.Columns = append(createTable.Columns[:i], createTable.Columns[i+1:]...)
+	case *ast.AddTableConstraint:
+		createTable.TableConstraints = append(createTable.TableConstraints, alt.TableConstraint)
+	case *ast.DropConstraint:
+		for i, c := range createTable.TableConstraints {
+			if c.Name != nil && c.Name.Name == alt.Name.Name {
+				createTable.TableConstraints = append(createTable.TableConstraints[:i], createTable.TableConstraints[i+1:]...)
+				return nil
+			}
+		}
+		return fmt.Errorf("constraint '%s' of table '%s' is undefined, but got '%s'", alt.Name.Name, createTable.Name.Name, alter.SQL())
+	case *ast.AddRowDeletionPolicy:
+		if createTable.RowDeletionPolicy != nil {
+			return fmt.Errorf("row deletion policy of table '%s' is already defined, but got '%s'", createTable.Name.Name, alter.SQL())
+		}
+		createTable.RowDeletionPolicy = &ast.CreateRowDeletionPolicy{RowDeletionPolicy: alt.RowDeletionPolicy}
+	case *ast.ReplaceRowDeletionPolicy:
+		if createTable.RowDeletionPolicy == nil {
+			return fmt.Errorf("row deletion policy of table '%s' is undefined, but got '%s'", createTable.Name.Name, alter.SQL())
+		}
+		createTable.RowDeletionPolicy = &ast.CreateRowDeletionPolicy{RowDeletionPolicy: alt.RowDeletionPolicy}
+	case *ast.DropRowDeletionPolicy:
+		if createTable.RowDeletionPolicy == nil {
+			return fmt.Errorf("row deletion policy of table '%s' is undefined, but got '%s'", createTable.Name.Name, alter.SQL())
+		}
+		createTable.RowDeletionPolicy = nil
+	case *ast.AlterColumn:
+		i := columnIndex(createTable, alt.Name.Name)
+		if i < 0 {
+			return fmt.Errorf("column '%s' of table '%s' is undefined, but got '%s'", alt.Name.Name, createTable.Name.Name, alter.SQL())
+		}
+		// the options of the column are kept
+		col := *createTable.Columns[i]
+		col.Type = alt.Type
+		col.NotNull = alt.NotNull
+		col.DefaultExpr = alt.DefaultExpr
+		createTable.Columns[i] = &col
+	case *ast.AlterColumnSet:
+		i := columnIndex(createTable, alt.Name.Name)
+		if i < 0 {
+			return fmt.Errorf("column '%s' of table '%s' is undefined, but got '%s'", alt.Name.Name, createTable.Name.Name, alter.SQL())
+		}
+		col := *createTable.Columns[i]
+		if alt.Options != nil {
+			col.Options = alt.Options
+		}
+		if alt.DefaultExpr != nil {
+			col.DefaultExpr = alt.DefaultExpr
+		}
+		createTable.Columns[i] = &col
+	case *ast.SetOnDelete:
+		if createTable.Cluster == nil {
+			return fmt.Errorf("table '%s' is not interleaved, but got '%s'", createTable.Name.Name, alter.SQL())
+		}
+		createTable.Cluster.OnDelete = alt.OnDelete
+	default:
+		return fmt.Errorf("stmt should be CreateTable, CreateIndex or AlterTable adding, dropping or altering a column, a constraint or a row deletion policy, but got '%s'", alter.SQL())
+	}
+
+	return nil
+}
+
+// alterColumnAnnotations applies the ALTER TABLE statement to the annotations
+// of the columns of the table, and returns them. t is the annotations
+// extracted from the statement, which may be nil. The yo:type annotation and
+// the options of an altered column are kept unless the statement has others
+// as the DDL parser keeps the options.
+func alterColumnAnnotations(annotations map[string]*columnAnnotation, alter *ast.AlterTable, t *tableAnnotations) map[string]*columnAnnotation {
+	var name string
+	switch alt := alter.TableAlteration.(type) {
+	case *ast.AddColumn:
+		name = alt.Column.Name.Name
+	case *ast.AlterColumn:
+		name = alt.Name.Name
+	case *ast.DropColumn:
+		delete(annotations, alt.Name.Name)
+		return annotations
+	default:
+		return annotations
+	}
+
+	var a *columnAnnotation
+	if t != nil {
+		a = t.columns[name]
+	}
+	if a == nil {
+		a = &columnAnnotation{}
+	}
+	if old := annotations[name]; old != nil {
+		if a.customType == "" {
+			a.customType = old.customType
+		}
+		if a.options == nil {
+			a.options = old.options
+		}
+	}
+	if annotations == nil {
+		annotations = make(map[string]*columnAnnotation)
+	}
+	annotations[name] = a
+
+	return annotations
+}
+
+// columnIndex returns the index of the column in the table, or -1 if the
+// column is undefined.
+func columnIndex(createTable *ast.CreateTable, name string) int {
+	for i, c := range createTable.Columns {
+		if c.Name.Name == name {
+			return i
+		}
+	}
+	return -1
 }
 
 type table struct {
-	createTable   *ast.CreateTable
-	createIndexes []*ast.CreateIndex
+	createTable       *ast.CreateTable
+	createView        *ast.CreateView
+	viewColumns       []string // explicit column list of CREATE VIEW v (a, b) AS ...
+	createIndexes     []*ast.CreateIndex
+	columnAnnotations map[string]*columnAnnotation // by column name
+}
+
+func (t table) name() string {
+	if t.createView != nil {
+		return t.createView.Name.Name
+	}
+	return t.createTable.Name.Name
+}
+
+// dropIndex removes the index named name from the table defining it, and
+// reports whether the index is defined.
+func dropIndex(tables map[string]table, name string) bool {
+	for tname, t := range tables {
+		for i, ix := range t.createIndexes {
+			if ix.Name.Name == name {
+				t.createIndexes = append(t.createIndexes[:i:i], t.createIndexes[i+1:]...)
+				tables[tname] = t
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// renameTable renames the table from to, and the references to it by the
+// indexes, the interleaved tables and the foreign keys.
+func renameTable(tables map[string]table, from, to string) error {
+	v, ok := tables[from]
+	if !ok || v.createTable == nil {
+		return fmt.Errorf("table '%s' is undefined, but got the rename of it to '%s'", from, to)
+	}
+	if _, ok := tables[to]; ok {
+		return fmt.Errorf("table '%s' is already defined, but got the rename of '%s' to it", to, from)
+	}
+
+	delete(tables, from)
+	v.createTable.Name.Name = to
+	tables[to] = v
+
+	for _, t := range tables {
+		for _, ix := range t.createIndexes {
+			if ix.TableName.Name == from {
+				ix.TableName.Name = to
+			}
+			if ix.InterleaveIn != nil && ix.InterleaveIn.TableName.Name == from {
+				ix.InterleaveIn.TableName.Name = to
+			}
+		}
+		if t.createTable == nil {
+			continue
+		}
+		if c := t.createTable.Cluster; c != nil && c.TableName.Name == from {
+			c.TableName.Name = to
+		}
+		for _, c := range t.createTable.TableConstraints {
+			if fk, ok := c.Constraint.(*ast.ForeignKey); ok && fk.ReferenceTable.Name == from {
+				fk.ReferenceTable.Name = to
+			}
+		}
+	}
+
+	return nil
+}
+
+// indexDefined reports whether an index named name is defined on any table.
+func indexDefined(tables map[string]table, name string) bool {
+	for _, t := range tables {
+		for _, ix := range t.createIndexes {
+			if ix.Name.Name == name {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 type SpannerLoaderFromDDL struct {
-	tables map[string]table
+	tables        map[string]table
+	schemaNames   map[string]string // schema-qualified names by the names replacing them
+	searchIndexes map[string][]*models.SearchIndex
+	vectorIndexes map[string][]*models.VectorIndex
+	changeStreams []*models.ChangeStream
+	clientVersion string
+	protoTypes    *ProtoTypes
+	views         map[string]*resolvedView // cache of resolveView
+}
+
+// SetClientVersion sets the version of cloud.google.com/go/spanner which the
+// generated code is compiled with. The latest version is assumed if empty.
+func (s *SpannerLoaderFromDDL) SetClientVersion(v string) {
+	s.clientVersion = v
+}
+
+// SetProtoTypes sets the proto types of the proto bundle, which tell the kinds
+// and the Go types of PROTO and ENUM columns.
+func (s *SpannerLoaderFromDDL) SetProtoTypes(p *ProtoTypes) {
+	s.protoTypes = p
 }
 
 func (s *SpannerLoaderFromDDL) ParamN(n int) string {
@@ -86,7 +504,7 @@ func (s *SpannerLoaderFromDDL) MaskFunc() string {
 }
 
 func (s *SpannerLoaderFromDDL) ParseType(dt string, nullable bool) (int, string, string) {
-	return SpanParseType(dt, nullable)
+	return spanParseType(dt, nullable, s.clientVersion, s.protoTypes)
 }
 
 func (s *SpannerLoaderFromDDL) ValidCustomType(dataType string, customType string) bool {
@@ -96,16 +514,62 @@ func (s *SpannerLoaderFromDDL) ValidCustomType(dataType string, customType strin
 func (s *SpannerLoaderFromDDL) TableList() ([]*models.Table, error) {
 	var tables []*models.Table
 	for _, t := range s.tables {
+		if t.createView != nil {
+			continue
+		}
+		var parent string
+		if t.createTable.Cluster != nil {
+			parent = s.qualifiedName(t.createTable.Cluster.TableName.Name)
+		}
+		var policy *models.RowDeletionPolicy
+		if p := t.createTable.RowDeletionPolicy; p != nil {
+			var err error
+			policy, err = rowDeletionPolicy(p.RowDeletionPolicy)
+			if err != nil {
+				return nil, err
+			}
+		}
+		name := s.qualifiedName(t.name())
+		schema, _ := splitQualifiedName(name)
 		tables = append(tables, &models.Table{
-			TableName: t.createTable.Name.Name,
-			ManualPk:  true,
+			TableName:         name,
+			Schema:            schema,
+			Type:              "BASE TABLE",
+			ManualPk:          true,
+			ParentTableName:   parent,
+			RowDeletionPolicy: policy,
 		})
 	}
 
 	return tables, nil
 }
 
+func (s *SpannerLoaderFromDDL) ViewList() ([]*models.Table, error) {
+	var views []*models.Table
+	for _, t := range s.tables {
+		if t.createView == nil {
+			continue
+		}
+		name := s.qualifiedName(t.name())
+		schema, _ := splitQualifiedName(name)
+		views = append(views, &models.Table{
+			TableName: name,
+			Schema:    schema,
+			Type:      "VIEW",
+			ManualPk:  true,
+			IsView:    true,
+		})
+	}
+
+	return views, nil
+}
+
 func (s *SpannerLoaderFromDDL) ColumnList(name string) ([]*models.Column, error) {
+	name = s.internalName(name)
+	if s.tables[name].createView != nil {
+		return s.viewColumnList(name)
+	}
+
 	var cols []*models.Column
 	table := s.tables[name].createTable
 
@@ -114,27 +578,63 @@ func (s *SpannerLoaderFromDDL) ColumnList(name string) ([]*models.Column, error)
 		check[pk.Name.Name] = struct{}{}
 	}
 
+	enumValues := checkInValues(table.TableConstraints)
+
 	for i, c := range table.Columns {
 		_, pk := check[c.Name.Name]
-		cols = append(cols, &models.Column{
+		col := &models.Column{
 			FieldOrdinal: i + 1,
 			ColumnName:   c.Name.Name,
 			DataType:     c.Type.SQL(),
 			NotNull:      c.NotNull,
 			IsPrimaryKey: pk,
 			IsGenerated:  c.GeneratedExpr != nil,
-		})
+			EnumValues:   enumValues[c.Name.Name],
+		}
+		if c.Options != nil && c.Options.AllowCommitTimestamp {
+			col.Options = map[string]string{"allow_commit_timestamp": "true"}
+		}
+		if a := s.tables[name].columnAnnotations[c.Name.Name]; a != nil {
+			for k, v := range a.options {
+				if col.Options == nil {
+					col.Options = make(map[string]string)
+				}
+				col.Options[k] = v
+			}
+			col.IsIdentity = a.identity
+			col.Sequence = a.sequence
+			col.CustomType = a.customType
+			col.VectorLength = a.vectorLength
+			if a.scalarType != "" {
+				col.DataType = strings.Replace(col.DataType, "BOOL", a.scalarType, 1)
+			}
+			if a.protoType != "" {
+				// the kinds of the proto types are known only by the
+				// descriptors
+				kind := s.protoTypes.kind(a.protoType)
+				if kind == "" {
+					return nil, fmt.Errorf("proto type '%s' of column '%s' of table '%s' is unknown, the proto descriptors file is required", a.protoType, c.Name.Name, name)
+				}
+				col.DataType = strings.Replace(col.DataType, "BOOL", kind+"<"+a.protoType+">", 1)
+			}
+			if a.elementNotNull {
+				col.DataType = strings.TrimSuffix(col.DataType, ">") + " NOT NULL>"
+			}
+		}
+		cols = append(cols, col)
 	}
 
 	return cols, nil
 }
 
 func (s *SpannerLoaderFromDDL) IndexList(name string) ([]*models.Index, error) {
+	name = s.internalName(name)
 	var indexes []*models.Index
 	for _, index := range s.tables[name].createIndexes {
 		indexes = append(indexes, &models.Index{
-			IndexName: index.Name.Name,
-			IsUnique:  index.Unique,
+			IndexName:      s.qualifiedName(index.Name.Name),
+			IsUnique:       index.Unique,
+			IsNullFiltered: index.NullFiltered,
 		})
 	}
 
@@ -142,6 +642,7 @@ func (s *SpannerLoaderFromDDL) IndexList(name string) ([]*models.Index, error) {
 }
 
 func (s *SpannerLoaderFromDDL) IndexColumnList(table, index string) ([]*models.IndexColumn, error) {
+	table, index = s.internalName(table), s.internalName(index)
 	if index == "PRIMARY_KEY" {
 		return s.primaryKeyColumnList(table)
 	}
@@ -152,13 +653,19 @@ func (s *SpannerLoaderFromDDL) IndexColumnList(table, index string) ([]*models.I
 			continue
 		}
 
-		// add storing columns first
+		// add storing columns first, which are numbered in the order of
+		// the names as INFORMATION_SCHEMA does
 		if ix.Storing != nil {
+			var storing []string
 			for _, c := range ix.Storing.Columns {
+				storing = append(storing, c.Name)
+			}
+			sort.Strings(storing)
+			for i, c := range storing {
 				cols = append(cols, &models.IndexColumn{
-					SeqNo:      0,
+					SeqNo:      i + 1,
 					Storing:    true,
-					ColumnName: c.Name,
+					ColumnName: c,
 				})
 			}
 		}
@@ -167,6 +674,7 @@ func (s *SpannerLoaderFromDDL) IndexColumnList(table, index string) ([]*models.I
 			cols = append(cols, &models.IndexColumn{
 				SeqNo:      i + 1,
 				ColumnName: c.Name.Name,
+				Desc:       c.Dir == ast.DirectionDesc,
 			})
 		}
 		break
@@ -181,13 +689,153 @@ func (s *SpannerLoaderFromDDL) primaryKeyColumnList(table string) ([]*models.Ind
 		return nil, nil
 	}
 
+	if tbl.createView != nil {
+		return s.viewPrimaryKeyColumnList(table)
+	}
+
 	var cols []*models.IndexColumn
 	for i, key := range tbl.createTable.PrimaryKeys {
 		cols = append(cols, &models.IndexColumn{
 			SeqNo:      i + 1,
 			ColumnName: key.Name.Name,
+			Desc:       key.Dir == ast.DirectionDesc,
 		})
 	}
 
 	return cols, nil
 }
+
+// ReadDDLFiles reads the DDL of all the files of paths given by DDLFiles, and
+// returns the DDL concatenated in lexical order with the files.
+func ReadDDLFiles(paths ...string) (string, []string, error) {
+	files, err := DDLFiles(paths...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sb strings.Builder
+	for _, f := range files {
+		var b []byte
+		if f == "-" {
+			b, err = ioutil.ReadAll(os.Stdin)
+		} else {
+			b, err = ioutil.ReadFile(f)
+		}
+		if err != nil {
+			return "", nil, err
+		}
+
+		// terminate the last statement of a file if it is not
+		ddl := strings.TrimRightFunc(string(b), unicode.IsSpace)
+		sb.WriteString(ddl)
+		if ddl != "" && !strings.HasSuffix(ddl, ";") {
+			sb.WriteString(";")
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String(), files, nil
+}
+
+// SplitDDLStatements splits the DDL into the statements without the
+// terminating semicolons and the comments, such as to apply them by the
+// database admin API.
+func SplitDDLStatements(ddl string) []string {
+	var stmts []string
+	var sb strings.Builder
+	add := func() {
+		if stmt := strings.TrimSpace(sb.String()); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+		sb.Reset()
+	}
+
+	s := &ddlScanner{src: ddl}
+	for s.pos < len(s.src) {
+		start := s.pos
+		rest := s.src[s.pos:]
+		switch {
+		case strings.HasPrefix(rest, "--"), strings.HasPrefix(rest, "#"), strings.HasPrefix(rest, "/*"):
+			// comments are replaced by a space or the line break ending them
+			// not to join the tokens
+			s.skip()
+			if strings.HasSuffix(s.src[start:s.pos], "\n") {
+				sb.WriteByte('\n')
+			} else {
+				sb.WriteByte(' ')
+			}
+		case s.skip():
+			sb.WriteString(s.src[start:s.pos])
+		case rest[0] == ';':
+			add()
+			s.pos++
+		default:
+			sb.WriteByte(rest[0])
+			s.pos++
+		}
+	}
+	add()
+
+	return stmts
+}
+
+// DDLFiles returns the files of the DDL of paths in lexical order. Each path
+// is a file, a directory whose .sql files are the files, or a glob pattern of
+// the files. It is an error if a directory or a pattern has no file. "-" is
+// the standard input, which precedes the files.
+func DDLFiles(paths ...string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no ddl file is specified")
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	add := func(f string) {
+		if !seen[f] {
+			seen[f] = true
+			files = append(files, f)
+		}
+	}
+
+	stdin := false
+	for _, path := range paths {
+		if path == "-" {
+			stdin = true
+			continue
+		}
+
+		var matches []string
+		if fi, err := os.Stat(path); err == nil {
+			if !fi.IsDir() {
+				add(path)
+				continue
+			}
+			if matches, err = filepath.Glob(filepath.Join(path, "*.sql")); err != nil {
+				return nil, err
+			}
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		} else if strings.ContainsAny(path, "*?[") {
+			if matches, err = filepath.Glob(path); err != nil {
+				return nil, fmt.Errorf("invalid pattern of ddl files '%s': %v", path, err)
+			}
+		} else {
+			return nil, err
+		}
+
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no ddl file is found by '%s'", path)
+		}
+		for _, f := range matches {
+			if fi, err := os.Stat(f); err == nil && !fi.IsDir() {
+				add(f)
+			}
+		}
+	}
+
+	sort.Strings(files)
+	if stdin {
+		files = append([]string{"-"}, files...)
+	}
+	return files, nil
+}
@@ -0,0 +1,1675 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.mercari.io/yo/models"
+)
+
+func newTestLoaderFromDDL(t *testing.T, ddl string) (*SpannerLoaderFromDDL, error) {
+	t.Helper()
+
+	fpath := filepath.Join(t.TempDir(), "schema.sql")
+	if err := os.WriteFile(fpath, []byte(ddl), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSpannerLoaderFromDDL(fpath)
+}
+
+const testBaseSchema = `
+CREATE TABLE Users (
+  UserID STRING(32) NOT NULL,
+  Name STRING(MAX),
+  Age INT64 NOT NULL,
+) PRIMARY KEY(UserID);
+
+CREATE TABLE Orders (
+  OrderID STRING(32) NOT NULL,
+  UserID STRING(32) NOT NULL,
+  Amount INT64 NOT NULL,
+) PRIMARY KEY(OrderID);
+`
+
+func TestViewColumnList(t *testing.T) {
+	tests := []struct {
+		name   string
+		ddl    string
+		cols   []*models.Column
+		errMsg string
+	}{
+		{
+			name: "plain select",
+			ddl:  "CREATE VIEW UserNames SQL SECURITY INVOKER AS SELECT u.UserID, u.Name FROM Users u;",
+			cols: []*models.Column{
+				{FieldOrdinal: 1, ColumnName: "UserID", DataType: "STRING(32)", NotNull: true, IsPrimaryKey: true},
+				{FieldOrdinal: 2, ColumnName: "Name", DataType: "STRING(MAX)"},
+			},
+		},
+		{
+			name: "explicit column list",
+			ddl:  "CREATE VIEW UserNames (ID, DisplayName) SQL SECURITY INVOKER AS SELECT Users.UserID, Users.Name AS N FROM Users;",
+			cols: []*models.Column{
+				{FieldOrdinal: 1, ColumnName: "ID", DataType: "STRING(32)", NotNull: true, IsPrimaryKey: true},
+				{FieldOrdinal: 2, ColumnName: "DisplayName", DataType: "STRING(MAX)"},
+			},
+		},
+		{
+			name: "outer join",
+			ddl:  "CREATE VIEW UserNames SQL SECURITY INVOKER AS SELECT o.OrderID, u.UserID, u.Age FROM Orders o LEFT OUTER JOIN Users u ON o.UserID = u.UserID;",
+			cols: []*models.Column{
+				{FieldOrdinal: 1, ColumnName: "OrderID", DataType: "STRING(32)", NotNull: true},
+				{FieldOrdinal: 2, ColumnName: "UserID", DataType: "STRING(32)"},
+				{FieldOrdinal: 3, ColumnName: "Age", DataType: "INT64"},
+			},
+		},
+		{
+			name:   "mismatched column list",
+			ddl:    "CREATE VIEW UserNames (ID) SQL SECURITY INVOKER AS SELECT Users.UserID, Users.Name FROM Users;",
+			errMsg: "view 'UserNames' declares 1 columns, but its query selects 2 columns",
+		},
+		{
+			name:   "ambiguous column",
+			ddl:    "CREATE VIEW UserNames SQL SECURITY INVOKER AS SELECT o.OrderID, UserID FROM Orders o JOIN Users u ON o.UserID = u.UserID;",
+			errMsg: "view 'UserNames' selects ambiguous column 'UserID' of tables 'Orders' and 'Users'",
+		},
+		{
+			name: "column joined by using",
+			ddl:  "CREATE VIEW UserNames SQL SECURITY INVOKER AS SELECT o.OrderID, UserID FROM Orders o JOIN Users u USING (UserID);",
+			cols: []*models.Column{
+				{FieldOrdinal: 1, ColumnName: "OrderID", DataType: "STRING(32)", NotNull: true, IsPrimaryKey: true},
+				{FieldOrdinal: 2, ColumnName: "UserID", DataType: "STRING(32)", NotNull: true, IsPrimaryKey: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := newTestLoaderFromDDL(t, testBaseSchema+tt.ddl)
+			if err != nil {
+				t.Fatalf("failed to load ddl: %v", err)
+			}
+
+			cols, err := l.ColumnList("UserNames")
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.cols, cols); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestViewPrimaryKeyColumnList(t *testing.T) {
+	tests := []struct {
+		name string
+		ddl  string
+		pks  []*models.IndexColumn
+	}{
+		{
+			name: "join on non primary key column",
+			ddl:  "CREATE VIEW UserOrders SQL SECURITY INVOKER AS SELECT o.OrderID, u.UserID, u.Name FROM Orders o JOIN Users u ON o.UserID = u.UserID;",
+			pks: []*models.IndexColumn{
+				{SeqNo: 1, ColumnName: "OrderID"},
+				{SeqNo: 2, ColumnName: "UserID"},
+			},
+		},
+		{
+			name: "primary key substituted by joined column",
+			ddl:  "CREATE VIEW UserOrders SQL SECURITY INVOKER AS SELECT o.OrderID, o.UserID AS OrderUserID, u.Name FROM Orders o JOIN Users u ON u.UserID = o.UserID;",
+			pks: []*models.IndexColumn{
+				{SeqNo: 1, ColumnName: "OrderID"},
+				{SeqNo: 2, ColumnName: "OrderUserID"},
+			},
+		},
+		{
+			name: "primary key not selected",
+			ddl:  "CREATE VIEW UserOrders SQL SECURITY INVOKER AS SELECT o.OrderID, u.Name FROM Orders o JOIN Users u ON o.Amount = u.Age;",
+		},
+		{
+			name: "outer join",
+			ddl:  "CREATE VIEW UserOrders SQL SECURITY INVOKER AS SELECT o.OrderID, u.UserID, u.Name FROM Orders o LEFT OUTER JOIN Users u ON o.UserID = u.UserID;",
+		},
+		{
+			name: "aggregating view",
+			ddl:  "CREATE VIEW UserOrders SQL SECURITY INVOKER AS SELECT o.UserID, COUNT(*) AS OrderCount FROM Orders o GROUP BY o.UserID;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := newTestLoaderFromDDL(t, testBaseSchema+tt.ddl)
+			if err != nil {
+				t.Fatalf("failed to load ddl: %v", err)
+			}
+
+			pks, err := l.IndexColumnList("UserOrders", "PRIMARY_KEY")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.pks, pks); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIndexOnView(t *testing.T) {
+	ddl := testBaseSchema + `
+CREATE VIEW UserNames SQL SECURITY INVOKER AS SELECT u.UserID, u.Name FROM Users u;
+CREATE INDEX UserNamesByName ON UserNames (Name);
+`
+	_, err := newTestLoaderFromDDL(t, ddl)
+	errMsg := "indexes cannot be created on view 'UserNames'"
+	if err == nil || !strings.Contains(err.Error(), errMsg) {
+		t.Errorf("expect error %q, but got %v", errMsg, err)
+	}
+}
+
+func TestInterleavedPrimaryKeyColumnList(t *testing.T) {
+	ddl := `
+CREATE TABLE Users (
+  UserID STRING(32) NOT NULL,
+) PRIMARY KEY(UserID);
+
+CREATE TABLE UserOrders (
+  OrderID STRING(32) NOT NULL,
+  Amount INT64 NOT NULL,
+  UserID STRING(32) NOT NULL,
+) PRIMARY KEY(UserID, OrderID DESC),
+  INTERLEAVE IN PARENT Users ON DELETE CASCADE;
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	pks, err := l.IndexColumnList("UserOrders", "PRIMARY_KEY")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []*models.IndexColumn{
+		{SeqNo: 1, ColumnName: "UserID"},
+		{SeqNo: 2, ColumnName: "OrderID", Desc: true},
+	}
+	if diff := cmp.Diff(want, pks); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestColumnOptions(t *testing.T) {
+	ddl := `
+CREATE TABLE Events (
+  EventID STRING(32) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+  Payload STRING(MAX) OPTIONS (unknown_option = 'a, b'),
+) PRIMARY KEY(EventID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Events")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []map[string]string{
+		nil,
+		{"allow_commit_timestamp": "true"},
+		{"unknown_option": "'a, b'"},
+	}
+	for i, c := range cols {
+		if diff := cmp.Diff(want[i], c.Options); diff != "" {
+			t.Errorf("%s: (-want, +got)\n%s", c.ColumnName, diff)
+		}
+	}
+}
+
+func TestIdentityColumn(t *testing.T) {
+	ddl := `
+CREATE TABLE Tickets (
+  TicketID INT64 NOT NULL GENERATED BY DEFAULT AS IDENTITY (BIT_REVERSED_POSITIVE SKIP RANGE 1, 1000),
+  SerialID INT64 GENERATED BY DEFAULT AS IDENTITY,
+  Counter INT64 AUTO_INCREMENT,
+  Title STRING(MAX) NOT NULL,
+) PRIMARY KEY(TicketID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Tickets")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]bool{"TicketID": true, "SerialID": true, "Counter": true, "Title": false}
+	for _, c := range cols {
+		if c.IsIdentity != want[c.ColumnName] {
+			t.Errorf("%s: expect IsIdentity %v, but got %v", c.ColumnName, want[c.ColumnName], c.IsIdentity)
+		}
+	}
+	if !cols[0].NotNull {
+		t.Errorf("TicketID: expect NOT NULL")
+	}
+}
+
+func TestSequenceColumn(t *testing.T) {
+	ddl := `
+CREATE SEQUENCE TicketSequence OPTIONS (sequence_kind = "bit_reversed_positive");
+CREATE TABLE Tickets (
+  TicketID INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE TicketSequence)),
+  Title STRING(MAX) NOT NULL DEFAULT ("untitled"),
+) PRIMARY KEY(TicketID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Tickets")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{"TicketID": "TicketSequence", "Title": ""}
+	for _, c := range cols {
+		if c.Sequence != want[c.ColumnName] {
+			t.Errorf("%s: expect Sequence %q, but got %q", c.ColumnName, want[c.ColumnName], c.Sequence)
+		}
+	}
+
+	undefined := `
+CREATE TABLE Tickets (
+  TicketID INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE TicketSequence)),
+) PRIMARY KEY(TicketID);
+`
+	if _, err := newTestLoaderFromDDL(t, undefined); err == nil {
+		t.Errorf("expect error of the undefined sequence")
+	}
+}
+
+func TestParseSequenceDefault(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "(GET_NEXT_SEQUENCE_VALUE(SEQUENCE MySequence))", want: "MySequence"},
+		{expr: "( get_next_sequence_value ( sequence `My Sequence` ) )", want: "My Sequence"},
+		{expr: "GET_NEXT_SEQUENCE_VALUE(SEQUENCE MySequence)", want: "MySequence"},
+		{expr: "(GET_NEXT_SEQUENCE_VALUE(SEQUENCE MySequence) + 1)", want: ""},
+		{expr: "(0)", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := parseSequenceDefault(tt.expr); got != tt.want {
+			t.Errorf("%s: expect %q, but got %q", tt.expr, tt.want, got)
+		}
+	}
+}
+
+func TestCustomTypeComments(t *testing.T) {
+	tests := []struct {
+		name   string
+		ddl    string
+		types  map[string]string
+		errMsg string
+	}{
+		{
+			name: "annotated",
+			ddl: `
+CREATE TABLE Accounts (
+  AccountID STRING(32) NOT NULL, -- yo:type github.com/acme/types.AccountID
+  -- yo:type types.Ignored
+  Status INT64 NOT NULL, -- yo:type types.Status
+  Note STRING(MAX), -- just a comment
+  Profile JSON, -- yo:type *github.com/acme/types.Profile
+) PRIMARY KEY(AccountID);
+`,
+			types: map[string]string{"AccountID": "github.com/acme/types.AccountID", "Status": "types.Status", "Note": "", "Profile": "*github.com/acme/types.Profile"},
+		},
+		{
+			name: "malformed",
+			ddl: `
+CREATE TABLE Accounts (
+  AccountID STRING(32) NOT NULL, -- yo:type types.Account ID
+) PRIMARY KEY(AccountID);
+`,
+			errMsg: "malformed annotation 'yo:type types.Account ID' of column 'AccountID' of table 'Accounts', must be like 'yo:type github.com/acme/types.Status'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := newTestLoaderFromDDL(t, tt.ddl)
+			if tt.errMsg != "" {
+				if err == nil || err.Error() != tt.errMsg {
+					t.Errorf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load ddl: %v", err)
+			}
+
+			cols, err := l.ColumnList("Accounts")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := make(map[string]string)
+			for _, c := range cols {
+				got[c.ColumnName] = c.CustomType
+			}
+			if diff := cmp.Diff(tt.types, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTableListAndViewList(t *testing.T) {
+	l, err := newTestLoaderFromDDL(t, testBaseSchema+"CREATE VIEW UserNames SQL SECURITY INVOKER AS SELECT Users.UserID, Users.Name FROM Users;")
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	tables, err := l.TableList()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var tableNames []string
+	for _, tbl := range tables {
+		if tbl.IsView {
+			t.Errorf("table %s is flagged as a view", tbl.TableName)
+		}
+		tableNames = append(tableNames, tbl.TableName)
+	}
+	sort.Strings(tableNames)
+	if diff := cmp.Diff([]string{"Orders", "Users"}, tableNames); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+
+	views, err := l.ViewList()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []*models.Table{{TableName: "UserNames", Type: "VIEW", ManualPk: true, IsView: true}}
+	if diff := cmp.Diff(want, views); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestRowDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		ddl    string
+		want   *models.RowDeletionPolicy
+		errMsg string
+	}{
+		{
+			name: "create",
+			ddl: `
+CREATE TABLE Sessions (
+  SessionID STRING(32) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(SessionID), ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+`,
+			want: &models.RowDeletionPolicy{ColumnName: "CreatedAt", NumDays: 30},
+		},
+		{
+			name: "replace",
+			ddl: `
+CREATE TABLE Sessions (
+  SessionID STRING(32) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(SessionID), ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+ALTER TABLE Sessions REPLACE ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 7 DAY));
+`,
+			want: &models.RowDeletionPolicy{ColumnName: "CreatedAt", NumDays: 7},
+		},
+		{
+			name: "drop",
+			ddl: `
+CREATE TABLE Sessions (
+  SessionID STRING(32) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(SessionID), ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+ALTER TABLE Sessions DROP ROW DELETION POLICY;
+`,
+		},
+		{
+			name: "add twice",
+			ddl: `
+CREATE TABLE Sessions (
+  SessionID STRING(32) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(SessionID), ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
+ALTER TABLE Sessions ADD ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 7 DAY));
+`,
+			errMsg: "row deletion policy of table 'Sessions' is already defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := newTestLoaderFromDDL(t, tt.ddl)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load ddl: %v", err)
+			}
+
+			tables, err := l.TableList()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, tables[0].RowDeletionPolicy); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRowDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		expr string
+		want *models.RowDeletionPolicy
+	}{
+		{expr: "OLDER_THAN(CreatedAt, INTERVAL 30 DAY)", want: &models.RowDeletionPolicy{ColumnName: "CreatedAt", NumDays: 30}},
+		{expr: "older_than(`ExpiredAt`, interval 0 day)", want: &models.RowDeletionPolicy{ColumnName: "ExpiredAt", NumDays: 0}},
+		{expr: ""},
+	}
+
+	for _, tt := range tests {
+		if diff := cmp.Diff(tt.want, parseRowDeletionPolicy(tt.expr)); diff != "" {
+			t.Errorf("%s: (-want, +got)\n%s", tt.expr, diff)
+		}
+	}
+}
+
+func TestExtractVectorIndexes(t *testing.T) {
+	ddl := `
+CREATE TABLE Documents (
+  DocumentID INT64 NOT NULL,
+  Embedding ARRAY<FLOAT32>(vector_length=>3),
+  Title STRING(MAX),
+) PRIMARY KEY(DocumentID);
+CREATE VECTOR INDEX DocumentsByEmbedding ON Documents(Embedding)
+STORING (Title)
+WHERE Embedding IS NOT NULL
+OPTIONS (distance_type = 'COSINE', tree_depth = 2, num_leaves = 1000);
+`
+	blanked, annotations, err := extractColumnAnnotations(ddl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := annotations[strings.Index(ddl, "CREATE TABLE Documents")].columns["Embedding"].vectorLength; got != 3 {
+		t.Errorf("expect the vector length 3, but got %d", got)
+	}
+	if strings.Contains(blanked, "vector_length") {
+		t.Errorf("vector length should be blanked, but got %s", blanked)
+	}
+
+	blanked, indexes := extractVectorIndexes(blanked)
+	want := map[string][]*models.VectorIndex{
+		"Documents": {{
+			IndexName:      "DocumentsByEmbedding",
+			ColumnName:     "Embedding",
+			StoringColumns: []string{"Title"},
+			Options:        map[string]string{"distance_type": "'COSINE'", "tree_depth": "2", "num_leaves": "1000"},
+		}},
+	}
+	if diff := cmp.Diff(want, indexes); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+	if strings.Contains(blanked, "VECTOR") {
+		t.Errorf("vector index should be blanked, but got %s", blanked)
+	}
+}
+
+func TestParseVectorLength(t *testing.T) {
+	tests := []struct {
+		dataType string
+		want     string
+		length   int64
+	}{
+		{dataType: "ARRAY<FLOAT32>(vector_length=>128)", want: "ARRAY<FLOAT32>", length: 128},
+		{dataType: "ARRAY<FLOAT64>( VECTOR_LENGTH => 3 )", want: "ARRAY<FLOAT64>", length: 3},
+		{dataType: "ARRAY<FLOAT32>", want: "ARRAY<FLOAT32>"},
+		{dataType: "STRING(MAX)", want: "STRING(MAX)"},
+	}
+
+	for _, tt := range tests {
+		got, length := parseVectorLength(tt.dataType)
+		if got != tt.want || length != tt.length {
+			t.Errorf("%s: expect %s and %d, but got %s and %d", tt.dataType, tt.want, tt.length, got, length)
+		}
+	}
+}
+
+func TestExtractChangeStreams(t *testing.T) {
+	ddl := `
+CREATE CHANGE STREAM Everything FOR ALL;
+CREATE CHANGE STREAM Orders FOR Orders, OrderItems(Quantity, Price) OPTIONS (value_capture_type = 'NEW_ROW');
+CREATE CHANGE STREAM Dropped FOR Users;
+CREATE CHANGE STREAM Altered;
+ALTER CHANGE STREAM Orders SET FOR Orders(), Payments;
+ALTER CHANGE STREAM Everything DROP FOR ALL;
+ALTER CHANGE STREAM Altered SET FOR ALL;
+DROP CHANGE STREAM Dropped;
+CREATE TABLE Orders (
+  OrderID INT64 NOT NULL,
+) PRIMARY KEY(OrderID);
+`
+	blanked, streams := extractChangeStreams(ddl)
+
+	want := []*models.ChangeStream{
+		{Name: "Everything"},
+		{Name: "Orders", TableNames: []string{"Orders", "Payments"}},
+		{Name: "Altered", All: true},
+	}
+	if diff := cmp.Diff(want, streams); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+	if strings.Contains(blanked, "CHANGE STREAM") || !strings.Contains(blanked, "CREATE TABLE Orders") {
+		t.Errorf("unexpected blanked ddl: %s", blanked)
+	}
+	if len(blanked) != len(ddl) {
+		t.Errorf("expect length %d, but got %d", len(ddl), len(blanked))
+	}
+}
+
+func TestIdempotentDDL(t *testing.T) {
+	ddl := `
+CREATE TABLE IF NOT EXISTS Users (
+  UserID STRING(32) NOT NULL,
+  Name STRING(MAX),
+) PRIMARY KEY(UserID);
+CREATE TABLE IF NOT EXISTS Users (
+  UserID STRING(32) NOT NULL,
+) PRIMARY KEY(UserID);
+CREATE INDEX IF NOT EXISTS UsersByName ON Users(Name);
+CREATE INDEX IF NOT EXISTS UsersByName ON Users(Name);
+CREATE VIEW UserNames SQL SECURITY INVOKER AS SELECT Users.UserID FROM Users;
+CREATE OR REPLACE VIEW UserNames SQL SECURITY INVOKER AS SELECT Users.UserID, Users.Name FROM Users;
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Users")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cols) != 2 {
+		t.Errorf("expect the first definition of Users, but got %d columns", len(cols))
+	}
+
+	indexes, err := l.IndexList("Users")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indexes) != 1 {
+		t.Errorf("expect 1 index, but got %d", len(indexes))
+	}
+
+	cols, err = l.ColumnList("UserNames")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cols) != 2 {
+		t.Errorf("expect the replaced definition of UserNames, but got %d columns", len(cols))
+	}
+}
+
+func TestNamedSchema(t *testing.T) {
+	ddl := `
+CREATE SCHEMA sales;
+CREATE TABLE sales.Orders (
+  OrderID STRING(32) NOT NULL,
+  UserID STRING(32) NOT NULL,
+) PRIMARY KEY(OrderID);
+CREATE TABLE sales.OrderItems (
+  OrderID STRING(32) NOT NULL,
+  ItemID INT64 NOT NULL,
+) PRIMARY KEY(OrderID, ItemID), INTERLEAVE IN PARENT sales.Orders ON DELETE CASCADE;
+CREATE INDEX sales.OrdersByUserID ON sales.Orders(UserID);
+`
+	l, err := newTestLoaderFromDDL(t, testBaseSchema+ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	tables, err := l.TableList()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].TableName < tables[j].TableName })
+	want := []*models.Table{
+		{TableName: "Orders", Type: "BASE TABLE", ManualPk: true},
+		{TableName: "Users", Type: "BASE TABLE", ManualPk: true},
+		{TableName: "sales.OrderItems", Schema: "sales", Type: "BASE TABLE", ManualPk: true, ParentTableName: "sales.Orders"},
+		{TableName: "sales.Orders", Schema: "sales", Type: "BASE TABLE", ManualPk: true},
+	}
+	if diff := cmp.Diff(want, tables); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+
+	cols, err := l.ColumnList("sales.Orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cols) != 2 {
+		t.Errorf("expect 2 columns of sales.Orders, but got %d", len(cols))
+	}
+
+	indexes, err := l.IndexList("sales.Orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indexes) != 1 || indexes[0].IndexName != "sales.OrdersByUserID" {
+		t.Fatalf("expect the index sales.OrdersByUserID, but got %v", indexes)
+	}
+
+	indexCols, err := l.IndexColumnList("sales.Orders", "sales.OrdersByUserID")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indexCols) != 1 || indexCols[0].ColumnName != "UserID" {
+		t.Errorf("expect the index column UserID, but got %v", indexCols)
+	}
+}
+
+func TestNamedSchemaCollision(t *testing.T) {
+	ddl := `
+CREATE SCHEMA sales;
+CREATE TABLE sales.Orders (
+  OrderID STRING(32) NOT NULL,
+) PRIMARY KEY(OrderID);
+CREATE TABLE sales_Orders (
+  OrderID STRING(32) NOT NULL,
+  Amount INT64 NOT NULL,
+) PRIMARY KEY(OrderID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	tables, err := l.TableList()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].TableName < tables[j].TableName })
+	want := []*models.Table{
+		{TableName: "sales.Orders", Schema: "sales", Type: "BASE TABLE", ManualPk: true},
+		{TableName: "sales_Orders", Type: "BASE TABLE", ManualPk: true},
+	}
+	if diff := cmp.Diff(want, tables); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+
+	for table, n := range map[string]int{"sales.Orders": 1, "sales_Orders": 2} {
+		cols, err := l.ColumnList(table)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cols) != n {
+			t.Errorf("expect %d columns of %s, but got %d", n, table, len(cols))
+		}
+	}
+}
+
+func TestIndexColumnListStoring(t *testing.T) {
+	ddl := `
+CREATE TABLE Items (
+  ItemID INT64 NOT NULL,
+  Name STRING(MAX),
+  Price INT64,
+  Category STRING(MAX),
+) PRIMARY KEY(ItemID);
+CREATE INDEX ItemsByName ON Items(Name DESC) STORING (Price, Category);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.IndexColumnList("Items", "ItemsByName")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// storing columns are in the order of the names as INFORMATION_SCHEMA
+	want := []*models.IndexColumn{
+		{SeqNo: 1, ColumnName: "Category", Storing: true},
+		{SeqNo: 2, ColumnName: "Price", Storing: true},
+		{SeqNo: 1, ColumnName: "Name", Desc: true},
+	}
+	if diff := cmp.Diff(want, cols); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestSearchIndexes(t *testing.T) {
+	ddl := `
+CREATE TABLE Albums (
+  AlbumID STRING(32) NOT NULL,
+  SingerID STRING(32) NOT NULL,
+  Title STRING(MAX),
+  Title_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Title)) HIDDEN,
+  Title_Substring_Tokens TOKENLIST AS (TOKENIZE_SUBSTRING(Title)) HIDDEN,
+  ReleasedAt TIMESTAMP,
+) PRIMARY KEY(AlbumID);
+CREATE SEARCH INDEX AlbumsIndex ON Albums(Title_Tokens, Title_Substring_Tokens)
+STORING (Title)
+PARTITION BY SingerID
+ORDER BY ReleasedAt DESC
+OPTIONS (sort_order_sharding = true);
+CREATE INDEX AlbumsBySingerID ON Albums(SingerID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Albums")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, c := range cols {
+		names = append(names, c.ColumnName)
+	}
+	if diff := cmp.Diff([]string{"AlbumID", "SingerID", "Title", "ReleasedAt"}, names); diff != "" {
+		t.Errorf("TOKENLIST columns should be excluded (-want, +got)\n%s", diff)
+	}
+
+	indexes, err := l.IndexList("Albums")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indexes) != 1 || indexes[0].IndexName != "AlbumsBySingerID" {
+		t.Errorf("expect only the index AlbumsBySingerID, but got %v", indexes)
+	}
+
+	searchIndexes, err := l.SearchIndexList("Albums")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []*models.SearchIndex{{
+		IndexName:        "AlbumsIndex",
+		Columns:          []string{"Title_Tokens", "Title_Substring_Tokens"},
+		FullTextColumns:  []string{"Title_Tokens"},
+		StoringColumns:   []string{"Title"},
+		PartitionColumns: []string{"SingerID"},
+		OrderColumns:     []string{"ReleasedAt"},
+	}}
+	if diff := cmp.Diff(want, searchIndexes); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestAlterTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		ddl     string
+		columns []string
+		errMsg  string
+	}{
+		{
+			name: "add and drop columns",
+			ddl: `
+ALTER TABLE Users ADD COLUMN Email STRING(MAX);
+ALTER TABLE Users ADD COLUMN Nickname STRING(MAX);
+ALTER TABLE Users DROP COLUMN Nickname;
+ALTER TABLE Users DROP COLUMN Age;
+`,
+			columns: []string{"UserID", "Name", "Email"},
+		},
+		{
+			name: "add and drop constraint",
+			ddl: `
+ALTER TABLE Orders ADD CONSTRAINT FK_OrdersUsers FOREIGN KEY (UserID) REFERENCES Users (UserID);
+ALTER TABLE Orders DROP CONSTRAINT FK_OrdersUsers;
+`,
+		},
+		{
+			name:   "drop undefined column",
+			ddl:    "ALTER TABLE Users DROP COLUMN Email;",
+			errMsg: "column 'Email' of table 'Users' is undefined",
+		},
+		{
+			name:   "drop undefined constraint",
+			ddl:    "ALTER TABLE Orders DROP CONSTRAINT FK_OrdersUsers;",
+			errMsg: "constraint 'FK_OrdersUsers' of table 'Orders' is undefined",
+		},
+		{
+			name:   "undefined table",
+			ddl:    "ALTER TABLE Items DROP COLUMN Name;",
+			errMsg: "table 'Items' is undefined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := newTestLoaderFromDDL(t, testBaseSchema+tt.ddl)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load ddl: %v", err)
+			}
+			if tt.columns == nil {
+				return
+			}
+
+			cols, err := l.ColumnList("Users")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var names []string
+			for _, c := range cols {
+				names = append(names, c.ColumnName)
+			}
+			if diff := cmp.Diff(tt.columns, names); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAlterTableColumnAnnotations(t *testing.T) {
+	ddl := `
+CREATE TABLE Events (
+  EventID STRING(32) NOT NULL,
+  Title STRING(MAX),
+) PRIMARY KEY(EventID);
+ALTER TABLE Events ADD COLUMN CreatedAt TIMESTAMP OPTIONS (allow_commit_timestamp = true);
+ALTER TABLE Events ADD COLUMN Score FLOAT32;
+ALTER TABLE Events ADD COLUMN Related ARRAY<UUID NOT NULL>;
+ALTER TABLE Events ADD COLUMN Title_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Title)) HIDDEN;
+ALTER TABLE Events ALTER COLUMN Related ARRAY<STRING(36)>;
+CREATE SEARCH INDEX EventsIndex ON Events(Title_Tokens);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Events")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []*models.Column{
+		{FieldOrdinal: 1, ColumnName: "EventID", DataType: "STRING(32)", NotNull: true, IsPrimaryKey: true},
+		{FieldOrdinal: 2, ColumnName: "Title", DataType: "STRING(MAX)"},
+		{FieldOrdinal: 3, ColumnName: "CreatedAt", DataType: "TIMESTAMP", Options: map[string]string{"allow_commit_timestamp": "true"}},
+		{FieldOrdinal: 4, ColumnName: "Score", DataType: "FLOAT32"},
+		{FieldOrdinal: 5, ColumnName: "Related", DataType: "ARRAY<STRING(36)>"},
+	}
+	if diff := cmp.Diff(want, cols); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+
+	indexes, err := l.SearchIndexList("Events")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indexes) != 1 || !cmp.Equal(indexes[0].FullTextColumns, []string{"Title_Tokens"}) {
+		t.Errorf("expect the full-text column Title_Tokens, but got %v", indexes)
+	}
+}
+
+func TestRenamedTableColumnAnnotations(t *testing.T) {
+	ddl := `
+CREATE TABLE Albums (
+  AlbumID STRING(32) NOT NULL,
+  Title STRING(MAX),
+  Title_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Title)) HIDDEN,
+  Score FLOAT32,
+) PRIMARY KEY(AlbumID);
+RENAME TABLE Albums TO Records;
+CREATE TABLE Albums (
+  AlbumID STRING(32) NOT NULL,
+  Score FLOAT64,
+) PRIMARY KEY(AlbumID);
+CREATE SEARCH INDEX RecordsIndex ON Records(Title_Tokens);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	for table, want := range map[string]string{"Records": "FLOAT32", "Albums": "FLOAT64"} {
+		cols, err := l.ColumnList(table)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cols[len(cols)-1].DataType; got != want {
+			t.Errorf("%s: expect %s, but got %s", table, want, got)
+		}
+	}
+
+	indexes, err := l.SearchIndexList("Records")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indexes) != 1 || !cmp.Equal(indexes[0].FullTextColumns, []string{"Title_Tokens"}) {
+		t.Errorf("expect the full-text column Title_Tokens, but got %v", indexes)
+	}
+}
+
+func TestForeignKeyList(t *testing.T) {
+	ddl := `
+CREATE TABLE Users (
+  UserID STRING(32) NOT NULL,
+  TenantID STRING(32) NOT NULL,
+) PRIMARY KEY(TenantID, UserID);
+
+CREATE TABLE Orders (
+  OrderID STRING(32) NOT NULL,
+  TenantID STRING(32) NOT NULL,
+  UserID STRING(32) NOT NULL,
+  ReviewerID STRING(32),
+  FOREIGN KEY (TenantID, UserID) REFERENCES Users (TenantID, UserID),
+) PRIMARY KEY(OrderID);
+
+ALTER TABLE Orders ADD CONSTRAINT FK_OrdersReviewer FOREIGN KEY (TenantID, ReviewerID) REFERENCES Users (TenantID, UserID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	fks, err := l.ForeignKeyList("Orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []*models.ForeignKey{
+		{ColumnNames: []string{"TenantID", "UserID"}, RefTableName: "Users", RefColumnNames: []string{"TenantID", "UserID"}},
+		{ConstraintName: "FK_OrdersReviewer", ColumnNames: []string{"TenantID", "ReviewerID"}, RefTableName: "Users", RefColumnNames: []string{"TenantID", "UserID"}},
+	}
+	if diff := cmp.Diff(want, fks); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestArrayElementNullability(t *testing.T) {
+	ddl := `
+CREATE TABLE Tags (
+  TagID INT64 NOT NULL,
+  Names ARRAY<STRING(MAX)> NOT NULL,
+  Codes ARRAY<INT64 NOT NULL>,
+) PRIMARY KEY(TagID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Tags")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"int64", "[]spanner.NullString", "[]int64"}
+	for i, c := range cols {
+		_, _, typ := l.ParseType(c.DataType, !c.NotNull)
+		if typ != want[i] {
+			t.Errorf("%s: expect %s, but got %s", c.ColumnName, want[i], typ)
+		}
+	}
+}
+
+func TestUnparsedScalarTypes(t *testing.T) {
+	ddl := `
+CREATE TABLE Events (
+  EventID UUID NOT NULL,
+  Score FLOAT32,
+  Duration INTERVAL,
+  Related ARRAY<UUID NOT NULL>,
+) PRIMARY KEY(EventID);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Events")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"UUID", "FLOAT32", "INTERVAL", "ARRAY<UUID NOT NULL>"}
+	var got []string
+	for _, c := range cols {
+		got = append(got, c.DataType)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestParseTypeClientVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    []string
+	}{
+		{version: "", want: []string{"float32", "spanner.NullFloat32", "[]spanner.NullFloat32", "spanner.Interval", "spanner.NullInterval", "github.com/google/uuid.UUID", "spanner.NullUUID", "[]spanner.NullUUID"}},
+		{version: "v1.81.0", want: []string{"float32", "spanner.NullFloat32", "[]spanner.NullFloat32", "spanner.Interval", "spanner.NullInterval", "github.com/google/uuid.UUID", "spanner.NullUUID", "[]spanner.NullUUID"}},
+		{version: "v1.76.0", want: []string{"float32", "spanner.NullFloat32", "[]spanner.NullFloat32", "spanner.Interval", "spanner.NullInterval", "spanner.GenericColumnValue", "spanner.GenericColumnValue", "[]spanner.GenericColumnValue"}},
+		{version: "v1.60.0", want: []string{"float32", "spanner.NullFloat32", "[]spanner.NullFloat32", "spanner.GenericColumnValue", "spanner.GenericColumnValue", "spanner.GenericColumnValue", "spanner.GenericColumnValue", "[]spanner.GenericColumnValue"}},
+		{version: "v1.45.0", want: []string{"float64", "spanner.NullFloat64", "[]spanner.NullFloat64", "spanner.GenericColumnValue", "spanner.GenericColumnValue", "spanner.GenericColumnValue", "spanner.GenericColumnValue", "[]spanner.GenericColumnValue"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			l := &SpannerLoaderFromDDL{}
+			l.SetClientVersion(tt.version)
+
+			var got []string
+			for _, c := range []struct {
+				dt       string
+				nullable bool
+			}{
+				{"FLOAT32", false}, {"FLOAT32", true}, {"ARRAY<FLOAT32>", true},
+				{"INTERVAL", false}, {"INTERVAL", true},
+				{"UUID", false}, {"UUID", true}, {"ARRAY<UUID>", true},
+			} {
+				_, _, typ := l.ParseType(c.dt, c.nullable)
+				got = append(got, typ)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPGParseType(t *testing.T) {
+	tests := []struct {
+		dt       string
+		nullable bool
+		length   int
+		typ      string
+	}{
+		{dt: "bigint", length: -1, typ: "int64"},
+		{dt: "bigint", nullable: true, length: -1, typ: "spanner.NullInt64"},
+		{dt: "character varying(32)", length: 32, typ: "string"},
+		{dt: "character varying", nullable: true, length: -1, typ: "spanner.NullString"},
+		{dt: "boolean", length: -1, typ: "bool"},
+		{dt: "double precision", length: -1, typ: "float64"},
+		{dt: "real", nullable: true, length: -1, typ: "spanner.NullFloat32"},
+		{dt: "bytea", length: -1, typ: "[]byte"},
+		{dt: "timestamp with time zone", length: -1, typ: "time.Time"},
+		{dt: "spanner.commit_timestamp", nullable: true, length: -1, typ: "spanner.NullTime"},
+		{dt: "date", length: -1, typ: "civil.Date"},
+		{dt: "numeric", length: -1, typ: "spanner.PGNumeric"},
+		{dt: "jsonb", nullable: true, length: -1, typ: "spanner.PGJsonB"},
+		{dt: "bigint[]", nullable: true, length: -1, typ: "[]spanner.NullInt64"},
+		{dt: "character varying(32)[]", length: -1, typ: "[]spanner.NullString"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dt, func(t *testing.T) {
+			l := NewSpannerPGLoader(nil)
+			length, _, typ := l.ParseType(tt.dt, tt.nullable)
+			if length != tt.length || typ != tt.typ {
+				t.Errorf("ParseType(%q, %v) = %d, %q, want %d, %q", tt.dt, tt.nullable, length, typ, tt.length, tt.typ)
+			}
+		})
+	}
+}
+
+func TestParseTypeProto(t *testing.T) {
+	protos, err := NewProtoTypes("", map[string]string{"examples.music": "example.com/musicpb"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	l := &SpannerLoaderFromDDL{}
+	l.SetProtoTypes(protos)
+
+	tests := []struct {
+		dt       string
+		nullable bool
+		want     string
+	}{
+		{dt: "PROTO<examples.music.SingerInfo>", nullable: true, want: "*example.com/musicpb.SingerInfo"},
+		{dt: "PROTO<examples.music.SingerInfo.Address>", want: "*example.com/musicpb.SingerInfo_Address"},
+		{dt: "ENUM<examples.music.Genre>", want: "example.com/musicpb.Genre"},
+		{dt: "ENUM<examples.music.Genre>", nullable: true, want: "*example.com/musicpb.Genre"},
+		{dt: "ARRAY<PROTO<examples.music.SingerInfo>>", nullable: true, want: "[]*example.com/musicpb.SingerInfo"},
+		{dt: "ARRAY<ENUM<examples.music.Genre> NOT NULL>", nullable: true, want: "[]example.com/musicpb.Genre"},
+		{dt: "PROTO<other.Raw>", want: "[]byte"},
+		{dt: "ENUM<other.Kind>", nullable: true, want: "spanner.NullInt64"},
+	}
+
+	for _, tt := range tests {
+		if _, _, typ := l.ParseType(tt.dt, tt.nullable); typ != tt.want {
+			t.Errorf("%s: expect %s, but got %s", tt.dt, tt.want, typ)
+		}
+	}
+}
+
+func TestExtractProtoBundles(t *testing.T) {
+	ddl := `
+CREATE PROTO BUNDLE (examples.music.SingerInfo, examples.music.Genre, ` + "`examples.music.Album`" + `);
+ALTER PROTO BUNDLE INSERT (examples.music.Song) UPDATE (examples.music.Genre) DELETE (examples.music.Album);
+CREATE TABLE Singers (
+  SingerID INT64 NOT NULL,
+  Info examples.music.SingerInfo,
+  Genres ARRAY<` + "`examples.music.Genre`" + `>,
+) PRIMARY KEY(SingerID);
+`
+	blanked, types := extractProtoBundles(ddl)
+	want := map[string]bool{"examples.music.SingerInfo": true, "examples.music.Genre": true, "examples.music.Song": true}
+	if diff := cmp.Diff(want, types); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+	if strings.Contains(blanked, "BUNDLE") {
+		t.Errorf("proto bundle should be blanked, but got %s", blanked)
+	}
+
+	blanked, annotations, err := extractColumnAnnotations(blanked)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for column, want := range map[string]string{"Info": "examples.music.SingerInfo", "Genres": "examples.music.Genre"} {
+		if got := annotations[strings.Index(blanked, "CREATE TABLE Singers")].columns[column].protoType; got != want {
+			t.Errorf("%s: expect %s, but got %s", column, want, got)
+		}
+	}
+	if strings.Contains(blanked, "examples") || !strings.Contains(blanked, "ARRAY<BOOL") {
+		t.Errorf("proto types should be replaced by BOOL, but got %s", blanked)
+	}
+}
+
+func TestCheckInValues(t *testing.T) {
+	ddl := `
+CREATE TABLE Orders (
+  OrderID STRING(32) NOT NULL,
+  Status STRING(16) NOT NULL,
+  Priority STRING(16) NOT NULL,
+  Amount INT64 NOT NULL,
+  CONSTRAINT CK_Status CHECK (Status IN ('PENDING', 'SHIPPED', 'DONE')),
+  CHECK (Priority IN ('LOW', 'HIGH')),
+  CHECK (Status NOT IN ('DONE')),
+  CHECK (Amount > 0),
+) PRIMARY KEY(OrderID);
+
+ALTER TABLE Orders ADD CONSTRAINT CK_Status_Active CHECK (Status IN ('PENDING', 'SHIPPED'));
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	cols, err := l.ColumnList("Orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := make(map[string][]string)
+	for _, c := range cols {
+		if c.EnumValues != nil {
+			got[c.ColumnName] = c.EnumValues
+		}
+	}
+	want := map[string][]string{
+		"Status":   {"PENDING", "SHIPPED"},
+		"Priority": {"LOW", "HIGH"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestParseCheckInClause(t *testing.T) {
+	tests := []struct {
+		clause string
+		column string
+		values []string
+		ok     bool
+	}{
+		{clause: "Status IN ('A', 'B')", column: "Status", values: []string{"A", "B"}, ok: true},
+		{clause: "(`Status` in (\"A\",'it\\'s'))", column: "Status", values: []string{"A", "it's"}, ok: true},
+		{clause: "Status IS NOT NULL"},
+		{clause: "Status IN ('A', 1)"},
+		{clause: "Status IN ('A') OR Status IS NULL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.clause, func(t *testing.T) {
+			column, values, ok := parseCheckInClause(tt.clause)
+			if ok != tt.ok || column != tt.column {
+				t.Fatalf("want:%q %v got:%q %v", tt.column, tt.ok, column, ok)
+			}
+			if diff := cmp.Diff(tt.values, values); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNullFilteredIndex(t *testing.T) {
+	ddl := `
+CREATE TABLE Users (
+  UserID STRING(32) NOT NULL,
+  Email STRING(MAX),
+  Name STRING(MAX),
+) PRIMARY KEY(UserID);
+CREATE NULL_FILTERED INDEX UsersByEmail ON Users(Email);
+CREATE INDEX UsersByName ON Users(Name DESC);
+`
+	l, err := newTestLoaderFromDDL(t, ddl)
+	if err != nil {
+		t.Fatalf("failed to load ddl: %v", err)
+	}
+
+	indexes, err := l.IndexList("Users")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make(map[string]bool)
+	for _, ix := range indexes {
+		got[ix.IndexName] = ix.IsNullFiltered
+	}
+	want := map[string]bool{"UsersByEmail": true, "UsersByName": false}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+
+	cols, err := l.IndexColumnList("Users", "UsersByName")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cols) != 1 || !cols[0].Desc {
+		t.Errorf("expect a descending key column, but got %+v", cols)
+	}
+}
+
+func TestDDLFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, f := range []string{"002_orders.sql", "001_users.sql", "README.md", "sub/003_items.sql"} {
+		fpath := filepath.Join(dir, f)
+		if err := os.MkdirAll(filepath.Dir(fpath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fpath, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	join := func(f string) string { return filepath.Join(dir, f) }
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  []string
+		err   bool
+	}{
+		{name: "file", paths: []string{join("002_orders.sql")}, want: []string{join("002_orders.sql")}},
+		{name: "directory", paths: []string{dir}, want: []string{join("001_users.sql"), join("002_orders.sql")}},
+		{name: "glob", paths: []string{join("*/*.sql")}, want: []string{join("sub/003_items.sql")}},
+		{
+			name:  "multiple paths in lexical order",
+			paths: []string{join("sub/003_items.sql"), dir, join("001_users.sql")},
+			want:  []string{join("001_users.sql"), join("002_orders.sql"), join("sub/003_items.sql")},
+		},
+		{name: "unknown file", paths: []string{join("unknown.sql")}, err: true},
+		{name: "no match", paths: []string{join("*.ddl")}, err: true},
+		{name: "standard input", paths: []string{join("002_orders.sql"), "-"}, want: []string{"-", join("002_orders.sql")}},
+		{name: "no path", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DDLFiles(tt.paths...)
+			if tt.err {
+				if err == nil {
+					t.Errorf("want error, but got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("(-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMigrationHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		ddl     string
+		tables  []string
+		columns []*models.Column
+		indexes []string
+		errMsg  string
+	}{
+		{
+			name: "alter columns",
+			ddl: `
+ALTER TABLE Users ALTER COLUMN Name STRING(64) NOT NULL;
+ALTER TABLE Users ALTER COLUMN Age INT64;
+`,
+			tables: []string{"Orders", "Users"},
+			columns: []*models.Column{
+				{FieldOrdinal: 1, ColumnName: "UserID", DataType: "STRING(32)", NotNull: true, IsPrimaryKey: true},
+				{FieldOrdinal: 2, ColumnName: "Name", DataType: "STRING(64)", NotNull: true},
+				{FieldOrdinal: 3, ColumnName: "Age", DataType: "INT64"},
+			},
+		},
+		{
+			name: "statements in comments and strings",
+			ddl: `
+-- ALTER TABLE Orders RENAME TO Purchases;
+/* RENAME TABLE Users TO Members; */
+CREATE TABLE Items (
+  ItemID STRING(32) NOT NULL,
+  Note STRING(MAX) DEFAULT ("RENAME TABLE Orders TO Purchases"),
+) PRIMARY KEY(ItemID);
+`,
+			tables: []string{"Items", "Orders", "Users"},
+		},
+		{
+			name: "drop tables and indexes",
+			ddl: `
+CREATE INDEX UsersByName ON Users(Name);
+CREATE INDEX UsersByAge ON Users(Age);
+DROP INDEX UsersByName;
+CREATE TABLE Items (
+  ItemID STRING(32) NOT NULL,
+) PRIMARY KEY(ItemID);
+DROP TABLE Orders;
+`,
+			tables:  []string{"Items", "Users"},
+			indexes: []string{"UsersByAge"},
+		},
+		{
+			name: "rename tables",
+			ddl: `
+CREATE INDEX MembersByName ON Users(Name);
+RENAME TABLE Users TO Members, Orders TO Users;
+ALTER TABLE Users RENAME TO Purchases, ADD SYNONYM Users;
+CREATE TABLE Users (
+  UserID STRING(32) NOT NULL,
+) PRIMARY KEY(UserID);
+`,
+			tables: []string{"Members", "Purchases", "Users"},
+			columns: []*models.Column{
+				{FieldOrdinal: 1, ColumnName: "UserID", DataType: "STRING(32)", NotNull: true, IsPrimaryKey: true},
+			},
+		},
+		{
+			name:   "drop undefined table",
+			ddl:    "DROP TABLE Items;",
+			errMsg: "table 'Items' is undefined",
+		},
+		{
+			name:   "drop undefined index",
+			ddl:    "DROP INDEX UsersByName;",
+			errMsg: "index 'UsersByName' is undefined",
+		},
+		{
+			name:   "alter undefined column",
+			ddl:    "ALTER TABLE Users ALTER COLUMN Email STRING(MAX);",
+			errMsg: "column 'Email' of table 'Users' is undefined",
+		},
+		{
+			name:   "rename to defined table",
+			ddl:    "RENAME TABLE Users TO Orders;",
+			errMsg: "table 'Orders' is already defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := newTestLoaderFromDDL(t, testBaseSchema+tt.ddl)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("expect error %q, but got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to load ddl: %v", err)
+			}
+
+			tables, err := l.TableList()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var names []string
+			for _, table := range tables {
+				names = append(names, table.TableName)
+			}
+			sort.Strings(names)
+			if diff := cmp.Diff(tt.tables, names); diff != "" {
+				t.Errorf("tables (-want, +got)\n%s", diff)
+			}
+
+			if tt.columns != nil {
+				cols, err := l.ColumnList("Users")
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if diff := cmp.Diff(tt.columns, cols); diff != "" {
+					t.Errorf("columns (-want, +got)\n%s", diff)
+				}
+			}
+
+			if tt.indexes != nil {
+				indexes, err := l.IndexList("Users")
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				var names []string
+				for _, ix := range indexes {
+					names = append(names, ix.IndexName)
+				}
+				if diff := cmp.Diff(tt.indexes, names); diff != "" {
+					t.Errorf("indexes (-want, +got)\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractRenameTables(t *testing.T) {
+	ddl := "CREATE TABLE A (ID INT64) PRIMARY KEY(ID);\n" +
+		"RENAME TABLE A TO B, `C` TO `D`;\n" +
+		"ALTER TABLE B RENAME TO E, ADD SYNONYM B;\n" +
+		"ALTER TABLE E ADD COLUMN Name STRING(MAX);\n"
+
+	got, renames := extractRenameTables(ddl)
+	if len(got) != len(ddl) {
+		t.Errorf("expect the length %d, but got %d", len(ddl), len(got))
+	}
+	if strings.Contains(got, "RENAME") || !strings.Contains(got, "ALTER TABLE E ADD COLUMN") {
+		t.Errorf("expect the renames to be removed, but got %q", got)
+	}
+
+	want := []tableRename{
+		{pos: 43, from: "A", to: "B"},
+		{pos: 43, from: "C", to: "D"},
+		{pos: 76, from: "B", to: "E"},
+	}
+	if diff := cmp.Diff(want, renames, cmp.AllowUnexported(tableRename{})); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestViewOrder(t *testing.T) {
+	views := []*dumpView{
+		{name: "AlbumTitles", query: "SELECT a.Title FROM music.SingerAlbums AS a"},
+		{name: "SingerNames", query: "SELECT Singers.Name FROM Singers -- FROM AlbumTitles"},
+		{name: "music.SingerAlbums", query: "SELECT SingerNames.Name, Albums.Title FROM SingerNames JOIN Albums ON SingerNames.Name = Albums.Artist"},
+	}
+
+	var got []string
+	for _, v := range viewOrder(views) {
+		got = append(got, v.name)
+	}
+	want := []string{"SingerNames", "music.SingerAlbums", "AlbumTitles"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestDumpSchemaDDL(t *testing.T) {
+	child := &dumpTable{
+		table: &models.Table{TableName: "Albums", ParentTableName: "Singers"},
+		columns: []*dumpColumn{
+			{Column: &models.Column{FieldOrdinal: 1, ColumnName: "SingerID", DataType: "INT64", NotNull: true}},
+			{Column: &models.Column{FieldOrdinal: 2, ColumnName: "AlbumID", DataType: "INT64", NotNull: true}},
+			{Column: &models.Column{FieldOrdinal: 3, ColumnName: "Status", DataType: "STRING(16)", EnumValues: []string{"DRAFT", "IT'S OUT"}}},
+			{Column: &models.Column{FieldOrdinal: 4, ColumnName: "Embedding", DataType: "ARRAY<FLOAT32>", VectorLength: 4}},
+		},
+		primaryKey: []*models.IndexColumn{
+			{SeqNo: 2, ColumnName: "AlbumID", Desc: true},
+			{SeqNo: 1, ColumnName: "SingerID"},
+		},
+		onDelete:      "CASCADE",
+		vectorIndexes: []*models.VectorIndex{{IndexName: "AlbumsByEmbedding", ColumnName: "Embedding", Options: map[string]string{"distance_type": "COSINE", "num_leaves": "10"}}},
+	}
+	parent := &dumpTable{
+		table: &models.Table{TableName: "Singers", RowDeletionPolicy: &models.RowDeletionPolicy{ColumnName: "UpdatedAt", NumDays: 30}},
+		columns: []*dumpColumn{
+			{Column: &models.Column{FieldOrdinal: 1, ColumnName: "SingerID", DataType: "INT64", NotNull: true, IsIdentity: true}},
+			{Column: &models.Column{FieldOrdinal: 2, ColumnName: "Order", DataType: "INT64", Sequence: "OrderSequence"}},
+			{Column: &models.Column{FieldOrdinal: 3, ColumnName: "Info", DataType: "PROTO<examples.music.SingerInfo>"}},
+			{Column: &models.Column{FieldOrdinal: 4, ColumnName: "Name", DataType: "STRING(MAX)", NotNull: true}},
+			{Column: &models.Column{FieldOrdinal: 5, ColumnName: "Name_Tokens", DataType: "TOKENLIST", IsGenerated: true}, generationExpr: "TOKENIZE_FULLTEXT(Name)"},
+			{Column: &models.Column{FieldOrdinal: 6, ColumnName: "UpperName", DataType: "STRING(MAX)", IsGenerated: true}, generationExpr: "UPPER(Name)", stored: true},
+			{Column: &models.Column{FieldOrdinal: 7, ColumnName: "UpdatedAt", DataType: "TIMESTAMP", NotNull: true, Options: map[string]string{"allow_commit_timestamp": "TRUE"}}},
+		},
+		primaryKey: []*models.IndexColumn{{SeqNo: 1, ColumnName: "SingerID"}},
+		indexes: []*dumpIndex{{
+			index: &models.Index{IndexName: "SingersByName", IsUnique: true, IsNullFiltered: true},
+			columns: []*models.IndexColumn{
+				{SeqNo: 1, ColumnName: "Name"},
+				{SeqNo: 1, ColumnName: "Order", Storing: true},
+			},
+		}},
+		foreignKeys:   []*models.ForeignKey{{ConstraintName: "FK_Singers_Albums", ColumnNames: []string{"SingerID"}, RefTableName: "Albums", RefColumnNames: []string{"SingerID"}}},
+		searchIndexes: []*models.SearchIndex{{IndexName: "SingersByNameTokens", Columns: []string{"Name_Tokens"}}},
+	}
+	schema := &dumpSchema{
+		tables:        interleavedOrder([]*dumpTable{child, parent}),
+		views:         []*dumpView{{name: "music.SingerNames", query: "SELECT Singers.Name FROM Singers"}},
+		changeStreams: []*models.ChangeStream{{Name: "AllChanges", All: true}, {Name: "SingerChanges", TableNames: []string{"Singers"}}},
+	}
+
+	want := `CREATE SCHEMA music;
+
+CREATE PROTO BUNDLE (
+  examples.music.SingerInfo
+);
+
+CREATE SEQUENCE OrderSequence OPTIONS (sequence_kind = 'bit_reversed_positive');
+
+CREATE TABLE Singers (
+  SingerID INT64 NOT NULL GENERATED BY DEFAULT AS IDENTITY (BIT_REVERSED_POSITIVE),
+  ` + "`Order`" + ` INT64 DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE OrderSequence)),
+  Info examples.music.SingerInfo,
+  Name STRING(MAX) NOT NULL,
+  Name_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN,
+  UpperName STRING(MAX) AS (UPPER(Name)) STORED,
+  UpdatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true)
+) PRIMARY KEY (SingerID),
+  ROW DELETION POLICY (OLDER_THAN(UpdatedAt, INTERVAL 30 DAY));
+
+CREATE UNIQUE NULL_FILTERED INDEX SingersByName ON Singers (Name) STORING (` + "`Order`" + `);
+
+CREATE SEARCH INDEX SingersByNameTokens ON Singers (Name_Tokens);
+
+CREATE TABLE Albums (
+  SingerID INT64 NOT NULL,
+  AlbumID INT64 NOT NULL,
+  Status STRING(16),
+  Embedding ARRAY<FLOAT32>(vector_length=>4),
+  CHECK (Status IN ('DRAFT', 'IT\'S OUT'))
+) PRIMARY KEY (SingerID, AlbumID DESC),
+  INTERLEAVE IN PARENT Singers ON DELETE CASCADE;
+
+CREATE VECTOR INDEX AlbumsByEmbedding ON Albums (Embedding) WHERE Embedding IS NOT NULL OPTIONS (distance_type = 'COSINE', num_leaves = 10);
+
+ALTER TABLE Singers ADD CONSTRAINT FK_Singers_Albums FOREIGN KEY (SingerID) REFERENCES Albums (SingerID);
+
+CREATE VIEW music.SingerNames SQL SECURITY INVOKER AS SELECT Singers.Name FROM Singers;
+
+CREATE CHANGE STREAM AllChanges FOR ALL;
+
+CREATE CHANGE STREAM SingerChanges FOR Singers;
+`
+	if diff := cmp.Diff(want, schema.ddl()); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
+
+func TestSplitDDLStatements(t *testing.T) {
+	ddl := "-- singers\n" +
+		"CREATE TABLE Singers (\n" +
+		"  SingerID INT64 NOT NULL, -- yo:type example.com/types.ID\n" +
+		"  Name STRING(MAX) DEFAULT ('a;b'),\n" +
+		") PRIMARY KEY (SingerID);\n" +
+		"/* albums; */ CREATE INDEX SingersByName ON Singers (Name);\n" +
+		"\n;\n"
+
+	want := []string{
+		"CREATE TABLE Singers (\n  SingerID INT64 NOT NULL, \n  Name STRING(MAX) DEFAULT ('a;b'),\n) PRIMARY KEY (SingerID)",
+		"CREATE INDEX SingersByName ON Singers (Name)",
+	}
+	if diff := cmp.Diff(want, SplitDDLStatements(ddl)); diff != "" {
+		t.Errorf("(-want, +got)\n%s", diff)
+	}
+}
@@ -0,0 +1,1181 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"go.mercari.io/yo/models"
+)
+
+// preparsedDDL is what the extractors take out of the DDL before it is given
+// to the DDL parser.
+type preparsedDDL struct {
+	schemaNames       map[string]string
+	ifNotExists       map[string]bool
+	viewColumns       map[string][]string
+	searchIndexes     map[string][]*models.SearchIndex
+	vectorIndexes     map[string][]*models.VectorIndex
+	sequences         map[string]bool
+	changeStreams     []*models.ChangeStream
+	protoBundle       map[string]bool
+	renames           []tableRename
+	columnAnnotations map[int]*tableAnnotations // by position of the statements
+}
+
+// preparseDDL runs all the extractors over the DDL, and returns the DDL which
+// the DDL parser understands with what is extracted from it.
+//
+// The pinned memefish parses only a subset of the current DDL of Cloud
+// Spanner, so the statements and the parts of them which it does not
+// understand are found by the regular expressions and the scanner in this
+// file instead. Nothing else in the package scans the DDL text, so that this
+// file is all to be replaced once memefish is upgraded.
+func preparseDDL(ddl string) (string, *preparsedDDL, error) {
+	p := &preparsedDDL{}
+	ddl, p.schemaNames = extractSchemas(ddl)
+	ddl, p.ifNotExists = extractIfNotExists(ddl)
+	ddl, p.viewColumns = extractViewColumnLists(ddl)
+	ddl, p.searchIndexes = extractSearchIndexes(ddl)
+	ddl, p.vectorIndexes = extractVectorIndexes(ddl)
+	ddl, p.sequences = extractSequences(ddl)
+	ddl, p.changeStreams = extractChangeStreams(ddl)
+	ddl, p.protoBundle = extractProtoBundles(ddl)
+	ddl, p.renames = extractRenameTables(ddl)
+	ddl, annotations, err := extractColumnAnnotations(ddl)
+	if err != nil {
+		return "", nil, err
+	}
+	p.columnAnnotations = annotations
+
+	return ddl, p, nil
+}
+
+// createTableRegexp matches the head of CREATE TABLE statements up to the
+// opening parenthesis of the column definitions.
+var createTableRegexp = regexp.MustCompile("(?i)\\bCREATE\\s+TABLE\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)\\s*\\(")
+
+// ddlScanner walks DDL text skipping string literals, quoted identifiers and
+// comments.
+type ddlScanner struct {
+	src string
+	pos int
+}
+
+// skip advances the position over a string literal, a quoted identifier or a
+// comment at the current position. It reports whether anything is skipped.
+func (s *ddlScanner) skip() bool {
+	rest := s.src[s.pos:]
+	switch {
+	case strings.HasPrefix(rest, "--"), strings.HasPrefix(rest, "#"):
+		if i := strings.IndexByte(rest, '\n'); i >= 0 {
+			s.pos += i + 1
+		} else {
+			s.pos = len(s.src)
+		}
+	case strings.HasPrefix(rest, "/*"):
+		if i := strings.Index(rest[2:], "*/"); i >= 0 {
+			s.pos += i + 4
+		} else {
+			s.pos = len(s.src)
+		}
+	case rest[0] == '\'' || rest[0] == '"' || rest[0] == '`':
+		q := rest[0]
+		i := 1
+		for i < len(rest) && rest[i] != q {
+			if rest[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		s.pos += i + 1
+		if s.pos > len(s.src) {
+			s.pos = len(s.src)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// ident reads an identifier at the current position. It returns "" if there
+// is no identifier.
+func (s *ddlScanner) ident() string {
+	start := s.pos
+	if s.pos < len(s.src) && s.src[s.pos] == '`' {
+		s.skip()
+		return strings.Trim(s.src[start:s.pos], "`")
+	}
+	for s.pos < len(s.src) && isIdentChar(s.src[s.pos]) {
+		s.pos++
+	}
+	return s.src[start:s.pos]
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// skipSpaces advances the position over white spaces and comments.
+func (s *ddlScanner) skipSpaces() {
+	for s.pos < len(s.src) {
+		rest := s.src[s.pos:]
+		switch {
+		case strings.IndexByte(" \t\r\n", rest[0]) >= 0:
+			s.pos++
+		case strings.HasPrefix(rest, "--"), strings.HasPrefix(rest, "#"), strings.HasPrefix(rest, "/*"):
+			s.skip()
+		default:
+			return
+		}
+	}
+}
+
+// skipParens advances the position over the parenthesized text at the current
+// position.
+func (s *ddlScanner) skipParens() {
+	for depth := 0; s.pos < len(s.src); {
+		if s.skip() {
+			continue
+		}
+		if s.src[s.pos] == '(' {
+			depth++
+		} else if s.src[s.pos] == ')' {
+			depth--
+		}
+		s.pos++
+		if depth == 0 {
+			return
+		}
+	}
+}
+
+// skipColumn advances the position over the rest of a column definition
+// including the following comma, stopping before the closing parenthesis of
+// the column definitions or the semicolon of the statement.
+func (s *ddlScanner) skipColumn() {
+	for s.pos < len(s.src) {
+		if s.skip() {
+			continue
+		}
+		switch s.src[s.pos] {
+		case '(':
+			s.skipParens()
+			continue
+		case ')', ';':
+			return
+		case ',':
+			s.pos++
+			return
+		}
+		s.pos++
+	}
+}
+
+// blankOut replaces the text of b between start and end by spaces keeping the
+// line breaks. The extractors remove the text which the DDL parser does not
+// understand in this way instead of deleting it so that positions in parser
+// errors stay correct.
+func blankOut(b []byte, start, end int) {
+	for i := start; i < end; i++ {
+		if b[i] != '\n' {
+			b[i] = ' '
+		}
+	}
+}
+
+// maskDDL returns the DDL whose comments and string literals are blanked out
+// by blankOut, so that the regular expressions finding the statements which
+// the DDL parser does not understand never match the text in them. The
+// positions in the DDL are kept.
+func maskDDL(ddl string) string {
+	masked := []byte(ddl)
+	s := &ddlScanner{src: ddl}
+	for s.pos < len(s.src) {
+		start := s.pos
+		if !s.skip() {
+			s.pos++
+			continue
+		}
+		if ddl[start] != '`' {
+			blankOut(masked, start, s.pos)
+		}
+	}
+	return string(masked)
+}
+
+// splitOptions splits the option list `a = 1, b = 'x'` into key/value pairs.
+// Values are kept as written in the DDL.
+func splitOptions(list string) map[string]string {
+	options := make(map[string]string)
+
+	s := &ddlScanner{src: list}
+	start, depth := 0, 0
+	add := func(item string) {
+		if kv := strings.SplitN(item, "=", 2); len(kv) == 2 {
+			options[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+	for s.pos < len(s.src) {
+		if s.skip() {
+			continue
+		}
+		switch s.src[s.pos] {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				add(s.src[start:s.pos])
+				start = s.pos + 1
+			}
+		}
+		s.pos++
+	}
+	add(s.src[start:])
+
+	return options
+}
+
+// columnAnnotation is a part of a column definition which the DDL parser does
+// not understand.
+type columnAnnotation struct {
+	options        map[string]string // OPTIONS of the column other than allow_commit_timestamp
+	elementNotNull bool              // NOT NULL of the elements of ARRAY<T NOT NULL>
+	identity       bool              // GENERATED BY DEFAULT AS IDENTITY or AUTO_INCREMENT
+	sequence       string            // sequence of DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE s))
+	customType     string            // Go type given by a yo:type comment
+	tokenList      bool              // TOKENLIST removed from the definitions
+	fullText       bool              // TOKENLIST tokenized by TOKENIZE_FULLTEXT
+	vectorLength   int64             // vector_length of ARRAY<T>(vector_length=>N)
+	protoType      string            // fully qualified name of the type of PROTO and ENUM columns
+	scalarType     string            // FLOAT32, INTERVAL or UUID replaced by BOOL
+}
+
+// unparsedScalarTypes is the scalar types which the DDL parser does not
+// understand.
+var unparsedScalarTypes = map[string]bool{
+	"FLOAT32":  true,
+	"INTERVAL": true,
+	"UUID":     true,
+}
+
+// fullTextRegexp matches the tokenizer of full-text TOKENLIST columns, which
+// can be scored by SCORE.
+var fullTextRegexp = regexp.MustCompile(`(?i)\bTOKENIZE_FULLTEXT\s*\(`)
+
+// customTypeRegexp matches Go types of yo:type comments, which are qualified by
+// the package name or the import path like github.com/acme/types.Status. The
+// pointer types are allowed for JSON columns.
+var customTypeRegexp = regexp.MustCompile(`^\*?(?:[A-Za-z0-9_.~-]+(?:/[A-Za-z0-9_.~-]+)*\.)?[A-Za-z_][A-Za-z0-9_]*$`)
+
+// parseColumnComment parses the annotation in a trailing comment of a column
+// definition such as `-- yo:type github.com/acme/types.Status`. Comments which
+// are not annotations are ignored.
+func parseColumnComment(a *columnAnnotation, table, column, comment string) error {
+	comment = strings.TrimSpace(strings.TrimLeft(comment, "-#"))
+	if !strings.HasPrefix(comment, "yo:") {
+		return nil
+	}
+
+	fields := strings.Fields(comment)
+	switch fields[0] {
+	case "yo:type":
+		if len(fields) != 2 || !customTypeRegexp.MatchString(fields[1]) {
+			return fmt.Errorf("malformed annotation '%s' of column '%s' of table '%s', must be like 'yo:type github.com/acme/types.Status'", comment, column, table)
+		}
+		a.customType = fields[1]
+	default:
+		fmt.Fprintf(os.Stderr, "warning: unknown annotation %s of column %s of table %s is ignored\n", fields[0], column, table)
+	}
+
+	return nil
+}
+
+// alterColumnRegexp matches the head of ALTER TABLE statements adding or
+// altering a column up to the column name.
+var alterColumnRegexp = regexp.MustCompile("(?i)\\bALTER\\s+TABLE\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)\\s+(?:ADD\\s+COLUMN\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?|ALTER\\s+COLUMN\\s+)")
+
+// tableAnnotations is the column annotations extracted from a CREATE TABLE
+// statement or an ALTER TABLE statement adding or altering a column.
+type tableAnnotations struct {
+	table   string
+	columns map[string]*columnAnnotation // by column name
+	removed bool                         // the statement adds a TOKENLIST column, and is removed
+}
+
+// extractColumnAnnotations removes OPTIONS clauses other than
+// allow_commit_timestamp, NOT NULL of array elements, vector lengths of
+// arrays, IDENTITY clauses and the default values taken from sequences from
+// column definitions of CREATE TABLE statements and ALTER TABLE statements
+// adding or altering a column because the DDL parser understands only the
+// allow_commit_timestamp option, no NOT NULL in array types, no vector
+// lengths, no IDENTITY and no sequences.
+// The proto types of PROTO and ENUM columns and the scalar types FLOAT32,
+// INTERVAL and UUID are replaced by BOOL because it does not understand them
+// either.
+// TOKENLIST columns, which the DDL parser does not understand either, are
+// removed entirely, and only whether they are tokenized for full-text search
+// is annotated.
+// The yo:type annotations in the comments following column definitions on the
+// same line are extracted as well. They are returned per position of the
+// statements in the DDL, which is the position of the parsed statements as
+// well, so that the annotations follow the tables through renames and are not
+// mixed up by a table dropped and created again. The removed text is blanked
+// out by blankOut.
+func extractColumnAnnotations(ddl string) (string, map[int]*tableAnnotations, error) {
+	annotations := make(map[int]*tableAnnotations)
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+
+	scan := func(m []int, top int) error {
+		t := &tableAnnotations{table: strings.Trim(ddl[m[2]:m[3]], "`")}
+		s := &ddlScanner{src: ddl, pos: m[1]}
+		if err := scanColumnDefs(s, blanked, top, t); err != nil {
+			return err
+		}
+		if t.removed {
+			if s.pos < len(s.src) {
+				s.pos++ // semicolon
+			}
+			blankOut(blanked, m[0], s.pos)
+		}
+		if len(t.columns) > 0 {
+			annotations[m[0]] = t
+		}
+		return nil
+	}
+	for _, m := range createTableRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		if err := scan(m, 1); err != nil {
+			return "", nil, err
+		}
+	}
+	for _, m := range alterColumnRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		if err := scan(m, 0); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return string(blanked), annotations, nil
+}
+
+// scanColumnDefs extracts the annotations of the column definitions at the
+// current position into t, and blanks out the removed text of blanked. The
+// column definitions are at the parenthesis depth top, which is 1 for the
+// column definitions of CREATE TABLE and 0 for the single column definition
+// of ALTER TABLE ending at the semicolon.
+func scanColumnDefs(s *ddlScanner, blanked []byte, top int, t *tableAnnotations) error {
+	annotation := func(column string) *columnAnnotation {
+		if t.columns == nil {
+			t.columns = make(map[string]*columnAnnotation)
+		}
+		if t.columns[column] == nil {
+			t.columns[column] = &columnAnnotation{}
+		}
+		return t.columns[column]
+	}
+
+	depth, angle := top, 0
+	column, columnStart := "", s.pos
+	newColumn, typeNext, elemNext := true, false, false
+	for s.pos < len(s.src) && depth >= top {
+		c := s.src[s.pos]
+		if c == ';' && depth == top {
+			break
+		}
+		if (c == '-' || c == '#') && depth == top && column != "" && !atLineStart(s.src, s.pos) {
+			start := s.pos
+			if s.skip() {
+				if err := parseColumnComment(annotation(column), t.table, column, s.src[start:s.pos]); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if c != '`' && s.skip() {
+			continue
+		}
+
+		switch {
+		case c == '(':
+			depth++
+			s.pos++
+		case c == ')':
+			depth--
+			s.pos++
+		case c == '<' && depth == top:
+			angle++
+			elemNext = true
+			s.pos++
+		case c == '>' && depth == top:
+			angle--
+			s.pos++
+			if angle != 0 {
+				continue
+			}
+			// ARRAY<FLOAT32>(vector_length=>N) of embedding columns
+			start := s.pos
+			s.skipSpaces()
+			if s.pos < len(s.src) && s.src[s.pos] == '(' {
+				s.skipParens()
+				if _, n := parseVectorLength(s.src[start:s.pos]); n > 0 {
+					annotation(column).vectorLength = n
+					blankOut(blanked, start, s.pos)
+					continue
+				}
+			}
+			s.pos = start
+		case c == ',' && depth == top && angle == 0:
+			newColumn = true
+			s.pos++
+		case c == '`' || isIdentChar(c):
+			start := s.pos
+			word := s.ident()
+			if depth != top {
+				continue
+			}
+			if newColumn {
+				column, columnStart = word, start
+				newColumn, typeNext = false, true
+				continue
+			}
+			if typeNext && strings.EqualFold(word, "TOKENLIST") {
+				// TOKENLIST columns are only for search indexes, so
+				// the whole definitions are removed
+				s.skipColumn()
+				a := annotation(column)
+				a.fullText = fullTextRegexp.MatchString(s.src[columnStart:s.pos])
+				a.tokenList = true
+				if top == 0 {
+					// the statement adding the column is removed by
+					// the caller
+					t.removed = true
+					return nil
+				}
+				blankOut(blanked, columnStart, s.pos)
+				column, newColumn = "", true
+				continue
+			}
+			if (typeNext || elemNext) && !strings.EqualFold(column, "CONSTRAINT") &&
+				unparsedScalarTypes[strings.ToUpper(word)] {
+				annotation(column).scalarType = strings.ToUpper(word)
+				blankOut(blanked, start, s.pos)
+				copy(blanked[start:], "BOOL")
+				typeNext, elemNext = false, false
+				continue
+			}
+			if (typeNext || elemNext) && !strings.EqualFold(column, "CONSTRAINT") &&
+				(s.src[start] == '`' || s.pos < len(s.src) && s.src[s.pos] == '.') {
+				// PROTO and ENUM columns are typed by the qualified
+				// names of the proto types, which are replaced by BOOL
+				// to be parsed
+				name := word
+				for s.pos < len(s.src) && s.src[s.pos] == '.' {
+					s.pos++
+					name += "." + s.ident()
+				}
+				if s.pos-start < len("BOOL") {
+					return fmt.Errorf("proto type '%s' of column '%s' of table '%s' must be qualified by the package", name, column, t.table)
+				}
+				annotation(column).protoType = name
+				blankOut(blanked, start, s.pos)
+				copy(blanked[start:], "BOOL")
+				typeNext, elemNext = false, false
+				continue
+			}
+			typeNext, elemNext = false, false
+
+			switch {
+			case strings.EqualFold(word, "NOT") && angle > 0:
+				s.skipSpaces()
+				if !strings.EqualFold(s.ident(), "NULL") {
+					continue
+				}
+				annotation(column).elementNotNull = true
+				blankOut(blanked, start, s.pos)
+			case strings.EqualFold(word, "GENERATED"):
+				// GENERATED BY DEFAULT AS IDENTITY [(sequence options)]
+				matched := true
+				for _, kw := range []string{"BY", "DEFAULT", "AS", "IDENTITY"} {
+					s.skipSpaces()
+					if !strings.EqualFold(s.ident(), kw) {
+						matched = false
+						break
+					}
+				}
+				if !matched {
+					continue
+				}
+				end := s.pos
+				s.skipSpaces()
+				if s.pos < len(s.src) && s.src[s.pos] == '(' {
+					s.skipParens()
+					end = s.pos
+				}
+				s.pos = end
+				annotation(column).identity = true
+				blankOut(blanked, start, s.pos)
+			case strings.EqualFold(word, "AUTO_INCREMENT"):
+				// AUTO_INCREMENT is the shorthand of an identity column
+				// using the default sequence kind of the database
+				annotation(column).identity = true
+				blankOut(blanked, start, s.pos)
+			case strings.EqualFold(word, "DEFAULT"):
+				s.skipSpaces()
+				if s.pos >= len(s.src) || s.src[s.pos] != '(' {
+					continue
+				}
+				exprStart := s.pos
+				s.skipParens()
+				if seq := parseSequenceDefault(s.src[exprStart:s.pos]); seq != "" {
+					annotation(column).sequence = seq
+					blankOut(blanked, start, s.pos)
+				}
+			case strings.EqualFold(word, "OPTIONS"):
+				s.skipSpaces()
+				if s.pos >= len(s.src) || s.src[s.pos] != '(' {
+					continue
+				}
+				listStart := s.pos + 1
+				s.skipParens()
+
+				// allow_commit_timestamp is left to the DDL parser
+				options := splitOptions(s.src[listStart : s.pos-1])
+				known := true
+				for k := range options {
+					if !strings.EqualFold(k, "allow_commit_timestamp") {
+						known = false
+					}
+				}
+				if known {
+					continue
+				}
+				a := annotation(column)
+				if a.options == nil {
+					a.options = make(map[string]string)
+				}
+				for k, v := range options {
+					a.options[k] = v
+				}
+				blankOut(blanked, start, s.pos)
+			}
+		default:
+			s.pos++
+		}
+	}
+
+	return nil
+}
+
+// atLineStart reports whether only white spaces precede pos in its line.
+func atLineStart(src string, pos int) bool {
+	i := strings.LastIndexByte(src[:pos], '\n')
+	return strings.TrimLeft(src[i+1:pos], " \t\r") == ""
+}
+
+// createSchemaRegexp matches CREATE SCHEMA statements.
+var createSchemaRegexp = regexp.MustCompile("(?i)\\bCREATE\\s+SCHEMA\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?([A-Za-z_][A-Za-z0-9_]*)[^;]*;?")
+
+// qualifiedNameRegexp matches the schema-qualified names of tables, indexes
+// and views in CREATE statements.
+var qualifiedNameRegexp = regexp.MustCompile("(?i)\\bCREATE\\s+(?:OR\\s+REPLACE\\s+)?(?:TABLE|VIEW|(?:UNIQUE\\s+)?(?:NULL_FILTERED\\s+)?(?:SEARCH\\s+)?INDEX)\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?([A-Za-z_][A-Za-z0-9_]*)\\.")
+
+// schemaSeparators is the characters replacing the dots of schema-qualified
+// names in the order of preference.
+const schemaSeparators = "_0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// extractSchemas replaces schema-qualified names such as myschema.Orders by
+// identifiers such as myschema_Orders because the DDL parser does not
+// understand named schemas, and removes CREATE SCHEMA statements. The dots are
+// replaced by the first of schemaSeparators with which no identifier collides
+// with the others in the DDL, such as myschema0Orders if myschema_Orders is
+// defined as well. The replaced names are returned by the identifiers, and the
+// removed statements are blanked out by blankOut.
+func extractSchemas(ddl string) (string, map[string]string) {
+	masked := maskDDL(ddl)
+	schemas := make(map[string]bool)
+	for _, m := range createSchemaRegexp.FindAllStringSubmatch(masked, -1) {
+		schemas[m[1]] = true
+	}
+	for _, m := range qualifiedNameRegexp.FindAllStringSubmatch(masked, -1) {
+		schemas[m[1]] = true
+	}
+
+	names := make(map[string]string)
+	if len(schemas) == 0 {
+		return ddl, names
+	}
+
+	replaced := []byte(ddl)
+	for _, m := range createSchemaRegexp.FindAllStringIndex(masked, -1) {
+		blankOut(replaced, m[0], m[1])
+	}
+
+	// the words are collected so that the replacing identifiers do not
+	// collide with them
+	var qualified [][3]int // start, dot and end of the qualified names
+	words := make(map[string]bool)
+	s := &ddlScanner{src: ddl}
+	for s.pos < len(s.src) {
+		c := s.src[s.pos]
+		if c == '`' || !isIdentChar(c) {
+			start := s.pos
+			if !s.skip() {
+				s.pos++
+			} else if c == '`' {
+				words[strings.Trim(ddl[start:s.pos], "`")] = true
+			}
+			continue
+		}
+
+		start := s.pos
+		word := s.ident()
+		words[word] = true
+		if !schemas[word] || s.pos+1 >= len(s.src) || s.src[s.pos] != '.' || !isIdentChar(s.src[s.pos+1]) {
+			continue
+		}
+		dot := s.pos
+		s.pos++
+		s.ident()
+		qualified = append(qualified, [3]int{start, dot, s.pos})
+	}
+
+	for i := 0; i < len(schemaSeparators); i++ {
+		names = make(map[string]string)
+		collided := false
+		for _, q := range qualified {
+			name := ddl[q[0]:q[1]] + schemaSeparators[i:i+1] + ddl[q[1]+1:q[2]]
+			if words[name] || names[name] != "" && names[name] != ddl[q[0]:q[2]] {
+				collided = true
+				break
+			}
+			names[name] = ddl[q[0]:q[2]]
+		}
+		if !collided {
+			for _, q := range qualified {
+				replaced[q[1]] = schemaSeparators[i]
+			}
+			break
+		}
+	}
+
+	return string(replaced), names
+}
+
+// viewColumnListRegexp matches the head of CREATE VIEW statements having an
+// explicit column list such as `CREATE VIEW v (a, b) SQL SECURITY INVOKER AS`.
+var viewColumnListRegexp = regexp.MustCompile("(?i)\\bCREATE\\s+(?:OR\\s+REPLACE\\s+)?VIEW\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)\\s*(\\([^()]*\\))")
+
+// extractViewColumnLists removes explicit column lists from CREATE VIEW
+// statements because the DDL parser does not understand them. The lists are
+// returned per view name, and the removed text is blanked out by blankOut.
+func extractViewColumnLists(ddl string) (string, map[string][]string) {
+	lists := make(map[string][]string)
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+	for _, m := range viewColumnListRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		name := strings.Trim(masked[m[2]:m[3]], "`")
+		list := masked[m[4]+1 : m[5]-1]
+
+		var cols []string
+		for _, c := range strings.Split(list, ",") {
+			cols = append(cols, strings.Trim(strings.TrimSpace(c), "`"))
+		}
+		lists[name] = cols
+
+		blankOut(blanked, m[4], m[5])
+	}
+
+	return string(blanked), lists
+}
+
+// ifNotExistsRegexp matches CREATE TABLE and CREATE INDEX statements having
+// IF NOT EXISTS.
+var ifNotExistsRegexp = regexp.MustCompile("(?i)\\bCREATE\\s+(TABLE|(?:UNIQUE\\s+)?(?:NULL_FILTERED\\s+)?INDEX)\\s+(IF\\s+NOT\\s+EXISTS)\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)")
+
+// extractIfNotExists removes IF NOT EXISTS from CREATE TABLE and CREATE INDEX
+// statements so that they are parsed as the plain forms. The statements are
+// returned as a set of "TABLE name" and "INDEX name", and the removed text is
+// blanked out by blankOut.
+func extractIfNotExists(ddl string) (string, map[string]bool) {
+	stmts := make(map[string]bool)
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+	for _, m := range ifNotExistsRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		kind := "TABLE"
+		if !strings.EqualFold(ddl[m[2]:m[3]], "TABLE") {
+			kind = "INDEX"
+		}
+		stmts[kind+" "+strings.Trim(ddl[m[6]:m[7]], "`")] = true
+
+		blankOut(blanked, m[4], m[5])
+	}
+
+	return string(blanked), stmts
+}
+
+// tableRename is a rename of a table by a RENAME TABLE statement or an ALTER
+// TABLE statement, at pos of the DDL.
+type tableRename struct {
+	pos      int
+	from, to string
+}
+
+// renameTableRegexp matches RENAME TABLE statements and ALTER TABLE statements
+// renaming tables, with their renames as the first and the second groups.
+var renameTableRegexp = regexp.MustCompile("(?is)\\b(?:RENAME\\s+TABLE\\s+([^;]*)|ALTER\\s+TABLE\\s+((?:`[^`]+`|[A-Za-z_][A-Za-z0-9_.]*)\\s+RENAME\\s+TO\\s+[^;]*));?")
+
+// renameToRegexp matches a rename "a TO b" of the statements renaming tables.
+var renameToRegexp = regexp.MustCompile("(?is)^\\s*(`[^`]+`|[A-Za-z_][A-Za-z0-9_.]*)\\s+(?:RENAME\\s+)?TO\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_.]*)")
+
+// extractRenameTables removes the statements renaming tables because the DDL
+// parser does not understand them. The renames are returned in the order of
+// the statements with their positions, and the removed text is blanked out by
+// blankOut. The synonyms added by ALTER TABLE RENAME TO are ignored.
+func extractRenameTables(ddl string) (string, []tableRename) {
+	var renames []tableRename
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+	for _, m := range renameTableRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		var pairs []string
+		if m[2] >= 0 {
+			pairs = strings.Split(masked[m[2]:m[3]], ",")
+		} else {
+			// ALTER TABLE a RENAME TO b, ADD SYNONYM a
+			pairs = strings.SplitN(masked[m[4]:m[5]], ",", 2)[:1]
+		}
+		for _, pair := range pairs {
+			if r := renameToRegexp.FindStringSubmatch(pair); r != nil {
+				renames = append(renames, tableRename{
+					pos:  m[0],
+					from: strings.Trim(r[1], "`"),
+					to:   strings.Trim(r[2], "`"),
+				})
+			}
+		}
+
+		blankOut(blanked, m[0], m[1])
+	}
+
+	return string(blanked), renames
+}
+
+// createSearchIndexRegexp matches the head of CREATE SEARCH INDEX statements
+// up to the opening parenthesis of the TOKENLIST columns.
+var createSearchIndexRegexp = regexp.MustCompile("(?i)\\bCREATE\\s+SEARCH\\s+INDEX\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)\\s+ON\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)\\s*\\(")
+
+// extractSearchIndexes removes CREATE SEARCH INDEX statements because the DDL
+// parser does not understand them. The search indexes are returned per table
+// name, and the removed text is blanked out by blankOut.
+func extractSearchIndexes(ddl string) (string, map[string][]*models.SearchIndex) {
+	indexes := make(map[string][]*models.SearchIndex)
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+
+	for _, m := range createSearchIndexRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		index := &models.SearchIndex{IndexName: strings.Trim(ddl[m[2]:m[3]], "`")}
+		table := strings.Trim(ddl[m[4]:m[5]], "`")
+
+		s := &ddlScanner{src: ddl, pos: m[1] - 1}
+		index.Columns = s.columnList()
+		for s.pos < len(s.src) && s.src[s.pos] != ';' {
+			if s.skip() {
+				continue
+			}
+			if !isIdentChar(s.src[s.pos]) {
+				s.pos++
+				continue
+			}
+
+			switch word := strings.ToUpper(s.ident()); word {
+			case "STORING":
+				s.skipSpaces()
+				index.StoringColumns = s.columnList()
+			case "PARTITION", "ORDER":
+				s.skipSpaces()
+				if !strings.EqualFold(s.ident(), "BY") {
+					continue
+				}
+				var cols []string
+				for {
+					s.skipSpaces()
+					col := s.ident()
+					if col == "" || strings.EqualFold(col, "INTERLEAVE") {
+						// , INTERLEAVE IN follows the columns
+						break
+					}
+					cols = append(cols, col)
+
+					s.skipSpaces()
+					pos := s.pos
+					if dir := strings.ToUpper(s.ident()); dir != "ASC" && dir != "DESC" {
+						s.pos = pos
+					}
+					s.skipSpaces()
+					if s.pos >= len(s.src) || s.src[s.pos] != ',' {
+						break
+					}
+					s.pos++
+				}
+				if word == "PARTITION" {
+					index.PartitionColumns = cols
+				} else {
+					index.OrderColumns = cols
+				}
+			}
+		}
+		if s.pos < len(s.src) {
+			s.pos++ // semicolon
+		}
+
+		blankOut(blanked, m[0], s.pos)
+		indexes[table] = append(indexes[table], index)
+	}
+
+	return string(blanked), indexes
+}
+
+// columnList reads the parenthesized column names at the current position.
+// The ordering of the columns is ignored.
+func (s *ddlScanner) columnList() []string {
+	if s.pos >= len(s.src) || s.src[s.pos] != '(' {
+		return nil
+	}
+	s.pos++
+
+	var cols []string
+	expectColumn := true
+	for s.pos < len(s.src) {
+		if s.skip() {
+			continue
+		}
+		c := s.src[s.pos]
+		switch {
+		case c == ')':
+			s.pos++
+			return cols
+		case c == ',':
+			expectColumn = true
+			s.pos++
+		case c == '`' || isIdentChar(c):
+			word := s.ident()
+			if expectColumn {
+				cols = append(cols, word)
+				expectColumn = false
+			}
+		default:
+			s.pos++
+		}
+	}
+
+	return cols
+}
+
+// createVectorIndexRegexp matches the head of CREATE VECTOR INDEX statements
+// up to the opening parenthesis of the embedding column.
+var createVectorIndexRegexp = regexp.MustCompile("(?i)\\bCREATE\\s+VECTOR\\s+INDEX\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)\\s+ON\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)\\s*\\(")
+
+// extractVectorIndexes removes CREATE VECTOR INDEX statements because the DDL
+// parser does not understand them. The vector indexes are returned per table
+// name, and the removed text is blanked out by blankOut.
+func extractVectorIndexes(ddl string) (string, map[string][]*models.VectorIndex) {
+	indexes := make(map[string][]*models.VectorIndex)
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+
+	for _, m := range createVectorIndexRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		index := &models.VectorIndex{IndexName: strings.Trim(ddl[m[2]:m[3]], "`")}
+		table := strings.Trim(ddl[m[4]:m[5]], "`")
+
+		s := &ddlScanner{src: ddl, pos: m[1] - 1}
+		if cols := s.columnList(); len(cols) > 0 {
+			index.ColumnName = cols[0]
+		}
+		for s.pos < len(s.src) && s.src[s.pos] != ';' {
+			if s.skip() {
+				continue
+			}
+			if !isIdentChar(s.src[s.pos]) {
+				s.pos++
+				continue
+			}
+
+			switch strings.ToUpper(s.ident()) {
+			case "STORING":
+				s.skipSpaces()
+				index.StoringColumns = s.columnList()
+			case "OPTIONS":
+				s.skipSpaces()
+				if s.pos >= len(s.src) || s.src[s.pos] != '(' {
+					continue
+				}
+				listStart := s.pos + 1
+				s.skipParens()
+				index.Options = splitOptions(s.src[listStart : s.pos-1])
+			}
+		}
+		if s.pos < len(s.src) {
+			s.pos++ // semicolon
+		}
+
+		blankOut(blanked, m[0], s.pos)
+		indexes[table] = append(indexes[table], index)
+	}
+
+	return string(blanked), indexes
+}
+
+// sequenceStmtRegexp matches the head of CREATE, ALTER and DROP SEQUENCE
+// statements.
+var sequenceStmtRegexp = regexp.MustCompile("(?i)\\b(CREATE|ALTER|DROP)\\s+SEQUENCE\\s+(?:IF\\s+(?:NOT\\s+)?EXISTS\\s+)?(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)")
+
+// extractSequences removes CREATE, ALTER and DROP SEQUENCE statements because
+// the DDL parser does not understand them. The names of the sequences which
+// exist after the statements are returned, and the removed text is blanked
+// out by blankOut.
+func extractSequences(ddl string) (string, map[string]bool) {
+	sequences := make(map[string]bool)
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+
+	for _, m := range sequenceStmtRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		name := strings.Trim(ddl[m[4]:m[5]], "`")
+		if strings.EqualFold(ddl[m[2]:m[3]], "DROP") {
+			delete(sequences, name)
+		} else {
+			sequences[name] = true
+		}
+
+		s := &ddlScanner{src: ddl, pos: m[1]}
+		for s.pos < len(s.src) && s.src[s.pos] != ';' {
+			if !s.skip() {
+				s.pos++
+			}
+		}
+		if s.pos < len(s.src) {
+			s.pos++ // semicolon
+		}
+
+		blankOut(blanked, m[0], s.pos)
+	}
+
+	return string(blanked), sequences
+}
+
+// changeStreamStmtRegexp matches the head of CREATE, ALTER and DROP CHANGE
+// STREAM statements.
+var changeStreamStmtRegexp = regexp.MustCompile("(?i)\\b(CREATE|ALTER|DROP)\\s+CHANGE\\s+STREAM\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_]*)")
+
+// extractChangeStreams removes CREATE, ALTER and DROP CHANGE STREAM statements
+// because the DDL parser does not understand them. The change streams which
+// exist after the statements are returned in the order of creation, and the
+// removed text is blanked out by blankOut.
+func extractChangeStreams(ddl string) (string, []*models.ChangeStream) {
+	var streams []*models.ChangeStream
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+
+	for _, m := range changeStreamStmtRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		name := strings.Trim(ddl[m[4]:m[5]], "`")
+		i := changeStreamIndex(streams, name)
+
+		var stream *models.ChangeStream
+		switch strings.ToUpper(ddl[m[2]:m[3]]) {
+		case "CREATE":
+			stream = &models.ChangeStream{Name: name}
+			if i >= 0 {
+				streams[i] = stream
+			} else {
+				streams = append(streams, stream)
+			}
+		case "ALTER":
+			if i >= 0 {
+				stream = streams[i]
+			}
+		case "DROP":
+			if i >= 0 {
+				streams = append(streams[:i], streams[i+1:]...)
+			}
+		}
+
+		s := &ddlScanner{src: ddl, pos: m[1]}
+		for s.pos < len(s.src) && s.src[s.pos] != ';' {
+			if s.skip() {
+				continue
+			}
+			if !isIdentChar(s.src[s.pos]) {
+				s.pos++
+				continue
+			}
+
+			switch strings.ToUpper(s.ident()) {
+			case "FOR":
+				all, tables := s.changeStreamTables()
+				if stream != nil {
+					stream.All, stream.TableNames = all, tables
+				}
+			case "DROP":
+				// ALTER CHANGE STREAM s DROP FOR ALL
+				s.skipSpaces()
+				if strings.EqualFold(s.ident(), "FOR") && stream != nil {
+					stream.All, stream.TableNames = false, nil
+				}
+			case "OPTIONS":
+				s.skipSpaces()
+				s.skipParens()
+			}
+		}
+		if s.pos < len(s.src) {
+			s.pos++ // semicolon
+		}
+
+		blankOut(blanked, m[0], s.pos)
+	}
+
+	return string(blanked), streams
+}
+
+// changeStreamTables reads ALL or the tables with the optional column lists
+// following FOR of a change stream.
+func (s *ddlScanner) changeStreamTables() (bool, []string) {
+	s.skipSpaces()
+	start := s.pos
+	if strings.EqualFold(s.ident(), "ALL") {
+		return true, nil
+	}
+	s.pos = start
+
+	var tables []string
+	for {
+		s.skipSpaces()
+		table := s.ident()
+		if table == "" {
+			break
+		}
+		tables = append(tables, table)
+
+		s.skipSpaces()
+		if s.pos < len(s.src) && s.src[s.pos] == '(' {
+			// the columns do not matter to the generated code
+			s.skipParens()
+			s.skipSpaces()
+		}
+		if s.pos >= len(s.src) || s.src[s.pos] != ',' {
+			break
+		}
+		s.pos++
+	}
+
+	return false, tables
+}
+
+// protoBundleRegexp matches the heads of the statements of the proto bundle.
+var protoBundleRegexp = regexp.MustCompile(`(?i)\b(CREATE|ALTER|DROP)\s+PROTO\s+BUNDLE\b`)
+
+// extractProtoBundles removes CREATE, ALTER and DROP PROTO BUNDLE statements
+// because the DDL parser does not understand them. The fully qualified names
+// of the proto types in the final proto bundle are returned, and the removed
+// text is blanked out by blankOut.
+func extractProtoBundles(ddl string) (string, map[string]bool) {
+	types := make(map[string]bool)
+	blanked := []byte(ddl)
+	masked := maskDDL(ddl)
+
+	for _, m := range protoBundleRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		stmt := strings.ToUpper(ddl[m[2]:m[3]])
+		if stmt == "DROP" {
+			types = make(map[string]bool)
+		}
+
+		s := &ddlScanner{src: ddl, pos: m[1]}
+		clause := "INSERT"
+		for s.pos < len(s.src) && s.src[s.pos] != ';' {
+			if s.skip() {
+				continue
+			}
+			switch c := s.src[s.pos]; {
+			case c == '(':
+				s.pos++
+				for _, name := range s.protoTypeList() {
+					switch clause {
+					case "INSERT", "UPDATE":
+						types[name] = true
+					case "DELETE":
+						delete(types, name)
+					}
+				}
+			case isIdentChar(c):
+				// INSERT, UPDATE and DELETE of ALTER PROTO BUNDLE
+				clause = strings.ToUpper(s.ident())
+			default:
+				s.pos++
+			}
+		}
+		if s.pos < len(s.src) {
+			s.pos++ // semicolon
+		}
+
+		blankOut(blanked, m[0], s.pos)
+	}
+
+	return string(blanked), types
+}
+
+// protoTypeList reads the comma-separated names of the proto types up to the
+// closing parenthesis. The names may be quoted by backquotes.
+func (s *ddlScanner) protoTypeList() []string {
+	var names []string
+	for s.pos < len(s.src) {
+		s.skipSpaces()
+		if s.pos >= len(s.src) {
+			break
+		}
+		switch c := s.src[s.pos]; {
+		case c == ')':
+			s.pos++
+			return names
+		case c == ',':
+			s.pos++
+		case c == '`' || isIdentChar(c):
+			if name := s.qualifiedIdent(); name != "" {
+				names = append(names, name)
+			}
+		default:
+			s.pos++
+		}
+	}
+
+	return names
+}
+
+// qualifiedIdent reads an identifier qualified by dots such as
+// examples.music.SingerInfo at the current position.
+func (s *ddlScanner) qualifiedIdent() string {
+	name := s.ident()
+	for s.pos < len(s.src) && s.src[s.pos] == '.' {
+		s.pos++
+		name += "." + s.ident()
+	}
+	return name
+}
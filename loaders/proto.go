@@ -0,0 +1,159 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Kinds of proto types.
+const (
+	protoKindMessage = "PROTO"
+	protoKindEnum    = "ENUM"
+)
+
+// ProtoTypes resolves the Go types of PROTO and ENUM columns, which are the
+// types generated by protoc-gen-go from the proto files of the proto bundle.
+type ProtoTypes struct {
+	kinds    map[string]string // PROTO or ENUM by fully qualified name
+	packages map[string]string // proto packages by fully qualified name
+	imports  map[string]string // Go import paths by proto package
+}
+
+// NewProtoTypes loads the proto types from descriptorsFile, which is the
+// FileDescriptorSet given to Cloud Spanner as the descriptors of the proto
+// bundle, if it is not empty. The Go packages of the proto packages are the
+// go_package options of the proto files, overridden by packages which are the
+// Go import paths by proto package.
+func NewProtoTypes(descriptorsFile string, packages map[string]string) (*ProtoTypes, error) {
+	p := &ProtoTypes{
+		kinds:    make(map[string]string),
+		packages: make(map[string]string),
+		imports:  make(map[string]string),
+	}
+
+	if descriptorsFile != "" {
+		b, err := ioutil.ReadFile(descriptorsFile)
+		if err != nil {
+			return nil, err
+		}
+		var set descriptorpb.FileDescriptorSet
+		if err := proto.Unmarshal(b, &set); err != nil {
+			return nil, fmt.Errorf("invalid proto descriptors file '%s': %v", descriptorsFile, err)
+		}
+
+		for _, file := range set.GetFile() {
+			pkg := file.GetPackage()
+			if goPackage := file.GetOptions().GetGoPackage(); goPackage != "" {
+				// go_package may be followed by the package name such as
+				// github.com/acme/musicpb;musicpb
+				p.imports[pkg] = strings.SplitN(goPackage, ";", 2)[0]
+			}
+			p.addTypes(pkg, pkg, file.GetMessageType(), file.GetEnumType())
+		}
+	}
+
+	for pkg, path := range packages {
+		p.imports[pkg] = path
+	}
+
+	return p, nil
+}
+
+// addTypes adds the messages and the enums declared in scope, which is either
+// the proto package or a message, with the nested ones.
+func (p *ProtoTypes) addTypes(pkg, scope string, messages []*descriptorpb.DescriptorProto, enums []*descriptorpb.EnumDescriptorProto) {
+	qualify := func(name string) string {
+		if scope == "" {
+			return name
+		}
+		return scope + "." + name
+	}
+
+	for _, m := range messages {
+		name := qualify(m.GetName())
+		p.kinds[name] = protoKindMessage
+		p.packages[name] = pkg
+		p.addTypes(pkg, name, m.GetNestedType(), m.GetEnumType())
+	}
+	for _, e := range enums {
+		name := qualify(e.GetName())
+		p.kinds[name] = protoKindEnum
+		p.packages[name] = pkg
+	}
+}
+
+// kind returns the kind of the proto type, or "" if it is unknown.
+func (p *ProtoTypes) kind(name string) string {
+	if p == nil {
+		return ""
+	}
+	return p.kinds[name]
+}
+
+// goType returns the Go type of the proto type qualified by the import path of
+// the Go package such as github.com/acme/musicpb.SingerInfo. The nested types
+// are joined by underscores as protoc-gen-go does. It returns "" if the Go
+// package is unknown.
+func (p *ProtoTypes) goType(name string) string {
+	if p == nil {
+		return ""
+	}
+
+	pkg, ok := p.packages[name]
+	if !ok {
+		// the longest proto package given by the user
+		for protoPkg := range p.imports {
+			if strings.HasPrefix(name, protoPkg+".") && (!ok || len(protoPkg) > len(pkg)) {
+				pkg, ok = protoPkg, true
+			}
+		}
+	}
+	path := p.imports[pkg]
+	if !ok || path == "" {
+		return ""
+	}
+
+	goName := name
+	if pkg != "" {
+		goName = strings.TrimPrefix(name, pkg+".")
+	}
+	return path + "." + strings.Replace(goName, ".", "_", -1)
+}
+
+// protoTypeRegexp matches the data types of PROTO and ENUM columns such as
+// PROTO<examples.music.SingerInfo>.
+var protoTypeRegexp = regexp.MustCompile(`^(PROTO|ENUM)<([^<>]+)>$`)
+
+// parseProtoType splits the data type of a PROTO or ENUM column into the kind
+// and the fully qualified name of the proto type.
+func parseProtoType(dt string) (string, string, bool) {
+	m := protoTypeRegexp.FindStringSubmatch(dt)
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.mercari.io/yo/models"
+)
+
+// Loader is a source of the schema, such as a database or ddl files, whose
+// tables, views, columns and indexes are generated by the templates. A loader
+// may also implement the following methods to generate the code of the
+// dependencies of the views, the search indexes, the vector indexes, the
+// foreign keys and the change streams:
+//
+//	ViewDependencies(view string) ([]string, error)
+//	SearchIndexList(table string) ([]*models.SearchIndex, error)
+//	VectorIndexList(table string) ([]*models.VectorIndex, error)
+//	ForeignKeyList(table string) ([]*models.ForeignKey, error)
+//	ChangeStreamList() ([]*models.ChangeStream, error)
+//
+// The loader registered by Register is also given the flags of the generation
+// by the following methods if it implements them, and the generated code is
+// of the dialect returned by Dialect:
+//
+//	SetClientVersion(v string)
+//	SetProtoTypes(p *ProtoTypes)
+//	Dialect() string
+type Loader interface {
+	// ParamN returns the placeholder of the 0-based nth parameter of the
+	// queries, such as @param0.
+	ParamN(n int) string
+
+	// MaskFunc returns the mask of the placeholders of the queries.
+	MaskFunc() string
+
+	// ParseType returns the length, the nil value and the Go type of the
+	// column of the type dt such as STRING(32), which is typed by SpanParseType
+	// for the column types of Cloud Spanner.
+	ParseType(dt string, nullable bool) (int, string, string)
+
+	// ValidCustomType reports whether the column of the type dataType can be
+	// typed by customType.
+	ValidCustomType(dataType string, customType string) bool
+
+	// TableList returns the tables.
+	TableList() ([]*models.Table, error)
+
+	// ViewList returns the views.
+	ViewList() ([]*models.Table, error)
+
+	// ColumnList returns the columns of the table or the view.
+	ColumnList(table string) ([]*models.Column, error)
+
+	// IndexList returns the indexes of the table.
+	IndexList(table string) ([]*models.Index, error)
+
+	// IndexColumnList returns the columns of the index of the table, where
+	// the index PRIMARY_KEY is the primary key.
+	IndexColumnList(table string, index string) ([]*models.IndexColumn, error)
+}
+
+var (
+	_ Loader = (*SpannerLoader)(nil)
+	_ Loader = (*SpannerPGLoader)(nil)
+	_ Loader = (*SpannerLoaderFromDDL)(nil)
+	_ Loader = (*JSONLoader)(nil)
+)
+
+// Factory returns the loader of the schema of source, which is the argument
+// of yo generate such as the path of a schema file.
+type Factory func(source string) (Loader, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[string]Factory)
+)
+
+// Register registers the factory of the loader of name, which is selected by
+// --loader of yo generate. It allows a program running yo as a library to load
+// the schema from another source such as a schema file of its own format. It
+// panics if name is registered twice or factory is nil.
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if factory == nil {
+		panic("loaders: Register factory is nil")
+	}
+	if _, dup := factories[name]; dup {
+		panic("loaders: Register called twice for loader " + name)
+	}
+	factories[name] = factory
+}
+
+// Lookup returns the factory of the loader registered by name.
+func Lookup(name string) (Factory, error) {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	factory, ok := factories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown loader '%s', registered loaders are %v", name, registered())
+	}
+	return factory, nil
+}
+
+// registered returns the sorted names of the registered loaders.
+func registered() []string {
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
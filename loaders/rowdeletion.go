@@ -0,0 +1,64 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/cloudspannerecosystem/memefish/ast"
+
+	"go.mercari.io/yo/models"
+)
+
+// rowDeletionPolicyRegexp matches ROW_DELETION_POLICY_EXPRESSION of
+// INFORMATION_SCHEMA.TABLES, such as `OLDER_THAN(CreatedAt, INTERVAL 30 DAY)`.
+var rowDeletionPolicyRegexp = regexp.MustCompile("(?i)^\\s*OLDER_THAN\\s*\\(\\s*`?([A-Za-z_][A-Za-z0-9_]*)`?\\s*,\\s*INTERVAL\\s+([0-9]+)\\s+DAY\\s*\\)\\s*$")
+
+// rowDeletionPolicy converts the row deletion policy of the DDL.
+func rowDeletionPolicy(p *ast.RowDeletionPolicy) (*models.RowDeletionPolicy, error) {
+	value, base := p.NumDays.Value, 10
+	if p.NumDays.Base == 16 {
+		// hexadecimal literals are prefixed by 0x
+		value, base = value[2:], 16
+	}
+	days, err := strconv.ParseInt(value, base, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval of row deletion policy '%s': %v", p.SQL(), err)
+	}
+
+	return &models.RowDeletionPolicy{ColumnName: p.ColumnName.Name, NumDays: days}, nil
+}
+
+// parseRowDeletionPolicy parses the expression of a row deletion policy. It
+// returns nil if expr is empty or not understood.
+func parseRowDeletionPolicy(expr string) *models.RowDeletionPolicy {
+	m := rowDeletionPolicyRegexp.FindStringSubmatch(expr)
+	if m == nil {
+		return nil
+	}
+	days, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &models.RowDeletionPolicy{ColumnName: m[1], NumDays: days}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import "strings"
+
+// splitQualifiedName splits the schema-qualified name into the schema and the
+// name in the schema. The schema is "" if name is in the default schema.
+func splitQualifiedName(name string) (string, string) {
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// qualify returns the name qualified by schema unless schema is the default
+// schema.
+func qualify(schema, name string) string {
+	if schema == "" {
+		return name
+	}
+	return schema + "." + name
+}
+
+// qualifiedName returns the schema-qualified name of the identifier replaced
+// by extractSchemas.
+func (s *SpannerLoaderFromDDL) qualifiedName(name string) string {
+	if q, ok := s.schemaNames[name]; ok {
+		return q
+	}
+	return name
+}
+
+// internalName returns the identifier which replaces the schema-qualified
+// name in the loader.
+func (s *SpannerLoaderFromDDL) internalName(name string) string {
+	for n, q := range s.schemaNames {
+		if q == name {
+			return n
+		}
+	}
+	return name
+}
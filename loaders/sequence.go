@@ -0,0 +1,39 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"regexp"
+	"strings"
+)
+
+// sequenceDefaultRegexp matches the default values of columns taken from a
+// sequence, such as `GET_NEXT_SEQUENCE_VALUE(SEQUENCE MySequence)`.
+var sequenceDefaultRegexp = regexp.MustCompile("(?i)^\\(?\\s*GET_NEXT_SEQUENCE_VALUE\\s*\\(\\s*SEQUENCE\\s+(`[^`]+`|[A-Za-z_][A-Za-z0-9_.]*)\\s*\\)\\s*\\)?$")
+
+// parseSequenceDefault returns the sequence which the default value expr of a
+// column is taken from. It returns "" if expr is not a sequence.
+func parseSequenceDefault(expr string) string {
+	m := sequenceDefaultRegexp.FindStringSubmatch(strings.TrimSpace(expr))
+	if m == nil {
+		return ""
+	}
+	return strings.Trim(m[1], "`")
+}
@@ -28,6 +28,7 @@ import (
 
 	"cloud.google.com/go/spanner"
 	"github.com/kenshaw/snaker"
+	"go.mercari.io/yo/internal"
 	"go.mercari.io/yo/models"
 	"google.golang.org/api/iterator"
 )
@@ -39,7 +40,21 @@ func NewSpannerLoader(client *spanner.Client) *SpannerLoader {
 }
 
 type SpannerLoader struct {
-	client *spanner.Client
+	client        *spanner.Client
+	clientVersion string
+	protoTypes    *ProtoTypes
+}
+
+// SetClientVersion sets the version of cloud.google.com/go/spanner which the
+// generated code is compiled with. The latest version is assumed if empty.
+func (s *SpannerLoader) SetClientVersion(v string) {
+	s.clientVersion = v
+}
+
+// SetProtoTypes sets the proto types, which tell the Go types of PROTO and
+// ENUM columns.
+func (s *SpannerLoader) SetProtoTypes(p *ProtoTypes) {
+	s.protoTypes = p
 }
 
 func (s *SpannerLoader) ParamN(n int) string {
@@ -51,7 +66,7 @@ func (s *SpannerLoader) MaskFunc() string {
 }
 
 func (s *SpannerLoader) ParseType(dt string, nullable bool) (int, string, string) {
-	return SpanParseType(dt, nullable)
+	return spanParseType(dt, nullable, s.clientVersion, s.protoTypes)
 }
 
 func (s *SpannerLoader) ValidCustomType(dataType string, customType string) bool {
@@ -62,7 +77,7 @@ func (s *SpannerLoader) TableList() ([]*models.Table, error) {
 	var err error
 
 	// get the tables
-	rows, err := spanTables(s.client)
+	rows, err := spanTables(s.client, "BASE TABLE")
 	if err != nil {
 		return nil, err
 	}
@@ -71,13 +86,36 @@ func (s *SpannerLoader) TableList() ([]*models.Table, error) {
 	var tables []*models.Table
 	for _, row := range rows {
 		tables = append(tables, &models.Table{
+			TableName:         row.TableName,
+			Schema:            row.Schema,
+			Type:              row.Type,
+			ManualPk:          true,
+			ParentTableName:   row.ParentTableName,
+			RowDeletionPolicy: row.RowDeletionPolicy,
+		})
+	}
+
+	return tables, nil
+}
+
+func (s *SpannerLoader) ViewList() ([]*models.Table, error) {
+	rows, err := spanTables(s.client, "VIEW")
+	if err != nil {
+		return nil, err
+	}
+
+	var views []*models.Table
+	for _, row := range rows {
+		views = append(views, &models.Table{
 			TableName: row.TableName,
+			Schema:    row.Schema,
 			Type:      row.Type,
 			ManualPk:  true,
+			IsView:    true,
 		})
 	}
 
-	return tables, nil
+	return views, nil
 }
 
 func (s *SpannerLoader) ColumnList(table string) ([]*models.Column, error) {
@@ -92,11 +130,61 @@ func (s *SpannerLoader) IndexColumnList(table string, index string) ([]*models.I
 	return SpanIndexColumns(s.client, table, index)
 }
 
+// SearchIndexList returns the search indexes of the table.
+func (s *SpannerLoader) SearchIndexList(table string) ([]*models.SearchIndex, error) {
+	return spanSearchIndexes(s.client, table)
+}
+
+// VectorIndexList returns the vector indexes of the table.
+func (s *SpannerLoader) VectorIndexList(table string) ([]*models.VectorIndex, error) {
+	return spanVectorIndexes(s.client, table)
+}
+
+// ForeignKeyList returns the foreign keys of the table.
+func (s *SpannerLoader) ForeignKeyList(table string) ([]*models.ForeignKey, error) {
+	return spanForeignKeys(s.client, table)
+}
+
+// ChangeStreamList returns the change streams.
+func (s *SpannerLoader) ChangeStreamList() ([]*models.ChangeStream, error) {
+	return spanChangeStreams(s.client)
+}
+
 var lengthRegexp = regexp.MustCompile(`\(([0-9]+|MAX)\)$`)
 
+// float32ClientVersion is the first version of cloud.google.com/go/spanner
+// which supports FLOAT32.
+const float32ClientVersion = "v1.60.0"
+
+// intervalClientVersion and uuidClientVersion are the first versions of
+// cloud.google.com/go/spanner which support INTERVAL and UUID.
+const (
+	intervalClientVersion = "v1.76.0"
+	uuidClientVersion     = "v1.81.0"
+)
+
+// ValidateClientVersion validates the version of cloud.google.com/go/spanner
+// given by the user, which is like v1.60.0.
+func ValidateClientVersion(v string) error {
+	if v == "" {
+		return nil
+	}
+	if _, ok := internal.ParseClientVersion(v); !ok {
+		return fmt.Errorf("invalid spanner client version '%s', must be like %s", v, float32ClientVersion)
+	}
+	return nil
+}
+
 // SpanParseType parse a mysql type into a Go type based on the column
 // definition.
 func SpanParseType(dt string, nullable bool) (int, string, string) {
+	return spanParseType(dt, nullable, "", nil)
+}
+
+// spanParseType is SpanParseType for the Go types supported by clientVersion
+// of cloud.google.com/go/spanner. The Go types of PROTO and ENUM columns are
+// resolved by protos, and qualified by the import paths of the packages.
+func spanParseType(dt string, nullable bool, clientVersion string, protos *ProtoTypes) (int, string, string) {
 	nilVal := "nil"
 	length := -1
 
@@ -152,9 +240,52 @@ func SpanParseType(dt string, nullable bool) (int, string, string) {
 			typ = "spanner.NullFloat64"
 		}
 
+	case "FLOAT32":
+		nilVal = "0.0"
+		typ = "float32"
+		if nullable {
+			nilVal = "spanner.NullFloat32{}"
+			typ = "spanner.NullFloat32"
+		}
+		if !internal.ClientSupports(clientVersion, float32ClientVersion) {
+			// older clients read and write FLOAT32 as FLOAT64
+			typ = "float64"
+			if nullable {
+				nilVal = "spanner.NullFloat64{}"
+				typ = "spanner.NullFloat64"
+			}
+		}
+
 	case "BYTES":
 		typ = "[]byte"
 
+	case "INTERVAL":
+		nilVal = "spanner.Interval{}"
+		typ = "spanner.Interval"
+		if nullable {
+			nilVal = "spanner.NullInterval{}"
+			typ = "spanner.NullInterval"
+		}
+		if !internal.ClientSupports(clientVersion, intervalClientVersion) {
+			// older clients read and write INTERVAL only as the raw values
+			nilVal = "spanner.GenericColumnValue{}"
+			typ = "spanner.GenericColumnValue"
+		}
+
+	case "UUID":
+		// uuid.UUID is qualified by the import path of its package
+		nilVal = "uuid.UUID{}"
+		typ = "github.com/google/uuid.UUID"
+		if nullable {
+			nilVal = "spanner.NullUUID{}"
+			typ = "spanner.NullUUID"
+		}
+		if !internal.ClientSupports(clientVersion, uuidClientVersion) {
+			// older clients read and write UUID only as the raw values
+			nilVal = "spanner.GenericColumnValue{}"
+			typ = "spanner.GenericColumnValue"
+		}
+
 	case "TIMESTAMP":
 		nilVal = "time.Time{}"
 		typ = "time.Time"
@@ -187,9 +318,35 @@ func SpanParseType(dt string, nullable bool) (int, string, string) {
 		}
 
 	default:
+		if kind, name, ok := parseProtoType(dt); ok {
+			goType := protos.goType(name)
+			switch {
+			case goType == "" && kind == protoKindMessage:
+				// read as the serialized messages
+				typ = "[]byte"
+			case goType == "":
+				// read as the numbers of the enums
+				nilVal, typ = "0", "int64"
+				if nullable {
+					nilVal, typ = "spanner.NullInt64{}", "spanner.NullInt64"
+				}
+			case kind == protoKindMessage:
+				typ = "*" + goType
+			default:
+				nilVal, typ = "0", goType
+				if nullable {
+					nilVal, typ = "nil", "*"+goType
+				}
+			}
+			break
+		}
+
 		if strings.HasPrefix(dt, "ARRAY<") {
+			// elements are nullable unless declared as ARRAY<T NOT NULL>
 			eleDataType := strings.TrimSuffix(strings.TrimPrefix(dt, "ARRAY<"), ">")
-			_, _, eleTyp := SpanParseType(eleDataType, false)
+			eleNullable := !strings.HasSuffix(eleDataType, " NOT NULL")
+			eleDataType = strings.TrimSuffix(eleDataType, " NOT NULL")
+			_, _, eleTyp := spanParseType(eleDataType, eleNullable, clientVersion, protos)
 			typ, nilVal = "[]"+eleTyp, "nil"
 			if !nullable {
 				nilVal = typ + "{}"
@@ -204,15 +361,18 @@ func SpanParseType(dt string, nullable bool) (int, string, string) {
 	return length, nilVal, typ
 }
 
-// spanTables runs a custom query, returning results as Table.
-func spanTables(client *spanner.Client) ([]*models.Table, error) {
+// spanTables runs a custom query, returning results as Table. tableType is
+// either "BASE TABLE" or "VIEW". The tables in named schemas are qualified by
+// the schemas.
+func spanTables(client *spanner.Client, tableType string) ([]*models.Table, error) {
 	ctx := context.Background()
 
 	const sqlstr = `SELECT ` +
-		`TABLE_NAME ` +
+		`TABLE_SCHEMA, TABLE_NAME, TABLE_TYPE, PARENT_TABLE_NAME, ROW_DELETION_POLICY_EXPRESSION ` +
 		`FROM INFORMATION_SCHEMA.TABLES ` +
-		`WHERE TABLE_SCHEMA = ""`
+		`WHERE TABLE_SCHEMA NOT IN ("INFORMATION_SCHEMA", "SPANNER_SYS") AND TABLE_TYPE = @type`
 	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["type"] = tableType
 	iter := client.Single().Query(ctx, stmt)
 	defer iter.Stop()
 
@@ -227,9 +387,29 @@ func spanTables(client *spanner.Client) ([]*models.Table, error) {
 		}
 
 		var t models.Table
+		if err := row.ColumnByName("TABLE_SCHEMA", &t.Schema); err != nil {
+			return nil, err
+		}
 		if err := row.ColumnByName("TABLE_NAME", &t.TableName); err != nil {
 			return nil, err
 		}
+		t.TableName = qualify(t.Schema, t.TableName)
+		if err := row.ColumnByName("TABLE_TYPE", &t.Type); err != nil {
+			return nil, err
+		}
+		var parent spanner.NullString
+		if err := row.ColumnByName("PARENT_TABLE_NAME", &parent); err != nil {
+			return nil, err
+		}
+		if parent.Valid {
+			// interleaved tables are in the same schema as the parents
+			t.ParentTableName = qualify(t.Schema, parent.StringVal)
+		}
+		var policy spanner.NullString
+		if err := row.ColumnByName("ROW_DELETION_POLICY_EXPRESSION", &policy); err != nil {
+			return nil, err
+		}
+		t.RowDeletionPolicy = parseRowDeletionPolicy(policy.StringVal)
 
 		res = append(res, &t)
 	}
@@ -241,22 +421,37 @@ func spanTables(client *spanner.Client) ([]*models.Table, error) {
 func spanTableColumns(client *spanner.Client, table string) ([]*models.Column, error) {
 	ctx := context.Background()
 
+	// IS_IDENTITY is missing in old versions of the emulator
+	identity := `FALSE`
+	hasIdentity, err := spanHasColumn(client, "COLUMNS", "IS_IDENTITY")
+	if err != nil {
+		return nil, err
+	}
+	if hasIdentity {
+		identity = `IFNULL(c.IS_IDENTITY = "YES", FALSE)`
+	}
+
 	// sql query
-	const sqlstr = `SELECT ` +
+	sqlstr := `SELECT ` +
 		`c.COLUMN_NAME, c.ORDINAL_POSITION, c.IS_NULLABLE, c.SPANNER_TYPE, ` +
 		`EXISTS (` +
 		`  SELECT 1 FROM INFORMATION_SCHEMA.INDEX_COLUMNS ic ` +
-		`  WHERE ic.TABLE_SCHEMA = "" and ic.TABLE_NAME = c.TABLE_NAME ` +
+		`  WHERE ic.TABLE_SCHEMA = c.TABLE_SCHEMA and ic.TABLE_NAME = c.TABLE_NAME ` +
 		`  AND ic.COLUMN_NAME = c.COLUMN_NAME` +
 		`  AND ic.INDEX_NAME = "PRIMARY_KEY" ` +
 		`) IS_PRIMARY_KEY, ` +
-		`IS_GENERATED = "ALWAYS" AS IS_GENERATED ` +
+		`IS_GENERATED = "ALWAYS" AS IS_GENERATED, ` +
+		identity + ` AS IS_IDENTITY, ` +
+		`c.COLUMN_DEFAULT ` +
 		`FROM INFORMATION_SCHEMA.COLUMNS c ` +
-		`WHERE c.TABLE_SCHEMA = "" AND c.TABLE_NAME = @table ` +
+		`WHERE c.TABLE_SCHEMA = @schema AND c.TABLE_NAME = @table ` +
+		`AND c.SPANNER_TYPE != "TOKENLIST" ` +
 		`ORDER BY c.ORDINAL_POSITION`
 
+	schema, name := splitQualifiedName(table)
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["table"] = table
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
 	iter := client.Single().Query(ctx, stmt)
 
 	defer iter.Stop()
@@ -290,16 +485,322 @@ func spanTableColumns(client *spanner.Client, table string) ([]*models.Column, e
 		if err := row.ColumnByName("SPANNER_TYPE", &c.DataType); err != nil {
 			return nil, err
 		}
+		c.DataType, c.VectorLength = parseVectorLength(c.DataType)
 		if err := row.ColumnByName("IS_PRIMARY_KEY", &c.IsPrimaryKey); err != nil {
 			return nil, err
 		}
 		if err := row.ColumnByName("IS_GENERATED", &c.IsGenerated); err != nil {
 			return nil, err
 		}
+		if err := row.ColumnByName("IS_IDENTITY", &c.IsIdentity); err != nil {
+			return nil, err
+		}
+		var columnDefault spanner.NullString
+		if err := row.ColumnByName("COLUMN_DEFAULT", &columnDefault); err != nil {
+			return nil, err
+		}
+		c.Sequence = parseSequenceDefault(columnDefault.StringVal)
 
 		res = append(res, &c)
 	}
 
+	options, err := spanColumnOptions(client, table)
+	if err != nil {
+		return nil, err
+	}
+	enumValues, err := spanCheckInValues(client, table)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range res {
+		c.Options = options[c.ColumnName]
+		c.EnumValues = enumValues[c.ColumnName]
+	}
+
+	return res, nil
+}
+
+// spanHasColumn reports whether the view of INFORMATION_SCHEMA has the column,
+// which may be missing in old versions of the emulator.
+func spanHasColumn(client *spanner.Client, view, column string) (bool, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`COUNT(*) AS COUNT ` +
+		`FROM INFORMATION_SCHEMA.COLUMNS ` +
+		`WHERE TABLE_SCHEMA = "INFORMATION_SCHEMA" AND TABLE_NAME = @view AND COLUMN_NAME = @column`
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["view"] = view
+	stmt.Params["column"] = column
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return false, err
+	}
+	var count int64
+	if err := row.ColumnByName("COUNT", &count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// spanHasView reports whether INFORMATION_SCHEMA has the view, which may be
+// missing in old versions of the emulator.
+func spanHasView(client *spanner.Client, view string) (bool, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`COUNT(*) AS COUNT ` +
+		`FROM INFORMATION_SCHEMA.TABLES ` +
+		`WHERE TABLE_SCHEMA = "INFORMATION_SCHEMA" AND TABLE_NAME = @view`
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["view"] = view
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return false, err
+	}
+	var count int64
+	if err := row.ColumnByName("COUNT", &count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// spanCheckInValues runs a custom query, returning the values of the columns
+// of table constrained by CHECK (column IN (...)) by column name.
+func spanCheckInValues(client *spanner.Client, table string) (map[string][]string, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`ccu.COLUMN_NAME, cc.CHECK_CLAUSE ` +
+		`FROM INFORMATION_SCHEMA.CHECK_CONSTRAINTS cc ` +
+		`JOIN INFORMATION_SCHEMA.CONSTRAINT_COLUMN_USAGE ccu ` +
+		`  ON ccu.CONSTRAINT_SCHEMA = cc.CONSTRAINT_SCHEMA AND ccu.CONSTRAINT_NAME = cc.CONSTRAINT_NAME ` +
+		`WHERE ccu.TABLE_SCHEMA = @schema AND ccu.TABLE_NAME = @table`
+
+	schema, name := splitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := make(map[string][]string)
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var column, clause string
+		if err := row.ColumnByName("COLUMN_NAME", &column); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("CHECK_CLAUSE", &clause); err != nil {
+			return nil, err
+		}
+
+		// the NOT NULL constraints are also listed, whose clauses are
+		// not IN lists
+		if c, vals, ok := parseCheckInClause(clause); ok && c == column {
+			addCheckInValues(res, column, vals)
+		}
+	}
+
+	return res, nil
+}
+
+// spanForeignKeys runs a custom query, returning the foreign keys of table.
+func spanForeignKeys(client *spanner.Client, table string) ([]*models.ForeignKey, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`rc.CONSTRAINT_NAME, kcu.COLUMN_NAME, ref.TABLE_SCHEMA AS REF_TABLE_SCHEMA, ` +
+		`ref.TABLE_NAME AS REF_TABLE_NAME, ref.COLUMN_NAME AS REF_COLUMN_NAME ` +
+		`FROM INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS rc ` +
+		`JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE kcu ` +
+		`  ON kcu.CONSTRAINT_SCHEMA = rc.CONSTRAINT_SCHEMA AND kcu.CONSTRAINT_NAME = rc.CONSTRAINT_NAME ` +
+		`JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE ref ` +
+		`  ON ref.CONSTRAINT_SCHEMA = rc.UNIQUE_CONSTRAINT_SCHEMA AND ref.CONSTRAINT_NAME = rc.UNIQUE_CONSTRAINT_NAME ` +
+		`  AND ref.ORDINAL_POSITION = kcu.POSITION_IN_UNIQUE_CONSTRAINT ` +
+		`WHERE kcu.TABLE_SCHEMA = @schema AND kcu.TABLE_NAME = @table ` +
+		`ORDER BY rc.CONSTRAINT_NAME, kcu.ORDINAL_POSITION`
+
+	schema, name := splitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.ForeignKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var constraintName, columnName, refSchema, refTable, refColumn string
+		if err := row.ColumnByName("CONSTRAINT_NAME", &constraintName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("COLUMN_NAME", &columnName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("REF_TABLE_SCHEMA", &refSchema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("REF_TABLE_NAME", &refTable); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("REF_COLUMN_NAME", &refColumn); err != nil {
+			return nil, err
+		}
+		constraintName = qualify(schema, constraintName)
+
+		if len(res) == 0 || res[len(res)-1].ConstraintName != constraintName {
+			res = append(res, &models.ForeignKey{
+				ConstraintName: constraintName,
+				RefTableName:   qualify(refSchema, refTable),
+			})
+		}
+		fk := res[len(res)-1]
+		fk.ColumnNames = append(fk.ColumnNames, columnName)
+		fk.RefColumnNames = append(fk.RefColumnNames, refColumn)
+	}
+
+	return res, nil
+}
+
+// spanChangeStreams runs a custom query, returning the change streams with the
+// tables watched by them.
+func spanChangeStreams(client *spanner.Client) ([]*models.ChangeStream, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`cs.CHANGE_STREAM_SCHEMA, cs.CHANGE_STREAM_NAME, cs.ALL, t.TABLE_SCHEMA, t.TABLE_NAME ` +
+		`FROM INFORMATION_SCHEMA.CHANGE_STREAMS cs ` +
+		`LEFT JOIN INFORMATION_SCHEMA.CHANGE_STREAM_TABLES t ` +
+		`  ON t.CHANGE_STREAM_SCHEMA = cs.CHANGE_STREAM_SCHEMA AND t.CHANGE_STREAM_NAME = cs.CHANGE_STREAM_NAME ` +
+		`ORDER BY cs.CHANGE_STREAM_SCHEMA, cs.CHANGE_STREAM_NAME, t.TABLE_SCHEMA, t.TABLE_NAME`
+
+	stmt := spanner.NewStatement(sqlstr)
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.ChangeStream{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var schema, name string
+		var all bool
+		var tableSchema, tableName spanner.NullString
+		if err := row.ColumnByName("CHANGE_STREAM_SCHEMA", &schema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("CHANGE_STREAM_NAME", &name); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ALL", &all); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("TABLE_SCHEMA", &tableSchema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("TABLE_NAME", &tableName); err != nil {
+			return nil, err
+		}
+		name = qualify(schema, name)
+
+		if len(res) == 0 || res[len(res)-1].Name != name {
+			res = append(res, &models.ChangeStream{Name: name, All: all})
+		}
+		stream := res[len(res)-1]
+		if !all && tableName.Valid {
+			stream.TableNames = append(stream.TableNames, qualify(tableSchema.StringVal, tableName.StringVal))
+		}
+	}
+
+	return res, nil
+}
+
+// spanColumnOptions runs a custom query, returning options of the columns of
+// table by column name.
+func spanColumnOptions(client *spanner.Client, table string) (map[string]map[string]string, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`COLUMN_NAME, OPTION_NAME, OPTION_VALUE ` +
+		`FROM INFORMATION_SCHEMA.COLUMN_OPTIONS ` +
+		`WHERE TABLE_SCHEMA = @schema AND TABLE_NAME = @table`
+
+	schema, name := splitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := make(map[string]map[string]string)
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var column, name, value string
+		if err := row.ColumnByName("COLUMN_NAME", &column); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("OPTION_NAME", &name); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("OPTION_VALUE", &value); err != nil {
+			return nil, err
+		}
+
+		if res[column] == nil {
+			res[column] = make(map[string]string)
+		}
+		res[column][name] = value
+	}
+
 	return res, nil
 }
 
@@ -313,15 +814,18 @@ func SpanTableIndexes(client *spanner.Client, table string) ([]*models.Index, er
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`INDEX_NAME, IS_UNIQUE ` +
+		`INDEX_NAME, IS_UNIQUE, IS_NULL_FILTERED ` +
 		`FROM INFORMATION_SCHEMA.INDEXES ` +
-		`WHERE TABLE_SCHEMA = "" ` +
+		`WHERE TABLE_SCHEMA = @schema ` +
 		`AND INDEX_NAME != "PRIMARY_KEY" ` +
 		`AND TABLE_NAME = @table ` +
+		`AND INDEX_TYPE = "INDEX" ` +
 		`AND SPANNER_IS_MANAGED = FALSE `
 
+	schema, name := splitQualifiedName(table)
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["table"] = table
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
 	iter := client.Single().Query(ctx, stmt)
 
 	defer iter.Stop()
@@ -340,9 +844,13 @@ func SpanTableIndexes(client *spanner.Client, table string) ([]*models.Index, er
 		if err := row.ColumnByName("INDEX_NAME", &i.IndexName); err != nil {
 			return nil, err
 		}
+		i.IndexName = qualify(schema, i.IndexName)
 		if err := row.ColumnByName("IS_UNIQUE", &i.IsUnique); err != nil {
 			return nil, err
 		}
+		if err := row.ColumnByName("IS_NULL_FILTERED", &i.IsNullFiltered); err != nil {
+			return nil, err
+		}
 
 		res = append(res, &i)
 	}
@@ -356,19 +864,23 @@ func SpanIndexColumns(client *spanner.Client, table string, index string) ([]*mo
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`ORDINAL_POSITION, COLUMN_NAME ` +
+		`ORDINAL_POSITION, COLUMN_NAME, COLUMN_ORDERING ` +
 		`FROM INFORMATION_SCHEMA.INDEX_COLUMNS ` +
-		`WHERE TABLE_SCHEMA = "" AND INDEX_NAME = @index AND TABLE_NAME = @table ` +
-		`ORDER BY ORDINAL_POSITION`
+		`WHERE TABLE_SCHEMA = @schema AND INDEX_NAME = @index AND TABLE_NAME = @table ` +
+		`ORDER BY ORDINAL_POSITION, COLUMN_NAME`
 
+	schema, name := splitQualifiedName(table)
+	_, index = splitQualifiedName(index)
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["table"] = table
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
 	stmt.Params["index"] = index
 	iter := client.Single().Query(ctx, stmt)
 
 	defer iter.Stop()
 
 	res := []*models.IndexColumn{}
+	storing := 0
 	for {
 		row, err := iter.Next()
 		if err != nil {
@@ -385,11 +897,20 @@ func SpanIndexColumns(client *spanner.Client, table string, index string) ([]*mo
 		}
 		i.SeqNo = int(ord.Int64)
 		if !ord.Valid {
+			// storing columns have no ordinal position, and are numbered
+			// in the order of the names
+			storing++
+			i.SeqNo = storing
 			i.Storing = true
 		}
 		if err := row.ColumnByName("COLUMN_NAME", &i.ColumnName); err != nil {
 			return nil, err
 		}
+		var ordering spanner.NullString
+		if err := row.ColumnByName("COLUMN_ORDERING", &ordering); err != nil {
+			return nil, err
+		}
+		i.Desc = ordering.StringVal == "DESC"
 
 		res = append(res, &i)
 	}
@@ -397,6 +918,193 @@ func SpanIndexColumns(client *spanner.Client, table string, index string) ([]*mo
 	return res, nil
 }
 
+// spanSearchIndexes runs a custom query, returning the search indexes of table.
+// The key columns of the search indexes other than the TOKENLIST columns are
+// returned as the partition columns, and the TOKENLIST columns generated by
+// TOKENIZE_FULLTEXT are returned as the full-text columns as well.
+func spanSearchIndexes(client *spanner.Client, table string) ([]*models.SearchIndex, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`ic.INDEX_NAME, ic.COLUMN_NAME, ic.ORDINAL_POSITION, c.SPANNER_TYPE, c.GENERATION_EXPRESSION ` +
+		`FROM INFORMATION_SCHEMA.INDEXES i ` +
+		`JOIN INFORMATION_SCHEMA.INDEX_COLUMNS ic ` +
+		`  ON ic.TABLE_SCHEMA = i.TABLE_SCHEMA AND ic.TABLE_NAME = i.TABLE_NAME AND ic.INDEX_NAME = i.INDEX_NAME ` +
+		`JOIN INFORMATION_SCHEMA.COLUMNS c ` +
+		`  ON c.TABLE_SCHEMA = ic.TABLE_SCHEMA AND c.TABLE_NAME = ic.TABLE_NAME AND c.COLUMN_NAME = ic.COLUMN_NAME ` +
+		`WHERE i.TABLE_SCHEMA = @schema AND i.TABLE_NAME = @table AND i.INDEX_TYPE = "SEARCH" ` +
+		`ORDER BY ic.INDEX_NAME, ic.ORDINAL_POSITION`
+
+	schema, name := splitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.SearchIndex{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var indexName, columnName, spannerType string
+		var ord spanner.NullInt64
+		var expr spanner.NullString
+		if err := row.ColumnByName("INDEX_NAME", &indexName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("COLUMN_NAME", &columnName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ORDINAL_POSITION", &ord); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("SPANNER_TYPE", &spannerType); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("GENERATION_EXPRESSION", &expr); err != nil {
+			return nil, err
+		}
+		indexName = qualify(schema, indexName)
+
+		if len(res) == 0 || res[len(res)-1].IndexName != indexName {
+			res = append(res, &models.SearchIndex{IndexName: indexName})
+		}
+		ix := res[len(res)-1]
+		switch {
+		case spannerType == "TOKENLIST":
+			ix.Columns = append(ix.Columns, columnName)
+			if fullTextRegexp.MatchString(expr.StringVal) {
+				ix.FullTextColumns = append(ix.FullTextColumns, columnName)
+			}
+		case !ord.Valid:
+			ix.StoringColumns = append(ix.StoringColumns, columnName)
+		default:
+			ix.PartitionColumns = append(ix.PartitionColumns, columnName)
+		}
+	}
+
+	return res, nil
+}
+
+// spanVectorIndexes runs custom queries, returning the vector indexes of table
+// with their options.
+func spanVectorIndexes(client *spanner.Client, table string) ([]*models.VectorIndex, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`ic.INDEX_NAME, ic.COLUMN_NAME, ic.ORDINAL_POSITION ` +
+		`FROM INFORMATION_SCHEMA.INDEXES i ` +
+		`JOIN INFORMATION_SCHEMA.INDEX_COLUMNS ic ` +
+		`  ON ic.TABLE_SCHEMA = i.TABLE_SCHEMA AND ic.TABLE_NAME = i.TABLE_NAME AND ic.INDEX_NAME = i.INDEX_NAME ` +
+		`WHERE i.TABLE_SCHEMA = @schema AND i.TABLE_NAME = @table AND i.INDEX_TYPE = "VECTOR" ` +
+		`ORDER BY ic.INDEX_NAME, ic.ORDINAL_POSITION`
+
+	schema, name := splitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.VectorIndex{}
+	byName := make(map[string]*models.VectorIndex)
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var indexName, columnName string
+		var ord spanner.NullInt64
+		if err := row.ColumnByName("INDEX_NAME", &indexName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("COLUMN_NAME", &columnName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ORDINAL_POSITION", &ord); err != nil {
+			return nil, err
+		}
+
+		ix, ok := byName[indexName]
+		if !ok {
+			ix = &models.VectorIndex{IndexName: qualify(schema, indexName), Options: make(map[string]string)}
+			byName[indexName] = ix
+			res = append(res, ix)
+		}
+		if ord.Valid {
+			ix.ColumnName = columnName
+		} else {
+			ix.StoringColumns = append(ix.StoringColumns, columnName)
+		}
+	}
+
+	// INDEX_OPTIONS is missing in old versions of the emulator
+	if len(res) == 0 {
+		return res, nil
+	}
+	hasOptions, err := spanHasView(client, "INDEX_OPTIONS")
+	if err != nil {
+		return nil, err
+	}
+	if !hasOptions {
+		return res, nil
+	}
+
+	// sql query
+	const optionsSQL = `SELECT ` +
+		`INDEX_NAME, OPTION_NAME, OPTION_VALUE ` +
+		`FROM INFORMATION_SCHEMA.INDEX_OPTIONS ` +
+		`WHERE TABLE_SCHEMA = @schema AND TABLE_NAME = @table AND INDEX_TYPE = "VECTOR"`
+
+	stmt = spanner.NewStatement(optionsSQL)
+	stmt.Params["schema"] = schema
+	stmt.Params["table"] = name
+	optIter := client.Single().Query(ctx, stmt)
+
+	defer optIter.Stop()
+
+	for {
+		row, err := optIter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var indexName, option, value string
+		if err := row.ColumnByName("INDEX_NAME", &indexName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("OPTION_NAME", &option); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("OPTION_VALUE", &value); err != nil {
+			return nil, err
+		}
+
+		if ix, ok := byName[indexName]; ok {
+			ix.Options[option] = value
+		}
+	}
+
+	return res, nil
+}
+
 func SpanValidateCustomType(dataType string, customType string) bool {
 	// No custom type validation now
 	return true
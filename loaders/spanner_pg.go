@@ -0,0 +1,609 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"go.mercari.io/yo/internal"
+	"go.mercari.io/yo/models"
+	"google.golang.org/api/iterator"
+)
+
+// pgDefaultSchema is the default schema of PostgreSQL-dialect databases, whose
+// tables are not qualified by it.
+const pgDefaultSchema = "public"
+
+// NewSpannerPGLoader returns the loader of the schema of a PostgreSQL-dialect
+// database, which is read from the pg-style information_schema.
+func NewSpannerPGLoader(client *spanner.Client) *SpannerPGLoader {
+	return &SpannerPGLoader{
+		client: client,
+	}
+}
+
+type SpannerPGLoader struct {
+	client        *spanner.Client
+	clientVersion string
+}
+
+// SetClientVersion sets the version of cloud.google.com/go/spanner which the
+// generated code is compiled with. The latest version is assumed if empty.
+func (s *SpannerPGLoader) SetClientVersion(v string) {
+	s.clientVersion = v
+}
+
+func (s *SpannerPGLoader) ParamN(n int) string {
+	return fmt.Sprintf("$%d", n+1)
+}
+
+func (s *SpannerPGLoader) MaskFunc() string {
+	return "?"
+}
+
+func (s *SpannerPGLoader) ParseType(dt string, nullable bool) (int, string, string) {
+	return pgParseType(dt, nullable, s.clientVersion)
+}
+
+func (s *SpannerPGLoader) ValidCustomType(dataType string, customType string) bool {
+	return SpanValidateCustomType(dataType, customType)
+}
+
+func (s *SpannerPGLoader) TableList() ([]*models.Table, error) {
+	rows, err := pgTables(s.client, "BASE TABLE")
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []*models.Table
+	for _, row := range rows {
+		row.ManualPk = true
+		tables = append(tables, row)
+	}
+
+	return tables, nil
+}
+
+func (s *SpannerPGLoader) ViewList() ([]*models.Table, error) {
+	rows, err := pgTables(s.client, "VIEW")
+	if err != nil {
+		return nil, err
+	}
+
+	var views []*models.Table
+	for _, row := range rows {
+		row.ManualPk = true
+		row.IsView = true
+		views = append(views, row)
+	}
+
+	return views, nil
+}
+
+func (s *SpannerPGLoader) ColumnList(table string) ([]*models.Column, error) {
+	return pgTableColumns(s.client, table)
+}
+
+func (s *SpannerPGLoader) IndexList(table string) ([]*models.Index, error) {
+	return pgTableIndexes(s.client, table)
+}
+
+func (s *SpannerPGLoader) IndexColumnList(table string, index string) ([]*models.IndexColumn, error) {
+	return pgIndexColumns(s.client, table, index)
+}
+
+// ForeignKeyList returns the foreign keys of the table.
+func (s *SpannerPGLoader) ForeignKeyList(table string) ([]*models.ForeignKey, error) {
+	return pgForeignKeys(s.client, table)
+}
+
+// pgQualify returns the name qualified by schema unless schema is the default
+// schema of PostgreSQL-dialect databases.
+func pgQualify(schema, name string) string {
+	if schema == pgDefaultSchema {
+		schema = ""
+	}
+	return qualify(schema, name)
+}
+
+// pgSplitQualifiedName splits the name qualified by pgQualify into the schema
+// and the name.
+func pgSplitQualifiedName(name string) (string, string) {
+	schema, name := splitQualifiedName(name)
+	if schema == "" {
+		schema = pgDefaultSchema
+	}
+	return schema, name
+}
+
+// pgCommitTimestampType is the type of the columns written with the commit
+// timestamps, which have the allow_commit_timestamp option in GoogleSQL.
+const pgCommitTimestampType = "spanner.commit_timestamp"
+
+// pgParseType parses a type of a PostgreSQL-dialect database into a Go type
+// supported by clientVersion of cloud.google.com/go/spanner.
+func pgParseType(dt string, nullable bool, clientVersion string) (int, string, string) {
+	nilVal := "nil"
+	length := -1
+
+	// separate type and length from dt with length such as character varying(32)
+	if m := lengthRegexp.FindStringSubmatchIndex(dt); m != nil {
+		l, err := strconv.Atoi(dt[m[2]:m[3]])
+		if err != nil {
+			panic("could not convert precision")
+		}
+		length = l
+
+		// trim length from dt
+		dt = dt[:m[0]] + dt[m[1]:]
+	}
+
+	var typ string
+	switch dt {
+	case "boolean":
+		nilVal = "false"
+		typ = "bool"
+		if nullable {
+			nilVal = "spanner.NullBool{}"
+			typ = "spanner.NullBool"
+		}
+
+	case "character varying", "text":
+		nilVal = `""`
+		typ = "string"
+		if nullable {
+			nilVal = "spanner.NullString{}"
+			typ = "spanner.NullString"
+		}
+
+	case "bigint":
+		nilVal = "0"
+		typ = "int64"
+		if nullable {
+			nilVal = "spanner.NullInt64{}"
+			typ = "spanner.NullInt64"
+		}
+
+	case "double precision":
+		nilVal = "0.0"
+		typ = "float64"
+		if nullable {
+			nilVal = "spanner.NullFloat64{}"
+			typ = "spanner.NullFloat64"
+		}
+
+	case "real":
+		nilVal = "0.0"
+		typ = "float32"
+		if nullable {
+			nilVal = "spanner.NullFloat32{}"
+			typ = "spanner.NullFloat32"
+		}
+		if !internal.ClientSupports(clientVersion, float32ClientVersion) {
+			// older clients read and write real as double precision
+			typ = "float64"
+			if nullable {
+				nilVal = "spanner.NullFloat64{}"
+				typ = "spanner.NullFloat64"
+			}
+		}
+
+	case "bytea":
+		typ = "[]byte"
+
+	case "timestamp with time zone", pgCommitTimestampType:
+		nilVal = "time.Time{}"
+		typ = "time.Time"
+		if nullable {
+			nilVal = "spanner.NullTime{}"
+			typ = "spanner.NullTime"
+		}
+
+	case "date":
+		nilVal = "civil.Date{}"
+		typ = "civil.Date"
+		if nullable {
+			nilVal = "spanner.NullDate{}"
+			typ = "spanner.NullDate"
+		}
+
+	case "jsonb":
+		nilVal = `spanner.PGJsonB{Valid: true}`
+		typ = "spanner.PGJsonB"
+		if nullable {
+			nilVal = `spanner.PGJsonB{}`
+		}
+
+	case "numeric":
+		nilVal = `spanner.PGNumeric{Valid: true}`
+		typ = "spanner.PGNumeric"
+		if nullable {
+			nilVal = `spanner.PGNumeric{}`
+		}
+
+	default:
+		if strings.HasSuffix(dt, "[]") {
+			// the elements of arrays are always nullable
+			_, _, eleTyp := pgParseType(strings.TrimSuffix(dt, "[]"), true, clientVersion)
+			typ, nilVal = "[]"+eleTyp, "nil"
+			if !nullable {
+				nilVal = typ + "{}"
+			}
+			break
+		}
+
+		// read only as the raw values
+		nilVal = "spanner.GenericColumnValue{}"
+		typ = "spanner.GenericColumnValue"
+	}
+
+	return length, nilVal, typ
+}
+
+// pgTables runs a custom query, returning results as Table. tableType is
+// either "BASE TABLE" or "VIEW". The tables in named schemas other than public
+// are qualified by the schemas.
+func pgTables(client *spanner.Client, tableType string) ([]*models.Table, error) {
+	ctx := context.Background()
+
+	const sqlstr = `SELECT ` +
+		`table_schema, table_name, table_type, parent_table_name ` +
+		`FROM information_schema.tables ` +
+		`WHERE table_schema NOT IN ('information_schema', 'spanner_sys', 'pg_catalog') AND table_type = $1`
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["p1"] = tableType
+	iter := client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	res := []*models.Table{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var t models.Table
+		if err := row.ColumnByName("table_schema", &t.Schema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("table_name", &t.TableName); err != nil {
+			return nil, err
+		}
+		t.TableName = pgQualify(t.Schema, t.TableName)
+		if t.Schema == pgDefaultSchema {
+			t.Schema = ""
+		}
+		if err := row.ColumnByName("table_type", &t.Type); err != nil {
+			return nil, err
+		}
+		var parent spanner.NullString
+		if err := row.ColumnByName("parent_table_name", &parent); err != nil {
+			return nil, err
+		}
+		if parent.Valid {
+			// interleaved tables are in the same schema as the parents
+			t.ParentTableName = qualify(t.Schema, parent.StringVal)
+		}
+
+		res = append(res, &t)
+	}
+
+	return res, nil
+}
+
+// pgTableColumns runs a custom query, returning results as Column. The columns
+// of spanner.commit_timestamp are returned as the ones having the
+// allow_commit_timestamp option.
+func pgTableColumns(client *spanner.Client, table string) ([]*models.Column, error) {
+	ctx := context.Background()
+
+	// is_identity is missing in old versions of the emulator
+	identity := `false`
+	hasIdentity, err := pgHasColumn(client, "columns", "is_identity")
+	if err != nil {
+		return nil, err
+	}
+	if hasIdentity {
+		identity = `COALESCE(c.is_identity = 'YES', false)`
+	}
+
+	// sql query
+	sqlstr := `SELECT ` +
+		`c.column_name, c.ordinal_position, c.is_nullable, c.spanner_type, ` +
+		`EXISTS (` +
+		`  SELECT 1 FROM information_schema.index_columns ic ` +
+		`  WHERE ic.table_schema = c.table_schema AND ic.table_name = c.table_name ` +
+		`  AND ic.column_name = c.column_name ` +
+		`  AND ic.index_name = 'PRIMARY_KEY'` +
+		`) AS is_primary_key, ` +
+		`c.is_generated = 'ALWAYS' AS is_generated, ` +
+		identity + ` AS is_identity ` +
+		`FROM information_schema.columns c ` +
+		`WHERE c.table_schema = $1 AND c.table_name = $2 ` +
+		`ORDER BY c.ordinal_position`
+
+	schema, name := pgSplitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["p1"] = schema
+	stmt.Params["p2"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.Column{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var c models.Column
+		var ord int64
+		if err := row.ColumnByName("ordinal_position", &ord); err != nil {
+			return nil, err
+		}
+		c.FieldOrdinal = int(ord)
+		if err := row.ColumnByName("column_name", &c.ColumnName); err != nil {
+			return nil, err
+		}
+		var isNullable string
+		if err := row.ColumnByName("is_nullable", &isNullable); err != nil {
+			return nil, err
+		}
+		if isNullable == "NO" {
+			c.NotNull = true
+		}
+		if err := row.ColumnByName("spanner_type", &c.DataType); err != nil {
+			return nil, err
+		}
+		if c.DataType == pgCommitTimestampType {
+			c.Options = map[string]string{"allow_commit_timestamp": "true"}
+		}
+		if err := row.ColumnByName("is_primary_key", &c.IsPrimaryKey); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("is_generated", &c.IsGenerated); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("is_identity", &c.IsIdentity); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &c)
+	}
+
+	return res, nil
+}
+
+// pgHasColumn reports whether the view of information_schema has the column,
+// which may be missing in old versions of the emulator.
+func pgHasColumn(client *spanner.Client, view, column string) (bool, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`COUNT(*) AS count ` +
+		`FROM information_schema.columns ` +
+		`WHERE table_schema = 'information_schema' AND table_name = $1 AND column_name = $2`
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["p1"] = view
+	stmt.Params["p2"] = column
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return false, err
+	}
+	var count int64
+	if err := row.ColumnByName("count", &count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// pgTableIndexes runs a custom query, returning results as Index.
+func pgTableIndexes(client *spanner.Client, table string) ([]*models.Index, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`index_name, is_unique = 'YES' AS is_unique, is_null_filtered = 'YES' AS is_null_filtered ` +
+		`FROM information_schema.indexes ` +
+		`WHERE table_schema = $1 ` +
+		`AND table_name = $2 ` +
+		`AND index_type = 'INDEX' ` +
+		`AND spanner_is_managed = 'NO'`
+
+	schema, name := pgSplitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["p1"] = schema
+	stmt.Params["p2"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.Index{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var i models.Index
+		if err := row.ColumnByName("index_name", &i.IndexName); err != nil {
+			return nil, err
+		}
+		i.IndexName = pgQualify(schema, i.IndexName)
+		if err := row.ColumnByName("is_unique", &i.IsUnique); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("is_null_filtered", &i.IsNullFiltered); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &i)
+	}
+
+	return res, nil
+}
+
+// pgIndexColumns runs a custom query, returning results as IndexColumn.
+func pgIndexColumns(client *spanner.Client, table string, index string) ([]*models.IndexColumn, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`ordinal_position, column_name, column_ordering ` +
+		`FROM information_schema.index_columns ` +
+		`WHERE table_schema = $1 AND table_name = $2 AND index_name = $3 ` +
+		`ORDER BY ordinal_position, column_name`
+
+	schema, name := pgSplitQualifiedName(table)
+	_, index = splitQualifiedName(index)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["p1"] = schema
+	stmt.Params["p2"] = name
+	stmt.Params["p3"] = index
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.IndexColumn{}
+	storing := 0
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var i models.IndexColumn
+		var ord spanner.NullInt64
+		if err := row.ColumnByName("ordinal_position", &ord); err != nil {
+			return nil, err
+		}
+		i.SeqNo = int(ord.Int64)
+		if !ord.Valid {
+			// storing columns have no ordinal position, and are numbered
+			// in the order of the names
+			storing++
+			i.SeqNo = storing
+			i.Storing = true
+		}
+		if err := row.ColumnByName("column_name", &i.ColumnName); err != nil {
+			return nil, err
+		}
+		var ordering spanner.NullString
+		if err := row.ColumnByName("column_ordering", &ordering); err != nil {
+			return nil, err
+		}
+		i.Desc = ordering.StringVal == "DESC"
+
+		res = append(res, &i)
+	}
+
+	return res, nil
+}
+
+// pgForeignKeys runs a custom query, returning the foreign keys of table.
+func pgForeignKeys(client *spanner.Client, table string) ([]*models.ForeignKey, error) {
+	ctx := context.Background()
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`rc.constraint_name, kcu.column_name, ref.table_schema AS ref_table_schema, ` +
+		`ref.table_name AS ref_table_name, ref.column_name AS ref_column_name ` +
+		`FROM information_schema.referential_constraints rc ` +
+		`JOIN information_schema.key_column_usage kcu ` +
+		`  ON kcu.constraint_schema = rc.constraint_schema AND kcu.constraint_name = rc.constraint_name ` +
+		`JOIN information_schema.key_column_usage ref ` +
+		`  ON ref.constraint_schema = rc.unique_constraint_schema AND ref.constraint_name = rc.unique_constraint_name ` +
+		`  AND ref.ordinal_position = kcu.position_in_unique_constraint ` +
+		`WHERE kcu.table_schema = $1 AND kcu.table_name = $2 ` +
+		`ORDER BY rc.constraint_name, kcu.ordinal_position`
+
+	schema, name := pgSplitQualifiedName(table)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["p1"] = schema
+	stmt.Params["p2"] = name
+	iter := client.Single().Query(ctx, stmt)
+
+	defer iter.Stop()
+
+	res := []*models.ForeignKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+
+		var constraintName, columnName, refSchema, refTable, refColumn string
+		if err := row.ColumnByName("constraint_name", &constraintName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("column_name", &columnName); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ref_table_schema", &refSchema); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ref_table_name", &refTable); err != nil {
+			return nil, err
+		}
+		if err := row.ColumnByName("ref_column_name", &refColumn); err != nil {
+			return nil, err
+		}
+		constraintName = pgQualify(schema, constraintName)
+
+		if len(res) == 0 || res[len(res)-1].ConstraintName != constraintName {
+			res = append(res, &models.ForeignKey{
+				ConstraintName: constraintName,
+				RefTableName:   pgQualify(refSchema, refTable),
+			})
+		}
+		fk := res[len(res)-1]
+		fk.ColumnNames = append(fk.ColumnNames, columnName)
+		fk.RefColumnNames = append(fk.RefColumnNames, refColumn)
+	}
+
+	return res, nil
+}
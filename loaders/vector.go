@@ -0,0 +1,59 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mercari.io/yo/models"
+)
+
+// vectorLengthRegexp matches the vector length of ARRAY types such as
+// ARRAY<FLOAT32>(vector_length=>128).
+var vectorLengthRegexp = regexp.MustCompile(`(?i)\(\s*vector_length\s*=>\s*([0-9]+)\s*\)\s*$`)
+
+// parseVectorLength splits the vector length from the data type of a vector
+// column. The length is 0 if the type has none.
+func parseVectorLength(dataType string) (string, int64) {
+	m := vectorLengthRegexp.FindStringSubmatchIndex(dataType)
+	if m == nil {
+		return dataType, 0
+	}
+	n, err := strconv.ParseInt(dataType[m[2]:m[3]], 10, 64)
+	if err != nil {
+		return dataType, 0
+	}
+
+	return strings.TrimSpace(dataType[:m[0]]), n
+}
+
+// VectorIndexList returns the vector indexes of the table.
+func (s *SpannerLoaderFromDDL) VectorIndexList(name string) ([]*models.VectorIndex, error) {
+	var indexes []*models.VectorIndex
+	for _, index := range s.vectorIndexes[s.internalName(name)] {
+		ix := *index
+		ix.IndexName = s.qualifiedName(ix.IndexName)
+		indexes = append(indexes, &ix)
+	}
+
+	return indexes, nil
+}
@@ -0,0 +1,503 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package loaders
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudspannerecosystem/memefish/ast"
+	"go.mercari.io/yo/models"
+)
+
+// viewSource is a table referenced in the FROM clause of a view.
+type viewSource struct {
+	alias    string
+	table    string
+	nullable bool // the rows may be missing by an outer join
+}
+
+// viewColumn is a column selected by a view with the source column it
+// originates from.
+type viewColumn struct {
+	name         string
+	column       *models.Column
+	source       *viewSource // nil if the column is not a plain column reference
+	sourceColumn string
+	aggregate    bool
+}
+
+// viewColumnRef is a column of a table referenced in the FROM clause of a view.
+type viewColumnRef struct {
+	alias  string
+	column string
+}
+
+// viewEquivalences is a union-find of columns known to have the same value
+// by the join conditions and the WHERE clause of a view.
+type viewEquivalences map[viewColumnRef]viewColumnRef
+
+func (e viewEquivalences) find(r viewColumnRef) viewColumnRef {
+	for {
+		p, ok := e[r]
+		if !ok || p == r {
+			return r
+		}
+		r = p
+	}
+}
+
+func (e viewEquivalences) union(a, b viewColumnRef) {
+	if ra, rb := e.find(a), e.find(b); ra != rb {
+		e[ra] = rb
+	}
+}
+
+// addExpr records the equalities of qualified columns in the conjunction expr.
+func (e viewEquivalences) addExpr(expr ast.Expr) {
+	switch v := expr.(type) {
+	case *ast.ParenExpr:
+		e.addExpr(v.Expr)
+	case *ast.BinaryExpr:
+		switch v.Op {
+		case ast.OpAnd:
+			e.addExpr(v.Left)
+			e.addExpr(v.Right)
+		case ast.OpEqual:
+			l, lok := v.Left.(*ast.Path)
+			r, rok := v.Right.(*ast.Path)
+			if lok && rok && len(l.Idents) == 2 && len(r.Idents) == 2 {
+				e.union(
+					viewColumnRef{alias: l.Idents[0].Name, column: l.Idents[1].Name},
+					viewColumnRef{alias: r.Idents[0].Name, column: r.Idents[1].Name},
+				)
+			}
+		}
+	}
+}
+
+// addJoins records the equalities given by the join conditions in expr.
+func (e viewEquivalences) addJoins(expr ast.TableExpr) {
+	switch v := expr.(type) {
+	case *ast.Join:
+		e.addJoins(v.Left)
+		e.addJoins(v.Right)
+		switch c := v.Cond.(type) {
+		case *ast.On:
+			e.addExpr(c.Expr)
+		case *ast.Using:
+			for _, l := range collectViewSources(v.Left, nil) {
+				for _, r := range collectViewSources(v.Right, nil) {
+					for _, id := range c.Idents {
+						e.union(viewColumnRef{alias: l.alias, column: id.Name}, viewColumnRef{alias: r.alias, column: id.Name})
+					}
+				}
+			}
+		}
+	case *ast.ParenTableExpr:
+		e.addJoins(v.Source)
+	}
+}
+
+// collectUsingColumns adds the columns of the USING clauses of the joins in
+// expr to cols.
+func collectUsingColumns(expr ast.TableExpr, cols map[string]bool) {
+	switch v := expr.(type) {
+	case *ast.Join:
+		collectUsingColumns(v.Left, cols)
+		collectUsingColumns(v.Right, cols)
+		if c, ok := v.Cond.(*ast.Using); ok {
+			for _, id := range c.Idents {
+				cols[id.Name] = true
+			}
+		}
+	case *ast.ParenTableExpr:
+		collectUsingColumns(v.Source, cols)
+	}
+}
+
+// viewSelect returns the SELECT statement of the view query.
+func viewSelect(view *ast.CreateView) (*ast.Select, error) {
+	q := view.Query
+	for {
+		switch v := q.(type) {
+		case *ast.SubQuery:
+			q = v.Query
+		case *ast.Select:
+			return v, nil
+		default:
+			return nil, fmt.Errorf("view '%s' must be defined by a simple SELECT query", view.Name.Name)
+		}
+	}
+}
+
+// collectViewSources collects tables referenced in the FROM clause in order.
+// The tables of the side of outer joins which may not match are nullable.
+func collectViewSources(expr ast.TableExpr, sources []*viewSource) []*viewSource {
+	switch v := expr.(type) {
+	case *ast.TableName:
+		alias := v.Table.Name
+		if v.As != nil {
+			alias = v.As.Alias.Name
+		}
+		sources = append(sources, &viewSource{alias: alias, table: v.Table.Name})
+	case *ast.Join:
+		left := len(sources)
+		sources = collectViewSources(v.Left, sources)
+		right := len(sources)
+		sources = collectViewSources(v.Right, sources)
+
+		if v.Op == ast.RightOuterJoin || v.Op == ast.FullOuterJoin {
+			for _, src := range sources[left:right] {
+				src.nullable = true
+			}
+		}
+		if v.Op == ast.LeftOuterJoin || v.Op == ast.FullOuterJoin {
+			for _, src := range sources[right:] {
+				src.nullable = true
+			}
+		}
+	case *ast.ParenTableExpr:
+		sources = collectViewSources(v.Source, sources)
+	}
+
+	return sources
+}
+
+// resolvedView is a view whose query is resolved against the tables it
+// selects from.
+type resolvedView struct {
+	sel     *ast.Select
+	sources []*viewSource
+	columns []*viewColumn
+	pks     []*models.IndexColumn
+}
+
+// resolveView resolves the view. The result is cached since the columns and
+// the primary key of a view are resolved from those of the views it selects
+// from.
+func (s *SpannerLoaderFromDDL) resolveView(name string) (*resolvedView, error) {
+	if v, ok := s.views[name]; ok {
+		return v, nil
+	}
+
+	sel, err := viewSelect(s.tables[name].createView)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &resolvedView{sel: sel}
+	if sel.From != nil {
+		v.sources = collectViewSources(sel.From.Source, nil)
+	}
+	if v.columns, err = s.resolveViewColumns(name, sel, v.sources); err != nil {
+		return nil, err
+	}
+	if v.pks, err = s.resolveViewPrimaryKey(v); err != nil {
+		return nil, err
+	}
+
+	if s.views == nil {
+		s.views = make(map[string]*resolvedView)
+	}
+	s.views[name] = v
+
+	return v, nil
+}
+
+// resolveViewColumns resolves the columns selected by the view. Types are taken
+// from the underlying tables (or views) of the select expressions.
+func (s *SpannerLoaderFromDDL) resolveViewColumns(name string, sel *ast.Select, sources []*viewSource) ([]*viewColumn, error) {
+	sourceColumns := make(map[*viewSource][]*models.Column)
+	for _, src := range sources {
+		if _, ok := s.tables[src.table]; !ok {
+			return nil, fmt.Errorf("view '%s' references undefined table '%s'", name, src.table)
+		}
+		cols, err := s.ColumnList(src.table)
+		if err != nil {
+			return nil, err
+		}
+		sourceColumns[src] = cols
+	}
+
+	// the columns joined by USING may be selected without the qualifiers
+	using := make(map[string]bool)
+	if sel.From != nil {
+		collectUsingColumns(sel.From.Source, using)
+	}
+
+	lookup := func(alias, column string) (*viewSource, *models.Column, error) {
+		var found *viewSource
+		var col *models.Column
+		for _, src := range sources {
+			if alias != "" && src.alias != alias {
+				continue
+			}
+			for _, c := range sourceColumns[src] {
+				if c.ColumnName != column {
+					continue
+				}
+				if found == nil {
+					found, col = src, c
+				} else if alias == "" && !using[column] {
+					return nil, nil, fmt.Errorf("view '%s' selects ambiguous column '%s' of tables '%s' and '%s'", name, column, found.table, src.table)
+				}
+			}
+		}
+		return found, col, nil
+	}
+
+	var resolve func(expr ast.Expr) (*viewColumn, error)
+	resolve = func(expr ast.Expr) (*viewColumn, error) {
+		switch e := expr.(type) {
+		case *ast.ParenExpr:
+			return resolve(e.Expr)
+		case *ast.Ident:
+			src, c, err := lookup("", e.Name)
+			if err != nil {
+				return nil, err
+			}
+			if c == nil {
+				return nil, fmt.Errorf("view '%s' selects unknown column '%s'", name, e.Name)
+			}
+			return &viewColumn{name: e.Name, column: c, source: src, sourceColumn: c.ColumnName}, nil
+		case *ast.Path:
+			if len(e.Idents) != 2 {
+				break
+			}
+			src, c, err := lookup(e.Idents[0].Name, e.Idents[1].Name)
+			if err != nil {
+				return nil, err
+			}
+			if c == nil {
+				return nil, fmt.Errorf("view '%s' selects unknown column '%s'", name, e.SQL())
+			}
+			return &viewColumn{name: e.Idents[1].Name, column: c, source: src, sourceColumn: c.ColumnName}, nil
+		case *ast.CastExpr:
+			return &viewColumn{column: &models.Column{DataType: e.Type.SQL()}}, nil
+		case *ast.CountStarExpr:
+			return &viewColumn{column: &models.Column{DataType: "INT64", NotNull: true}, aggregate: true}, nil
+		case *ast.CallExpr:
+			switch strings.ToUpper(e.Func.Name) {
+			case "COUNT", "COUNTIF":
+				return &viewColumn{column: &models.Column{DataType: "INT64", NotNull: true}, aggregate: true}, nil
+			case "AVG":
+				return &viewColumn{column: &models.Column{DataType: "FLOAT64"}, aggregate: true}, nil
+			case "SUM", "MIN", "MAX", "ANY_VALUE":
+				if len(e.Args) != 1 {
+					break
+				}
+				arg, err := resolve(e.Args[0].Expr)
+				if err != nil {
+					return nil, err
+				}
+				return &viewColumn{column: &models.Column{DataType: arg.column.DataType}, aggregate: true}, nil
+			}
+		}
+
+		return nil, fmt.Errorf("view '%s' selects expression '%s' whose type cannot be resolved", name, expr.SQL())
+	}
+
+	var cols []*viewColumn
+	for _, item := range sel.Results {
+		switch v := item.(type) {
+		case *ast.Star:
+			for _, src := range sources {
+				for _, c := range sourceColumns[src] {
+					cols = append(cols, &viewColumn{name: c.ColumnName, column: c, source: src, sourceColumn: c.ColumnName})
+				}
+			}
+		case *ast.DotStar:
+			for _, src := range sources {
+				if src.alias != v.Expr.SQL() {
+					continue
+				}
+				for _, c := range sourceColumns[src] {
+					cols = append(cols, &viewColumn{name: c.ColumnName, column: c, source: src, sourceColumn: c.ColumnName})
+				}
+			}
+		case *ast.Alias:
+			col, err := resolve(v.Expr)
+			if err != nil {
+				return nil, err
+			}
+			col.name = v.As.Alias.Name
+			cols = append(cols, col)
+		case *ast.ExprSelectItem:
+			col, err := resolve(v.Expr)
+			if err != nil {
+				return nil, err
+			}
+			cols = append(cols, col)
+		}
+	}
+
+	// explicit column list overrides the names of the select items positionally
+	if declared := s.tables[name].viewColumns; len(declared) != 0 {
+		if len(declared) != len(cols) {
+			return nil, fmt.Errorf("view '%s' declares %d columns, but its query selects %d columns", name, len(declared), len(cols))
+		}
+		for i, c := range cols {
+			c.name = declared[i]
+		}
+	}
+
+	for _, c := range cols {
+		if c.name == "" {
+			return nil, fmt.Errorf("view '%s' selects an expression without a column name", name)
+		}
+	}
+
+	return cols, nil
+}
+
+func (s *SpannerLoaderFromDDL) viewColumnList(name string) ([]*models.Column, error) {
+	v, err := s.resolveView(name)
+	if err != nil {
+		return nil, err
+	}
+
+	check := make(map[string]struct{})
+	for _, pk := range v.pks {
+		check[pk.ColumnName] = struct{}{}
+	}
+
+	var cols []*models.Column
+	for i, c := range v.columns {
+		_, pk := check[c.name]
+		cols = append(cols, &models.Column{
+			FieldOrdinal: i + 1,
+			ColumnName:   c.name,
+			DataType:     c.column.DataType,
+			NotNull:      c.column.NotNull && (c.source == nil || !c.source.nullable),
+			IsPrimaryKey: pk,
+		})
+	}
+
+	return cols, nil
+}
+
+func (s *SpannerLoaderFromDDL) viewPrimaryKeyColumnList(name string) ([]*models.IndexColumn, error) {
+	v, err := s.resolveView(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.pks, nil
+}
+
+// resolveViewPrimaryKey returns the union of the primary keys of all tables
+// which the view selects from, named by the view columns. A primary key column
+// which is not selected may be substituted by a selected column joined to it
+// by equality. It returns nil if the union does not identify a view row
+// uniquely, that is, the view aggregates rows, joins tables by outer joins,
+// which make the primary key columns of the unmatched side NULL, or does not
+// select some of the primary key columns.
+func (s *SpannerLoaderFromDDL) resolveViewPrimaryKey(v *resolvedView) ([]*models.IndexColumn, error) {
+	sel, vcols := v.sel, v.columns
+	if sel.From == nil || sel.GroupBy != nil {
+		return nil, nil
+	}
+	for _, c := range vcols {
+		if c.aggregate {
+			return nil, nil
+		}
+	}
+	for _, src := range v.sources {
+		if src.nullable {
+			return nil, nil
+		}
+	}
+
+	eq := make(viewEquivalences)
+	eq.addJoins(sel.From.Source)
+	if sel.Where != nil {
+		eq.addExpr(sel.Where.Expr)
+	}
+
+	lookup := func(ref viewColumnRef) *viewColumn {
+		for _, c := range vcols {
+			if c.source != nil && c.source.alias == ref.alias && c.sourceColumn == ref.column {
+				return c
+			}
+		}
+		for _, c := range vcols {
+			if c.source != nil && eq.find(viewColumnRef{alias: c.source.alias, column: c.sourceColumn}) == eq.find(ref) {
+				return c
+			}
+		}
+		return nil
+	}
+
+	var cols []*models.IndexColumn
+	seen := make(map[*viewColumn]bool)
+	for _, src := range v.sources {
+		pks, err := s.primaryKeyColumnList(src.table)
+		if err != nil {
+			return nil, err
+		}
+		if len(pks) == 0 && s.tables[src.table].createView != nil {
+			// rows of the source view are not unique
+			return nil, nil
+		}
+
+		for _, pk := range pks {
+			c := lookup(viewColumnRef{alias: src.alias, column: pk.ColumnName})
+			if c == nil {
+				return nil, nil
+			}
+			if seen[c] {
+				continue
+			}
+			seen[c] = true
+
+			cols = append(cols, &models.IndexColumn{
+				SeqNo:      len(cols) + 1,
+				ColumnName: c.name,
+			})
+		}
+	}
+
+	return cols, nil
+}
+
+// ViewDependencies returns the tables which the view selects from. It returns
+// nil if name is not a view.
+func (s *SpannerLoaderFromDDL) ViewDependencies(name string) ([]string, error) {
+	view := s.tables[s.internalName(name)].createView
+	if view == nil {
+		return nil, nil
+	}
+
+	sel, err := viewSelect(view)
+	if err != nil {
+		return nil, err
+	}
+	if sel.From == nil {
+		return nil, nil
+	}
+
+	var deps []string
+	for _, src := range collectViewSources(sel.From.Source, nil) {
+		deps = append(deps, s.qualifiedName(src.table))
+	}
+
+	return deps, nil
+}
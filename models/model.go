@@ -21,36 +21,92 @@ package models
 
 // Table represents table info.
 type Table struct {
-	Type      string // type
-	TableName string // table_name
-	ManualPk  bool   // manual_pk
+	Type            string `json:"type"`                        // type
+	TableName       string `json:"table_name"`                  // table_name
+	ManualPk        bool   `json:"manual_pk"`                   // manual_pk
+	IsView          bool   `json:"is_view,omitempty"`           // is_view
+	ParentTableName string `json:"parent_table_name,omitempty"` // parent_table_name
+	Schema          string `json:"table_schema,omitempty"`      // table_schema
+
+	RowDeletionPolicy *RowDeletionPolicy `json:"row_deletion_policy,omitempty"` // row deletion policy, nil if none
+}
+
+// RowDeletionPolicy represents the row deletion policy of a table, which
+// deletes the rows older than NumDays days by the timestamp of ColumnName.
+type RowDeletionPolicy struct {
+	ColumnName string `json:"column_name"` // column of OLDER_THAN
+	NumDays    int64  `json:"num_days"`    // days of the interval of OLDER_THAN
 }
 
 // Column represents column info.
 type Column struct {
-	FieldOrdinal int    // field_ordinal
-	ColumnName   string // column_name
-	DataType     string // data_type
-	NotNull      bool   // not_null
-	IsPrimaryKey bool   // is_primary_key
-	IsGenerated  bool   // is_generated
+	FieldOrdinal int               `json:"field_ordinal"`           // field_ordinal
+	ColumnName   string            `json:"column_name"`             // column_name
+	DataType     string            `json:"data_type"`               // data_type
+	NotNull      bool              `json:"not_null"`                // not_null
+	IsPrimaryKey bool              `json:"is_primary_key"`          // is_primary_key
+	IsGenerated  bool              `json:"is_generated,omitempty"`  // is_generated
+	IsIdentity   bool              `json:"is_identity,omitempty"`   // is_identity
+	Sequence     string            `json:"sequence,omitempty"`      // sequence of the default value GET_NEXT_SEQUENCE_VALUE(SEQUENCE ...)
+	VectorLength int64             `json:"vector_length,omitempty"` // vector_length of ARRAY<FLOAT32> and ARRAY<FLOAT64>, 0 if none
+	CustomType   string            `json:"custom_type,omitempty"`   // custom_type
+	Options      map[string]string `json:"options,omitempty"`       // options
+	EnumValues   []string          `json:"enum_values,omitempty"`   // values allowed by CHECK (column IN (...))
+	FieldName    string            `json:"field_name,omitempty"`    // name of the struct field, derived from column_name if empty
 }
 
 // Index represents an index.
 type Index struct {
-	IndexName string // index_name
-	IsUnique  bool   // is_unique
-	IsPrimary bool   // is_primary
-	SeqNo     int    // seq_no
-	Origin    string // origin
-	IsPartial bool   // is_partial
+	IndexName string `json:"index_name"` // index_name
+	IsUnique  bool   `json:"is_unique"`  // is_unique
+	IsPrimary bool   `json:"is_primary"` // is_primary
+	SeqNo     int    `json:"seq_no"`     // seq_no
+	Origin    string `json:"origin"`     // origin
+	IsPartial bool   `json:"is_partial"` // is_partial
+	// IsNullFiltered is true if the index does not have the rows any of
+	// whose key columns is NULL.
+	IsNullFiltered bool `json:"is_null_filtered"` // is_null_filtered
+}
+
+// SearchIndex represents a search index.
+type SearchIndex struct {
+	IndexName        string   `json:"index_name"`                  // index_name
+	Columns          []string `json:"columns"`                     // TOKENLIST columns
+	FullTextColumns  []string `json:"full_text_columns,omitempty"` // TOKENLIST columns tokenized by TOKENIZE_FULLTEXT
+	StoringColumns   []string `json:"storing_columns,omitempty"`   // storing columns
+	PartitionColumns []string `json:"partition_columns,omitempty"` // columns of PARTITION BY
+	OrderColumns     []string `json:"order_columns,omitempty"`     // columns of ORDER BY
+}
+
+// VectorIndex represents a vector index.
+type VectorIndex struct {
+	IndexName      string            `json:"index_name"`                // index_name
+	ColumnName     string            `json:"column_name"`               // embedding column
+	StoringColumns []string          `json:"storing_columns,omitempty"` // storing columns
+	Options        map[string]string `json:"options,omitempty"`         // options such as distance_type
+}
+
+// ForeignKey represents a foreign key.
+type ForeignKey struct {
+	ConstraintName string   `json:"constraint_name"`  // constraint_name
+	ColumnNames    []string `json:"column_names"`     // referencing columns
+	RefTableName   string   `json:"ref_table_name"`   // referenced table_name
+	RefColumnNames []string `json:"ref_column_names"` // referenced columns, in the order of ColumnNames
+}
+
+// ChangeStream represents a change stream.
+type ChangeStream struct {
+	Name       string   `json:"name"`                  // change_stream_name
+	All        bool     `json:"all"`                   // FOR ALL
+	TableNames []string `json:"table_names,omitempty"` // watched tables unless All
 }
 
 // IndexColumn represents index column info.
 type IndexColumn struct {
-	SeqNo      int    // seq_no. If is'a Storing Column, this value is 0.
-	ColumnName string // column_name
-	Storing    bool   // storing column or not
+	SeqNo      int    `json:"seq_no"`      // seq_no. Key columns and storing columns are numbered separately from 1, storing columns in the order of the names.
+	ColumnName string `json:"column_name"` // column_name
+	Storing    bool   `json:"storing"`     // storing column or not
+	Desc       bool   `json:"desc"`        // descending order or not
 }
 
 // CustomTypes represents custom type definitions
@@ -58,5 +114,40 @@ type CustomTypes struct {
 	Tables []struct {
 		Name    string            `yaml:"name"`
 		Columns map[string]string `yaml:"columns"`
+
+		// PrimaryKey is the columns identifying the rows of a view, which
+		// replace the primary key derived from the tables it reads from.
+		PrimaryKey []string `yaml:"primary_key"`
+
+		// VersionColumn is the column checked and advanced by the updates
+		// with optimistic locking of the table.
+		VersionColumn string `yaml:"version_column"`
+
+		// SoftDeleteColumn is the timestamp column marking the rows deleted
+		// by the soft deletes of the table.
+		SoftDeleteColumn string `yaml:"soft_delete_column"`
+
+		// Tags is the struct tags of the fields of the columns such as
+		// validate:"required", which are added to the spanner and json tags.
+		// A json tag replaces the generated one.
+		Tags map[string]string `yaml:"tags"`
 	}
 }
+
+// Schema represents the tables and the views loaded from a database or a DDL
+// file, which are given to a Hook.
+type Schema struct {
+	Tables []*TableSchema `json:"tables"` // sorted by table_name
+}
+
+// TableSchema represents a table or a view with its columns and indexes.
+type TableSchema struct {
+	Table        *Table                    `json:"table"`
+	Columns      []*Column                 `json:"columns"`
+	Indexes      []*Index                  `json:"indexes"`
+	IndexColumns map[string][]*IndexColumn `json:"index_columns"` // by index_name, including PRIMARY_KEY
+}
+
+// Hook post-processes the loaded schema before the code is generated. The
+// changes made to the schema are used by the generation.
+type Hook func(*Schema) error
@@ -0,0 +1,39 @@
+// {{ .Name }}Consumer consumes the data change records of the change stream
+// '{{ .ChangeStream.Name }}' by the handlers of the tables. The records of the
+// tables without handlers are skipped.
+type {{ .Name }}Consumer struct {
+{{- range .Types }}
+	{{ .Name }} func(ctx context.Context, changes []*{{ .Name }}Change) error
+{{- end }}
+}
+
+// Consume decodes the data change record in JSON, and calls the handler of
+// the table of the record with the changes of the rows.
+func (c *{{ .Name }}Consumer) Consume(ctx context.Context, data []byte) error {
+	var rec YODataChangeRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return newErrorWithCode(codes.InvalidArgument, "{{ .Name }}Consumer.Consume", "{{ .ChangeStream.Name }}", err)
+	}
+
+	return c.ConsumeRecord(ctx, &rec)
+}
+
+// ConsumeRecord calls the handler of the table of the data change record with
+// the changes of the rows.
+func (c *{{ .Name }}Consumer) ConsumeRecord(ctx context.Context, rec *YODataChangeRecord) error {
+	switch rec.TableName {
+{{- range .Types }}
+	case "{{ .Table.TableName }}":
+		if c.{{ .Name }} == nil {
+			return nil
+		}
+		changes, err := Decode{{ .Name }}Changes(rec)
+		if err != nil {
+			return err
+		}
+		return c.{{ .Name }}(ctx, changes)
+{{- end }}
+	}
+
+	return nil
+}
@@ -1,78 +1,107 @@
 {{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "YOLog" .Fields) -}}
 {{- $table := (.Type.Table.TableName) -}}
-{{- if not .Index.IsUnique }}
+{{- $softdelete := .Type.SoftDeleteField }}
+{{- $kind := "index" }}{{ if .Index.IsNullFiltered }}{{ $kind = "null-filtered index" }}{{ end -}}
+{{- if .IsPrefix }}
+// Find{{ .FuncName }} retrieves multiple rows from '{{ $table }}' as a slice of {{ .Type.Name }}.
+{{- if .OrderFields }}
+// The rows are ordered by the rest of the index key.
+{{- end }}
+//
+// Generated from a prefix of the key of {{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).
+func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, error) {
+{{- else if not .Index.IsUnique }}
 // Find{{ .FuncName }} retrieves multiple rows from '{{ $table }}' as a slice of {{ .Type.Name }}.
 //
-// Generated from index '{{ .Index.IndexName }}'.
-func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}) ([]*{{ .Type.Name }}, error) {
+// Generated from {{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).
+func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, error) {
 {{- else }}
 // Find{{ .FuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.
 //
-// If no row is present with the given key, then ReadRow returns an error where
-// spanner.ErrCode(err) is codes.NotFound.
+// If no row is present with the given key, then an error is returned where
+// errors.Is(err, ErrNotFound) is true.
 //
-// Generated from unique index '{{ .Index.IndexName }}'.
-func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}) (*{{ .Type.Name }}, error) {
+// Generated from unique {{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).
+func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) (*{{ .Type.Name }}, error) {
 {{- end }}
-	{{- if not .NullableFields }}
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Find{{ .FuncName }}", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Find{{ .FuncName }}", "{{ $table }}")()
+{{ end }}
+	{{- if not (or .NullableFields $softdelete) }}
 	const sqlstr = "SELECT " +
 		"{{ escapedcolnames .Type.Fields }} " +
-		"FROM {{ $table }}@{FORCE_INDEX={{ .Index.IndexName }}} " +
+		"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} " +
 		"WHERE {{ colnamesquery .Fields " AND " }}"
+	{{- if .OrderFields }} +
+		" {{ orderby .OrderFields }}"
+	{{- end }}
 	{{- else }}
 	var sqlstr = "SELECT " +
 		"{{ escapedcolnames .Type.Fields }} " +
-		"FROM {{ $table }}@{FORCE_INDEX={{ .Index.IndexName }}} "
+		"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} "
 
 	conds := make([]string, {{ columncount .Fields }})
 	{{- range $i, $f := .Fields }}
 	{{- if $f.Col.NotNull }}
-		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = @param{{ $i }}"
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = {{ param $i }}"
 	{{- else }}
 	if {{ nullcheck $f }} {
+		{{- if and $.Index.IsNullFiltered (forceindex $.Index.IndexName) }}
+		YOWarn(ctx, "{{ colname $f.Col }} is NULL, but NULL is not in null-filtered index {{ $.Index.IndexName }}")
+		{{- end }}
 		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} IS NULL"
 	} else {
-		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = @param{{ $i }}"
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = {{ param $i }}"
 	}
 	{{- end }}
 	{{- end }}
+	{{- if $softdelete }}
+	if !yoIncludesDeleted(ctx) {
+		conds = append(conds, "{{ escapedcolname $softdelete.Col }} IS NULL")
+	}
+	{{- end }}
 	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+	{{- if .OrderFields }}
+	sqlstr += " {{ orderby .OrderFields }}"
+	{{- end }}
 	{{- end }}
 
 	stmt := spanner.NewStatement(sqlstr)
 	{{- range $i, $f := .Fields }}
 		{{- if $f.CustomType }}
-			stmt.Params["param{{ $i }}"] = {{ $f.Type }}({{ goparamname $f.Name }})
+			stmt.Params["{{ paramname $i }}"] = {{ spanvalue $f (goparamname $f.Name) }}
 		{{- else }}
-			stmt.Params["param{{ $i }}"] = {{ goparamname $f.Name }}
+			stmt.Params["{{ paramname $i }}"] = {{ goparamname $f.Name }}
 		{{- end }}
 	{{- end}}
 
 
-	decoder := new{{ .Type.Name }}_Decoder({{ .Type.Name }}Columns())
-
 	// run query
-	YOLog(ctx, sqlstr{{ goparamlist .Fields true false }})
+	defer yoLogQuery(ctx, "Find{{ .FuncName }}", stmt{{ goparamlist .Fields true false }})()
 {{- if .Index.IsUnique }}
-	iter := db.Query(ctx, stmt)
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	row, err := iter.Next()
 	if err != nil {
 		if err == iterator.Done {
-			return nil, newErrorWithCode(codes.NotFound, "Find{{ .FuncName }}", "{{ $table }}", err)
+			return nil, newNotFoundError("Find{{ .FuncName }}", "{{ $table }}", spanner.Key{ {{ gocustomparamlist .Fields false false }} }, Err{{ .Type.Name }}NotFound, nil)
 		}
 		return nil, newError("Find{{ .FuncName }}", "{{ $table }}", err)
 	}
 
-	{{ $short }}, err := decoder(row)
+	{{ $short }}, err := Scan{{ .Type.Name }}(row)
 	if err != nil {
 		return nil, newErrorWithCode(codes.Internal, "Find{{ .FuncName }}", "{{ $table }}", err)
 	}
 
 	return {{ $short }}, nil
 {{- else }}
-	iter := db.Query(ctx, stmt)
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
@@ -86,7 +115,7 @@ func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fie
 			return nil, newError("Find{{ .FuncName }}", "{{ $table }}", err)
 		}
 
-		{{ $short }}, err := decoder(row)
+		{{ $short }}, err := Scan{{ .Type.Name }}(row)
         if err != nil {
             return nil, newErrorWithCode(codes.Internal, "Find{{ .FuncName }}", "{{ $table }}", err)
         }
@@ -97,6 +126,77 @@ func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fie
 	return res, nil
 {{- end }}
 }
+{{- if $softdelete }}
+
+// Find{{ .FuncName }}IncludingDeleted is Find{{ .FuncName }} including the
+// soft-deleted rows.
+func Find{{ .FuncName }}IncludingDeleted(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ({{ if not .Index.IsUnique }}[]{{ end }}*{{ .Type.Name }}, error) {
+	return Find{{ .FuncName }}(YOIncludingDeleted(ctx), db{{ goparamlist .Fields true false }}, opts...)
+}
+{{- end }}
+{{- if not .Index.IsUnique }}
+
+// Count{{ .FuncName }} returns the number of the rows of '{{ $table }}' found by
+// Find{{ .FuncName }}.
+func Count{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) (int64, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Count{{ .FuncName }}", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Count{{ .FuncName }}", "{{ $table }}")()
+{{ end }}
+	{{- if not (or .NullableFields $softdelete) }}
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} " +
+		"WHERE {{ colnamesquery .Fields " AND " }}"
+	{{- else }}
+	var sqlstr = "SELECT COUNT(*) " +
+		"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} "
+
+	conds := make([]string, {{ columncount .Fields }})
+	{{- range $i, $f := .Fields }}
+	{{- if $f.Col.NotNull }}
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = {{ param $i }}"
+	{{- else }}
+	if {{ nullcheck $f }} {
+		{{- if and $.Index.IsNullFiltered (forceindex $.Index.IndexName) }}
+		YOWarn(ctx, "{{ colname $f.Col }} is NULL, but NULL is not in null-filtered index {{ $.Index.IndexName }}")
+		{{- end }}
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} IS NULL"
+	} else {
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = {{ param $i }}"
+	}
+	{{- end }}
+	{{- end }}
+	{{- if $softdelete }}
+	if !yoIncludesDeleted(ctx) {
+		conds = append(conds, "{{ escapedcolname $softdelete.Col }} IS NULL")
+	}
+	{{- end }}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+	{{- end }}
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .Fields }}
+		{{- if $f.CustomType }}
+			stmt.Params["{{ paramname $i }}"] = {{ spanvalue $f (goparamname $f.Name) }}
+		{{- else }}
+			stmt.Params["{{ paramname $i }}"] = {{ goparamname $f.Name }}
+		{{- end }}
+	{{- end}}
+
+	// run query
+	defer yoLogQuery(ctx, "Count{{ .FuncName }}", stmt{{ goparamlist .Fields true false }})()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("Count{{ .FuncName }}", "{{ $table }}", err)
+	}
+
+	return n, nil
+}
+{{- end }}
+{{- if not .IsPrefix }}
 
 
 // Read{{ .FuncName }} retrieves multiples rows from '{{ $table }}' by KeySet as a slice.
@@ -105,8 +205,8 @@ func Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fie
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index '{{ .Index.IndexName }}'.
-func Read{{ .FuncName }}(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*{{ .Type.Name }}, error) {
+// Generated from {{ if .Index.IsUnique }}unique {{ end }}{{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).
+func Read{{ .FuncName }}(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, error) {
 	var res []*{{ .Type.Name }}
     columns := []string{
 {{- range .Type.PrimaryKeyFields }}
@@ -120,11 +220,9 @@ func Read{{ .FuncName }}(ctx context.Context, db YORODB, keys spanner.KeySet) ([
 {{- end }}
 }
 
-	decoder := new{{ .Type.Name }}_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "{{ $table }}", "{{ .Index.IndexName }}", keys, columns)
+	rows := yoRead(ctx, db, "{{ $table }}", "{{ .Index.IndexName }}", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		{{ $short }}, err := decoder(row)
+		{{ $short }}, err := Scan{{ .Type.Name }}(row)
 		if err != nil {
 			return err
 		}
@@ -139,3 +237,241 @@ func Read{{ .FuncName }}(ctx context.Context, db YORODB, keys spanner.KeySet) ([
     return res, nil
 }
 
+// {{ .RowName }} represents a row of index '{{ .Index.IndexName }}' of '{{ $table }}',
+// which has the index key, the storing columns and the primary key.
+type {{ .RowName }} struct {
+{{- range .RowFields }}
+{{- if .CustomType }}
+	{{ .Name }} {{ retype .CustomType }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}
+{{- else }}
+	{{ .Name }} {{ .Type }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}
+{{- end }}
+{{- end }}
+}
+
+// Read{{ .RowName }}s retrieves multiples rows from index '{{ .Index.IndexName }}' by
+// KeySet as a slice. This reads only the index and never reads '{{ $table }}'.
+func Read{{ .RowName }}s(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .RowName }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Read{{ .RowName }}s", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Read{{ .RowName }}s", "{{ $table }}")()
+{{ end }}
+	var res []*{{ .RowName }}
+	columns := []string{
+{{- range .RowFields }}
+		"{{ colname .Col }}",
+{{- end }}
+	}
+
+	rows := yoRead(ctx, db, "{{ $table }}", "{{ .Index.IndexName }}", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scan{{ .RowName }}(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "Read{{ .RowName }}s", "{{ $table }}", err)
+	}
+
+	return res, nil
+}
+
+// scan{{ .RowName }} decodes row having the columns of {{ .RowName }} in order.
+func scan{{ .RowName }}(row *spanner.Row) (*{{ .RowName }}, error) {
+	var r {{ .RowName }}
+	{{- range .RowFields }}
+	{{- if customjson . }}
+	var {{ customtypeparam .Name }} spanner.GenericColumnValue
+	{{- else if .CustomType }}
+	var {{ customtypeparam .Name }} {{ .Type }}
+	{{- end }}
+	{{- end }}
+	if err := row.Columns({{ range $i, $f := .RowFields }}{{ if $i }}, {{ end }}{{ if $f.CustomType }}&{{ customtypeparam $f.Name }}{{ else }}&r.{{ $f.Name }}{{ end }}{{ end }}); err != nil {
+		return nil, err
+	}
+	{{- range .RowFields }}
+	{{- if customjson . }}
+	if err := yoUnmarshalJSON({{ customtypeparam .Name }}, &r.{{ .Name }}); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal column {{ colname .Col }}: %v", err)
+	}
+	{{- else if .CustomType }}
+	r.{{ .Name }} = {{ customvalue . (customtypeparam .Name) }}
+	{{- end }}
+	{{- end }}
+
+	return &r, nil
+}
+{{- $rowfunc := print "Find" .RowName }}{{ if not .Index.IsUnique }}{{ $rowfunc = print $rowfunc "s" }}{{ end }}
+
+{{- if .Index.IsUnique }}
+
+// {{ $rowfunc }} retrieves a row from index '{{ .Index.IndexName }}' as a
+// {{ .RowName }} by the index key. It selects only the columns covered by the
+// index, so that the query never joins back to '{{ $table }}'.
+//
+// If no row is present with the given key, then an error is returned where
+// errors.Is(err, ErrNotFound) is true.
+func {{ $rowfunc }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) (*{{ .RowName }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ $rowfunc }}", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ $rowfunc }}", "{{ $table }}")()
+{{ end }}
+{{- else }}
+
+// {{ $rowfunc }} retrieves multiple rows from index '{{ .Index.IndexName }}' as a
+// slice of {{ .RowName }} by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to '{{ $table }}'.
+func {{ $rowfunc }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ([]*{{ .RowName }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ $rowfunc }}", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ $rowfunc }}", "{{ $table }}")()
+{{ end }}
+{{- end }}
+	{{- if not .NullableFields }}
+	const sqlstr = "SELECT " +
+		"{{ escapedcolnames .RowFields }} " +
+		"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} " +
+		"WHERE {{ colnamesquery .Fields " AND " }}"
+	{{- else }}
+	var sqlstr = "SELECT " +
+		"{{ escapedcolnames .RowFields }} " +
+		"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} "
+
+	conds := make([]string, {{ columncount .Fields }})
+	{{- range $i, $f := .Fields }}
+	{{- if $f.Col.NotNull }}
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = {{ param $i }}"
+	{{- else }}
+	if {{ nullcheck $f }} {
+		{{- if $.Index.IsNullFiltered }}
+		YOWarn(ctx, "{{ colname $f.Col }} is NULL, but NULL is not in null-filtered index {{ $.Index.IndexName }}")
+		{{- end }}
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} IS NULL"
+	} else {
+		conds[{{ $i }}] = "{{ escapedcolname $f.Col }} = {{ param $i }}"
+	}
+	{{- end }}
+	{{- end }}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+	{{- end }}
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .Fields }}
+		{{- if $f.CustomType }}
+			stmt.Params["{{ paramname $i }}"] = {{ spanvalue $f (goparamname $f.Name) }}
+		{{- else }}
+			stmt.Params["{{ paramname $i }}"] = {{ goparamname $f.Name }}
+		{{- end }}
+	{{- end}}
+
+	// run query
+	defer yoLogQuery(ctx, "{{ $rowfunc }}", stmt{{ goparamlist .Fields true false }})()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+{{- if .Index.IsUnique }}
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("{{ $rowfunc }}", "{{ $table }}", spanner.Key{ {{ gocustomparamlist .Fields false false }} }, Err{{ .Type.Name }}NotFound, nil)
+		}
+		return nil, newError("{{ $rowfunc }}", "{{ $table }}", err)
+	}
+
+	r, err := scan{{ .RowName }}(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "{{ $rowfunc }}", "{{ $table }}", err)
+	}
+
+	return r, nil
+{{- else }}
+
+	res := []*{{ .RowName }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("{{ $rowfunc }}", "{{ $table }}", err)
+		}
+
+		r, err := scan{{ .RowName }}(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ $rowfunc }}", "{{ $table }}", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+{{- end }}
+}
+{{- if .PageFields }}
+{{- $func := .PageFuncName }}
+
+// {{ $func }} retrieves a page of at most pageSize rows from '{{ $table }}'
+// ordered by the key of index '{{ .Index.IndexName }}' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func {{ $func }}(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, string, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ $func }}", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ $func }}", "{{ $table }}")()
+{{ end }}
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "{{ $func }}", "{{ $table }}", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		{{- range $i, $f := .PageFields }}
+		var k{{ $i }} {{ if $f.Field.CustomType }}{{ retype $f.Field.CustomType }}{{ else }}{{ $f.Field.Type }}{{ end }}
+		{{- end }}
+		if err := yoDecodePageToken(pageToken{{ range $i, $f := .PageFields }}, &k{{ $i }}{{ end }}); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "{{ $func }}", "{{ $table }}", err)
+		}
+		key = []interface{}{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ if $f.Field.CustomType }}{{ spanvalue $f.Field (print "k" $i) }}{{ else }}k{{ $i }}{{ end }}{{ end -}} }
+	}
+	stmt := yoPageStatement("SELECT {{ escapedcolnames .Type.Fields }} FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }}",
+		[]string{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}"{{ escapedcolname $f.Field.Col }}"{{ end -}} },
+		[]bool{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ $f.Desc }}{{ end -}} },
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "{{ $func }}", stmt)()
+	res, err := Scan{{ pluralize .Type.Name }}(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("{{ $func }}", "{{ $table }}", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken({{ range $i, $f := .PageFields }}{{ if $i }}, {{ end }}last.{{ $f.Field.Name }}{{ end }})
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "{{ $func }}", "{{ $table }}", err)
+	}
+
+	return res, token, nil
+}
+{{- end }}
+{{- end }}
@@ -1,17 +1,81 @@
 {{- $short := (shortname .Name "err" "res" "sqlstr" "db" "YOLog") -}}
 {{- $table := (.Table.TableName) -}}
+{{- $committs := (committsfields .Fields) }}
+{{- $softdelete := .SoftDeleteField }}
+{{- $delete := "Delete" }}{{ if $softdelete }}{{ $delete = "HardDelete" }}{{ end }}
+{{- $deletedvalue := "time.Now()" }}{{ if autocommitts }}{{ range $committs }}{{ if and $softdelete (eq .Name $softdelete.Name) }}{{ $deletedvalue = "spanner.CommitTimestamp" }}{{ end }}{{ end }}{{ end }}
+{{- $values := "columnsToValues" }}{{ if and autocommitts $committs }}{{ $values = "mutationValues" }}{{ end }}
+{{- $identity := false }}{{ range .Fields }}{{ if or .Col.IsIdentity .Col.Sequence }}{{ $identity = true }}{{ end }}{{ end -}}
 // {{ .Name }} represents a row from '{{ $table }}'.
+{{- with .Table.RowDeletionPolicy }}
+// The rows are deleted by the row deletion policy of the table
+// {{ .NumDays }} days after {{ .ColumnName }}.
+{{- end }}
 type {{ .Name }} struct {
 {{- range .Fields }}
 {{- if eq (.Col.DataType) (.Col.ColumnName) }}
-	{{ .Name }} string `spanner:"{{ .Col.ColumnName }}" json:"{{ .Col.ColumnName }}"` // {{ .Col.ColumnName }} enum
+	{{ .Name }} string `{{ fieldtag . }}` // {{ .Col.ColumnName }} enum
 {{- else if .CustomType }}
-	{{ .Name }} {{ retype .CustomType }} `spanner:"{{ .Col.ColumnName }}" json:"{{ .Col.ColumnName }}"` // {{ .Col.ColumnName }}
+	{{ .Name }} {{ retype .CustomType }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}
 {{- else }}
-	{{ .Name }} {{ .Type }} `spanner:"{{ .Col.ColumnName }}" json:"{{ .Col.ColumnName }}"` // {{ .Col.ColumnName }}
+	{{ .Name }} {{ .Type }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}
+{{- end }}
+{{- end }}
+}
+{{- range $e := .Enums }}
+
+// {{ $e.Name }} is the values of '{{ colname $e.Field.Col }}' of '{{ $table }}'.
+type {{ $e.Name }} string
+
+// Values of {{ $e.Name }}.
+const (
+{{- range $e.Values }}
+	{{ .Name }} {{ $e.Name }} = {{ printf "%q" .Value }}
+{{- end }}
+)
+{{- end }}
+{{- with .Table.RowDeletionPolicy }}
+
+// Row deletion policy of '{{ $table }}'. Cloud Spanner deletes the rows whose
+// {{ $.Name }}TTLColumn is older than {{ $.Name }}TTL in the background.
+const (
+	{{ $.Name }}TTLColumn = "{{ .ColumnName }}"
+	{{ $.Name }}TTL       = {{ .NumDays }} * 24 * time.Hour
+)
+{{- end }}
+{{- with .TTLField }}
+
+// ExpiresAt returns the time when the row of {{ $short }} expires by the row
+// deletion policy of '{{ $table }}'.
+{{- if or (eq .Type "spanner.NullTime") (eq .Type "*time.Time") }} It returns false if {{ .Name }} is NULL,
+// where the row never expires.
+{{- else }} It always returns true because {{ .Name }}
+// is NOT NULL.
 {{- end }}
+func ({{ $short }} *{{ $.Name }}) ExpiresAt() (time.Time, bool) {
+{{- if eq .Type "spanner.NullTime" }}
+	if !{{ $short }}.{{ .Name }}.Valid {
+		return time.Time{}, false
+	}
+	return {{ $short }}.{{ .Name }}.Time.Add({{ $.Name }}TTL), true
+{{- else if eq .Type "*time.Time" }}
+	if {{ $short }}.{{ .Name }} == nil {
+		return time.Time{}, false
+	}
+	return {{ $short }}.{{ .Name }}.Add({{ $.Name }}TTL), true
+{{- else }}
+	return {{ $short }}.{{ .Name }}.Add({{ $.Name }}TTL), true
 {{- end }}
 }
+{{- end }}
+
+// {{ .Name }}TableName is the name of '{{ $table }}', which is qualified by the
+// schema if the table is in a named schema.
+const {{ .Name }}TableName = "{{ $table }}"
+
+// Err{{ .Name }}NotFound is the error matched by errors.Is when the row is not
+// found in '{{ $table }}'.
+var Err{{ .Name }}NotFound = errors.New("yo: {{ $table }} not found")
 
 {{ if .PrimaryKey }}
 func {{ .Name }}PrimaryKeys() []string {
@@ -31,6 +95,17 @@ func {{ .Name }}Columns() []string {
 	}
 }
 
+// {{ .Name }}Column is a column of '{{ $table }}'.
+type {{ .Name }}Column string
+
+// Columns of '{{ $table }}'.
+const (
+{{- range .Fields }}
+	{{ $.Name }}Column{{ .Name }} {{ $.Name }}Column = "{{ colname .Col }}"
+{{- end }}
+)
+
+{{ if not .Table.IsView -}}
 func {{ .Name }}WritableColumns() []string {
 	return []string{
 {{- range .Fields }}
@@ -41,6 +116,22 @@ func {{ .Name }}WritableColumns() []string {
 	}
 }
 
+{{ if $identity -}}
+// {{ .Name }}InsertColumns returns the writable columns except the identity
+// columns and the columns defaulted by sequences, whose values are assigned by
+// Cloud Spanner.
+func {{ .Name }}InsertColumns() []string {
+	return []string{
+{{- range .Fields }}
+	{{- if not (or .Col.IsGenerated .Col.IsIdentity .Col.Sequence) }}
+		"{{ colname .Col }}",
+	{{- end }}
+{{- end }}
+	}
+}
+
+{{ end -}}
+{{ end -}}
 func ({{ $short }} *{{ .Name }}) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
 	ret := make([]interface{}, 0, len(cols))
 	for _, col := range cols {
@@ -61,6 +152,7 @@ func ({{ $short }} *{{ .Name }}) columnsToPtrs(cols []string, customPtrs map[str
 	return ret, nil
 }
 
+{{ if not .Table.IsView -}}
 func ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{}, error) {
 	ret := make([]interface{}, 0, len(cols))
 	for _, col := range cols {
@@ -68,7 +160,7 @@ func ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{},
 {{- range .Fields }}
 		case "{{ colname .Col }}":
 			{{- if .CustomType }}
-			ret = append(ret, {{ .Type }}({{ $short }}.{{ .Name }}))
+			ret = append(ret, {{ spanvalue . (print $short "." .Name) }})
 			{{- else }}
 			ret = append(ret, {{ $short }}.{{ .Name }})
 			{{- end }}
@@ -80,12 +172,35 @@ func ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{},
 
 	return ret, nil
 }
+{{- if and autocommitts $committs }}
+
+// mutationValues returns the values of cols to write. The values of the
+// commit timestamp columns are spanner.CommitTimestamp so that Cloud Spanner
+// writes the commit timestamps of the transactions into them.
+func ({{ $short }} *{{ .Name }}) mutationValues(cols []string) ([]interface{}, error) {
+	ret, err := {{ $short }}.columnsToValues(cols)
+	if err != nil {
+		return nil, err
+	}
+	for i, col := range cols {
+		switch col {
+		case {{ range $i, $f := $committs }}{{ if $i }}, {{ end }}"{{ colname $f.Col }}"{{ end }}:
+			ret[i] = spanner.CommitTimestamp
+		}
+	}
+
+	return ret, nil
+}
+{{- end }}
 
+{{ end -}}
 // new{{ .Name }}_Decoder returns a decoder which reads a row from *spanner.Row
 // into {{ .Name }}. The decoder is not goroutine-safe. Don't use it concurrently.
 func new{{ .Name }}_Decoder(cols []string) func(*spanner.Row) (*{{ .Name }}, error) {
 	{{- range .Fields }}
-		{{- if .CustomType }}
+		{{- if customjson . }}
+			var {{ customtypeparam .Name }} spanner.GenericColumnValue
+		{{- else if .CustomType }}
 			var {{ customtypeparam .Name }} {{ .Type }}
 		{{- end }}
 	{{- end }}
@@ -108,8 +223,12 @@ func new{{ .Name }}_Decoder(cols []string) func(*spanner.Row) (*{{ .Name }}, err
             return nil, err
         }
         {{- range .Fields }}
-            {{- if .CustomType }}
-                {{ $short }}.{{ .Name }} = {{ retype .CustomType }}({{ customtypeparam .Name }})
+            {{- if customjson . }}
+                if err := yoUnmarshalJSON({{ customtypeparam .Name }}, &{{ $short }}.{{ .Name }}); err != nil {
+                    return nil, fmt.Errorf("failed to unmarshal column {{ colname .Col }}: %v", err)
+                }
+            {{- else if .CustomType }}
+                {{ $short }}.{{ .Name }} = {{ customvalue . (customtypeparam .Name) }}
             {{- end }}
         {{- end }}
 
@@ -118,85 +237,2070 @@ func new{{ .Name }}_Decoder(cols []string) func(*spanner.Row) (*{{ .Name }}, err
 	}
 }
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func ({{ $short }} *{{ .Name }}) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())
-	return spanner.Insert("{{ $table }}", {{ .Name }}WritableColumns(), values)
+// Scan{{ .Name }} decodes row into {{ .Name }}. The row may have any subset of
+// the columns of '{{ $table }}', such as the result of a query or a read.
+func Scan{{ .Name }}(row *spanner.Row) (*{{ .Name }}, error) {
+	return new{{ .Name }}_Decoder(row.ColumnNames())(row)
 }
 
-{{ if ne (fieldnames .Fields $short .PrimaryKeyFields) "" }}
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func ({{ $short }} *{{ .Name }}) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())
-	return spanner.Update("{{ $table }}", {{ .Name }}WritableColumns(), values)
+// Scan{{ pluralize .Name }} decodes all the rows of iter into a slice of {{ .Name }}.
+// iter is stopped when it returns.
+func Scan{{ pluralize .Name }}(iter *spanner.RowIterator) ([]*{{ .Name }}, error) {
+	var res []*{{ .Name }}
+	var decoder func(*spanner.Row) (*{{ .Name }}, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = new{{ .Name }}_Decoder(row.ColumnNames())
+		}
+
+		{{ $short }}, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, {{ $short }})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func ({{ $short }} *{{ .Name }}) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())
-	return spanner.InsertOrUpdate("{{ $table }}", {{ .Name }}WritableColumns(), values)
+// {{ .Name }}Iterator iterates over the rows of a partition of '{{ $table }}'
+// decoded into {{ .Name }}.
+type {{ .Name }}Iterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*{{ .Name }}, error)
 }
 
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func ({{ $short }} *{{ .Name }}) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, {{ .Name }}PrimaryKeys()...)
+// Execute{{ .Name }}Partition returns an iterator of the rows of p, which is a
+// partition given by {{ if not .Table.IsView }}PartitionReadAll{{ pluralize .Name }} or {{ end }}{{ .Name }}Query.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func Execute{{ .Name }}Partition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *{{ .Name }}Iterator {
+	return &{{ .Name }}Iterator{iter: btx.Execute(ctx, p)}
+}
 
-	values, err := {{ $short }}.columnsToValues(colsWithPKeys)
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *{{ .Name }}Iterator) Next() (*{{ .Name }}, error) {
+	row, err := it.iter.Next()
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "{{ .Name }}.UpdateColumns", "{{ $table }}", err)
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("{{ .Name }}Iterator.Next", "{{ $table }}", err)
 	}
 
-	return spanner.Update("{{ $table }}", colsWithPKeys, values), nil
+	if it.decoder == nil {
+		it.decoder = new{{ .Name }}_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "{{ .Name }}Iterator.Next", "{{ $table }}", err)
+	}
+
+	return v, nil
 }
 
-// Find{{ .Name }} gets a {{ .Name }} by primary key
-func Find{{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}) (*{{ .Name }}, error) {
-	key := spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }
-	row, err := db.ReadRow(ctx, "{{ $table }}", key, {{ .Name }}Columns())
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *{{ .Name }}Iterator) Stop() {
+	it.iter.Stop()
+}
+
+// yield{{ .Name }}Rows decodes the rows of rows into {{ .Name }} and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yield{{ .Name }}Rows(rows *spanner.RowIterator, method string, yield func(*{{ .Name }}, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*{{ .Name }}, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "{{ $table }}", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = new{{ .Name }}_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "{{ $table }}", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// Count{{ pluralize .Name }} returns the number of the rows of '{{ $table }}'.
+func Count{{ pluralize .Name }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Count{{ pluralize .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Count{{ pluralize .Name }}", "{{ $table }}")()
+{{ end }}
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM {{ escapedtable $table }}")
+	{{- if $softdelete }}
+	if !yoIncludesDeleted(ctx) {
+		stmt.SQL += " WHERE {{ escapedcolname $softdelete.Col }} IS NULL"
+	}
+	{{- end }}
+
+	defer yoLogQuery(ctx, "Count{{ pluralize .Name }}", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newError("Find{{ .Name }}", "{{ $table }}", err)
+		return 0, newError("Count{{ pluralize .Name }}", "{{ $table }}", err)
+	}
+
+	return n, nil
+}
+
+// All{{ pluralize .Name }}Seq returns an iterator of all the rows of '{{ $table }}',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*{{ .Name }}, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func All{{ pluralize .Name }}Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*{{ .Name }}, error) bool) {
+	return func(yield func(*{{ .Name }}, error) bool) {
+		{{- if otel }}
+		ctx, span := yoStartSpan(ctx, "All{{ pluralize .Name }}Seq", "{{ $table }}", "")
+		defer span.End()
+{{ end }}
+		{{- if metrics }}
+		defer yoObserve("All{{ pluralize .Name }}Seq", "{{ $table }}")()
+{{ end }}
+	{{- if or .Table.IsView (not .PrimaryKeyFields) }}
+		stmt := spanner.NewStatement("SELECT {{ escapedcolnames .Fields }} FROM {{ escapedtable $table }}")
+		defer yoLogQuery(ctx, "All{{ pluralize .Name }}Seq", stmt)()
+		yield{{ .Name }}Rows(yoQuery(ctx, db, stmt, opts), "All{{ pluralize .Name }}Seq", yield)
+	{{- else }}
+		{{- if $softdelete }}
+		if !yoIncludesDeleted(ctx) {
+			next := yield
+			yield = func(v *{{ .Name }}, err error) bool {
+				if err == nil && v.IsDeleted() {
+					return true
+				}
+				return next(v, err)
+			}
+		}
+		{{- end }}
+		rows := yoRead(ctx, db, "{{ $table }}", "", spanner.AllKeys(), {{ .Name }}Columns(), opts)
+		yield{{ .Name }}Rows(rows, "All{{ pluralize .Name }}Seq", yield)
+	{{- end }}
 	}
+}
+{{- if not .Table.IsView }}
 
-	decoder := new{{ .Name }}_Decoder({{ .Name}}Columns())
-	{{ $short }}, err := decoder(row)
+// PartitionReadAll{{ pluralize .Name }} partitions the read of all the rows of
+// '{{ $table }}' in btx, so that the partitions are read in parallel by
+// Execute{{ .Name }}Partition for bulk exports.
+{{- if databoost }} The partitions are executed by
+// Data Boost if enabled by yopts.
+{{- end }}
+func PartitionReadAll{{ pluralize .Name }}(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "PartitionReadAll{{ pluralize .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("PartitionReadAll{{ pluralize .Name }}", "{{ $table }}")()
+{{ end }}
+	ropts := spanner.ReadOptions{}
+	{{- if databoost }}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	{{- end }}
+	ps, err := btx.PartitionReadWithOptions(ctx, "{{ $table }}", spanner.AllKeys(), {{ .Name }}Columns(), opts, ropts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "Find{{ .Name }}", "{{ $table }}", err)
+		return nil, newError("PartitionReadAll{{ pluralize .Name }}", "{{ $table }}", err)
 	}
 
-	return {{ $short }}, nil
+	return ps, nil
 }
+{{- end }}
 
-// Read{{ .Name }} retrieves multiples rows from {{ .Name }} by KeySet as a slice.
-func Read{{ .Name }}(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*{{ .Name }}, error) {
-	var res []*{{ .Name }}
+// {{ .Name }}Query is a query builder for '{{ $table }}'. The conditions are
+// given by the predicates of {{ .Name }}Where, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of {{ .Name }}Column.
+type {{ .Name }}Query struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+	{{- if $softdelete }}
 
-	decoder := new{{ .Name }}_Decoder({{ .Name}}Columns())
+	includeDeleted bool
+	{{- end }}
+}
 
-	rows := db.Read(ctx, "{{ $table }}", keys, {{ .Name }}Columns())
-	err := rows.Do(func(row *spanner.Row) error {
-		{{ $short }}, err := decoder(row)
+// New{{ .Name }}Query returns a {{ .Name }}Query filtered by preds.
+func New{{ .Name }}Query(preds ...YOPredicate) *{{ .Name }}Query {
+	return &{{ .Name }}Query{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *{{ .Name }}Query) Where(preds ...YOPredicate) *{{ .Name }}Query {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *{{ .Name }}Query) OrderBy(col {{ .Name }}Column) *{{ .Name }}Query {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *{{ .Name }}Query) OrderByDesc(col {{ .Name }}Column) *{{ .Name }}Query {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *{{ .Name }}Query) Limit(n int) *{{ .Name }}Query {
+	q.limit = n
+	return q
+}
+
+{{ if $softdelete -}}
+// IncludingDeleted includes the soft-deleted rows, which are filtered out by
+// default.
+func (q *{{ .Name }}Query) IncludingDeleted() *{{ .Name }}Query {
+	q.includeDeleted = true
+	return q
+}
+
+// conditions returns the predicates of the query, and the one filtering out
+// the soft-deleted rows unless they are included.
+func (q *{{ .Name }}Query) conditions() []YOPredicate {
+	if q.includeDeleted {
+		return q.preds
+	}
+	return append(q.preds[:len(q.preds):len(q.preds)], {{ .Name }}Where.{{ $softdelete.Name }}.IsNull())
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *{{ .Name }}Query) Statement() spanner.Statement {
+	return yoStatement("{{ escapedcolnames .Fields }}", "{{ escapedtable $table }}", q.conditions(), q.orders, q.limit)
+}
+{{- else -}}
+// Statement returns the parameterized statement of the query.
+func (q *{{ .Name }}Query) Statement() spanner.Statement {
+	return yoStatement("{{ escapedcolnames .Fields }}", "{{ escapedtable $table }}", q.preds, q.orders, q.limit)
+}
+{{- end }}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *{{ .Name }}Query) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ .Name }}Query.Query", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ .Name }}Query.Query", "{{ $table }}")()
+{{ end }}
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "{{ .Name }}Query.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ .Name }}{}
+	for {
+		row, err := iter.Next()
 		if err != nil {
-			return err
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("{{ .Name }}Query.Query", "{{ $table }}", err)
 		}
-		res = append(res, {{ $short }})
 
-		return nil
-	})
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "Read{{ .Name }}", "{{ $table }}", err)
+		v, err := Scan{{ .Name }}(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ .Name }}Query.Query", "{{ $table }}", err)
+		}
+
+		res = append(res, v)
 	}
 
 	return res, nil
 }
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *{{ .Name }}Query) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ .Name }}Query.Count", "{{ $table }}", "")
+	defer span.End()
 {{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ .Name }}Query.Count", "{{ $table }}")()
+{{ end }}
+	stmt := yoStatement("COUNT(*)", "{{ escapedtable $table }}", {{ if $softdelete }}q.conditions(){{ else }}q.preds{{ end }}, nil, 0)
 
-// Delete deletes the {{ .Name }} from the database.
-func ({{ $short }} *{{ .Name }}) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())
-	return spanner.Delete("{{ $table }}", spanner.Key(values))
+	defer yoLogQuery(ctx, "{{ .Name }}Query.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("{{ .Name }}Query.Count", "{{ $table }}", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*{{ .Name }}, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *{{ .Name }}Query) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*{{ .Name }}, error) bool) {
+	return func(yield func(*{{ .Name }}, error) bool) {
+		{{- if otel }}
+		ctx, span := yoStartSpan(ctx, "{{ .Name }}Query.Seq", "{{ $table }}", "")
+		defer span.End()
+{{ end }}
+		{{- if metrics }}
+		defer yoObserve("{{ .Name }}Query.Seq", "{{ $table }}")()
+{{ end }}
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "{{ .Name }}Query.Seq", stmt)()
+		yield{{ .Name }}Rows(yoQuery(ctx, db, stmt, opts), "{{ .Name }}Query.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by Execute{{ .Name }}Partition. The query must not have OrderBy or
+// Limit, which cannot be partitioned.
+{{- if databoost }} The partitions are executed by Data
+// Boost if enabled by yopts.
+{{- end }}
+func (q *{{ .Name }}Query) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ .Name }}Query.PartitionQuery", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ .Name }}Query.PartitionQuery", "{{ $table }}")()
+{{ end }}
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "{{ .Name }}Query.PartitionQuery", "{{ $table }}", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "{{ .Name }}Query.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	{{- if databoost }}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	{{- end }}
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("{{ .Name }}Query.PartitionQuery", "{{ $table }}", err)
+	}
+
+	return ps, nil
+}
+
+// {{ .Name }}Where has the typed predicate constructors of the columns of
+// '{{ $table }}'.
+var {{ .Name }}Where = struct {
+{{- range .Fields }}
+	{{ .Name }} {{ $.Name }}_{{ .Name }}Column
+{{- end }}
+}{}
+{{- range .Fields }}
+{{- $col := (escapedcolname .Col) }}
+{{- $typ := .Type }}{{ if .CustomType }}{{ $typ = retype .CustomType }}{{ end }}
+
+// {{ $.Name }}_{{ .Name }}Column has the predicate constructors of '{{ colname .Col }}'.
+type {{ $.Name }}_{{ .Name }}Column struct{}
+{{- if iscomparable . }}
+
+// Eq returns a predicate that '{{ colname .Col }}' is equal to v.
+func ({{ $.Name }}_{{ .Name }}Column) Eq(v {{ $typ }}) YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: "=", value: {{ if .CustomType }}{{ spanvalue . "v" }}{{ else }}v{{ end }}}
+}
+
+// Ne returns a predicate that '{{ colname .Col }}' is not equal to v.
+func ({{ $.Name }}_{{ .Name }}Column) Ne(v {{ $typ }}) YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: "!=", value: {{ if .CustomType }}{{ spanvalue . "v" }}{{ else }}v{{ end }}}
+}
+
+// Lt returns a predicate that '{{ colname .Col }}' is less than v.
+func ({{ $.Name }}_{{ .Name }}Column) Lt(v {{ $typ }}) YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: "<", value: {{ if .CustomType }}{{ spanvalue . "v" }}{{ else }}v{{ end }}}
+}
+
+// Le returns a predicate that '{{ colname .Col }}' is less than or equal to v.
+func ({{ $.Name }}_{{ .Name }}Column) Le(v {{ $typ }}) YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: "<=", value: {{ if .CustomType }}{{ spanvalue . "v" }}{{ else }}v{{ end }}}
+}
+
+// Gt returns a predicate that '{{ colname .Col }}' is greater than v.
+func ({{ $.Name }}_{{ .Name }}Column) Gt(v {{ $typ }}) YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: ">", value: {{ if .CustomType }}{{ spanvalue . "v" }}{{ else }}v{{ end }}}
+}
+
+// Ge returns a predicate that '{{ colname .Col }}' is greater than or equal to v.
+func ({{ $.Name }}_{{ .Name }}Column) Ge(v {{ $typ }}) YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: ">=", value: {{ if .CustomType }}{{ spanvalue . "v" }}{{ else }}v{{ end }}}
+}
+
+// In returns a predicate that '{{ colname .Col }}' is equal to any of vs.
+func ({{ $.Name }}_{{ .Name }}Column) In(vs ...{{ $typ }}) YOPredicate {
+	{{- if .CustomType }}
+	values := make([]{{ .Type }}, len(vs))
+	for i, v := range vs {
+		values[i] = {{ spanvalue . "v" }}
+	}
+	return YOPredicate{column: "{{ $col }}", op: "IN", value: values}
+	{{- else }}
+	return YOPredicate{column: "{{ $col }}", op: "IN", value: vs}
+	{{- end }}
+}
+{{- end }}
+{{- if not .Col.NotNull }}
+
+// IsNull returns a predicate that '{{ colname .Col }}' is NULL.
+func ({{ $.Name }}_{{ .Name }}Column) IsNull() YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that '{{ colname .Col }}' is not NULL.
+func ({{ $.Name }}_{{ .Name }}Column) IsNotNull() YOPredicate {
+	return YOPredicate{column: "{{ $col }}", op: "IS NOT NULL"}
+}
+{{- end }}
+{{- if and dml (not $.Table.IsView) (not .Col.IsPrimaryKey) (not .Col.IsGenerated) }}
+
+// To returns an assignment of v to '{{ colname .Col }}' for UpdateAll{{ pluralize $.Name }}Where.
+func ({{ $.Name }}_{{ .Name }}Column) To(v {{ $typ }}) YOAssignment {
+	return YOAssignment{column: "{{ $col }}", value: {{ if .CustomType }}{{ spanvalue . "v" }}{{ else }}v{{ end }}}
+}
+{{- end }}
+{{- end }}
+{{- if and dml (not .Table.IsView) }}
+
+// {{ .Name }}Set has the typed setters of the columns of '{{ $table }}' which
+// are updated by UpdateAll{{ pluralize .Name }}Where.
+var {{ .Name }}Set = struct {
+{{- range .Fields }}
+{{- if not (or .Col.IsPrimaryKey .Col.IsGenerated) }}
+	{{ .Name }} {{ $.Name }}_{{ .Name }}Column
+{{- end }}
+{{- end }}
+}{}
+
+// DeleteAll{{ pluralize .Name }}Where deletes all the rows of '{{ $table }}' where all
+// preds are satisfied by partitioned DML, and returns the lower bound of the
+// number of the deleted rows. It deletes all the rows if preds is empty. It
+// is intended for bulk maintenance, and is not atomic across partitions.
+func DeleteAll{{ pluralize .Name }}Where(ctx context.Context, client *spanner.Client, preds ...YOPredicate) (int64, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "DeleteAll{{ pluralize .Name }}Where", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("DeleteAll{{ pluralize .Name }}Where", "{{ $table }}")()
+{{ end }}
+	stmt := yoDeleteWhereDML("{{ escapedtable $table }}", preds)
+
+	defer yoLogQuery(ctx, "DeleteAll{{ pluralize .Name }}Where", stmt)()
+	n, err := client.PartitionedUpdate(ctx, stmt)
+	if err != nil {
+		return n, newError("DeleteAll{{ pluralize .Name }}Where", "{{ $table }}", err)
+	}
+
+	return n, nil
+}
+
+// UpdateAll{{ pluralize .Name }}Where updates the columns of sets in all the rows of
+// '{{ $table }}' where all preds are satisfied by partitioned DML, and returns
+// the lower bound of the number of the updated rows. The assignments are
+// given by {{ .Name }}Set. It is intended for bulk maintenance such as
+// backfills, and is not atomic across partitions.
+func UpdateAll{{ pluralize .Name }}Where(ctx context.Context, client *spanner.Client, sets []YOAssignment, preds ...YOPredicate) (int64, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "UpdateAll{{ pluralize .Name }}Where", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("UpdateAll{{ pluralize .Name }}Where", "{{ $table }}")()
+{{ end }}
+	if len(sets) == 0 {
+		return 0, newErrorWithCode(codes.InvalidArgument, "UpdateAll{{ pluralize .Name }}Where", "{{ $table }}", errors.New("no columns to update"))
+	}
+
+	stmt := yoUpdateWhereDML("{{ escapedtable $table }}", sets, preds)
+
+	defer yoLogQuery(ctx, "UpdateAll{{ pluralize .Name }}Where", stmt)()
+	n, err := client.PartitionedUpdate(ctx, stmt)
+	if err != nil {
+		return n, newError("UpdateAll{{ pluralize .Name }}Where", "{{ $table }}", err)
+	}
+
+	return n, nil
+}
+{{- end }}
+
+{{ if .Table.IsView }}
+{{- if .PrimaryKey }}
+// Find{{ .Name }} gets a {{ .Name }} by primary key by querying the view.
+func Find{{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Find{{ .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Find{{ .Name }}", "{{ $table }}")()
+{{ end }}
+	const sqlstr = "SELECT " +
+		"{{ escapedcolnames .Fields }} " +
+		"FROM {{ escapedtable $table }} " +
+		"WHERE {{ colnamesquery .PrimaryKeyFields " AND " }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .PrimaryKeyFields }}
+		{{- if $f.CustomType }}
+			stmt.Params["{{ paramname $i }}"] = {{ spanvalue $f (goparamname $f.Name) }}
+		{{- else }}
+			stmt.Params["{{ paramname $i }}"] = {{ goparamname $f.Name }}
+		{{- end }}
+	{{- end }}
+
+	// run query
+	defer yoLogQuery(ctx, "Find{{ .Name }}", stmt{{ goparamlist .PrimaryKeyFields true false }})()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("Find{{ .Name }}", "{{ $table }}", spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }, Err{{ .Name }}NotFound, nil)
+		}
+		return nil, newError("Find{{ .Name }}", "{{ $table }}", err)
+	}
+
+	{{ $short }}, err := Scan{{ .Name }}(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "Find{{ .Name }}", "{{ $table }}", err)
+	}
+
+	return {{ $short }}, nil
 }
+{{- end }}
+{{- else }}
+{{- if hooks }}
+// {{ .Name }}Hooks are the callbacks of the generated writes of {{ .Name }}, which
+// are set to {{ .Name }}WriteHooks. Embed {{ .Name }}NopHooks to implement some
+// of them.
+type {{ .Name }}Hooks interface {
+	// BeforeInsert is called with the row before a mutation or a DML
+	// statement to insert it is built by Insert, InsertOrUpdate or InsertDML,
+	// so that it can fill or normalize the values.
+	BeforeInsert(ctx context.Context, row *{{ .Name }})
+
+	// BeforeUpdate is called with the row before a mutation or a DML
+	// statement to update it is built by Update, UpdateColumns or UpdateDML.
+	BeforeUpdate(ctx context.Context, row *{{ .Name }})
+
+	// BeforeDelete is called with the row before a mutation or a DML
+	// statement to delete it is built by Delete or DeleteDML.
+	BeforeDelete(ctx context.Context, row *{{ .Name }})
+
+	// AfterInsert is called with the row after it is inserted by
+	// RunInsertDML or InsertAll{{ pluralize .Name }}, which write the row by themselves.
+	AfterInsert(ctx context.Context, row *{{ .Name }})
+
+	// AfterUpdate is called with the row after it is updated by RunUpdateDML.
+	AfterUpdate(ctx context.Context, row *{{ .Name }})
+}
+
+// {{ .Name }}NopHooks is {{ .Name }}Hooks doing nothing.
+type {{ .Name }}NopHooks struct{}
+
+func ({{ .Name }}NopHooks) BeforeInsert(context.Context, *{{ .Name }}) {}
+func ({{ .Name }}NopHooks) BeforeUpdate(context.Context, *{{ .Name }}) {}
+func ({{ .Name }}NopHooks) BeforeDelete(context.Context, *{{ .Name }}) {}
+func ({{ .Name }}NopHooks) AfterInsert(context.Context, *{{ .Name }})  {}
+func ({{ .Name }}NopHooks) AfterUpdate(context.Context, *{{ .Name }})  {}
+
+// {{ .Name }}WriteHooks is the hooks called by the generated writes of
+// {{ .Name }}, which must not be nil.
+var {{ .Name }}WriteHooks {{ .Name }}Hooks = {{ .Name }}NopHooks{}
+
+{{ end -}}
+{{- if $identity }}
+// Insert returns a Mutation to insert a row into a table. The identity columns
+// and the columns defaulted by sequences are omitted so that Cloud Spanner
+// assigns their values. If the row already exists, the write or transaction
+// fails.
+func ({{ $short }} *{{ .Name }}) Insert(ctx context.Context) *spanner.Mutation {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}InsertColumns())
+	return spanner.Insert("{{ $table }}", {{ .Name }}InsertColumns(), values)
+}
+
+// InsertWithID returns a Mutation to insert a row into a table with the values
+// of the identity columns and the columns defaulted by sequences given by the
+// {{ .Name }}. If the row already exists, the write or transaction fails.
+func ({{ $short }} *{{ .Name }}) InsertWithID(ctx context.Context) *spanner.Mutation {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())
+	return spanner.Insert("{{ $table }}", {{ .Name }}WritableColumns(), values)
+}
+{{- if dml }}
+
+// InsertDML returns a DML statement to insert a row into a table, which is run
+// by the Update of a read-write transaction. The identity columns and the
+// columns defaulted by sequences are omitted so that Cloud Spanner assigns
+// their values.
+func ({{ $short }} *{{ .Name }}) InsertDML(ctx context.Context) spanner.Statement {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}InsertColumns())
+	return yoInsertDML("{{ escapedtable $table }}", {{ .Name }}InsertColumns(), values)
+}
+{{- end }}
+{{- else }}
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func ({{ $short }} *{{ .Name }}) Insert(ctx context.Context) *spanner.Mutation {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())
+	return spanner.Insert("{{ $table }}", {{ .Name }}WritableColumns(), values)
+}
+{{- if dml }}
+
+// InsertDML returns a DML statement to insert a row into a table, which is run
+// by the Update of a read-write transaction. If the row already exists, the
+// statement fails.
+func ({{ $short }} *{{ .Name }}) InsertDML(ctx context.Context) spanner.Statement {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())
+	return yoInsertDML("{{ escapedtable $table }}", {{ .Name }}WritableColumns(), values)
+}
+{{- end }}
+{{- end }}
+{{- if dml }}
+
+// RunInsertDML inserts the {{ .Name }} by InsertDML in tx, and scans the row written back into
+// the {{ .Name }} by {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }}, so that the default values and the generated
+// columns are populated without a read. The commit timestamp columns are not
+// returned because they cannot be read in the transaction writing them. tx is
+// intended to be given by YORunInTransaction.
+func ({{ $short }} *{{ .Name }}) RunInsertDML(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "RunInsertDML", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("RunInsertDML", "{{ $table }}")()
+{{ end }}
+	stmt := {{ $short }}.InsertDML(ctx)
+	stmt.SQL += " {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }} {{ escapedcolnames .Fields $committs }}"
+
+	defer yoLogQuery(ctx, "RunInsertDML", stmt)()
+	row, err := yoRunDML(ctx, tx, stmt)
+	if err != nil {
+		return newError("RunInsertDML", "{{ $table }}", err)
+	}
+
+	res, err := Scan{{ .Name }}(row)
+	if err != nil {
+		return newErrorWithCode(codes.Internal, "RunInsertDML", "{{ $table }}", err)
+	}
+	{{- range $committs }}
+	res.{{ .Name }} = {{ $short }}.{{ .Name }}
+	{{- end }}
+	*{{ $short }} = *res
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.AfterInsert(ctx, {{ $short }})
+	{{- end }}
+
+	return nil
+}
+{{- end }}
+
+// InsertAll{{ pluralize .Name }} inserts rows into '{{ $table }}' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAll{{ pluralize .Name }}(ctx context.Context, client *spanner.Client, rows []*{{ .Name }}) (int, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "InsertAll{{ pluralize .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("InsertAll{{ pluralize .Name }}", "{{ $table }}")()
+{{ end }}
+	ms := {{ pluralize .Name }}Insert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len({{ .Name }}WritableColumns()) * (1 + {{ len .Indexes }})
+
+	{{- if hooks }}
+
+	n, err := yoApplyInBatches(ctx, client, "InsertAll{{ pluralize .Name }}", "{{ $table }}", ms, YOMutationLimit/mutationsPerRow)
+	for _, row := range rows[:n] {
+		{{ .Name }}WriteHooks.AfterInsert(ctx, row)
+	}
+
+	return n, err
+	{{- else }}
+
+	return yoApplyInBatches(ctx, client, "InsertAll{{ pluralize .Name }}", "{{ $table }}", ms, YOMutationLimit/mutationsPerRow)
+	{{- end }}
+}
+
+// {{ pluralize .Name }}Insert returns Mutations to insert the rows into
+// '{{ $table }}', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAll{{ pluralize .Name }}, the Mutations are
+// not split into batches.
+func {{ pluralize .Name }}Insert(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+{{- if .PrimaryKeyFields }}
+
+{{ if ne (fieldnames .Fields $short .PrimaryKeyFields) "" }}
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func ({{ $short }} *{{ .Name }}) Update(ctx context.Context) *spanner.Mutation {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())
+	return spanner.Update("{{ $table }}", {{ .Name }}WritableColumns(), values)
+}
+{{- if dml }}
+
+// UpdateDML returns a DML statement to update a row in a table, which is run
+// by the Update of a read-write transaction. No row is updated if the row does
+// not exist.
+func ({{ $short }} *{{ .Name }}) UpdateDML(ctx context.Context) spanner.Statement {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())
+	return yoUpdateDML("{{ escapedtable $table }}", {{ .Name }}WritableColumns(), values, {{ .Name }}PrimaryKeys())
+}
+
+// RunUpdateDML updates the {{ .Name }} by UpdateDML in tx, and scans the row written back into
+// the {{ .Name }} by {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }}, so that the default values and the generated
+// columns are populated without a read. The commit timestamp columns are not
+// returned because they cannot be read in the transaction writing them. If the row does
+// not exist, an error is returned where errors.Is(err, ErrNotFound) is true. tx
+// is intended to be given by YORunInTransaction.
+func ({{ $short }} *{{ .Name }}) RunUpdateDML(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "RunUpdateDML", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("RunUpdateDML", "{{ $table }}")()
+{{ end }}
+	stmt := {{ $short }}.UpdateDML(ctx)
+	stmt.SQL += " {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }} {{ escapedcolnames .Fields $committs }}"
+
+	defer yoLogQuery(ctx, "RunUpdateDML", stmt)()
+	row, err := yoRunDML(ctx, tx, stmt)
+	if err != nil {
+		if err == iterator.Done {
+			return newNotFoundError("RunUpdateDML", "{{ $table }}", {{ $short }}.primaryKey(), Err{{ .Name }}NotFound, nil)
+		}
+		return newError("RunUpdateDML", "{{ $table }}", err)
+	}
+
+	res, err := Scan{{ .Name }}(row)
+	if err != nil {
+		return newErrorWithCode(codes.Internal, "RunUpdateDML", "{{ $table }}", err)
+	}
+	{{- range $committs }}
+	res.{{ .Name }} = {{ $short }}.{{ .Name }}
+	{{- end }}
+	*{{ $short }} = *res
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.AfterUpdate(ctx, {{ $short }})
+	{{- end }}
+
+	return nil
+}
+{{- if .VersionField }}
+{{- $version := .VersionField }}
+
+// UpdateWithVersionCheck updates the {{ .Name }} by a DML statement in tx only if
+// the version column '{{ colname $version.Col }}' still has the value of the {{ .Name }},
+// which is the one read, so that the changes by others since the read are
+// not overwritten. If the row is changed or deleted since the read, an error
+// is returned where errors.Is(err, ErrStaleWrite) is true. tx is intended to be
+// given by YORunInTransaction.
+{{- if eq $version.Type "int64" }}
+// The version of the {{ .Name }} is incremented by the update.
+{{- else }}
+// The version is written with the commit timestamp of tx, so that the
+// {{ .Name }} must be read again to be updated again.
+{{- end }}
+func ({{ $short }} *{{ .Name }}) UpdateWithVersionCheck(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "UpdateWithVersionCheck", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("UpdateWithVersionCheck", "{{ $table }}")()
+{{ end }}
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})
+	{{- end }}
+	cols := {{ .Name }}WritableColumns()
+	values, err := {{ $short }}.{{ $values }}(cols)
+	if err != nil {
+		return newErrorWithCode(codes.InvalidArgument, "UpdateWithVersionCheck", "{{ $table }}", err)
+	}
+	for i, col := range cols {
+		if col == "{{ colname $version.Col }}" {
+			{{- if eq $version.Type "int64" }}
+			values[i] = {{ $short }}.{{ $version.Name }} + 1
+			{{- else }}
+			values[i] = spanner.CommitTimestamp
+			{{- end }}
+		}
+	}
+
+	stmt := yoUpdateDML("{{ escapedtable $table }}", cols, values, {{ .Name }}PrimaryKeys())
+	stmt.SQL += " AND " + yoQuoteIdentifier("{{ colname $version.Col }}") + " = " + yoBindParam(stmt.Params, "version", {{ $short }}.{{ $version.Name }})
+
+	defer yoLogQuery(ctx, "UpdateWithVersionCheck", stmt)()
+	n, err := tx.Update(ctx, stmt)
+	if err != nil {
+		return newError("UpdateWithVersionCheck", "{{ $table }}", err)
+	}
+	if n == 0 {
+		return newErrorWithCode(codes.FailedPrecondition, "UpdateWithVersionCheck", "{{ $table }}", ErrStaleWrite)
+	}
+	{{- if eq $version.Type "int64" }}
+	{{ $short }}.{{ $version.Name }}++
+	{{- end }}
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.AfterUpdate(ctx, {{ $short }})
+	{{- end }}
+
+	return nil
+}
+{{- end }}
+{{- end }}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func ({{ $short }} *{{ .Name }}) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())
+	return spanner.InsertOrUpdate("{{ $table }}", {{ .Name }}WritableColumns(), values)
+}
+
+// {{ pluralize .Name }}Update returns Mutations to update the rows in
+// '{{ $table }}', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func {{ pluralize .Name }}Update(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// {{ pluralize .Name }}InsertOrUpdate returns Mutations to insert or update the
+// rows in '{{ $table }}', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func {{ pluralize .Name }}InsertOrUpdate(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+{{- if batchwrite }}
+
+// MutationGroup returns a MutationGroup to insert or update the {{ .Name }} with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func ({{ $short }} *{{ .Name }}) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{ {{- $short }}.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWrite{{ pluralize .Name }} inserts or updates rows in '{{ $table }}' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWrite{{ pluralize .Name }}(ctx context.Context, client *spanner.Client, rows []*{{ .Name }}) (map[int]error, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "BatchWrite{{ pluralize .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("BatchWrite{{ pluralize .Name }}", "{{ $table }}")()
+{{ end }}
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWrite{{ pluralize .Name }}", "{{ $table }}", groups)
+}
+{{- end }}
+
+// InsertOrUpdate{{ .Name }}AtLeastOnce inserts or updates row in '{{ $table }}' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdate{{ .Name }}AtLeastOnce(ctx context.Context, client *spanner.Client, row *{{ .Name }}) error {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "InsertOrUpdate{{ .Name }}AtLeastOnce", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("InsertOrUpdate{{ .Name }}AtLeastOnce", "{{ $table }}")()
+{{ end }}
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdate{{ .Name }}AtLeastOnce", "{{ $table }}", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func ({{ $short }} *{{ .Name }}) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})
+	{{- end }}
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, {{ .Name }}PrimaryKeys()...)
+
+	values, err := {{ $short }}.{{ $values }}(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "{{ .Name }}.UpdateColumns", "{{ $table }}", err)
+	}
+
+	return spanner.Update("{{ $table }}", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of '{{ $table }}' can be specified.
+func ({{ $short }} *{{ .Name }}) MutationForColumns(ctx context.Context, cols ...{{ .Name }}Column) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return {{ $short }}.UpdateColumns(ctx, names...)
+}
+
+// {{ .Name }}Tracker tracks the changes of a {{ .Name }}, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type {{ .Name }}Tracker struct {
+	row  *{{ .Name }}
+	orig {{ .Name }}
+}
+
+// Track{{ .Name }} returns a {{ .Name }}Tracker of row, which records the current
+// values of row, such as right after reading it.
+func Track{{ .Name }}(row *{{ .Name }}) *{{ .Name }}Tracker {
+	tr := &{{ .Name }}Tracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked {{ .Name }}.
+func (tr *{{ .Name }}Tracker) Row() *{{ .Name }} {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *{{ .Name }}Tracker) Reset() {
+	tr.orig = *tr.row
+{{- range .Fields }}
+	{{- if not (or .Col.IsPrimaryKey .Col.IsGenerated) }}
+	tr.orig.{{ .Name }} = yoClone(tr.row.{{ .Name }})
+	{{- end }}
+{{- end }}
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of '{{ $table }}'. The primary key columns and the
+// generated columns are never included.
+func (tr *{{ .Name }}Tracker) Changed() []{{ .Name }}Column {
+	var cols []{{ .Name }}Column
+{{- range .Fields }}
+	{{- if not (or .Col.IsPrimaryKey .Col.IsGenerated) }}
+	if !reflect.DeepEqual(tr.orig.{{ .Name }}, tr.row.{{ .Name }}) {
+		cols = append(cols, {{ $.Name }}Column{{ .Name }})
+	}
+	{{- end }}
+{{- end }}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *{{ .Name }}Tracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// Find{{ .Name }} gets a {{ .Name }} by primary key
+func Find{{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Find{{ .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Find{{ .Name }}", "{{ $table }}")()
+{{ end }}
+	key := spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }
+	row, err := yoReadRow(ctx, db, "{{ $table }}", key, {{ .Name }}Columns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("Find{{ .Name }}", "{{ $table }}", key, Err{{ .Name }}NotFound, nil)
+		}
+		return nil, newError("Find{{ .Name }}", "{{ $table }}", err)
+	}
+
+	{{ $short }}, err := Scan{{ .Name }}(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "Find{{ .Name }}", "{{ $table }}", err)
+	}
+	{{- if $softdelete }}
+	if {{ $short }}.IsDeleted() && !yoIncludesDeleted(ctx) {
+		return nil, newNotFoundError("Find{{ .Name }}", "{{ $table }}", key, Err{{ .Name }}NotFound, errors.New("row is soft-deleted"))
+	}
+	{{- end }}
+
+	return {{ $short }}, nil
+}
+{{- if $softdelete }}
+
+// Find{{ .Name }}IncludingDeleted gets a {{ .Name }} by primary key even if it is
+// soft-deleted.
+func Find{{ .Name }}IncludingDeleted(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {
+	return Find{{ .Name }}(YOIncludingDeleted(ctx), db{{ goparamlist .PrimaryKeyFields true false }}, opts...)
+}
+{{- end }}
+
+// {{ .Name }}Exists reports whether the row of the primary key exists in
+// '{{ $table }}', which reads only the primary key columns.
+func {{ .Name }}Exists(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (bool, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ .Name }}Exists", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ .Name }}Exists", "{{ $table }}")()
+{{ end }}
+	key := spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }
+	{{- if $softdelete }}
+	row, err := yoReadRow(ctx, db, "{{ $table }}", key, []string{"{{ colname $softdelete.Col }}"}, opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("{{ .Name }}Exists", "{{ $table }}", err)
+	}
+
+	var deletedAt spanner.NullTime
+	if err := row.Column(0, &deletedAt); err != nil {
+		return false, newErrorWithCode(codes.Internal, "{{ .Name }}Exists", "{{ $table }}", err)
+	}
+
+	return deletedAt.IsNull() || yoIncludesDeleted(ctx), nil
+	{{- else }}
+	if _, err := yoReadRow(ctx, db, "{{ $table }}", key, {{ .Name }}PrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("{{ .Name }}Exists", "{{ $table }}", err)
+	}
+
+	return true, nil
+	{{- end }}
+}
+
+// Read{{ .Name }} retrieves multiples rows from {{ .Name }} by KeySet as a slice.
+func Read{{ .Name }}(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Read{{ .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Read{{ .Name }}", "{{ $table }}")()
+{{ end }}
+	var res []*{{ .Name }}
+
+	{{- if $softdelete }}
+	includeDeleted := yoIncludesDeleted(ctx)
+	{{- end }}
+	rows := yoRead(ctx, db, "{{ $table }}", "", keys, {{ .Name }}Columns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		{{ $short }}, err := Scan{{ .Name }}(row)
+		if err != nil {
+			return err
+		}
+		{{- if $softdelete }}
+		if {{ $short }}.IsDeleted() && !includeDeleted {
+			return nil
+		}
+		{{- end }}
+		res = append(res, {{ $short }})
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "Read{{ .Name }}", "{{ $table }}", err)
+	}
+
+	return res, nil
+}
+{{- if $softdelete }}
+
+// Read{{ .Name }}IncludingDeleted retrieves multiples rows from {{ .Name }} by KeySet
+// as a slice, including the soft-deleted rows.
+func Read{{ .Name }}IncludingDeleted(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {
+	return Read{{ .Name }}(YOIncludingDeleted(ctx), db, keys, opts...)
+}
+
+// IsDeleted reports whether the {{ .Name }} is soft-deleted, where
+// '{{ colname $softdelete.Col }}' is not NULL.
+func ({{ $short }} *{{ .Name }}) IsDeleted() bool {
+	return {{ if eq $softdelete.Type "*time.Time" }}{{ $short }}.{{ $softdelete.Name }} != nil{{ else }}!{{ $short }}.{{ $softdelete.Name }}.IsNull(){{ end }}
+}
+{{- end }}
+
+// {{ .Name }}PrimaryKey is the primary key of '{{ $table }}'.
+type {{ .Name }}PrimaryKey struct {
+{{- range .PrimaryKeyFields }}
+	{{ .Name }} {{ if .CustomType }}{{ retype .CustomType }}{{ else }}{{ .Type }}{{ end }}
+{{- end }}
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to Read{{ .Name }}.
+func (k {{ .Name }}PrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{ {{- fieldnames .PrimaryKeyFields "k" -}} }
+}
+
+// Parse{{ .Name }}PrimaryKey parses key of the columns of the primary key of
+// '{{ $table }}' in order, such as the key of a mutation.
+func Parse{{ .Name }}PrimaryKey(key spanner.Key) ({{ .Name }}PrimaryKey, error) {
+	var k {{ .Name }}PrimaryKey
+	if len(key) != {{ len .PrimaryKeyFields }} {
+		return k, fmt.Errorf("key of '{{ $table }}' must have {{ len .PrimaryKeyFields }} columns, but got %d", len(key))
+	}
+{{- range $i, $f := .PrimaryKeyFields }}
+	v{{ $i }}, ok := key[{{ $i }}].({{ $f.Type }})
+	if !ok {
+		return k, fmt.Errorf("column '{{ colname $f.Col }}' of the key of '{{ $table }}' must be {{ $f.Type }}, but got %T", key[{{ $i }}])
+	}
+	k.{{ $f.Name }} = {{ if $f.CustomType }}{{ customvalue $f (print "v" $i) }}{{ else }}v{{ $i }}{{ end }}
+{{- end }}
+
+	return k, nil
+}
+{{- if not (hasfield .Fields "PrimaryKey") }}
+
+// PrimaryKey returns the primary key of {{ $short }}.
+func ({{ $short }} *{{ .Name }}) PrimaryKey() {{ .Name }}PrimaryKey {
+	return {{ .Name }}PrimaryKey{
+{{- range .PrimaryKeyFields }}
+		{{ .Name }}: {{ $short }}.{{ .Name }},
+{{- end }}
+	}
+}
+{{- end }}
+
+// Find{{ .Name }}ByKey gets a {{ .Name }} by the primary key of key.
+func Find{{ .Name }}ByKey(ctx context.Context, db YORODB, key {{ .Name }}PrimaryKey, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Find{{ .Name }}ByKey", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Find{{ .Name }}ByKey", "{{ $table }}")()
+{{ end }}
+	row, err := yoReadRow(ctx, db, "{{ $table }}", key.ToSpannerKey(), {{ .Name }}Columns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("Find{{ .Name }}ByKey", "{{ $table }}", key.ToSpannerKey(), Err{{ .Name }}NotFound, nil)
+		}
+		return nil, newError("Find{{ .Name }}ByKey", "{{ $table }}", err)
+	}
+
+	{{ $short }}, err := Scan{{ .Name }}(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "Find{{ .Name }}ByKey", "{{ $table }}", err)
+	}
+	{{- if $softdelete }}
+	if {{ $short }}.IsDeleted() && !yoIncludesDeleted(ctx) {
+		return nil, newNotFoundError("Find{{ .Name }}ByKey", "{{ $table }}", key.ToSpannerKey(), Err{{ .Name }}NotFound, errors.New("row is soft-deleted"))
+	}
+	{{- end }}
+
+	return {{ $short }}, nil
+}
+
+{{- if $softdelete }}
+// Delete{{ .Name }}ByKey returns a Mutation to soft-delete the row of key from
+// '{{ $table }}' by setting '{{ colname $softdelete.Col }}'.
+func Delete{{ .Name }}ByKey(ctx context.Context, key {{ .Name }}PrimaryKey) *spanner.Mutation {
+	cols := append({{ .Name }}PrimaryKeys(), "{{ colname $softdelete.Col }}")
+	values := append([]interface{}(key.ToSpannerKey()), {{ $deletedvalue }})
+	return spanner.Update("{{ $table }}", cols, values)
+}
+{{- else }}
+// Delete{{ .Name }}ByKey returns a Mutation to delete the row of key from
+// '{{ $table }}'.
+func Delete{{ .Name }}ByKey(ctx context.Context, key {{ .Name }}PrimaryKey) *spanner.Mutation {
+	return spanner.Delete("{{ $table }}", key.ToSpannerKey())
+}
+{{- end }}
+
+// Find{{ pluralize .Name }}ByKeys retrieves the rows of keys from '{{ $table }}' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func Find{{ pluralize .Name }}ByKeys(ctx context.Context, db YORODB, keys []{{ .Name }}PrimaryKey, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Find{{ pluralize .Name }}ByKeys", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Find{{ pluralize .Name }}ByKeys", "{{ $table }}")()
+{{ end }}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*{{ .Name }}, len(keys))
+	{{- if $softdelete }}
+	includeDeleted := yoIncludesDeleted(ctx)
+	{{- end }}
+	rows := yoRead(ctx, db, "{{ $table }}", "", spanner.KeySets(keySets...), {{ .Name }}Columns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		{{ $short }}, err := Scan{{ .Name }}(row)
+		if err != nil {
+			return err
+		}
+		{{- if $softdelete }}
+		if {{ $short }}.IsDeleted() && !includeDeleted {
+			return nil
+		}
+		{{- end }}
+		byKey[{{ $short }}.primaryKey().String()] = {{ $short }}
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "Find{{ pluralize .Name }}ByKeys", "{{ $table }}", err)
+	}
+
+	res := make([]*{{ .Name }}, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if {{ $short }}, ok := byKey[s]; ok {
+			res = append(res, {{ $short }})
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+{{- if .PageFields }}
+{{- $func := print "List" (pluralize .Name) "Page" }}
+
+// {{ $func }} retrieves a page of at most pageSize rows from '{{ $table }}'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func {{ $func }}(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, string, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ $func }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ $func }}", "{{ $table }}")()
+{{ end }}
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "{{ $func }}", "{{ $table }}", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		{{- range $i, $f := .PageFields }}
+		var k{{ $i }} {{ if $f.Field.CustomType }}{{ retype $f.Field.CustomType }}{{ else }}{{ $f.Field.Type }}{{ end }}
+		{{- end }}
+		if err := yoDecodePageToken(pageToken{{ range $i, $f := .PageFields }}, &k{{ $i }}{{ end }}); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "{{ $func }}", "{{ $table }}", err)
+		}
+		key = []interface{}{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ if $f.Field.CustomType }}{{ spanvalue $f.Field (print "k" $i) }}{{ else }}k{{ $i }}{{ end }}{{ end -}} }
+	}
+	stmt := yoPageStatement("SELECT {{ escapedcolnames .Fields }} FROM {{ escapedtable $table }}",
+		[]string{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}"{{ escapedcolname $f.Field.Col }}"{{ end -}} },
+		[]bool{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ $f.Desc }}{{ end -}} },
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "{{ $func }}", stmt)()
+	res, err := Scan{{ pluralize .Name }}(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("{{ $func }}", "{{ $table }}", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken({{ range $i, $f := .PageFields }}{{ if $i }}, {{ end }}last.{{ $f.Field.Name }}{{ end }})
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "{{ $func }}", "{{ $table }}", err)
+	}
+
+	return res, token, nil
+}
+{{- end }}
+{{- range (keyprefixes .PrimaryKeyFields) }}
+{{- $funcName := print "Read" $.Name "By" }}
+{{- range . }}{{ $funcName = print $funcName .Name }}{{ end }}
+
+// {{ $funcName }} retrieves multiples rows from {{ $.Name }} whose primary key
+// starts with the given key columns as a slice.
+func {{ $funcName }}(ctx context.Context, db YORODB{{ gocustomparamlist . true true }}, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ $funcName }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ $funcName }}", "{{ $table }}")()
+{{ end }}
+	var res []*{{ $.Name }}
+
+	keys := spanner.Key{ {{ gocustomparamlist . false false }} }.AsPrefix()
+	rows := yoRead(ctx, db, "{{ $table }}", "", keys, {{ $.Name }}Columns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		{{ $short }}, err := Scan{{ $.Name }}(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, {{ $short }})
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "{{ $funcName }}", "{{ $table }}", err)
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- range (keyranges $) }}
+{{- $col := .Column.Field }}
+{{- $typ := retype $col.Type }}{{ if $col.CustomType }}{{ $typ = retype $col.CustomType }}{{ end }}
+
+// {{ .Name }} retrieves multiple rows from {{ $.Name }} whose
+// '{{ colname $col.Col }}' is between start and end as a slice.
+{{- if .Prefix }}
+// The rows are limited to the ones whose primary key starts with the given
+// key columns.
+{{- end }}
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+{{- if .Column.Desc }}
+// The rows are in the descending order of '{{ colname $col.Col }}', so that
+// start must not be less than end.
+{{- end }}
+func {{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .Prefix true true }}, start, end {{ $typ }}, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ .Name }}", "{{ $table }}")()
+{{ end }}
+	var res []*{{ $.Name }}
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{ {{- gocustomparamlist .Prefix false false }}{{ if .Prefix }}, {{ end }}{{ if $col.CustomType }}{{ spanvalue $col "start" }}{{ else }}start{{ end -}} },
+		End:   spanner.Key{ {{- gocustomparamlist .Prefix false false }}{{ if .Prefix }}, {{ end }}{{ if $col.CustomType }}{{ spanvalue $col "end" }}{{ else }}end{{ end -}} },
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "{{ $table }}", "", keys, {{ $.Name }}Columns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		{{ $short }}, err := Scan{{ $.Name }}(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, {{ $short }})
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "{{ .Name }}", "{{ $table }}", err)
+	}
+
+	return res, nil
+}
+{{- end }}
+{{ end }}
+
+// primaryKey returns the key of the {{ .Name }}, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func ({{ $short }} *{{ .Name }}) primaryKey() spanner.Key {
+	return spanner.Key{ {{- fieldnames .PrimaryKeyFields $short -}} }
+}
+
+{{ if $softdelete -}}
+// Delete soft-deletes the {{ .Name }} by setting '{{ colname $softdelete.Col }}', so that the
+// generated reads filter it out by default.
+func ({{ $short }} *{{ .Name }}) Delete(ctx context.Context) *spanner.Mutation {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})
+	{{- end }}
+	cols := append({{ .Name }}PrimaryKeys(), "{{ colname $softdelete.Col }}")
+	values, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())
+	values = append(values, {{ $deletedvalue }})
+	return spanner.Update("{{ $table }}", cols, values)
+}
+{{- if dml }}
+
+// DeleteDML returns a DML statement to soft-delete the {{ .Name }} by setting
+// '{{ colname $softdelete.Col }}', which is run by the Update of a read-write transaction.
+func ({{ $short }} *{{ .Name }}) DeleteDML(ctx context.Context) spanner.Statement {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})
+	{{- end }}
+	cols := append({{ .Name }}PrimaryKeys(), "{{ colname $softdelete.Col }}")
+	values, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())
+	values = append(values, {{ $deletedvalue }})
+	return yoUpdateDML("{{ escapedtable $table }}", cols, values, {{ .Name }}PrimaryKeys())
+}
+{{- end }}
+
+{{ end -}}
+// {{ $delete }} deletes the {{ .Name }} from the database.
+func ({{ $short }} *{{ .Name }}) {{ $delete }}(ctx context.Context) *spanner.Mutation {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})
+	{{- end }}
+	return spanner.Delete("{{ $table }}", {{ $short }}.primaryKey())
+}
+{{- if dml }}
+
+// {{ $delete }}DML returns a DML statement to delete the {{ .Name }}, which is run by
+// the Update of a read-write transaction.
+func ({{ $short }} *{{ .Name }}) {{ $delete }}DML(ctx context.Context) spanner.Statement {
+	{{- if hooks }}
+	{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})
+	{{- end }}
+	values, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())
+	return yoDeleteDML("{{ escapedtable $table }}", {{ .Name }}PrimaryKeys(), values)
+}
+{{- end }}
+
+// {{ pluralize .Name }}Delete returns Mutations to delete the rows from
+// '{{ $table }}', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func {{ pluralize .Name }}Delete(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+{{- if .InterleavedTables }}
+
+// DeleteKeyRange deletes the {{ .Name }} by the key range of its primary key.
+// If includeChildren is true, the rows of the interleaved tables under the
+// {{ .Name }} are deleted explicitly as well.
+func ({{ $short }} *{{ .Name }}) DeleteKeyRange(ctx context.Context, includeChildren bool) []*spanner.Mutation {
+	key := {{ $short }}.primaryKey()
+	kr := spanner.KeyRange{
+		Start: key,
+		End:   key,
+		Kind:  spanner.ClosedClosed,
+	}
+
+	var ms []*spanner.Mutation
+	if includeChildren {
+		ms = append(ms,
+{{- range .InterleavedTables }}
+			spanner.Delete("{{ . }}", kr),
+{{- end }}
+		)
+	}
+	return append(ms, spanner.Delete("{{ $table }}", kr))
+}
+{{- end }}
+{{- if .Parent }}
+{{- $parent := .Parent.Name }}
+{{- $pshort := (shortname $parent "err" "res" "sqlstr" "db" "YOLog") }}
+
+// {{ pluralize .Name }}KeyRange returns the key range of the rows of
+// '{{ $table }}' interleaved in the {{ $parent }}.
+func ({{ $pshort }} *{{ $parent }}) {{ pluralize .Name }}KeyRange() spanner.KeyRange {
+	return {{ $pshort }}.primaryKey().AsPrefix()
+}
+
+// List{{ pluralize .Name }} retrieves the rows of '{{ $table }}' interleaved in
+// the {{ $parent }} as a slice.
+func ({{ $pshort }} *{{ $parent }}) List{{ pluralize .Name }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "List{{ pluralize .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("List{{ pluralize .Name }}", "{{ $table }}")()
+{{ end }}
+	var res []*{{ .Name }}
+
+	rows := yoRead(ctx, db, "{{ $table }}", "", {{ $pshort }}.{{ pluralize .Name }}KeyRange(), {{ .Name }}Columns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		{{ $short }}, err := Scan{{ .Name }}(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, {{ $short }})
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "List{{ pluralize .Name }}", "{{ $table }}", err)
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- range .ForeignKeys }}
+{{- $ref := .RefType.Name }}
+{{- $reftable := .RefType.Table.TableName }}
+{{- $rshort := (shortname $ref "err" "res" "sqlstr" "db" "YOLog") }}
+{{- $origin := print "foreign key (" (colnames .Fields) ")" }}
+{{- if .ForeignKey.ConstraintName }}{{ $origin = print "foreign key '" .ForeignKey.ConstraintName "'" }}{{ end }}
+
+// {{ .FetchName }} retrieves the row of '{{ $reftable }}' referenced by the
+// {{ $.Name }} as a {{ $ref }}.
+//
+// If no row is present, including when the referencing columns are NULL, then
+// an error is returned where errors.Is(err, ErrNotFound) is true.
+//
+// Generated from {{ $origin }}.
+func ({{ $short }} *{{ $.Name }}) {{ .FetchName }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (*{{ $ref }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ .FetchName }}", "{{ $reftable }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ .FetchName }}", "{{ $reftable }}")()
+{{ end }}
+	const sqlstr = "SELECT " +
+		"{{ escapedcolnames .RefType.Fields }} " +
+		"FROM {{ escapedtable $reftable }} " +
+		"WHERE {{ colnamesquery .RefFields " AND " }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .Fields }}
+	{{- if $f.CustomType }}
+	stmt.Params["{{ paramname $i }}"] = {{ spanvalue $f (print $short "." $f.Name) }}
+	{{- else }}
+	stmt.Params["{{ paramname $i }}"] = {{ $short }}.{{ $f.Name }}
+	{{- end }}
+	{{- end }}
+
+	// run query
+	defer yoLogQuery(ctx, "{{ .FetchName }}", stmt{{ range .Fields }}, {{ $short }}.{{ .Name }}{{ end }})()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("{{ .FetchName }}", "{{ $reftable }}", spanner.Key{ {{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}{{ $short }}.{{ $f.Name }}{{ end -}} }, Err{{ $ref }}NotFound, nil)
+		}
+		return nil, newError("{{ .FetchName }}", "{{ $reftable }}", err)
+	}
+
+	res, err := Scan{{ $ref }}(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "{{ .FetchName }}", "{{ $reftable }}", err)
+	}
+
+	return res, nil
+}
+
+// {{ .ListName }} retrieves the rows of '{{ $table }}' referencing the
+// {{ $ref }} as a slice.
+//
+// Generated from {{ $origin }}.
+func ({{ $rshort }} *{{ $ref }}) {{ .ListName }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "{{ .ListName }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("{{ .ListName }}", "{{ $table }}")()
+{{ end }}
+	const sqlstr = "SELECT " +
+		"{{ escapedcolnames $.Fields }} " +
+		"FROM {{ escapedtable $table }} " +
+		"WHERE {{ colnamesquery .Fields " AND " }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .RefFields }}
+	{{- if $f.CustomType }}
+	stmt.Params["{{ paramname $i }}"] = {{ spanvalue $f (print $rshort "." $f.Name) }}
+	{{- else }}
+	stmt.Params["{{ paramname $i }}"] = {{ $rshort }}.{{ $f.Name }}
+	{{- end }}
+	{{- end }}
+
+	// run query
+	defer yoLogQuery(ctx, "{{ .ListName }}", stmt{{ range .RefFields }}, {{ $rshort }}.{{ .Name }}{{ end }})()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ $.Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("{{ .ListName }}", "{{ $table }}", err)
+		}
+
+		{{ $short }}, err := Scan{{ $.Name }}(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "{{ .ListName }}", "{{ $table }}", err)
+		}
+
+		res = append(res, {{ $short }})
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- if or .Table.IsView (not .PrimaryKeyFields) }}
+
+// QueryRead{{ .Name }} retrieves all rows from '{{ $table }}' as a slice.
+func QueryRead{{ .Name }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "QueryRead{{ .Name }}", "{{ $table }}", "")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("QueryRead{{ .Name }}", "{{ $table }}")()
+{{ end }}
+	const sqlstr = "SELECT " +
+		"{{ escapedcolnames .Fields }} " +
+		"FROM {{ escapedtable $table }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+
+	// run query
+	defer yoLogQuery(ctx, "QueryRead{{ .Name }}", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ .Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("QueryRead{{ .Name }}", "{{ $table }}", err)
+		}
+
+		{{ $short }}, err := Scan{{ .Name }}(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "QueryRead{{ .Name }}", "{{ $table }}", err)
+		}
+
+		res = append(res, {{ $short }})
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- range .SearchIndexes }}
+
+// Search{{ .FuncName }} retrieves rows from '{{ $table }}' whose {{ .Column }} matches
+// the search query as a slice of {{ $.Name }}. The query is in the raw search
+// query syntax of SEARCH.
+{{- if .Score }}
+// The rows are ordered by the relevance to the query given by SCORE.
+{{- end }}
+//
+// Generated from search index '{{ .Index.IndexName }}'.
+func Search{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .PartitionFields true true }}, query string, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Search{{ .FuncName }}", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Search{{ .FuncName }}", "{{ $table }}")()
+{{ end }}
+	const sqlstr = "SELECT " +
+		"{{ escapedcolnames $.Fields }} " +
+		"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} " +
+		"WHERE {{ if .PartitionFields }}{{ colnamesquery .PartitionFields " AND " }} AND {{ end }}SEARCH({{ .Column }}, @query)"{{ if .Score }} +
+		" ORDER BY SCORE({{ .Column }}, @query) DESC"{{ end }}
+
+	stmt := spanner.NewStatement(sqlstr)
+	{{- range $i, $f := .PartitionFields }}
+		{{- if $f.CustomType }}
+	stmt.Params["{{ paramname $i }}"] = {{ spanvalue $f (goparamname $f.Name) }}
+		{{- else }}
+	stmt.Params["{{ paramname $i }}"] = {{ goparamname $f.Name }}
+		{{- end }}
+	{{- end }}
+	stmt.Params["query"] = query
+
+	// run query
+	defer yoLogQuery(ctx, "Search{{ .FuncName }}", stmt{{ goparamlist .PartitionFields true false }}, query)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ $.Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("Search{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		{{ $short }}, err := Scan{{ $.Name }}(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "Search{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		res = append(res, {{ $short }})
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- range .VectorIndexes }}
+
+// Nearest{{ .FuncName }} retrieves the k rows from '{{ $table }}' whose {{ .Index.ColumnName }}
+// is approximately nearest to vector by {{ .DistanceFunc }} as a slice of
+// {{ $.Name }}, nearest first. The Limit of opts is ignored.
+//
+// Generated from vector index '{{ .Index.IndexName }}'.
+func Nearest{{ .FuncName }}(ctx context.Context, db YORODB, vector {{ .VectorType }}, k int, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {
+	{{- if otel }}
+	ctx, span := yoStartSpan(ctx, "Nearest{{ .FuncName }}", "{{ $table }}", "{{ .Index.IndexName }}")
+	defer span.End()
+{{ end }}
+	{{- if metrics }}
+	defer yoObserve("Nearest{{ .FuncName }}", "{{ $table }}")()
+{{ end }}
+	{{- if .Field.Col.VectorLength }}
+	if len(vector) != {{ .Field.Col.VectorLength }} {
+		return nil, newErrorWithCode(codes.InvalidArgument, "Nearest{{ .FuncName }}", "{{ $table }}", fmt.Errorf("vector length %d, must be {{ .Field.Col.VectorLength }}", len(vector)))
+	}
+	{{- end }}
+	if k <= 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "Nearest{{ .FuncName }}", "{{ $table }}", fmt.Errorf("k %d, must be positive", k))
+	}
+
+	const sqlstr = "SELECT " +
+		"{{ escapedcolnames $.Fields }} " +
+		"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} " +
+		{{- if not .Field.Col.NotNull }}
+		"WHERE {{ .Index.ColumnName }} IS NOT NULL " +
+		{{- end }}
+		"ORDER BY {{ .DistanceFunc }}({{ .Index.ColumnName }}, @vector, options => JSON '{\"num_leaves_to_search\": {{ .NumLeavesToSearch }}}'){{ if .Desc }} DESC{{ end }}"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["vector"] = vector
+
+	// the rows are limited to k by LIMIT following ORDER BY
+	o := &spanner.ReadOptions{Limit: k}
+	if ro := yoReadOptions(opts); ro != nil {
+		o.Priority, o.RequestTag = ro.Priority, ro.RequestTag
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "Nearest{{ .FuncName }}", stmt, vector, k)()
+	iter := yoQuery(ctx, db, stmt, []*spanner.ReadOptions{o})
+	defer iter.Stop()
+
+	// load results
+	res := []*{{ $.Name }}{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("Nearest{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		{{ $short }}, err := Scan{{ $.Name }}(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "Nearest{{ .FuncName }}", "{{ $table }}", err)
+		}
+
+		res = append(res, {{ $short }})
+	}
+
+	return res, nil
+}
+{{- end }}
+{{- if .ChangeStreams }}
+
+// {{ .Name }}Change is a change of a row of '{{ $table }}' in a data change
+// record of a change stream. Keys has only the primary key. NewValues and
+// OldValues have the primary key and the columns captured by the value capture
+// type of the change stream, and they are nil if no value is captured.
+type {{ .Name }}Change struct {
+	ModType         string
+	CommitTimestamp time.Time
+	Keys            *{{ .Name }}
+	NewValues       *{{ .Name }}
+	OldValues       *{{ .Name }}
+}
+
+// changeTypes{{ .Name }} is the Spanner types of the columns of '{{ $table }}',
+// which decode the values in the data change records.
+var changeTypes{{ .Name }} = map[string]string{
+{{- range .Fields }}
+	"{{ .Col.ColumnName }}": "{{ .Col.DataType }}",
+{{- end }}
+}
+
+// Decode{{ .Name }}Changes decodes the changes of the rows in the data change
+// record of '{{ $table }}'. It returns an error if the record is of another
+// table.
+func Decode{{ .Name }}Changes(rec *YODataChangeRecord) ([]*{{ .Name }}Change, error) {
+	if rec.TableName != "{{ $table }}" {
+		return nil, newErrorWithCode(codes.InvalidArgument, "Decode{{ .Name }}Changes", "{{ $table }}", fmt.Errorf("data change record of table %s", rec.TableName))
+	}
+
+	res := make([]*{{ .Name }}Change, 0, len(rec.Mods))
+	for _, mod := range rec.Mods {
+		change := &{{ .Name }}Change{ModType: rec.ModType, CommitTimestamp: rec.CommitTimestamp}
+
+		var err error
+		if change.Keys, err = decode{{ .Name }}Change(mod.Keys, nil); err != nil {
+			return nil, newErrorWithCode(codes.InvalidArgument, "Decode{{ .Name }}Changes", "{{ $table }}", err)
+		}
+		if change.NewValues, err = decode{{ .Name }}Change(mod.NewValues, mod.Keys); err != nil {
+			return nil, newErrorWithCode(codes.InvalidArgument, "Decode{{ .Name }}Changes", "{{ $table }}", err)
+		}
+		if change.OldValues, err = decode{{ .Name }}Change(mod.OldValues, mod.Keys); err != nil {
+			return nil, newErrorWithCode(codes.InvalidArgument, "Decode{{ .Name }}Changes", "{{ $table }}", err)
+		}
+		res = append(res, change)
+	}
+
+	return res, nil
+}
+
+// decode{{ .Name }}Change decodes the column values of a mod merged with the
+// keys. It returns nil if no value is captured.
+func decode{{ .Name }}Change(values, keys json.RawMessage) (*{{ .Name }}, error) {
+	row, err := yoChangeRow(changeTypes{{ .Name }}, values, keys)
+	if err != nil || row == nil {
+		return nil, err
+	}
+
+	return Scan{{ .Name }}(row)
+}
+{{- end }}
+{{- if and (not .Table.IsView) .PrimaryKeyFields (ne (fieldnames .Fields $short .PrimaryKeyFields) "") }}
+
+// {{ .Name }}Descriptor describes '{{ $table }}' for YORepository.
+var {{ .Name }}Descriptor = &YODescriptor[{{ .Name }}, {{ .Name }}PrimaryKey]{
+	Table:       "{{ $table }}",
+	Columns:     {{ .Name }}Columns(),
+	PrimaryKeys: {{ .Name }}PrimaryKeys(),
+	Key: func(row *{{ .Name }}) {{ .Name }}PrimaryKey {
+		return {{ .Name }}PrimaryKey{
+{{- range .PrimaryKeyFields }}
+			{{ .Name }}: row.{{ .Name }},
+{{- end }}
+		}
+	},
+	SpannerKey: {{ .Name }}PrimaryKey.ToSpannerKey,
+	Scan:       Scan{{ .Name }},
+	Find:       Find{{ .Name }}ByKey,
+	Read:       Read{{ .Name }},
+	Insert: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// New{{ .Name }}Repository returns a YORepository of '{{ $table }}' by client.
+func New{{ .Name }}Repository(client *spanner.Client) *YORepository[{{ .Name }}, {{ .Name }}PrimaryKey] {
+	return NewYORepository(client, {{ .Name }}Descriptor)
+}
+{{- end }}
+{{- if and stores (not .Table.IsView) .PrimaryKeyFields (ne (fieldnames .Fields $short .PrimaryKeyFields) "") }}
+
+// {{ .Name }}Store is the reads and writes of the rows of '{{ $table }}', which
+// are implemented by {{ .Name }}ClientStore. Services can depend on it instead of
+// the generated functions to mock it in their tests.
+type {{ .Name }}Store interface {
+	// Find gets a {{ .Name }} by primary key.
+	Find(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (*{{ .Name }}, error)
+
+	// Read retrieves multiple rows by keys.
+	Read(ctx context.Context, keys spanner.KeySet) ([]*{{ .Name }}, error)
+
+	// Exists reports whether the row of the primary key exists.
+	Exists(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (bool, error)
+{{- range .Indexes }}
+
+	// Find{{ .FuncName }} is Find{{ .FuncName }} of index '{{ .Index.IndexName }}'.
+	{{- if .Index.IsUnique }}
+	Find{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) (*{{ $.Name }}, error)
+	{{- else }}
+	Find{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) ([]*{{ $.Name }}, error)
+	{{- end }}
+{{- end }}
+
+	// Insert inserts row.
+	Insert(ctx context.Context, row *{{ .Name }}) error
+
+	// Update updates row.
+	Update(ctx context.Context, row *{{ .Name }}) error
+
+	// InsertOrUpdate inserts row, or updates it if it already exists.
+	InsertOrUpdate(ctx context.Context, row *{{ .Name }}) error
+
+	// Delete deletes row.
+	Delete(ctx context.Context, row *{{ .Name }}) error
+}
+
+// {{ .Name }}ClientStore is {{ .Name }}Store by a Spanner client. The reads are
+// strong reads by single-use read-only transactions, and the writes are
+// applied by single mutations.
+type {{ .Name }}ClientStore struct {
+	client *spanner.Client
+}
+
+var _ {{ .Name }}Store = (*{{ .Name }}ClientStore)(nil)
+
+// New{{ .Name }}Store returns {{ .Name }}ClientStore by client.
+func New{{ .Name }}Store(client *spanner.Client) *{{ .Name }}ClientStore {
+	return &{{ .Name }}ClientStore{client: client}
+}
+
+func (store *{{ .Name }}ClientStore) Find(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (*{{ .Name }}, error) {
+	return Find{{ .Name }}(ctx, store.client.Single(){{ goparamlist .PrimaryKeyFields true false }})
+}
+
+func (store *{{ .Name }}ClientStore) Read(ctx context.Context, keys spanner.KeySet) ([]*{{ .Name }}, error) {
+	return Read{{ .Name }}(ctx, store.client.Single(), keys)
+}
+
+func (store *{{ .Name }}ClientStore) Exists(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (bool, error) {
+	return {{ .Name }}Exists(ctx, store.client.Single(){{ goparamlist .PrimaryKeyFields true false }})
+}
+{{- range .Indexes }}
+
+{{- if .Index.IsUnique }}
+
+func (store *{{ $.Name }}ClientStore) Find{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) (*{{ $.Name }}, error) {
+{{- else }}
+
+func (store *{{ $.Name }}ClientStore) Find{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) ([]*{{ $.Name }}, error) {
+{{- end }}
+	return Find{{ .FuncName }}(ctx, store.client.Single(){{ goparamlist .Fields true false }})
+}
+{{- end }}
+
+func (store *{{ .Name }}ClientStore) Insert(ctx context.Context, row *{{ .Name }}) error {
+	return store.apply(ctx, "Insert", row.Insert(ctx))
+}
+
+func (store *{{ .Name }}ClientStore) Update(ctx context.Context, row *{{ .Name }}) error {
+	return store.apply(ctx, "Update", row.Update(ctx))
+}
+
+func (store *{{ .Name }}ClientStore) InsertOrUpdate(ctx context.Context, row *{{ .Name }}) error {
+	return store.apply(ctx, "InsertOrUpdate", row.InsertOrUpdate(ctx))
+}
+
+func (store *{{ .Name }}ClientStore) Delete(ctx context.Context, row *{{ .Name }}) error {
+	return store.apply(ctx, "Delete", row.Delete(ctx))
+}
+
+// apply applies m by the client, and returns the error of method if any.
+func (store *{{ .Name }}ClientStore) apply(ctx context.Context, method string, m *spanner.Mutation) error {
+	if _, err := store.client.Apply(ctx, []*spanner.Mutation{m}); err != nil {
+		return newError(method, "{{ $table }}", err)
+	}
+
+	return nil
+}
+{{- end }}
@@ -0,0 +1,98 @@
+{{- if and (not .Table.IsView) .PrimaryKeyFields -}}
+{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "YOLog" "t" "client" "ctx" "ms" "dels" "key" "row" "read" "cols" "want" "got" "i" "col" "p" "values") -}}
+{{- $table := (.Table.TableName) -}}
+{{- $committs := (committsfields .Fields) }}
+{{- $identity := false }}{{ range .Fields }}{{ if or .Col.IsIdentity .Col.Sequence }}{{ $identity = true }}{{ end }}{{ end -}}
+// testValue{{ .Name }} returns a {{ .Name }} whose columns are filled by
+// non-null values except the generated columns and the custom types.
+func testValue{{ .Name }}() *{{ .Name }} {
+	return &{{ .Name }}{
+{{- range .Fields }}
+{{- $value := testvalue . }}
+{{- if $value }}
+		{{ .Name }}: {{ $value }},
+{{- end }}
+{{- end }}
+	}
+}
+{{ if not (orphan .) }}
+// testRoundTrip{{ .Name }} inserts {{ $short }} into '{{ $table }}' by its Insert mutation
+// and asserts that the columns are read back as they are written. The rows
+// are deleted at the end of the test. It can be called by fuzz tests with
+// arbitrary values.
+{{- if ancestors . }}
+//
+// The rows of the tables which '{{ $table }}' is interleaved in are inserted
+// by the test values with the primary key of {{ $short }}.
+{{- end }}
+func testRoundTrip{{ .Name }}(t *testing.T, client *spanner.Client, {{ $short }} *{{ .Name }}) {
+	t.Helper()
+	ctx := context.Background()
+
+	// dels deletes the interleaved rows before their parents
+	var ms, dels []*spanner.Mutation
+{{- range ancestors . }}
+	{
+		p := testValue{{ .Name }}()
+{{- range .PrimaryKeyFields }}
+		p.{{ .Name }} = {{ $short }}.{{ .Name }}
+{{- end }}
+		values, _ := p.columnsToValues({{ .Name }}WritableColumns())
+		ms = append(ms, spanner.Insert("{{ .Table.TableName }}", {{ .Name }}WritableColumns(), values))
+		dels = append([]*spanner.Mutation{p.Delete(ctx)}, dels...)
+	}
+{{- end }}
+	ms = append(ms, {{ $short }}.Insert{{ if $identity }}WithID{{ end }}(ctx))
+	dels = append([]*spanner.Mutation{ {{- $short }}.Delete(ctx)}, dels...)
+	if _, err := client.Apply(ctx, ms); err != nil {
+		t.Fatalf("failed to insert into '{{ $table }}': %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := client.Apply(ctx, dels); err != nil {
+			t.Errorf("failed to delete from '{{ $table }}': %v", err)
+		}
+	})
+
+	key := {{ $short }}.primaryKey()
+	row, err := client.Single().ReadRow(ctx, "{{ $table }}", key, {{ .Name }}Columns())
+	if err != nil {
+		t.Fatalf("failed to read from '{{ $table }}': %v", err)
+	}
+	read, err := Scan{{ .Name }}(row)
+	if err != nil {
+		t.Fatalf("failed to decode the row of '{{ $table }}': %v", err)
+	}
+
+	// the generated columns are not compared
+	cols := {{ .Name }}WritableColumns()
+	want, err := {{ $short }}.columnsToValues(cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := read.columnsToValues(cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, col := range cols {
+{{- if and autocommitts $committs }}
+		switch col {
+		case {{ range $i, $f := $committs }}{{ if $i }}, {{ end }}"{{ colname $f.Col }}"{{ end }}:
+			// the commit timestamps are written by Cloud Spanner
+			continue
+		}
+{{- end }}
+		if !yoTestEqual(want[i], got[i]) {
+			t.Errorf("column %s of '{{ $table }}': want %v, but got %v", col, want[i], got[i])
+		}
+	}
+}
+{{ end }}
+func Test{{ .Name }}RoundTrip(t *testing.T) {
+{{- if orphan . }}
+	t.Skip("a table which '{{ $table }}' is interleaved in is not generated in this package")
+{{- else }}
+	client := yoTestClient(t)
+	testRoundTrip{{ .Name }}(t, client, testValue{{ .Name }}())
+{{- end }}
+}
+{{- end }}
@@ -0,0 +1,80 @@
+// yoTestNewClient creates the client of the round-trip tests if it is set by
+// a test file of the package. The client is created from the database given
+// by YO_TEST_DATABASE otherwise.
+var yoTestNewClient func(ctx context.Context) (*spanner.Client, error)
+
+// yoTestClient returns a client of the database for the round-trip tests. The
+// test is skipped if no database is configured. SPANNER_EMULATOR_HOST is
+// respected to test against the emulator.
+func yoTestClient(t *testing.T) *spanner.Client {
+	t.Helper()
+	ctx := context.Background()
+
+	newClient := yoTestNewClient
+	if newClient == nil {
+		db := os.Getenv("YO_TEST_DATABASE")
+		if db == "" {
+			t.Skip("YO_TEST_DATABASE is not set")
+		}
+		newClient = func(ctx context.Context) (*spanner.Client, error) {
+			return spanner.NewClient(ctx, db)
+		}
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+// yoTestEqual reports whether the column values written and read back are
+// equal. Times, intervals and numbers are compared by their values rather than their
+// representations.
+func yoTestEqual(want, got interface{}) bool {
+	switch w := want.(type) {
+	case time.Time:
+		g, ok := got.(time.Time)
+		return ok && w.Equal(g)
+	case spanner.NullTime:
+		g, ok := got.(spanner.NullTime)
+		return ok && w.Valid == g.Valid && w.Time.Equal(g.Time)
+	case big.Rat:
+		g, ok := got.(big.Rat)
+		return ok && w.Cmp(&g) == 0
+	case spanner.NullNumeric:
+		g, ok := got.(spanner.NullNumeric)
+		return ok && w.Valid == g.Valid && w.Numeric.Cmp(&g.Numeric) == 0
+	case spanner.Interval:
+		g, ok := got.(spanner.Interval)
+		return ok && w.String() == g.String()
+	case spanner.NullInterval:
+		g, ok := got.(spanner.NullInterval)
+		return ok && w.Valid == g.Valid && w.Interval.String() == g.Interval.String()
+	}
+
+	wv, gv := reflect.ValueOf(want), reflect.ValueOf(got)
+	if wv.Kind() == reflect.Ptr && gv.Kind() == reflect.Ptr && wv.Type() == gv.Type() {
+		// the nullable columns of pointers
+		if wv.IsNil() || gv.IsNil() {
+			return wv.IsNil() == gv.IsNil()
+		}
+		return yoTestEqual(wv.Elem().Interface(), gv.Elem().Interface())
+	}
+	if wv.Kind() == reflect.Slice && gv.Kind() == reflect.Slice && wv.Type() == gv.Type() {
+		// an empty array may be read back as nil
+		if wv.Len() != gv.Len() {
+			return false
+		}
+		for i := 0; i < wv.Len(); i++ {
+			if !yoTestEqual(wv.Index(i).Interface(), gv.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(want, got)
+}
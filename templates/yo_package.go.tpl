@@ -1,14 +1,48 @@
+{{- if .Header -}}
+{{ .Header }}
+
+{{ else -}}
 // Code generated by yo. DO NOT EDIT.
 // Package {{ .Package }} contains the types.
+{{ end -}}
 package {{ .Package }}
 
 import (
+{{- if .ChangeStreams }}
+	"bytes"
+{{- end }}
 	"context"
+{{- if .ChangeStreams }}
+	"encoding/json"
+{{- end }}
 	"errors"
 	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"time"
 
 	"cloud.google.com/go/spanner"
+{{- if or batchwrite .ChangeStreams }}
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
+{{- end }}
+{{- if metrics }}
+	"github.com/prometheus/client_golang/prometheus"
+{{- end }}
+{{- if otel }}
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+{{- end }}
 	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+{{- if .ChangeStreams }}
+	"google.golang.org/protobuf/types/known/structpb"
+{{- end }}
+{{- if .Imports }}
+{{ range .Imports }}
+	{{ .Alias }} "{{ .Path }}"
+{{- end }}
+{{- end }}
 )
@@ -261,7 +261,7 @@ func TestDefaultCompositePrimaryKey(t *testing.T) {
 	})
 
 	t.Run("ReadByError3", func(t *testing.T) {
-		got, err := models.ReadCompositePrimaryKeysByZYError(ctx, client.Single(), spanner.Key{cpk.Error})
+		got, err := models.ReadCompositePrimaryKeysByYZError(ctx, client.Single(), spanner.Key{cpk.Error})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -337,20 +337,20 @@ func TestDefaultFullType(t *testing.T) {
 				},
 				FTJSON:               json,
 				FTJSONNull:           json,
-				FTArrayStringNull:    []string{"xxx1", "yyy1"},
-				FTArrayString:        []string{"xxx1", "yyy1"},
-				FTArrayBoolNull:      []bool{true, false},
-				FTArrayBool:          []bool{true, false},
+				FTArrayStringNull:    []spanner.NullString{{StringVal: "xxx1", Valid: true}, {StringVal: "yyy1", Valid: true}},
+				FTArrayString:        []spanner.NullString{{StringVal: "xxx1", Valid: true}, {StringVal: "yyy1", Valid: true}},
+				FTArrayBoolNull:      []spanner.NullBool{{Bool: true, Valid: true}, {Bool: false, Valid: true}},
+				FTArrayBool:          []spanner.NullBool{{Bool: true, Valid: true}, {Bool: false, Valid: true}},
 				FTArrayBytesNull:     [][]byte{[]byte("xxx1"), []byte("yyy1")},
 				FTArrayBytes:         [][]byte{[]byte("xxx1"), []byte("yyy1")},
-				FTArrayTimestampNull: []time.Time{now, tomorrow},
-				FTArrayTimestamp:     []time.Time{now, tomorrow},
-				FTArrayIntNull:       []int64{100, 200},
-				FTArrayInt:           []int64{100, 200},
-				FTArrayFloatNull:     []float64{0.111, 0.222},
-				FTArrayFloat:         []float64{0.111, 0.222},
-				FTArrayDateNull:      []civil.Date{date, nextdate},
-				FTArrayDate:          []civil.Date{date, nextdate},
+				FTArrayTimestampNull: []spanner.NullTime{{Time: now, Valid: true}, {Time: tomorrow, Valid: true}},
+				FTArrayTimestamp:     []spanner.NullTime{{Time: now, Valid: true}, {Time: tomorrow, Valid: true}},
+				FTArrayIntNull:       []spanner.NullInt64{{Int64: 100, Valid: true}, {Int64: 200, Valid: true}},
+				FTArrayInt:           []spanner.NullInt64{{Int64: 100, Valid: true}, {Int64: 200, Valid: true}},
+				FTArrayFloatNull:     []spanner.NullFloat64{{Float64: 0.111, Valid: true}, {Float64: 0.222, Valid: true}},
+				FTArrayFloat:         []spanner.NullFloat64{{Float64: 0.111, Valid: true}, {Float64: 0.222, Valid: true}},
+				FTArrayDateNull:      []spanner.NullDate{{Date: date, Valid: true}, {Date: nextdate, Valid: true}},
+				FTArrayDate:          []spanner.NullDate{{Date: date, Valid: true}, {Date: nextdate, Valid: true}},
 				FTArrayJSONNull:      []spanner.NullJSON{json, jsonNull},
 				FTArrayJSON:          []spanner.NullJSON{json, jsonNull},
 			},
@@ -374,20 +374,20 @@ func TestDefaultFullType(t *testing.T) {
 				FTDateNull:           spanner.NullDate{},
 				FTJSON:               json,
 				FTJSONNull:           jsonNull,
-				FTArrayStringNull:    []string{"xxx2", "yyy2"},
-				FTArrayString:        []string{"xxx2", "yyy2"},
+				FTArrayStringNull:    []spanner.NullString{{StringVal: "xxx2", Valid: true}, {StringVal: "yyy2", Valid: true}},
+				FTArrayString:        []spanner.NullString{{StringVal: "xxx2", Valid: true}, {StringVal: "yyy2", Valid: true}},
 				FTArrayBoolNull:      nil,
-				FTArrayBool:          []bool{true, false},
+				FTArrayBool:          []spanner.NullBool{{Bool: true, Valid: true}, {Bool: false, Valid: true}},
 				FTArrayBytesNull:     nil,
 				FTArrayBytes:         [][]byte{[]byte("xxx2"), []byte("yyy2")},
 				FTArrayTimestampNull: nil,
-				FTArrayTimestamp:     []time.Time{now, tomorrow},
+				FTArrayTimestamp:     []spanner.NullTime{{Time: now, Valid: true}, {Time: tomorrow, Valid: true}},
 				FTArrayIntNull:       nil,
-				FTArrayInt:           []int64{100, 200},
+				FTArrayInt:           []spanner.NullInt64{{Int64: 100, Valid: true}, {Int64: 200, Valid: true}},
 				FTArrayFloatNull:     nil,
-				FTArrayFloat:         []float64{0.111, 0.222},
+				FTArrayFloat:         []spanner.NullFloat64{{Float64: 0.111, Valid: true}, {Float64: 0.222, Valid: true}},
 				FTArrayDateNull:      nil,
-				FTArrayDate:          []civil.Date{date, nextdate},
+				FTArrayDate:          []spanner.NullDate{{Date: date, Valid: true}, {Date: nextdate, Valid: true}},
 				FTArrayJSONNull:      nil,
 				FTArrayJSON:          []spanner.NullJSON{json, jsonNull},
 			},
@@ -411,20 +411,20 @@ func TestDefaultFullType(t *testing.T) {
 				FTDateNull:           spanner.NullDate{},
 				FTJSON:               json,
 				FTJSONNull:           jsonNull,
-				FTArrayStringNull:    []string{},
-				FTArrayString:        []string{},
-				FTArrayBoolNull:      []bool{},
-				FTArrayBool:          []bool{},
+				FTArrayStringNull:    []spanner.NullString{},
+				FTArrayString:        []spanner.NullString{},
+				FTArrayBoolNull:      []spanner.NullBool{},
+				FTArrayBool:          []spanner.NullBool{},
 				FTArrayBytesNull:     [][]byte{},
 				FTArrayBytes:         [][]byte{},
-				FTArrayTimestampNull: []time.Time{},
-				FTArrayTimestamp:     []time.Time{},
-				FTArrayIntNull:       []int64{},
-				FTArrayInt:           []int64{},
-				FTArrayFloatNull:     []float64{},
-				FTArrayFloat:         []float64{},
-				FTArrayDateNull:      []civil.Date{},
-				FTArrayDate:          []civil.Date{},
+				FTArrayTimestampNull: []spanner.NullTime{},
+				FTArrayTimestamp:     []spanner.NullTime{},
+				FTArrayIntNull:       []spanner.NullInt64{},
+				FTArrayInt:           []spanner.NullInt64{},
+				FTArrayFloatNull:     []spanner.NullFloat64{},
+				FTArrayFloat:         []spanner.NullFloat64{},
+				FTArrayDateNull:      []spanner.NullDate{},
+				FTArrayDate:          []spanner.NullDate{},
 				FTArrayJSONNull:      []spanner.NullJSON{},
 				FTArrayJSON:          []spanner.NullJSON{},
 			},
@@ -665,6 +665,44 @@ func TestGeneratedColumn(t *testing.T) {
 	})
 }
 
+func TestCommitTimestamp(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// UpdatedAt is added by ALTER TABLE with allow_commit_timestamp
+	a := &models.Article{
+		ID:    400,
+		Title: "Hello",
+	}
+
+	ts, err := client.Apply(ctx, []*spanner.Mutation{a.Insert(ctx)})
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	got, err := models.FindArticle(ctx, client.Single(), 400)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.UpdatedAt.Valid || !got.UpdatedAt.Time.Equal(ts) {
+		t.Errorf("error. want:%v got:%v", ts, got.UpdatedAt)
+	}
+
+	a.Title = "World"
+	ts, err = client.Apply(ctx, []*spanner.Mutation{a.Update(ctx)})
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	got, err = models.FindArticle(ctx, client.Single(), 400)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.UpdatedAt.Valid || !got.UpdatedAt.Time.Equal(ts) {
+		t.Errorf("error. want:%v got:%v", ts, got.UpdatedAt)
+	}
+}
+
 func TestSessionNotFound(t *testing.T) {
 	dbName := testutil.DatabaseName(spannerProjectName, spannerInstanceName, spannerDatabaseName)
 
@@ -116,3 +116,10 @@ CREATE TABLE GeneratedColumns (
   LastName STRING(50) NOT NULL,
   FullName STRING(100) NOT NULL AS (ARRAY_TO_STRING([FirstName, LastName], " ")) STORED,
 ) PRIMARY KEY (ID);
+
+CREATE TABLE Articles (
+  ID INT64 NOT NULL,
+  Title STRING(MAX) NOT NULL,
+) PRIMARY KEY (ID);
+
+ALTER TABLE Articles ADD COLUMN UpdatedAt TIMESTAMP OPTIONS (allow_commit_timestamp = true);
@@ -4,9 +4,12 @@ package customtypes
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 )
 
@@ -16,6 +19,14 @@ type Item struct {
 	Price int64 `spanner:"Price" json:"Price"` // Price
 }
 
+// ItemTableName is the name of 'Items', which is qualified by the
+// schema if the table is in a named schema.
+const ItemTableName = "Items"
+
+// ErrItemNotFound is the error matched by errors.Is when the row is not
+// found in 'Items'.
+var ErrItemNotFound = errors.New("yo: Items not found")
+
 func ItemPrimaryKeys() []string {
 	return []string{
 		"ID",
@@ -29,6 +40,15 @@ func ItemColumns() []string {
 	}
 }
 
+// ItemColumn is a column of 'Items'.
+type ItemColumn string
+
+// Columns of 'Items'.
+const (
+	ItemColumnID    ItemColumn = "ID"
+	ItemColumnPrice ItemColumn = "Price"
+)
+
 func ItemWritableColumns() []string {
 	return []string{
 		"ID",
@@ -92,6 +112,362 @@ func newItem_Decoder(cols []string) func(*spanner.Row) (*Item, error) {
 	}
 }
 
+// ScanItem decodes row into Item. The row may have any subset of
+// the columns of 'Items', such as the result of a query or a read.
+func ScanItem(row *spanner.Row) (*Item, error) {
+	return newItem_Decoder(row.ColumnNames())(row)
+}
+
+// ScanItems decodes all the rows of iter into a slice of Item.
+// iter is stopped when it returns.
+func ScanItems(iter *spanner.RowIterator) ([]*Item, error) {
+	var res []*Item
+	var decoder func(*spanner.Row) (*Item, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newItem_Decoder(row.ColumnNames())
+		}
+
+		i, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, i)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ItemIterator iterates over the rows of a partition of 'Items'
+// decoded into Item.
+type ItemIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*Item, error)
+}
+
+// ExecuteItemPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllItems or ItemQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteItemPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *ItemIterator {
+	return &ItemIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *ItemIterator) Next() (*Item, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("ItemIterator.Next", "Items", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newItem_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ItemIterator.Next", "Items", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *ItemIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldItemRows decodes the rows of rows into Item and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldItemRows(rows *spanner.RowIterator, method string, yield func(*Item, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*Item, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "Items", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newItem_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "Items", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountItems returns the number of the rows of 'Items'.
+func CountItems(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM Items")
+
+	defer yoLogQuery(ctx, "CountItems", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountItems", "Items", err)
+	}
+
+	return n, nil
+}
+
+// AllItemsSeq returns an iterator of all the rows of 'Items',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*Item, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllItemsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*Item, error) bool) {
+	return func(yield func(*Item, error) bool) {
+		rows := yoRead(ctx, db, "Items", "", spanner.AllKeys(), ItemColumns(), opts)
+		yieldItemRows(rows, "AllItemsSeq", yield)
+	}
+}
+
+// PartitionReadAllItems partitions the read of all the rows of
+// 'Items' in btx, so that the partitions are read in parallel by
+// ExecuteItemPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllItems(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "Items", spanner.AllKeys(), ItemColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllItems", "Items", err)
+	}
+
+	return ps, nil
+}
+
+// ItemQuery is a query builder for 'Items'. The conditions are
+// given by the predicates of ItemWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of ItemColumn.
+type ItemQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewItemQuery returns a ItemQuery filtered by preds.
+func NewItemQuery(preds ...YOPredicate) *ItemQuery {
+	return &ItemQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *ItemQuery) Where(preds ...YOPredicate) *ItemQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *ItemQuery) OrderBy(col ItemColumn) *ItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *ItemQuery) OrderByDesc(col ItemColumn) *ItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *ItemQuery) Limit(n int) *ItemQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *ItemQuery) Statement() spanner.Statement {
+	return yoStatement("ID, Price", "Items", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *ItemQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*Item, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "ItemQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*Item{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("ItemQuery.Query", "Items", err)
+		}
+
+		v, err := ScanItem(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "ItemQuery.Query", "Items", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *ItemQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "Items", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "ItemQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("ItemQuery.Count", "Items", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*Item, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *ItemQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*Item, error) bool) {
+	return func(yield func(*Item, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "ItemQuery.Seq", stmt)()
+		yieldItemRows(yoQuery(ctx, db, stmt, opts), "ItemQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteItemPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *ItemQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "ItemQuery.PartitionQuery", "Items", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "ItemQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("ItemQuery.PartitionQuery", "Items", err)
+	}
+
+	return ps, nil
+}
+
+// ItemWhere has the typed predicate constructors of the columns of
+// 'Items'.
+var ItemWhere = struct {
+	ID    Item_IDColumn
+	Price Item_PriceColumn
+}{}
+
+// Item_IDColumn has the predicate constructors of 'ID'.
+type Item_IDColumn struct{}
+
+// Eq returns a predicate that 'ID' is equal to v.
+func (Item_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ID' is not equal to v.
+func (Item_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ID' is less than v.
+func (Item_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ID' is less than or equal to v.
+func (Item_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ID' is greater than v.
+func (Item_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ID' is greater than or equal to v.
+func (Item_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ID' is equal to any of vs.
+func (Item_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "IN", value: vs}
+}
+
+// Item_PriceColumn has the predicate constructors of 'Price'.
+type Item_PriceColumn struct{}
+
+// Eq returns a predicate that 'Price' is equal to v.
+func (Item_PriceColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Price' is not equal to v.
+func (Item_PriceColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Price' is less than v.
+func (Item_PriceColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Price' is less than or equal to v.
+func (Item_PriceColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Price' is greater than v.
+func (Item_PriceColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Price' is greater than or equal to v.
+func (Item_PriceColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Price' is equal to any of vs.
+func (Item_PriceColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "IN", value: vs}
+}
+
 // Insert returns a Mutation to insert a row into a table. If the row already
 // exists, the write or transaction fails.
 func (i *Item) Insert(ctx context.Context) *spanner.Mutation {
@@ -99,6 +475,31 @@ func (i *Item) Insert(ctx context.Context) *spanner.Mutation {
 	return spanner.Insert("Items", ItemWritableColumns(), values)
 }
 
+// InsertAllItems inserts rows into 'Items' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllItems(ctx context.Context, client *spanner.Client, rows []*Item) (int, error) {
+	ms := ItemsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(ItemWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllItems", "Items", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// ItemsInsert returns Mutations to insert the rows into
+// 'Items', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllItems, the Mutations are
+// not split into batches.
+func ItemsInsert(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
 // Update returns a Mutation to update a row in a table. If the row does not
 // already exist, the write or transaction fails.
 func (i *Item) Update(ctx context.Context) *spanner.Mutation {
@@ -114,6 +515,63 @@ func (i *Item) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
 	return spanner.InsertOrUpdate("Items", ItemWritableColumns(), values)
 }
 
+// ItemsUpdate returns Mutations to update the rows in
+// 'Items', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func ItemsUpdate(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// ItemsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'Items', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func ItemsInsertOrUpdate(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the Item with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (i *Item) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{i.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteItems inserts or updates rows in 'Items' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteItems(ctx context.Context, client *spanner.Client, rows []*Item) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteItems", "Items", groups)
+}
+
+// InsertOrUpdateItemAtLeastOnce inserts or updates row in 'Items' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateItemAtLeastOnce(ctx context.Context, client *spanner.Client, row *Item) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateItemAtLeastOnce", "Items", err)
+	}
+
+	return nil
+}
+
 // UpdateColumns returns a Mutation to update specified columns of a row in a table.
 func (i *Item) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
 	// add primary keys to columns to update by primary keys
@@ -127,16 +585,78 @@ func (i *Item) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Muta
 	return spanner.Update("Items", colsWithPKeys, values), nil
 }
 
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'Items' can be specified.
+func (i *Item) MutationForColumns(ctx context.Context, cols ...ItemColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return i.UpdateColumns(ctx, names...)
+}
+
+// ItemTracker tracks the changes of a Item, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type ItemTracker struct {
+	row  *Item
+	orig Item
+}
+
+// TrackItem returns a ItemTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackItem(row *Item) *ItemTracker {
+	tr := &ItemTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked Item.
+func (tr *ItemTracker) Row() *Item {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *ItemTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.Price = yoClone(tr.row.Price)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'Items'. The primary key columns and the
+// generated columns are never included.
+func (tr *ItemTracker) Changed() []ItemColumn {
+	var cols []ItemColumn
+	if !reflect.DeepEqual(tr.orig.Price, tr.row.Price) {
+		cols = append(cols, ItemColumnPrice)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *ItemTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
 // FindItem gets a Item by primary key
-func FindItem(ctx context.Context, db YORODB, id int64) (*Item, error) {
+func FindItem(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*Item, error) {
 	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "Items", key, ItemColumns())
+	row, err := yoReadRow(ctx, db, "Items", key, ItemColumns(), opts)
 	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindItem", "Items", key, ErrItemNotFound, nil)
+		}
 		return nil, newError("FindItem", "Items", err)
 	}
 
-	decoder := newItem_Decoder(ItemColumns())
-	i, err := decoder(row)
+	i, err := ScanItem(row)
 	if err != nil {
 		return nil, newErrorWithCode(codes.Internal, "FindItem", "Items", err)
 	}
@@ -144,15 +664,194 @@ func FindItem(ctx context.Context, db YORODB, id int64) (*Item, error) {
 	return i, nil
 }
 
+// ItemExists reports whether the row of the primary key exists in
+// 'Items', which reads only the primary key columns.
+func ItemExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "Items", key, ItemPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("ItemExists", "Items", err)
+	}
+
+	return true, nil
+}
+
 // ReadItem retrieves multiples rows from Item by KeySet as a slice.
-func ReadItem(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*Item, error) {
+func ReadItem(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*Item, error) {
 	var res []*Item
+	rows := yoRead(ctx, db, "Items", "", keys, ItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		i, err := ScanItem(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, i)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadItem", "Items", err)
+	}
+
+	return res, nil
+}
+
+// ItemPrimaryKey is the primary key of 'Items'.
+type ItemPrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadItem.
+func (k ItemPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseItemPrimaryKey parses key of the columns of the primary key of
+// 'Items' in order, such as the key of a mutation.
+func ParseItemPrimaryKey(key spanner.Key) (ItemPrimaryKey, error) {
+	var k ItemPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'Items' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'ID' of the key of 'Items' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of i.
+func (i *Item) PrimaryKey() ItemPrimaryKey {
+	return ItemPrimaryKey{
+		ID: i.ID,
+	}
+}
 
-	decoder := newItem_Decoder(ItemColumns())
+// FindItemByKey gets a Item by the primary key of key.
+func FindItemByKey(ctx context.Context, db YORODB, key ItemPrimaryKey, opts ...*spanner.ReadOptions) (*Item, error) {
+	row, err := yoReadRow(ctx, db, "Items", key.ToSpannerKey(), ItemColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindItemByKey", "Items", key.ToSpannerKey(), ErrItemNotFound, nil)
+		}
+		return nil, newError("FindItemByKey", "Items", err)
+	}
+
+	i, err := ScanItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindItemByKey", "Items", err)
+	}
+
+	return i, nil
+}
 
-	rows := db.Read(ctx, "Items", keys, ItemColumns())
+// DeleteItemByKey returns a Mutation to delete the row of key from
+// 'Items'.
+func DeleteItemByKey(ctx context.Context, key ItemPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("Items", key.ToSpannerKey())
+}
+
+// FindItemsByKeys retrieves the rows of keys from 'Items' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindItemsByKeys(ctx context.Context, db YORODB, keys []ItemPrimaryKey, opts ...*spanner.ReadOptions) ([]*Item, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*Item, len(keys))
+	rows := yoRead(ctx, db, "Items", "", spanner.KeySets(keySets...), ItemColumns(), opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		i, err := decoder(row)
+		i, err := ScanItem(row)
+		if err != nil {
+			return err
+		}
+		byKey[i.primaryKey().String()] = i
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindItemsByKeys", "Items", err)
+	}
+
+	res := make([]*Item, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if i, ok := byKey[s]; ok {
+			res = append(res, i)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListItemsPage retrieves a page of at most pageSize rows from 'Items'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListItemsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*Item, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListItemsPage", "Items", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListItemsPage", "Items", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT ID, Price FROM Items",
+		[]string{"ID"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListItemsPage", stmt)()
+	res, err := ScanItems(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListItemsPage", "Items", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListItemsPage", "Items", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadItemRange retrieves multiple rows from Item whose
+// 'ID' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadItemRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*Item, error) {
+	var res []*Item
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "Items", "", keys, ItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		i, err := ScanItem(row)
 		if err != nil {
 			return err
 		}
@@ -161,14 +860,64 @@ func ReadItem(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*Item, err
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadItem", "Items", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadItemRange", "Items", err)
 	}
 
 	return res, nil
 }
 
+// primaryKey returns the key of the Item, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (i *Item) primaryKey() spanner.Key {
+	return spanner.Key{i.ID}
+}
+
 // Delete deletes the Item from the database.
 func (i *Item) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := i.columnsToValues(ItemPrimaryKeys())
-	return spanner.Delete("Items", spanner.Key(values))
+	return spanner.Delete("Items", i.primaryKey())
+}
+
+// ItemsDelete returns Mutations to delete the rows from
+// 'Items', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func ItemsDelete(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// ItemDescriptor describes 'Items' for YORepository.
+var ItemDescriptor = &YODescriptor[Item, ItemPrimaryKey]{
+	Table:       "Items",
+	Columns:     ItemColumns(),
+	PrimaryKeys: ItemPrimaryKeys(),
+	Key: func(row *Item) ItemPrimaryKey {
+		return ItemPrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: ItemPrimaryKey.ToSpannerKey,
+	Scan:       ScanItem,
+	Find:       FindItemByKey,
+	Read:       ReadItem,
+	Insert: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewItemRepository returns a YORepository of 'Items' by client.
+func NewItemRepository(client *spanner.Client) *YORepository[Item, ItemPrimaryKey] {
+	return NewYORepository(client, ItemDescriptor)
 }
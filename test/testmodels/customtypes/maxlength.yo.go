@@ -4,9 +4,12 @@ package customtypes
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 )
 
@@ -16,6 +19,14 @@ type MaxLength struct {
 	MaxBytes  []byte `spanner:"MaxBytes" json:"MaxBytes"`   // MaxBytes
 }
 
+// MaxLengthTableName is the name of 'MaxLengths', which is qualified by the
+// schema if the table is in a named schema.
+const MaxLengthTableName = "MaxLengths"
+
+// ErrMaxLengthNotFound is the error matched by errors.Is when the row is not
+// found in 'MaxLengths'.
+var ErrMaxLengthNotFound = errors.New("yo: MaxLengths not found")
+
 func MaxLengthPrimaryKeys() []string {
 	return []string{
 		"MaxString",
@@ -29,6 +40,15 @@ func MaxLengthColumns() []string {
 	}
 }
 
+// MaxLengthColumn is a column of 'MaxLengths'.
+type MaxLengthColumn string
+
+// Columns of 'MaxLengths'.
+const (
+	MaxLengthColumnMaxString MaxLengthColumn = "MaxString"
+	MaxLengthColumnMaxBytes  MaxLengthColumn = "MaxBytes"
+)
+
 func MaxLengthWritableColumns() []string {
 	return []string{
 		"MaxString",
@@ -92,6 +112,362 @@ func newMaxLength_Decoder(cols []string) func(*spanner.Row) (*MaxLength, error)
 	}
 }
 
+// ScanMaxLength decodes row into MaxLength. The row may have any subset of
+// the columns of 'MaxLengths', such as the result of a query or a read.
+func ScanMaxLength(row *spanner.Row) (*MaxLength, error) {
+	return newMaxLength_Decoder(row.ColumnNames())(row)
+}
+
+// ScanMaxLengths decodes all the rows of iter into a slice of MaxLength.
+// iter is stopped when it returns.
+func ScanMaxLengths(iter *spanner.RowIterator) ([]*MaxLength, error) {
+	var res []*MaxLength
+	var decoder func(*spanner.Row) (*MaxLength, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newMaxLength_Decoder(row.ColumnNames())
+		}
+
+		ml, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ml)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MaxLengthIterator iterates over the rows of a partition of 'MaxLengths'
+// decoded into MaxLength.
+type MaxLengthIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*MaxLength, error)
+}
+
+// ExecuteMaxLengthPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllMaxLengths or MaxLengthQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteMaxLengthPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *MaxLengthIterator {
+	return &MaxLengthIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *MaxLengthIterator) Next() (*MaxLength, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("MaxLengthIterator.Next", "MaxLengths", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newMaxLength_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "MaxLengthIterator.Next", "MaxLengths", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *MaxLengthIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldMaxLengthRows decodes the rows of rows into MaxLength and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldMaxLengthRows(rows *spanner.RowIterator, method string, yield func(*MaxLength, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*MaxLength, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "MaxLengths", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newMaxLength_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "MaxLengths", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountMaxLengths returns the number of the rows of 'MaxLengths'.
+func CountMaxLengths(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM MaxLengths")
+
+	defer yoLogQuery(ctx, "CountMaxLengths", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountMaxLengths", "MaxLengths", err)
+	}
+
+	return n, nil
+}
+
+// AllMaxLengthsSeq returns an iterator of all the rows of 'MaxLengths',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*MaxLength, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllMaxLengthsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*MaxLength, error) bool) {
+	return func(yield func(*MaxLength, error) bool) {
+		rows := yoRead(ctx, db, "MaxLengths", "", spanner.AllKeys(), MaxLengthColumns(), opts)
+		yieldMaxLengthRows(rows, "AllMaxLengthsSeq", yield)
+	}
+}
+
+// PartitionReadAllMaxLengths partitions the read of all the rows of
+// 'MaxLengths' in btx, so that the partitions are read in parallel by
+// ExecuteMaxLengthPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllMaxLengths(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "MaxLengths", spanner.AllKeys(), MaxLengthColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllMaxLengths", "MaxLengths", err)
+	}
+
+	return ps, nil
+}
+
+// MaxLengthQuery is a query builder for 'MaxLengths'. The conditions are
+// given by the predicates of MaxLengthWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of MaxLengthColumn.
+type MaxLengthQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewMaxLengthQuery returns a MaxLengthQuery filtered by preds.
+func NewMaxLengthQuery(preds ...YOPredicate) *MaxLengthQuery {
+	return &MaxLengthQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *MaxLengthQuery) Where(preds ...YOPredicate) *MaxLengthQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *MaxLengthQuery) OrderBy(col MaxLengthColumn) *MaxLengthQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *MaxLengthQuery) OrderByDesc(col MaxLengthColumn) *MaxLengthQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *MaxLengthQuery) Limit(n int) *MaxLengthQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *MaxLengthQuery) Statement() spanner.Statement {
+	return yoStatement("MaxString, MaxBytes", "MaxLengths", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *MaxLengthQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "MaxLengthQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*MaxLength{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("MaxLengthQuery.Query", "MaxLengths", err)
+		}
+
+		v, err := ScanMaxLength(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "MaxLengthQuery.Query", "MaxLengths", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *MaxLengthQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "MaxLengths", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "MaxLengthQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("MaxLengthQuery.Count", "MaxLengths", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*MaxLength, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *MaxLengthQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*MaxLength, error) bool) {
+	return func(yield func(*MaxLength, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "MaxLengthQuery.Seq", stmt)()
+		yieldMaxLengthRows(yoQuery(ctx, db, stmt, opts), "MaxLengthQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteMaxLengthPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *MaxLengthQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "MaxLengthQuery.PartitionQuery", "MaxLengths", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "MaxLengthQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("MaxLengthQuery.PartitionQuery", "MaxLengths", err)
+	}
+
+	return ps, nil
+}
+
+// MaxLengthWhere has the typed predicate constructors of the columns of
+// 'MaxLengths'.
+var MaxLengthWhere = struct {
+	MaxString MaxLength_MaxStringColumn
+	MaxBytes  MaxLength_MaxBytesColumn
+}{}
+
+// MaxLength_MaxStringColumn has the predicate constructors of 'MaxString'.
+type MaxLength_MaxStringColumn struct{}
+
+// Eq returns a predicate that 'MaxString' is equal to v.
+func (MaxLength_MaxStringColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'MaxString' is not equal to v.
+func (MaxLength_MaxStringColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'MaxString' is less than v.
+func (MaxLength_MaxStringColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "<", value: v}
+}
+
+// Le returns a predicate that 'MaxString' is less than or equal to v.
+func (MaxLength_MaxStringColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'MaxString' is greater than v.
+func (MaxLength_MaxStringColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'MaxString' is greater than or equal to v.
+func (MaxLength_MaxStringColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: ">=", value: v}
+}
+
+// In returns a predicate that 'MaxString' is equal to any of vs.
+func (MaxLength_MaxStringColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "IN", value: vs}
+}
+
+// MaxLength_MaxBytesColumn has the predicate constructors of 'MaxBytes'.
+type MaxLength_MaxBytesColumn struct{}
+
+// Eq returns a predicate that 'MaxBytes' is equal to v.
+func (MaxLength_MaxBytesColumn) Eq(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'MaxBytes' is not equal to v.
+func (MaxLength_MaxBytesColumn) Ne(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'MaxBytes' is less than v.
+func (MaxLength_MaxBytesColumn) Lt(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "<", value: v}
+}
+
+// Le returns a predicate that 'MaxBytes' is less than or equal to v.
+func (MaxLength_MaxBytesColumn) Le(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'MaxBytes' is greater than v.
+func (MaxLength_MaxBytesColumn) Gt(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'MaxBytes' is greater than or equal to v.
+func (MaxLength_MaxBytesColumn) Ge(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: ">=", value: v}
+}
+
+// In returns a predicate that 'MaxBytes' is equal to any of vs.
+func (MaxLength_MaxBytesColumn) In(vs ...[]byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "IN", value: vs}
+}
+
 // Insert returns a Mutation to insert a row into a table. If the row already
 // exists, the write or transaction fails.
 func (ml *MaxLength) Insert(ctx context.Context) *spanner.Mutation {
@@ -99,6 +475,31 @@ func (ml *MaxLength) Insert(ctx context.Context) *spanner.Mutation {
 	return spanner.Insert("MaxLengths", MaxLengthWritableColumns(), values)
 }
 
+// InsertAllMaxLengths inserts rows into 'MaxLengths' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllMaxLengths(ctx context.Context, client *spanner.Client, rows []*MaxLength) (int, error) {
+	ms := MaxLengthsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(MaxLengthWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllMaxLengths", "MaxLengths", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// MaxLengthsInsert returns Mutations to insert the rows into
+// 'MaxLengths', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllMaxLengths, the Mutations are
+// not split into batches.
+func MaxLengthsInsert(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
 // Update returns a Mutation to update a row in a table. If the row does not
 // already exist, the write or transaction fails.
 func (ml *MaxLength) Update(ctx context.Context) *spanner.Mutation {
@@ -114,6 +515,63 @@ func (ml *MaxLength) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
 	return spanner.InsertOrUpdate("MaxLengths", MaxLengthWritableColumns(), values)
 }
 
+// MaxLengthsUpdate returns Mutations to update the rows in
+// 'MaxLengths', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func MaxLengthsUpdate(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// MaxLengthsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'MaxLengths', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func MaxLengthsInsertOrUpdate(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the MaxLength with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (ml *MaxLength) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{ml.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteMaxLengths inserts or updates rows in 'MaxLengths' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteMaxLengths(ctx context.Context, client *spanner.Client, rows []*MaxLength) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteMaxLengths", "MaxLengths", groups)
+}
+
+// InsertOrUpdateMaxLengthAtLeastOnce inserts or updates row in 'MaxLengths' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateMaxLengthAtLeastOnce(ctx context.Context, client *spanner.Client, row *MaxLength) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateMaxLengthAtLeastOnce", "MaxLengths", err)
+	}
+
+	return nil
+}
+
 // UpdateColumns returns a Mutation to update specified columns of a row in a table.
 func (ml *MaxLength) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
 	// add primary keys to columns to update by primary keys
@@ -127,16 +585,78 @@ func (ml *MaxLength) UpdateColumns(ctx context.Context, cols ...string) (*spanne
 	return spanner.Update("MaxLengths", colsWithPKeys, values), nil
 }
 
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'MaxLengths' can be specified.
+func (ml *MaxLength) MutationForColumns(ctx context.Context, cols ...MaxLengthColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return ml.UpdateColumns(ctx, names...)
+}
+
+// MaxLengthTracker tracks the changes of a MaxLength, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type MaxLengthTracker struct {
+	row  *MaxLength
+	orig MaxLength
+}
+
+// TrackMaxLength returns a MaxLengthTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackMaxLength(row *MaxLength) *MaxLengthTracker {
+	tr := &MaxLengthTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked MaxLength.
+func (tr *MaxLengthTracker) Row() *MaxLength {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *MaxLengthTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.MaxBytes = yoClone(tr.row.MaxBytes)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'MaxLengths'. The primary key columns and the
+// generated columns are never included.
+func (tr *MaxLengthTracker) Changed() []MaxLengthColumn {
+	var cols []MaxLengthColumn
+	if !reflect.DeepEqual(tr.orig.MaxBytes, tr.row.MaxBytes) {
+		cols = append(cols, MaxLengthColumnMaxBytes)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *MaxLengthTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
 // FindMaxLength gets a MaxLength by primary key
-func FindMaxLength(ctx context.Context, db YORODB, maxString string) (*MaxLength, error) {
+func FindMaxLength(ctx context.Context, db YORODB, maxString string, opts ...*spanner.ReadOptions) (*MaxLength, error) {
 	key := spanner.Key{maxString}
-	row, err := db.ReadRow(ctx, "MaxLengths", key, MaxLengthColumns())
+	row, err := yoReadRow(ctx, db, "MaxLengths", key, MaxLengthColumns(), opts)
 	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindMaxLength", "MaxLengths", key, ErrMaxLengthNotFound, nil)
+		}
 		return nil, newError("FindMaxLength", "MaxLengths", err)
 	}
 
-	decoder := newMaxLength_Decoder(MaxLengthColumns())
-	ml, err := decoder(row)
+	ml, err := ScanMaxLength(row)
 	if err != nil {
 		return nil, newErrorWithCode(codes.Internal, "FindMaxLength", "MaxLengths", err)
 	}
@@ -144,15 +664,194 @@ func FindMaxLength(ctx context.Context, db YORODB, maxString string) (*MaxLength
 	return ml, nil
 }
 
+// MaxLengthExists reports whether the row of the primary key exists in
+// 'MaxLengths', which reads only the primary key columns.
+func MaxLengthExists(ctx context.Context, db YORODB, maxString string, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{maxString}
+	if _, err := yoReadRow(ctx, db, "MaxLengths", key, MaxLengthPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("MaxLengthExists", "MaxLengths", err)
+	}
+
+	return true, nil
+}
+
 // ReadMaxLength retrieves multiples rows from MaxLength by KeySet as a slice.
-func ReadMaxLength(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*MaxLength, error) {
+func ReadMaxLength(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
 	var res []*MaxLength
+	rows := yoRead(ctx, db, "MaxLengths", "", keys, MaxLengthColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ml, err := ScanMaxLength(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ml)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadMaxLength", "MaxLengths", err)
+	}
+
+	return res, nil
+}
+
+// MaxLengthPrimaryKey is the primary key of 'MaxLengths'.
+type MaxLengthPrimaryKey struct {
+	MaxString string
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadMaxLength.
+func (k MaxLengthPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.MaxString}
+}
+
+// ParseMaxLengthPrimaryKey parses key of the columns of the primary key of
+// 'MaxLengths' in order, such as the key of a mutation.
+func ParseMaxLengthPrimaryKey(key spanner.Key) (MaxLengthPrimaryKey, error) {
+	var k MaxLengthPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'MaxLengths' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(string)
+	if !ok {
+		return k, fmt.Errorf("column 'MaxString' of the key of 'MaxLengths' must be string, but got %T", key[0])
+	}
+	k.MaxString = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of ml.
+func (ml *MaxLength) PrimaryKey() MaxLengthPrimaryKey {
+	return MaxLengthPrimaryKey{
+		MaxString: ml.MaxString,
+	}
+}
 
-	decoder := newMaxLength_Decoder(MaxLengthColumns())
+// FindMaxLengthByKey gets a MaxLength by the primary key of key.
+func FindMaxLengthByKey(ctx context.Context, db YORODB, key MaxLengthPrimaryKey, opts ...*spanner.ReadOptions) (*MaxLength, error) {
+	row, err := yoReadRow(ctx, db, "MaxLengths", key.ToSpannerKey(), MaxLengthColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindMaxLengthByKey", "MaxLengths", key.ToSpannerKey(), ErrMaxLengthNotFound, nil)
+		}
+		return nil, newError("FindMaxLengthByKey", "MaxLengths", err)
+	}
+
+	ml, err := ScanMaxLength(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindMaxLengthByKey", "MaxLengths", err)
+	}
+
+	return ml, nil
+}
 
-	rows := db.Read(ctx, "MaxLengths", keys, MaxLengthColumns())
+// DeleteMaxLengthByKey returns a Mutation to delete the row of key from
+// 'MaxLengths'.
+func DeleteMaxLengthByKey(ctx context.Context, key MaxLengthPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("MaxLengths", key.ToSpannerKey())
+}
+
+// FindMaxLengthsByKeys retrieves the rows of keys from 'MaxLengths' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindMaxLengthsByKeys(ctx context.Context, db YORODB, keys []MaxLengthPrimaryKey, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*MaxLength, len(keys))
+	rows := yoRead(ctx, db, "MaxLengths", "", spanner.KeySets(keySets...), MaxLengthColumns(), opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		ml, err := decoder(row)
+		ml, err := ScanMaxLength(row)
+		if err != nil {
+			return err
+		}
+		byKey[ml.primaryKey().String()] = ml
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindMaxLengthsByKeys", "MaxLengths", err)
+	}
+
+	res := make([]*MaxLength, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if ml, ok := byKey[s]; ok {
+			res = append(res, ml)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListMaxLengthsPage retrieves a page of at most pageSize rows from 'MaxLengths'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListMaxLengthsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*MaxLength, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListMaxLengthsPage", "MaxLengths", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListMaxLengthsPage", "MaxLengths", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT MaxString, MaxBytes FROM MaxLengths",
+		[]string{"MaxString"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListMaxLengthsPage", stmt)()
+	res, err := ScanMaxLengths(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListMaxLengthsPage", "MaxLengths", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.MaxString)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListMaxLengthsPage", "MaxLengths", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadMaxLengthRange retrieves multiple rows from MaxLength whose
+// 'MaxString' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadMaxLengthRange(ctx context.Context, db YORODB, start, end string, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
+	var res []*MaxLength
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "MaxLengths", "", keys, MaxLengthColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ml, err := ScanMaxLength(row)
 		if err != nil {
 			return err
 		}
@@ -161,14 +860,64 @@ func ReadMaxLength(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*MaxL
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadMaxLength", "MaxLengths", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadMaxLengthRange", "MaxLengths", err)
 	}
 
 	return res, nil
 }
 
+// primaryKey returns the key of the MaxLength, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (ml *MaxLength) primaryKey() spanner.Key {
+	return spanner.Key{ml.MaxString}
+}
+
 // Delete deletes the MaxLength from the database.
 func (ml *MaxLength) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := ml.columnsToValues(MaxLengthPrimaryKeys())
-	return spanner.Delete("MaxLengths", spanner.Key(values))
+	return spanner.Delete("MaxLengths", ml.primaryKey())
+}
+
+// MaxLengthsDelete returns Mutations to delete the rows from
+// 'MaxLengths', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func MaxLengthsDelete(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// MaxLengthDescriptor describes 'MaxLengths' for YORepository.
+var MaxLengthDescriptor = &YODescriptor[MaxLength, MaxLengthPrimaryKey]{
+	Table:       "MaxLengths",
+	Columns:     MaxLengthColumns(),
+	PrimaryKeys: MaxLengthPrimaryKeys(),
+	Key: func(row *MaxLength) MaxLengthPrimaryKey {
+		return MaxLengthPrimaryKey{
+			MaxString: row.MaxString,
+		}
+	},
+	SpannerKey: MaxLengthPrimaryKey.ToSpannerKey,
+	Scan:       ScanMaxLength,
+	Find:       FindMaxLengthByKey,
+	Read:       ReadMaxLength,
+	Insert: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewMaxLengthRepository returns a YORepository of 'MaxLengths' by client.
+func NewMaxLengthRepository(client *spanner.Client) *YORepository[MaxLength, MaxLengthPrimaryKey] {
+	return NewYORepository(client, MaxLengthDescriptor)
 }
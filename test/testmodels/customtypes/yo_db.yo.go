@@ -4,11 +4,20 @@ package customtypes
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"cloud.google.com/go/spanner"
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
 	"github.com/googleapis/gax-go/v2/apierror"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,7 +27,9 @@ type YODB interface {
 	YORODB
 }
 
-// YORODB is the common interface for database operations.
+// YORODB is the reader which all generated read functions take. It is
+// satisfied by *spanner.ReadOnlyTransaction, *spanner.ReadWriteTransaction
+// and *spanner.BatchReadOnlyTransaction, and by fakes of them in tests.
 type YORODB interface {
 	ReadRow(ctx context.Context, table string, key spanner.Key, columns []string) (*spanner.Row, error)
 	Read(ctx context.Context, table string, keys spanner.KeySet, columns []string) *spanner.RowIterator
@@ -26,9 +37,623 @@ type YORODB interface {
 	Query(ctx context.Context, statement spanner.Statement) *spanner.RowIterator
 }
 
+// yoOptionsRODB is the reader of YORODB taking the read and the query
+// options, which the generated read functions use when the options are given.
+// The transactions of the client satisfy it, but a YORODB such as a fake in
+// tests may not, and then the options are ignored except Limit.
+type yoOptionsRODB interface {
+	ReadWithOptions(ctx context.Context, table string, keys spanner.KeySet, columns []string, opts *spanner.ReadOptions) (ri *spanner.RowIterator)
+	QueryWithOptions(ctx context.Context, statement spanner.Statement, opts spanner.QueryOptions) *spanner.RowIterator
+}
+
+var (
+	_ YORODB = (*spanner.ReadOnlyTransaction)(nil)
+	_ YORODB = (*spanner.ReadWriteTransaction)(nil)
+	_ YORODB = (*spanner.BatchReadOnlyTransaction)(nil)
+
+	_ yoOptionsRODB = (*spanner.ReadOnlyTransaction)(nil)
+	_ yoOptionsRODB = (*spanner.ReadWriteTransaction)(nil)
+	_ yoOptionsRODB = (*spanner.BatchReadOnlyTransaction)(nil)
+)
+
+// YOStaleRead returns a single-use read-only transaction of client reading at
+// bound, such as spanner.ExactStaleness or spanner.MaxStaleness, which is
+// given to a generated read function as db. Its Timestamp returns the read
+// timestamp after the read.
+func YOStaleRead(client *spanner.Client, bound spanner.TimestampBound) *spanner.ReadOnlyTransaction {
+	return client.Single().WithTimestampBound(bound)
+}
+
+// yoReadOptions returns the options given to a generated reader, or nil if no
+// options are given. Only the first options are used.
+func yoReadOptions(opts []*spanner.ReadOptions) *spanner.ReadOptions {
+	if len(opts) == 0 {
+		return nil
+	}
+	return opts[0]
+}
+
+// YOPartitionOptions are the options of the generated partitioned reads and
+// queries in addition to spanner.PartitionOptions.
+type YOPartitionOptions struct {
+	// DataBoostEnabled executes the partitions by Data Boost, which runs
+	// analytical scans on independent compute resources instead of the
+	// provisioned ones of the instance.
+	DataBoostEnabled bool
+}
+
+// yoPartitionOptions returns the options given to a generated partitioned
+// read or query, or the zero value if no options are given. Only the first
+// options are used.
+func yoPartitionOptions(opts []*YOPartitionOptions) YOPartitionOptions {
+	if len(opts) == 0 || opts[0] == nil {
+		return YOPartitionOptions{}
+	}
+	return *opts[0]
+}
+
+// yoRead reads rows from table, or from index of table if index is not empty,
+// with opts if given.
+func yoRead(ctx context.Context, db YORODB, table, index string, keys spanner.KeySet, columns []string, opts []*spanner.ReadOptions) *spanner.RowIterator {
+	o := yoReadOptions(opts)
+	odb, ok := db.(yoOptionsRODB)
+	if o == nil || !ok {
+		if index == "" {
+			return db.Read(ctx, table, keys, columns)
+		}
+		return db.ReadUsingIndex(ctx, table, index, keys, columns)
+	}
+
+	ro := *o
+	ro.Index = index
+	return odb.ReadWithOptions(ctx, table, keys, columns, &ro)
+}
+
+// yoReadRow reads a row of key from table with opts if given. The error is
+// codes.NotFound if the row does not exist.
+func yoReadRow(ctx context.Context, db YORODB, table string, key spanner.Key, columns []string, opts []*spanner.ReadOptions) (*spanner.Row, error) {
+	if yoReadOptions(opts) == nil {
+		return db.ReadRow(ctx, table, key, columns)
+	}
+
+	iter := yoRead(ctx, db, table, "", key, columns, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, status.Errorf(codes.NotFound, "row not found(Table: %v, PrimaryKey: %v)", table, key)
+	}
+	return row, err
+}
+
+// yoQueryOptionsKey is the context key of the query options given by
+// YOWithQueryOptions.
+type yoQueryOptionsKey struct{}
+
+// YOWithQueryOptions returns a context whose generated queries are run with
+// opts, such as the optimizer version and statistics package of Options,
+// which are not in spanner.ReadOptions. The Priority, the RequestTag and the
+// DataBoostEnabled of the read options given to the functions take
+// precedence over the ones of opts.
+func YOWithQueryOptions(ctx context.Context, opts spanner.QueryOptions) context.Context {
+	return context.WithValue(ctx, yoQueryOptionsKey{}, opts)
+}
+
+// yoQuery runs stmt with opts if given. The Limit of opts limits the number
+// of rows, and the Priority, the RequestTag and the DataBoostEnabled are
+// passed to the query along with the query options of ctx.
+func yoQuery(ctx context.Context, db YORODB, stmt spanner.Statement, opts []*spanner.ReadOptions) *spanner.RowIterator {
+	o := yoReadOptions(opts)
+	if o != nil && o.Limit > 0 {
+		stmt.SQL += fmt.Sprintf(" LIMIT %d", o.Limit)
+	}
+	qo, hasQueryOptions := ctx.Value(yoQueryOptionsKey{}).(spanner.QueryOptions)
+	odb, ok := db.(yoOptionsRODB)
+	if !ok || o == nil && !hasQueryOptions {
+		return db.Query(ctx, stmt)
+	}
+
+	if o != nil {
+		if o.Priority != 0 {
+			qo.Priority = o.Priority
+		}
+		if o.RequestTag != "" {
+			qo.RequestTag = o.RequestTag
+		}
+		if o.DataBoostEnabled {
+			qo.DataBoostEnabled = true
+		}
+	}
+	return odb.QueryWithOptions(ctx, stmt, qo)
+}
+
+// yoClone returns a copy of v if it is a slice, so that the elements of v
+// modified in place are detected by comparing v with the copy.
+func yoClone[T any](v T) T {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice || rv.IsNil() {
+		return v
+	}
+
+	c := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+	reflect.Copy(c, rv)
+	return c.Interface().(T)
+}
+
 // YOLog provides the log func used by generated queries.
 var YOLog = func(context.Context, string, ...interface{}) {}
 
+// YOWarn provides the warning func used by generated finders, such as when
+// NULL is given to a finder of a null-filtered index, which never has the rows
+// having NULL in its key.
+var YOWarn = func(context.Context, string, ...interface{}) {}
+
+// YOLogger is the structured logger of the statements run by generated
+// functions. Each statement is logged at debug level with the method, the SQL,
+// the parameters and the latency after it finishes. It is nil by default,
+// which disables the logging.
+var YOLogger *slog.Logger
+
+// YORedactParams replaces the values of the parameters logged by YOLogger
+// with "REDACTED", such as when the values contain personal data.
+var YORedactParams = false
+
+// yoLogQuery logs stmt by YOLog, and returns the func logging stmt by YOLogger
+// with the latency since the call. It is deferred by generated functions.
+func yoLogQuery(ctx context.Context, method string, stmt spanner.Statement, args ...interface{}) func() {
+	YOLog(ctx, stmt.SQL, args...)
+
+	logger := YOLogger
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		names := make([]string, 0, len(stmt.Params))
+		for name := range stmt.Params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		params := make([]any, 0, len(names))
+		for _, name := range names {
+			if YORedactParams {
+				params = append(params, slog.String(name, "REDACTED"))
+			} else {
+				params = append(params, slog.Any(name, stmt.Params[name]))
+			}
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "yo: run statement",
+			slog.String("method", method),
+			slog.String("sql", stmt.SQL),
+			slog.Group("params", params...),
+			slog.Duration("latency", time.Since(start)),
+		)
+	}
+}
+
+// yoQuoteIdentifier quotes name as an identifier of a query, so that the
+// reserved keywords can be used as the names of columns.
+func yoQuoteIdentifier(name string) string {
+	return "`" + name + "`"
+}
+
+// yoBindParam binds v to params, and returns the placeholder of it in a query.
+func yoBindParam(params map[string]interface{}, name string, v interface{}) string {
+	params[name] = v
+	return "@" + name
+}
+
+// YOPredicate is a condition on a column used by generated query builders.
+// It is created only by the typed predicate constructors of the columns, so
+// that the column name is always valid and the value is always passed as a
+// query parameter.
+type YOPredicate struct {
+	column string
+	op     string
+	value  interface{}
+}
+
+// yoStatement builds a statement to select cols from table where all preds
+// are satisfied, sorted by orders and limited to limit rows if limit is
+// positive. The values of preds are bound to the parameters in the same manner
+// as the generated finders.
+func yoStatement(cols, table string, preds []YOPredicate, orders []string, limit int) spanner.Statement {
+	sqlstr := "SELECT " + cols + " FROM " + table
+	params := make(map[string]interface{}, len(preds))
+
+	conds := yoConditions(preds, params)
+	if len(conds) != 0 {
+		sqlstr += " WHERE " + strings.Join(conds, " AND ")
+	}
+	if len(orders) != 0 {
+		sqlstr += " ORDER BY " + strings.Join(orders, ", ")
+	}
+	if limit > 0 {
+		sqlstr += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	return spanner.Statement{SQL: sqlstr, Params: params}
+}
+
+// yoConditions returns the conditions of preds, and binds their values to
+// param0, param1, ... in params.
+func yoConditions(preds []YOPredicate, params map[string]interface{}) []string {
+	conds := make([]string, 0, len(preds))
+	for i, p := range preds {
+		name := fmt.Sprintf("param%d", i)
+		switch p.op {
+		case "IS NULL", "IS NOT NULL":
+			conds = append(conds, p.column+" "+p.op)
+		case "IN":
+			conds = append(conds, p.column+" IN UNNEST("+yoBindParam(params, name, p.value)+")")
+		default:
+			conds = append(conds, p.column+" "+p.op+" "+yoBindParam(params, name, p.value))
+		}
+	}
+
+	return conds
+}
+
+// yoCount runs stmt selecting COUNT(*) and returns the count. The Limit of
+// opts is ignored.
+func yoCount(ctx context.Context, db YORODB, stmt spanner.Statement, opts []*spanner.ReadOptions) (int64, error) {
+	iter := yoQuery(ctx, db, stmt, yoWithoutLimit(opts))
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	if err := row.Columns(&n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// yoWithoutLimit returns opts whose Limit is cleared, which is overridden by
+// the LIMIT clause of a query builder.
+func yoWithoutLimit(opts []*spanner.ReadOptions) []*spanner.ReadOptions {
+	o := yoReadOptions(opts)
+	if o == nil || o.Limit == 0 {
+		return opts
+	}
+	ro := *o
+	ro.Limit = 0
+	return []*spanner.ReadOptions{&ro}
+}
+
+// yoPageStatement builds the statement of a page of at most pageSize rows of
+// the query sqlstr, which are sorted by cols in the directions of desc. The
+// page starts after key, which is the values of cols of the last row of the
+// previous page, or at the first row if key is nil.
+func yoPageStatement(sqlstr string, cols []string, desc []bool, key []interface{}, pageSize int) spanner.Statement {
+	params := make(map[string]interface{}, len(key)+1)
+
+	if key != nil {
+		keys := make([]string, len(cols))
+		for i := range cols {
+			keys[i] = yoBindParam(params, fmt.Sprintf("key%d", i), key[i])
+		}
+
+		// (c0 > @key0) OR (c0 = @key0 AND c1 > @key1) OR ...
+		conds := make([]string, len(cols))
+		for i, col := range cols {
+			terms := make([]string, 0, i+1)
+			for j := 0; j < i; j++ {
+				terms = append(terms, fmt.Sprintf("%s = %s", cols[j], keys[j]))
+			}
+			op := ">"
+			if desc[i] {
+				op = "<"
+			}
+			terms = append(terms, fmt.Sprintf("%s %s %s", col, op, keys[i]))
+			conds[i] = "(" + strings.Join(terms, " AND ") + ")"
+		}
+		sqlstr += " WHERE " + strings.Join(conds, " OR ")
+	}
+
+	orders := make([]string, len(cols))
+	for i, col := range cols {
+		orders[i] = col
+		if desc[i] {
+			orders[i] += " DESC"
+		}
+	}
+	sqlstr += " ORDER BY " + strings.Join(orders, ", ") + " LIMIT " + yoBindParam(params, "limit", int64(pageSize))
+
+	return spanner.Statement{SQL: sqlstr, Params: params}
+}
+
+// yoEncodePageToken encodes the key of the last row of a page into the opaque
+// token of the next page.
+func yoEncodePageToken(key ...interface{}) (string, error) {
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// yoDecodePageToken decodes the key of token encoded by yoEncodePageToken into
+// ptrs.
+func yoDecodePageToken(token string, ptrs ...interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return fmt.Errorf("invalid page token: %v", err)
+	}
+	var key []json.RawMessage
+	if err := json.Unmarshal(b, &key); err != nil || len(key) != len(ptrs) {
+		return errors.New("invalid page token")
+	}
+	for i, v := range key {
+		if err := json.Unmarshal(v, ptrs[i]); err != nil {
+			return fmt.Errorf("invalid page token: %v", err)
+		}
+	}
+	return nil
+}
+
+// YOMutationLimit is the maximum number of mutations applied in a commit by
+// the generated bulk insert functions. Spanner limits the number of mutations
+// per commit, which counts the inserted columns and the index entries.
+var YOMutationLimit = 80000
+
+// yoApplyInBatches applies ms in batches of batchSize mutations, committing
+// each batch separately. It returns the number of mutations applied.
+func yoApplyInBatches(ctx context.Context, client *spanner.Client, method, table string, ms []*spanner.Mutation, batchSize int) (int, error) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	written := 0
+	for start := 0; start < len(ms); start += batchSize {
+		end := start + batchSize
+		if end > len(ms) {
+			end = len(ms)
+		}
+
+		if _, err := client.Apply(ctx, ms[start:end]); err != nil {
+			return written, newErrorWithCode(spanner.ErrCode(err), method, table,
+				fmt.Errorf("batch %d (rows %d to %d) failed after %d rows written: %w", start/batchSize, start, end-1, written, err))
+		}
+		written += end - start
+	}
+
+	return written, nil
+}
+
+// YOBatchWrite applies groups by the BatchWrite API, such as the groups of the
+// rows with their interleaved rows. Each group is applied atomically, but the
+// groups are applied independently in any order, so that it is intended for
+// high-throughput ingestion which does not need the atomicity of all the
+// groups. It returns the errors of the groups which failed by the indexes of
+// the groups. The other groups are applied unless the error is returned.
+func YOBatchWrite(ctx context.Context, client *spanner.Client, groups []*spanner.MutationGroup) (map[int]error, error) {
+	return yoBatchWrite(ctx, client, "YOBatchWrite", "", groups)
+}
+
+func yoBatchWrite(ctx context.Context, client *spanner.Client, method, table string, groups []*spanner.MutationGroup) (map[int]error, error) {
+	failed := make(map[int]error)
+	err := client.BatchWrite(ctx, groups).Do(func(res *spannerpb.BatchWriteResponse) error {
+		st := res.GetStatus()
+		if code := codes.Code(st.GetCode()); code != codes.OK {
+			for _, i := range res.GetIndexes() {
+				failed[int(i)] = newErrorWithCode(code, method, table, errors.New(st.GetMessage()))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return failed, newError(method, table, err)
+	}
+
+	return failed, nil
+}
+
+// YOTransactionTimeout bounds a transaction of YORunInTransaction including its
+// retries, unless the context already has an earlier deadline. Spanner retries
+// an aborted transaction until the context is done, so that a transaction
+// aborted repeatedly by contention would run forever without it.
+var YOTransactionTimeout = time.Minute
+
+// YOTransactionRetried is called with the transaction tag and the number of
+// the attempts so far, when a transaction of YORunInTransaction is aborted
+// and is retried, so that the aborts can be counted by the metrics. It is
+// nil by default.
+var YOTransactionRetried func(ctx context.Context, tag string, attempt int)
+
+// YORunInTransaction runs f in a read-write transaction of client tagged by
+// tag, and returns the commit timestamp. It is the intended entry point of the
+// generated functions taking a *spanner.ReadWriteTransaction. f is run again
+// when the transaction is aborted, so it must not have side effects other than
+// the writes to tx. The retries are reported to YOTransactionRetried, and are
+// logged by YOLogger at warn level.
+func YORunInTransaction(ctx context.Context, client *spanner.Client, tag string, f func(ctx context.Context, tx *spanner.ReadWriteTransaction) error) (time.Time, error) {
+	if YOTransactionTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, YOTransactionTimeout)
+		defer cancel()
+	}
+
+	attempt := 0
+	resp, err := client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		attempt++
+		if attempt > 1 {
+			if YOTransactionRetried != nil {
+				YOTransactionRetried(ctx, tag, attempt)
+			}
+			if logger := YOLogger; logger != nil {
+				logger.WarnContext(ctx, "yo: retry aborted transaction", slog.String("tag", tag), slog.Int("attempt", attempt))
+			}
+		}
+		return f(ctx, tx)
+	}, spanner.TransactionOptions{TransactionTag: tag})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return resp.CommitTs, nil
+}
+
+// YODescriptor describes a table for YORepository, which is generated as
+// XXXDescriptor for each table having a primary key. T is the struct of the
+// rows and K is the typed primary key of the table.
+type YODescriptor[T any, K any] struct {
+	// Table is the name of the table.
+	Table string
+
+	// Columns is the names of the columns read into T.
+	Columns []string
+
+	// PrimaryKeys is the names of the columns of the primary key.
+	PrimaryKeys []string
+
+	// Key returns the primary key of a row.
+	Key func(row *T) K
+
+	// SpannerKey returns the spanner.Key of a primary key.
+	SpannerKey func(key K) spanner.Key
+
+	// Scan scans a row of Columns into T.
+	Scan func(row *spanner.Row) (*T, error)
+
+	// Find gets a row by the primary key.
+	Find func(ctx context.Context, db YORODB, key K, opts ...*spanner.ReadOptions) (*T, error)
+
+	// Read retrieves the rows of keys.
+	Read func(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*T, error)
+
+	// Insert, Update, InsertOrUpdate and Delete return the Mutations to write
+	// a row.
+	Insert         func(ctx context.Context, row *T) *spanner.Mutation
+	Update         func(ctx context.Context, row *T) *spanner.Mutation
+	InsertOrUpdate func(ctx context.Context, row *T) *spanner.Mutation
+	Delete         func(ctx context.Context, row *T) *spanner.Mutation
+}
+
+// YORepository is the CRUD of the rows of a table described by a
+// YODescriptor, which is shared by the tables. The reads are strong reads by
+// single-use read-only transactions of the client, and the writes are applied
+// by the client. The generated functions of the table are still available.
+type YORepository[T any, K any] struct {
+	client *spanner.Client
+	desc   *YODescriptor[T, K]
+}
+
+// NewYORepository returns a YORepository of the table described by desc, such
+// as XXXDescriptor.
+func NewYORepository[T any, K any](client *spanner.Client, desc *YODescriptor[T, K]) *YORepository[T, K] {
+	return &YORepository[T, K]{client: client, desc: desc}
+}
+
+// Descriptor returns the YODescriptor of the table.
+func (r *YORepository[T, K]) Descriptor() *YODescriptor[T, K] {
+	return r.desc
+}
+
+// Get gets the row of key.
+func (r *YORepository[T, K]) Get(ctx context.Context, key K) (*T, error) {
+	return r.desc.Find(ctx, r.client.Single(), key)
+}
+
+// GetMulti gets the rows of keys. The rows not found are omitted.
+func (r *YORepository[T, K]) GetMulti(ctx context.Context, keys []K) ([]*T, error) {
+	ks := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		ks[i] = r.desc.SpannerKey(k)
+	}
+
+	return r.desc.Read(ctx, r.client.Single(), spanner.KeySets(ks...))
+}
+
+// Insert inserts rows.
+func (r *YORepository[T, K]) Insert(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "Insert", r.desc.Insert, rows)
+}
+
+// Update updates rows.
+func (r *YORepository[T, K]) Update(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "Update", r.desc.Update, rows)
+}
+
+// InsertOrUpdate inserts rows, or updates them if they already exist.
+func (r *YORepository[T, K]) InsertOrUpdate(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "InsertOrUpdate", r.desc.InsertOrUpdate, rows)
+}
+
+// Delete deletes rows.
+func (r *YORepository[T, K]) Delete(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "Delete", r.desc.Delete, rows)
+}
+
+// apply applies the Mutations of rows built by mutation in a commit.
+func (r *YORepository[T, K]) apply(ctx context.Context, method string, mutation func(context.Context, *T) *spanner.Mutation, rows []*T) error {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i, row := range rows {
+		ms[i] = mutation(ctx, row)
+	}
+
+	if _, err := r.client.Apply(ctx, ms); err != nil {
+		return newError(method, r.desc.Table, err)
+	}
+
+	return nil
+}
+
+// ErrNotFound is the error matched by errors.Is when the row is not found.
+var ErrNotFound = errors.New("yo: not found")
+
+// ErrStaleWrite is the error matched by errors.Is when an update with a
+// version check finds the row changed or deleted since it was read.
+var ErrStaleWrite = errors.New("yo: stale write")
+
+// NotFoundError is the error of a row not found in a table, which is wrapped
+// by the errors of the generated functions. It is matched by errors.Is with
+// ErrNotFound and the ErrXXXNotFound of the table, and can be retrieved by
+// errors.As to get the table and the key of the row.
+type NotFoundError struct {
+	Table string
+	Key   spanner.Key
+	Code  codes.Code
+
+	sentinel error
+	err      error
+}
+
+func (e *NotFoundError) Error() string {
+	if e.err != nil {
+		return fmt.Sprintf("row not found(Table: %v, Key: %v): %v", e.Table, e.Key, e.err)
+	}
+	return fmt.Sprintf("row not found(Table: %v, Key: %v)", e.Table, e.Key)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether target is ErrNotFound or the ErrXXXNotFound of the table.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound || target == e.sentinel
+}
+
+// newNotFoundError returns the error of method where the row of key is not
+// found in table. sentinel is the ErrXXXNotFound of the table, and err is the
+// reason if any.
+func newNotFoundError(method, table string, key spanner.Key, sentinel, err error) error {
+	return newErrorWithCode(codes.NotFound, method, table, &NotFoundError{
+		Table:    table,
+		Key:      key,
+		Code:     codes.NotFound,
+		sentinel: sentinel,
+		err:      err,
+	})
+}
+
 func newError(method, table string, err error) error {
 	code := spanner.ErrCode(err)
 	return newErrorWithCode(code, method, table, err)
@@ -58,6 +683,11 @@ func (e yoError) Unwrap() error {
 	return e.err
 }
 
+// Is reports whether the error is ErrNotFound by the code of the error.
+func (e yoError) Is(target error) bool {
+	return target == ErrNotFound && e.code == codes.NotFound
+}
+
 func (e yoError) DBTableName() string {
 	return e.table
 }
@@ -4,7 +4,9 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
@@ -22,6 +24,14 @@ type CompositePrimaryKey struct {
 	Z     string `spanner:"Z" json:"Z"`         // Z
 }
 
+// CompositePrimaryKeyTableName is the name of 'CompositePrimaryKeys', which is qualified by the
+// schema if the table is in a named schema.
+const CompositePrimaryKeyTableName = "CompositePrimaryKeys"
+
+// ErrCompositePrimaryKeyNotFound is the error matched by errors.Is when the row is not
+// found in 'CompositePrimaryKeys'.
+var ErrCompositePrimaryKeyNotFound = errors.New("yo: CompositePrimaryKeys not found")
+
 func CompositePrimaryKeyPrimaryKeys() []string {
 	return []string{
 		"PKey1",
@@ -41,6 +51,20 @@ func CompositePrimaryKeyColumns() []string {
 	}
 }
 
+// CompositePrimaryKeyColumn is a column of 'CompositePrimaryKeys'.
+type CompositePrimaryKeyColumn string
+
+// Columns of 'CompositePrimaryKeys'.
+const (
+	CompositePrimaryKeyColumnID    CompositePrimaryKeyColumn = "Id"
+	CompositePrimaryKeyColumnPKey1 CompositePrimaryKeyColumn = "PKey1"
+	CompositePrimaryKeyColumnPKey2 CompositePrimaryKeyColumn = "PKey2"
+	CompositePrimaryKeyColumnError CompositePrimaryKeyColumn = "Error"
+	CompositePrimaryKeyColumnX     CompositePrimaryKeyColumn = "X"
+	CompositePrimaryKeyColumnY     CompositePrimaryKeyColumn = "Y"
+	CompositePrimaryKeyColumnZ     CompositePrimaryKeyColumn = "Z"
+)
+
 func CompositePrimaryKeyWritableColumns() []string {
 	return []string{
 		"Id",
@@ -129,66 +153,22 @@ func newCompositePrimaryKey_Decoder(cols []string) func(*spanner.Row) (*Composit
 	}
 }
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (cpk *CompositePrimaryKey) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
-	return spanner.Insert("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
-}
-
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (cpk *CompositePrimaryKey) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
-	return spanner.Update("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
-}
-
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (cpk *CompositePrimaryKey) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
-	return spanner.InsertOrUpdate("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
-}
-
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (cpk *CompositePrimaryKey) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, CompositePrimaryKeyPrimaryKeys()...)
-
-	values, err := cpk.columnsToValues(colsWithPKeys)
-	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "CompositePrimaryKey.UpdateColumns", "CompositePrimaryKeys", err)
-	}
-
-	return spanner.Update("CompositePrimaryKeys", colsWithPKeys, values), nil
-}
-
-// FindCompositePrimaryKey gets a CompositePrimaryKey by primary key
-func FindCompositePrimaryKey(ctx context.Context, db YORODB, pKey1 string, pKey2 int64) (*CompositePrimaryKey, error) {
-	key := spanner.Key{pKey1, pKey2}
-	row, err := db.ReadRow(ctx, "CompositePrimaryKeys", key, CompositePrimaryKeyColumns())
-	if err != nil {
-		return nil, newError("FindCompositePrimaryKey", "CompositePrimaryKeys", err)
-	}
-
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
-	cpk, err := decoder(row)
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKey", "CompositePrimaryKeys", err)
-	}
-
-	return cpk, nil
+// ScanCompositePrimaryKey decodes row into CompositePrimaryKey. The row may have any subset of
+// the columns of 'CompositePrimaryKeys', such as the result of a query or a read.
+func ScanCompositePrimaryKey(row *spanner.Row) (*CompositePrimaryKey, error) {
+	return newCompositePrimaryKey_Decoder(row.ColumnNames())(row)
 }
 
-// ReadCompositePrimaryKey retrieves multiples rows from CompositePrimaryKey by KeySet as a slice.
-func ReadCompositePrimaryKey(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
+// ScanCompositePrimaryKeys decodes all the rows of iter into a slice of CompositePrimaryKey.
+// iter is stopped when it returns.
+func ScanCompositePrimaryKeys(iter *spanner.RowIterator) ([]*CompositePrimaryKey, error) {
 	var res []*CompositePrimaryKey
+	var decoder func(*spanner.Row) (*CompositePrimaryKey, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newCompositePrimaryKey_Decoder(row.ColumnNames())
+		}
 
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
-
-	rows := db.Read(ctx, "CompositePrimaryKeys", keys, CompositePrimaryKeyColumns())
-	err := rows.Do(func(row *spanner.Row) error {
 		cpk, err := decoder(row)
 		if err != nil {
 			return err
@@ -198,110 +178,179 @@ func ReadCompositePrimaryKey(ctx context.Context, db YORODB, keys spanner.KeySet
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKey", "CompositePrimaryKeys", err)
+		return nil, err
 	}
 
 	return res, nil
 }
 
-// Delete deletes the CompositePrimaryKey from the database.
-func (cpk *CompositePrimaryKey) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyPrimaryKeys())
-	return spanner.Delete("CompositePrimaryKeys", spanner.Key(values))
+// CompositePrimaryKeyIterator iterates over the rows of a partition of 'CompositePrimaryKeys'
+// decoded into CompositePrimaryKey.
+type CompositePrimaryKeyIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*CompositePrimaryKey, error)
 }
 
-// FindCompositePrimaryKeysByError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
-//
-// Generated from index 'CompositePrimaryKeysByError'.
-func FindCompositePrimaryKeysByError(ctx context.Context, db YORODB, e int64) ([]*CompositePrimaryKey, error) {
-	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
-		"WHERE Error = @param0"
+// ExecuteCompositePrimaryKeyPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllCompositePrimaryKeys or CompositePrimaryKeyQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteCompositePrimaryKeyPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *CompositePrimaryKeyIterator {
+	return &CompositePrimaryKeyIterator{iter: btx.Execute(ctx, p)}
+}
 
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = e
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *CompositePrimaryKeyIterator) Next() (*CompositePrimaryKey, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("CompositePrimaryKeyIterator.Next", "CompositePrimaryKeys", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newCompositePrimaryKey_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "CompositePrimaryKeyIterator.Next", "CompositePrimaryKeys", err)
+	}
 
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+	return v, nil
+}
 
-	// run query
-	YOLog(ctx, sqlstr, e)
-	iter := db.Query(ctx, stmt)
-	defer iter.Stop()
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *CompositePrimaryKeyIterator) Stop() {
+	it.iter.Stop()
+}
 
-	// load results
-	res := []*CompositePrimaryKey{}
+// yieldCompositePrimaryKeyRows decodes the rows of rows into CompositePrimaryKey and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldCompositePrimaryKeyRows(rows *spanner.RowIterator, method string, yield func(*CompositePrimaryKey, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*CompositePrimaryKey, error)
 	for {
-		row, err := iter.Next()
+		row, err := rows.Next()
 		if err != nil {
-			if err == iterator.Done {
-				break
+			if err != iterator.Done {
+				yield(nil, newError(method, "CompositePrimaryKeys", err))
 			}
-			return nil, newError("FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+			return
 		}
 
-		cpk, err := decoder(row)
+		if decoder == nil {
+			decoder = newCompositePrimaryKey_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+			yield(nil, newErrorWithCode(codes.Internal, method, "CompositePrimaryKeys", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
 		}
-
-		res = append(res, cpk)
 	}
-
-	return res, nil
 }
 
-// ReadCompositePrimaryKeysByError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'CompositePrimaryKeysByError'.
-func ReadCompositePrimaryKeysByError(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
-	columns := []string{
-		"PKey1",
-		"PKey2",
-		"Error",
+// CountCompositePrimaryKeys returns the number of the rows of 'CompositePrimaryKeys'.
+func CountCompositePrimaryKeys(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM CompositePrimaryKeys")
+
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeys", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeys", "CompositePrimaryKeys", err)
 	}
 
-	decoder := newCompositePrimaryKey_Decoder(columns)
+	return n, nil
+}
 
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByError", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
-		if err != nil {
-			return err
-		}
-		res = append(res, cpk)
+// AllCompositePrimaryKeysSeq returns an iterator of all the rows of 'CompositePrimaryKeys',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*CompositePrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllCompositePrimaryKeysSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*CompositePrimaryKey, error) bool) {
+	return func(yield func(*CompositePrimaryKey, error) bool) {
+		rows := yoRead(ctx, db, "CompositePrimaryKeys", "", spanner.AllKeys(), CompositePrimaryKeyColumns(), opts)
+		yieldCompositePrimaryKeyRows(rows, "AllCompositePrimaryKeysSeq", yield)
+	}
+}
 
-		return nil
-	})
+// PartitionReadAllCompositePrimaryKeys partitions the read of all the rows of
+// 'CompositePrimaryKeys' in btx, so that the partitions are read in parallel by
+// ExecuteCompositePrimaryKeyPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllCompositePrimaryKeys(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "CompositePrimaryKeys", spanner.AllKeys(), CompositePrimaryKeyColumns(), opts, ropts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+		return nil, newError("PartitionReadAllCompositePrimaryKeys", "CompositePrimaryKeys", err)
 	}
 
-	return res, nil
+	return ps, nil
 }
 
-// FindCompositePrimaryKeysByZError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
-//
-// Generated from index 'CompositePrimaryKeysByError2'.
-func FindCompositePrimaryKeysByZError(ctx context.Context, db YORODB, e int64) ([]*CompositePrimaryKey, error) {
-	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
-		"WHERE Error = @param0"
+// CompositePrimaryKeyQuery is a query builder for 'CompositePrimaryKeys'. The conditions are
+// given by the predicates of CompositePrimaryKeyWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of CompositePrimaryKeyColumn.
+type CompositePrimaryKeyQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
 
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = e
+// NewCompositePrimaryKeyQuery returns a CompositePrimaryKeyQuery filtered by preds.
+func NewCompositePrimaryKeyQuery(preds ...YOPredicate) *CompositePrimaryKeyQuery {
+	return &CompositePrimaryKeyQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *CompositePrimaryKeyQuery) Where(preds ...YOPredicate) *CompositePrimaryKeyQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *CompositePrimaryKeyQuery) OrderBy(col CompositePrimaryKeyColumn) *CompositePrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *CompositePrimaryKeyQuery) OrderByDesc(col CompositePrimaryKeyColumn) *CompositePrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *CompositePrimaryKeyQuery) Limit(n int) *CompositePrimaryKeyQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *CompositePrimaryKeyQuery) Statement() spanner.Statement {
+	return yoStatement("Id, PKey1, PKey2, Error, X, Y, Z", "CompositePrimaryKeys", q.preds, q.orders, q.limit)
+}
 
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+// Query runs the query and returns the matched rows as a slice.
+func (q *CompositePrimaryKeyQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
 
 	// run query
-	YOLog(ctx, sqlstr, e)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
@@ -312,164 +361,1574 @@ func FindCompositePrimaryKeysByZError(ctx context.Context, db YORODB, e int64) (
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+			return nil, newError("CompositePrimaryKeyQuery.Query", "CompositePrimaryKeys", err)
 		}
 
-		cpk, err := decoder(row)
+		v, err := ScanCompositePrimaryKey(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+			return nil, newErrorWithCode(codes.Internal, "CompositePrimaryKeyQuery.Query", "CompositePrimaryKeys", err)
 		}
 
-		res = append(res, cpk)
+		res = append(res, v)
 	}
 
 	return res, nil
 }
 
-// ReadCompositePrimaryKeysByZError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'CompositePrimaryKeysByError2'.
-func ReadCompositePrimaryKeysByZError(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
-	columns := []string{
-		"PKey1",
-		"PKey2",
-		"Error",
-		"Z",
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *CompositePrimaryKeyQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "CompositePrimaryKeys", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CompositePrimaryKeyQuery.Count", "CompositePrimaryKeys", err)
 	}
 
-	decoder := newCompositePrimaryKey_Decoder(columns)
+	return n, nil
+}
 
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByError2", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
-		if err != nil {
-			return err
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*CompositePrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *CompositePrimaryKeyQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*CompositePrimaryKey, error) bool) {
+	return func(yield func(*CompositePrimaryKey, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
 		}
-		res = append(res, cpk)
 
-		return nil
-	})
+		defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.Seq", stmt)()
+		yieldCompositePrimaryKeyRows(yoQuery(ctx, db, stmt, opts), "CompositePrimaryKeyQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteCompositePrimaryKeyPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *CompositePrimaryKeyQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "CompositePrimaryKeyQuery.PartitionQuery", "CompositePrimaryKeys", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+		return nil, newError("CompositePrimaryKeyQuery.PartitionQuery", "CompositePrimaryKeys", err)
 	}
 
-	return res, nil
+	return ps, nil
 }
 
-// FindCompositePrimaryKeysByZYError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
-//
-// Generated from index 'CompositePrimaryKeysByError3'.
-func FindCompositePrimaryKeysByZYError(ctx context.Context, db YORODB, e int64) ([]*CompositePrimaryKey, error) {
-	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
-		"WHERE Error = @param0"
-
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = e
+// CompositePrimaryKeyWhere has the typed predicate constructors of the columns of
+// 'CompositePrimaryKeys'.
+var CompositePrimaryKeyWhere = struct {
+	ID    CompositePrimaryKey_IDColumn
+	PKey1 CompositePrimaryKey_PKey1Column
+	PKey2 CompositePrimaryKey_PKey2Column
+	Error CompositePrimaryKey_ErrorColumn
+	X     CompositePrimaryKey_XColumn
+	Y     CompositePrimaryKey_YColumn
+	Z     CompositePrimaryKey_ZColumn
+}{}
+
+// CompositePrimaryKey_IDColumn has the predicate constructors of 'Id'.
+type CompositePrimaryKey_IDColumn struct{}
+
+// Eq returns a predicate that 'Id' is equal to v.
+func (CompositePrimaryKey_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "=", value: v}
+}
 
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+// Ne returns a predicate that 'Id' is not equal to v.
+func (CompositePrimaryKey_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "!=", value: v}
+}
 
-	// run query
-	YOLog(ctx, sqlstr, e)
-	iter := db.Query(ctx, stmt)
-	defer iter.Stop()
+// Lt returns a predicate that 'Id' is less than v.
+func (CompositePrimaryKey_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "<", value: v}
+}
 
-	// load results
-	res := []*CompositePrimaryKey{}
-	for {
-		row, err := iter.Next()
-		if err != nil {
-			if err == iterator.Done {
-				break
-			}
-			return nil, newError("FindCompositePrimaryKeysByZYError", "CompositePrimaryKeys", err)
-		}
+// Le returns a predicate that 'Id' is less than or equal to v.
+func (CompositePrimaryKey_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "<=", value: v}
+}
 
-		cpk, err := decoder(row)
-		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByZYError", "CompositePrimaryKeys", err)
-		}
+// Gt returns a predicate that 'Id' is greater than v.
+func (CompositePrimaryKey_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: ">", value: v}
+}
 
-		res = append(res, cpk)
-	}
+// Ge returns a predicate that 'Id' is greater than or equal to v.
+func (CompositePrimaryKey_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: ">=", value: v}
+}
 
-	return res, nil
+// In returns a predicate that 'Id' is equal to any of vs.
+func (CompositePrimaryKey_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "IN", value: vs}
 }
 
-// ReadCompositePrimaryKeysByZYError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'CompositePrimaryKeysByError3'.
-func ReadCompositePrimaryKeysByZYError(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
-	columns := []string{
-		"PKey1",
-		"PKey2",
-		"Error",
-		"Z",
-		"Y",
-	}
+// CompositePrimaryKey_PKey1Column has the predicate constructors of 'PKey1'.
+type CompositePrimaryKey_PKey1Column struct{}
 
-	decoder := newCompositePrimaryKey_Decoder(columns)
+// Eq returns a predicate that 'PKey1' is equal to v.
+func (CompositePrimaryKey_PKey1Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "=", value: v}
+}
 
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByError3", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
-		if err != nil {
-			return err
-		}
-		res = append(res, cpk)
+// Ne returns a predicate that 'PKey1' is not equal to v.
+func (CompositePrimaryKey_PKey1Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "!=", value: v}
+}
 
-		return nil
-	})
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByZYError", "CompositePrimaryKeys", err)
-	}
+// Lt returns a predicate that 'PKey1' is less than v.
+func (CompositePrimaryKey_PKey1Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<", value: v}
+}
 
-	return res, nil
+// Le returns a predicate that 'PKey1' is less than or equal to v.
+func (CompositePrimaryKey_PKey1Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<=", value: v}
 }
 
-// FindCompositePrimaryKeysByXY retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
-//
-// Generated from index 'CompositePrimaryKeysByXY'.
-func FindCompositePrimaryKeysByXY(ctx context.Context, db YORODB, x string, y string) ([]*CompositePrimaryKey, error) {
-	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
-		"WHERE X = @param0 AND Y = @param1"
+// Gt returns a predicate that 'PKey1' is greater than v.
+func (CompositePrimaryKey_PKey1Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">", value: v}
+}
 
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = x
-	stmt.Params["param1"] = y
+// Ge returns a predicate that 'PKey1' is greater than or equal to v.
+func (CompositePrimaryKey_PKey1Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">=", value: v}
+}
 
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+// In returns a predicate that 'PKey1' is equal to any of vs.
+func (CompositePrimaryKey_PKey1Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "IN", value: vs}
+}
 
-	// run query
-	YOLog(ctx, sqlstr, x, y)
-	iter := db.Query(ctx, stmt)
-	defer iter.Stop()
+// CompositePrimaryKey_PKey2Column has the predicate constructors of 'PKey2'.
+type CompositePrimaryKey_PKey2Column struct{}
 
-	// load results
-	res := []*CompositePrimaryKey{}
-	for {
-		row, err := iter.Next()
-		if err != nil {
-			if err == iterator.Done {
-				break
-			}
-			return nil, newError("FindCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
-		}
+// Eq returns a predicate that 'PKey2' is equal to v.
+func (CompositePrimaryKey_PKey2Column) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "=", value: v}
+}
 
-		cpk, err := decoder(row)
+// Ne returns a predicate that 'PKey2' is not equal to v.
+func (CompositePrimaryKey_PKey2Column) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey2' is less than v.
+func (CompositePrimaryKey_PKey2Column) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey2' is less than or equal to v.
+func (CompositePrimaryKey_PKey2Column) Le(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey2' is greater than v.
+func (CompositePrimaryKey_PKey2Column) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey2' is greater than or equal to v.
+func (CompositePrimaryKey_PKey2Column) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey2' is equal to any of vs.
+func (CompositePrimaryKey_PKey2Column) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_ErrorColumn has the predicate constructors of 'Error'.
+type CompositePrimaryKey_ErrorColumn struct{}
+
+// Eq returns a predicate that 'Error' is equal to v.
+func (CompositePrimaryKey_ErrorColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Error' is not equal to v.
+func (CompositePrimaryKey_ErrorColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Error' is less than v.
+func (CompositePrimaryKey_ErrorColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Error' is less than or equal to v.
+func (CompositePrimaryKey_ErrorColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Error' is greater than v.
+func (CompositePrimaryKey_ErrorColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Error' is greater than or equal to v.
+func (CompositePrimaryKey_ErrorColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Error' is equal to any of vs.
+func (CompositePrimaryKey_ErrorColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_XColumn has the predicate constructors of 'X'.
+type CompositePrimaryKey_XColumn struct{}
+
+// Eq returns a predicate that 'X' is equal to v.
+func (CompositePrimaryKey_XColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'X' is not equal to v.
+func (CompositePrimaryKey_XColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'X' is less than v.
+func (CompositePrimaryKey_XColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "<", value: v}
+}
+
+// Le returns a predicate that 'X' is less than or equal to v.
+func (CompositePrimaryKey_XColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'X' is greater than v.
+func (CompositePrimaryKey_XColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "X", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'X' is greater than or equal to v.
+func (CompositePrimaryKey_XColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "X", op: ">=", value: v}
+}
+
+// In returns a predicate that 'X' is equal to any of vs.
+func (CompositePrimaryKey_XColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "X", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_YColumn has the predicate constructors of 'Y'.
+type CompositePrimaryKey_YColumn struct{}
+
+// Eq returns a predicate that 'Y' is equal to v.
+func (CompositePrimaryKey_YColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Y' is not equal to v.
+func (CompositePrimaryKey_YColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Y' is less than v.
+func (CompositePrimaryKey_YColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Y' is less than or equal to v.
+func (CompositePrimaryKey_YColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Y' is greater than v.
+func (CompositePrimaryKey_YColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Y' is greater than or equal to v.
+func (CompositePrimaryKey_YColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Y' is equal to any of vs.
+func (CompositePrimaryKey_YColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "Y", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_ZColumn has the predicate constructors of 'Z'.
+type CompositePrimaryKey_ZColumn struct{}
+
+// Eq returns a predicate that 'Z' is equal to v.
+func (CompositePrimaryKey_ZColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Z' is not equal to v.
+func (CompositePrimaryKey_ZColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Z' is less than v.
+func (CompositePrimaryKey_ZColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Z' is less than or equal to v.
+func (CompositePrimaryKey_ZColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Z' is greater than v.
+func (CompositePrimaryKey_ZColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Z' is greater than or equal to v.
+func (CompositePrimaryKey_ZColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Z' is equal to any of vs.
+func (CompositePrimaryKey_ZColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "Z", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (cpk *CompositePrimaryKey) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
+	return spanner.Insert("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
+}
+
+// InsertAllCompositePrimaryKeys inserts rows into 'CompositePrimaryKeys' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllCompositePrimaryKeys(ctx context.Context, client *spanner.Client, rows []*CompositePrimaryKey) (int, error) {
+	ms := CompositePrimaryKeysInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(CompositePrimaryKeyWritableColumns()) * (1 + 4)
+
+	return yoApplyInBatches(ctx, client, "InsertAllCompositePrimaryKeys", "CompositePrimaryKeys", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// CompositePrimaryKeysInsert returns Mutations to insert the rows into
+// 'CompositePrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllCompositePrimaryKeys, the Mutations are
+// not split into batches.
+func CompositePrimaryKeysInsert(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (cpk *CompositePrimaryKey) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
+	return spanner.Update("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (cpk *CompositePrimaryKey) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
+	return spanner.InsertOrUpdate("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
+}
+
+// CompositePrimaryKeysUpdate returns Mutations to update the rows in
+// 'CompositePrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func CompositePrimaryKeysUpdate(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// CompositePrimaryKeysInsertOrUpdate returns Mutations to insert or update the
+// rows in 'CompositePrimaryKeys', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func CompositePrimaryKeysInsertOrUpdate(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the CompositePrimaryKey with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (cpk *CompositePrimaryKey) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{cpk.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteCompositePrimaryKeys inserts or updates rows in 'CompositePrimaryKeys' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteCompositePrimaryKeys(ctx context.Context, client *spanner.Client, rows []*CompositePrimaryKey) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteCompositePrimaryKeys", "CompositePrimaryKeys", groups)
+}
+
+// InsertOrUpdateCompositePrimaryKeyAtLeastOnce inserts or updates row in 'CompositePrimaryKeys' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateCompositePrimaryKeyAtLeastOnce(ctx context.Context, client *spanner.Client, row *CompositePrimaryKey) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateCompositePrimaryKeyAtLeastOnce", "CompositePrimaryKeys", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (cpk *CompositePrimaryKey) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, CompositePrimaryKeyPrimaryKeys()...)
+
+	values, err := cpk.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "CompositePrimaryKey.UpdateColumns", "CompositePrimaryKeys", err)
+	}
+
+	return spanner.Update("CompositePrimaryKeys", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'CompositePrimaryKeys' can be specified.
+func (cpk *CompositePrimaryKey) MutationForColumns(ctx context.Context, cols ...CompositePrimaryKeyColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return cpk.UpdateColumns(ctx, names...)
+}
+
+// CompositePrimaryKeyTracker tracks the changes of a CompositePrimaryKey, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type CompositePrimaryKeyTracker struct {
+	row  *CompositePrimaryKey
+	orig CompositePrimaryKey
+}
+
+// TrackCompositePrimaryKey returns a CompositePrimaryKeyTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackCompositePrimaryKey(row *CompositePrimaryKey) *CompositePrimaryKeyTracker {
+	tr := &CompositePrimaryKeyTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked CompositePrimaryKey.
+func (tr *CompositePrimaryKeyTracker) Row() *CompositePrimaryKey {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *CompositePrimaryKeyTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.ID = yoClone(tr.row.ID)
+	tr.orig.Error = yoClone(tr.row.Error)
+	tr.orig.X = yoClone(tr.row.X)
+	tr.orig.Y = yoClone(tr.row.Y)
+	tr.orig.Z = yoClone(tr.row.Z)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'CompositePrimaryKeys'. The primary key columns and the
+// generated columns are never included.
+func (tr *CompositePrimaryKeyTracker) Changed() []CompositePrimaryKeyColumn {
+	var cols []CompositePrimaryKeyColumn
+	if !reflect.DeepEqual(tr.orig.ID, tr.row.ID) {
+		cols = append(cols, CompositePrimaryKeyColumnID)
+	}
+	if !reflect.DeepEqual(tr.orig.Error, tr.row.Error) {
+		cols = append(cols, CompositePrimaryKeyColumnError)
+	}
+	if !reflect.DeepEqual(tr.orig.X, tr.row.X) {
+		cols = append(cols, CompositePrimaryKeyColumnX)
+	}
+	if !reflect.DeepEqual(tr.orig.Y, tr.row.Y) {
+		cols = append(cols, CompositePrimaryKeyColumnY)
+	}
+	if !reflect.DeepEqual(tr.orig.Z, tr.row.Z) {
+		cols = append(cols, CompositePrimaryKeyColumnZ)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *CompositePrimaryKeyTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindCompositePrimaryKey gets a CompositePrimaryKey by primary key
+func FindCompositePrimaryKey(ctx context.Context, db YORODB, pKey1 string, pKey2 int64, opts ...*spanner.ReadOptions) (*CompositePrimaryKey, error) {
+	key := spanner.Key{pKey1, pKey2}
+	row, err := yoReadRow(ctx, db, "CompositePrimaryKeys", key, CompositePrimaryKeyColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindCompositePrimaryKey", "CompositePrimaryKeys", key, ErrCompositePrimaryKeyNotFound, nil)
+		}
+		return nil, newError("FindCompositePrimaryKey", "CompositePrimaryKeys", err)
+	}
+
+	cpk, err := ScanCompositePrimaryKey(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKey", "CompositePrimaryKeys", err)
+	}
+
+	return cpk, nil
+}
+
+// CompositePrimaryKeyExists reports whether the row of the primary key exists in
+// 'CompositePrimaryKeys', which reads only the primary key columns.
+func CompositePrimaryKeyExists(ctx context.Context, db YORODB, pKey1 string, pKey2 int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{pKey1, pKey2}
+	if _, err := yoReadRow(ctx, db, "CompositePrimaryKeys", key, CompositePrimaryKeyPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("CompositePrimaryKeyExists", "CompositePrimaryKeys", err)
+	}
+
+	return true, nil
+}
+
+// ReadCompositePrimaryKey retrieves multiples rows from CompositePrimaryKey by KeySet as a slice.
+func ReadCompositePrimaryKey(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKey", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// CompositePrimaryKeyPrimaryKey is the primary key of 'CompositePrimaryKeys'.
+type CompositePrimaryKeyPrimaryKey struct {
+	PKey1 string
+	PKey2 int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadCompositePrimaryKey.
+func (k CompositePrimaryKeyPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.PKey1, k.PKey2}
+}
+
+// ParseCompositePrimaryKeyPrimaryKey parses key of the columns of the primary key of
+// 'CompositePrimaryKeys' in order, such as the key of a mutation.
+func ParseCompositePrimaryKeyPrimaryKey(key spanner.Key) (CompositePrimaryKeyPrimaryKey, error) {
+	var k CompositePrimaryKeyPrimaryKey
+	if len(key) != 2 {
+		return k, fmt.Errorf("key of 'CompositePrimaryKeys' must have 2 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(string)
+	if !ok {
+		return k, fmt.Errorf("column 'PKey1' of the key of 'CompositePrimaryKeys' must be string, but got %T", key[0])
+	}
+	k.PKey1 = v0
+	v1, ok := key[1].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'PKey2' of the key of 'CompositePrimaryKeys' must be int64, but got %T", key[1])
+	}
+	k.PKey2 = v1
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of cpk.
+func (cpk *CompositePrimaryKey) PrimaryKey() CompositePrimaryKeyPrimaryKey {
+	return CompositePrimaryKeyPrimaryKey{
+		PKey1: cpk.PKey1,
+		PKey2: cpk.PKey2,
+	}
+}
+
+// FindCompositePrimaryKeyByKey gets a CompositePrimaryKey by the primary key of key.
+func FindCompositePrimaryKeyByKey(ctx context.Context, db YORODB, key CompositePrimaryKeyPrimaryKey, opts ...*spanner.ReadOptions) (*CompositePrimaryKey, error) {
+	row, err := yoReadRow(ctx, db, "CompositePrimaryKeys", key.ToSpannerKey(), CompositePrimaryKeyColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindCompositePrimaryKeyByKey", "CompositePrimaryKeys", key.ToSpannerKey(), ErrCompositePrimaryKeyNotFound, nil)
+		}
+		return nil, newError("FindCompositePrimaryKeyByKey", "CompositePrimaryKeys", err)
+	}
+
+	cpk, err := ScanCompositePrimaryKey(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeyByKey", "CompositePrimaryKeys", err)
+	}
+
+	return cpk, nil
+}
+
+// DeleteCompositePrimaryKeyByKey returns a Mutation to delete the row of key from
+// 'CompositePrimaryKeys'.
+func DeleteCompositePrimaryKeyByKey(ctx context.Context, key CompositePrimaryKeyPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("CompositePrimaryKeys", key.ToSpannerKey())
+}
+
+// FindCompositePrimaryKeysByKeys retrieves the rows of keys from 'CompositePrimaryKeys' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindCompositePrimaryKeysByKeys(ctx context.Context, db YORODB, keys []CompositePrimaryKeyPrimaryKey, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*CompositePrimaryKey, len(keys))
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", spanner.KeySets(keySets...), CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		byKey[cpk.primaryKey().String()] = cpk
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByKeys", "CompositePrimaryKeys", err)
+	}
+
+	res := make([]*CompositePrimaryKey, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if cpk, ok := byKey[s]; ok {
+			res = append(res, cpk)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListCompositePrimaryKeysPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListCompositePrimaryKeysPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysPage", "CompositePrimaryKeys", err)
+		}
+		key = []interface{}{k0, k1}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys",
+		[]string{"PKey1", "PKey2"},
+		[]bool{false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadCompositePrimaryKeyByPKey1 retrieves multiples rows from CompositePrimaryKey whose primary key
+// starts with the given key columns as a slice.
+func ReadCompositePrimaryKeyByPKey1(ctx context.Context, db YORODB, pKey1 string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+
+	keys := spanner.Key{pKey1}.AsPrefix()
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeyByPKey1", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// ReadCompositePrimaryKeyRange retrieves multiple rows from CompositePrimaryKey whose
+// 'PKey1' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadCompositePrimaryKeyRange(ctx context.Context, db YORODB, start, end string, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeyRange", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// ReadCompositePrimaryKeyByPKey1Range retrieves multiple rows from CompositePrimaryKey whose
+// 'PKey2' is between start and end as a slice.
+// The rows are limited to the ones whose primary key starts with the given
+// key columns.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadCompositePrimaryKeyByPKey1Range(ctx context.Context, db YORODB, pKey1 string, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{pKey1, start},
+		End:   spanner.Key{pKey1, end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeyByPKey1Range", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the CompositePrimaryKey, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (cpk *CompositePrimaryKey) primaryKey() spanner.Key {
+	return spanner.Key{cpk.PKey1, cpk.PKey2}
+}
+
+// Delete deletes the CompositePrimaryKey from the database.
+func (cpk *CompositePrimaryKey) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("CompositePrimaryKeys", cpk.primaryKey())
+}
+
+// CompositePrimaryKeysDelete returns Mutations to delete the rows from
+// 'CompositePrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func CompositePrimaryKeysDelete(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// CompositePrimaryKeyDescriptor describes 'CompositePrimaryKeys' for YORepository.
+var CompositePrimaryKeyDescriptor = &YODescriptor[CompositePrimaryKey, CompositePrimaryKeyPrimaryKey]{
+	Table:       "CompositePrimaryKeys",
+	Columns:     CompositePrimaryKeyColumns(),
+	PrimaryKeys: CompositePrimaryKeyPrimaryKeys(),
+	Key: func(row *CompositePrimaryKey) CompositePrimaryKeyPrimaryKey {
+		return CompositePrimaryKeyPrimaryKey{
+			PKey1: row.PKey1,
+			PKey2: row.PKey2,
+		}
+	},
+	SpannerKey: CompositePrimaryKeyPrimaryKey.ToSpannerKey,
+	Scan:       ScanCompositePrimaryKey,
+	Find:       FindCompositePrimaryKeyByKey,
+	Read:       ReadCompositePrimaryKey,
+	Insert: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewCompositePrimaryKeyRepository returns a YORepository of 'CompositePrimaryKeys' by client.
+func NewCompositePrimaryKeyRepository(client *spanner.Client) *YORepository[CompositePrimaryKey, CompositePrimaryKeyPrimaryKey] {
+	return NewYORepository(client, CompositePrimaryKeyDescriptor)
+}
+
+// FindCompositePrimaryKeysByError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByError' (Error).
+func FindCompositePrimaryKeysByError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByError", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+		}
+
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, cpk)
+	}
+
+	return res, nil
+}
+
+// CountCompositePrimaryKeysByError returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByError.
+func CountCompositePrimaryKeysByError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByError", stmt, e)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// ReadCompositePrimaryKeysByError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'CompositePrimaryKeysByError' (Error).
+func ReadCompositePrimaryKeysByError(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	columns := []string{
+		"PKey1",
+		"PKey2",
+		"Error",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// CompositePrimaryKeysByErrorRow represents a row of index 'CompositePrimaryKeysByError' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByErrorRow struct {
+	Error int64  `spanner:"Error" json:"Error"` // Error
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
+
+// ReadCompositePrimaryKeysByErrorRows retrieves multiples rows from index 'CompositePrimaryKeysByError' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByErrorRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByErrorRow, error) {
+	var res []*CompositePrimaryKeysByErrorRow
+	columns := []string{
+		"Error",
+		"PKey1",
+		"PKey2",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanCompositePrimaryKeysByErrorRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByErrorRows", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// scanCompositePrimaryKeysByErrorRow decodes row having the columns of CompositePrimaryKeysByErrorRow in order.
+func scanCompositePrimaryKeysByErrorRow(row *spanner.Row) (*CompositePrimaryKeysByErrorRow, error) {
+	var r CompositePrimaryKeysByErrorRow
+	if err := row.Columns(&r.Error, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindCompositePrimaryKeysByErrorRows retrieves multiple rows from index 'CompositePrimaryKeysByError' as a
+// slice of CompositePrimaryKeysByErrorRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByErrorRows(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByErrorRow, error) {
+	const sqlstr = "SELECT " +
+		"Error, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByErrorRows", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*CompositePrimaryKeysByErrorRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByErrorRows", "CompositePrimaryKeys", err)
+		}
+
+		r, err := scanCompositePrimaryKeysByErrorRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByErrorRows", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListCompositePrimaryKeysByErrorPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByError' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByErrorPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 string
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", err)
+		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError}",
+		[]string{"Error", "PKey1", "PKey2"},
+		[]bool{false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByErrorPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.Error, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
+}
+
+// FindCompositePrimaryKeysByZError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByError2' (Error).
+func FindCompositePrimaryKeysByZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByZError", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+		}
+
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, cpk)
+	}
+
+	return res, nil
+}
+
+// CountCompositePrimaryKeysByZError returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByZError.
+func CountCompositePrimaryKeysByZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByZError", stmt, e)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// ReadCompositePrimaryKeysByZError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'CompositePrimaryKeysByError2' (Error).
+func ReadCompositePrimaryKeysByZError(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	columns := []string{
+		"PKey1",
+		"PKey2",
+		"Error",
+		"Z",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError2", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// CompositePrimaryKeysByError2Row represents a row of index 'CompositePrimaryKeysByError2' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByError2Row struct {
+	Error int64  `spanner:"Error" json:"Error"` // Error
+	Z     string `spanner:"Z" json:"Z"`         // Z
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
+
+// ReadCompositePrimaryKeysByError2Rows retrieves multiples rows from index 'CompositePrimaryKeysByError2' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByError2Rows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError2Row, error) {
+	var res []*CompositePrimaryKeysByError2Row
+	columns := []string{
+		"Error",
+		"Z",
+		"PKey1",
+		"PKey2",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError2", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanCompositePrimaryKeysByError2Row(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError2Rows", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// scanCompositePrimaryKeysByError2Row decodes row having the columns of CompositePrimaryKeysByError2Row in order.
+func scanCompositePrimaryKeysByError2Row(row *spanner.Row) (*CompositePrimaryKeysByError2Row, error) {
+	var r CompositePrimaryKeysByError2Row
+	if err := row.Columns(&r.Error, &r.Z, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindCompositePrimaryKeysByError2Rows retrieves multiple rows from index 'CompositePrimaryKeysByError2' as a
+// slice of CompositePrimaryKeysByError2Row by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByError2Rows(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError2Row, error) {
+	const sqlstr = "SELECT " +
+		"Error, Z, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByError2Rows", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*CompositePrimaryKeysByError2Row{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByError2Rows", "CompositePrimaryKeys", err)
+		}
+
+		r, err := scanCompositePrimaryKeysByError2Row(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError2Rows", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListCompositePrimaryKeysByZErrorPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByError2' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByZErrorPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 string
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", err)
+		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2}",
+		[]string{"Error", "PKey1", "PKey2"},
+		[]bool{false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByZErrorPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.Error, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
+}
+
+// FindCompositePrimaryKeysByYZError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByError3' (Error).
+func FindCompositePrimaryKeysByYZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByYZError", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
+		}
+
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, cpk)
+	}
+
+	return res, nil
+}
+
+// CountCompositePrimaryKeysByYZError returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByYZError.
+func CountCompositePrimaryKeysByYZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByYZError", stmt, e)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// ReadCompositePrimaryKeysByYZError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'CompositePrimaryKeysByError3' (Error).
+func ReadCompositePrimaryKeysByYZError(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	columns := []string{
+		"PKey1",
+		"PKey2",
+		"Error",
+		"Y",
+		"Z",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError3", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// CompositePrimaryKeysByError3Row represents a row of index 'CompositePrimaryKeysByError3' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByError3Row struct {
+	Error int64  `spanner:"Error" json:"Error"` // Error
+	Y     string `spanner:"Y" json:"Y"`         // Y
+	Z     string `spanner:"Z" json:"Z"`         // Z
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
+
+// ReadCompositePrimaryKeysByError3Rows retrieves multiples rows from index 'CompositePrimaryKeysByError3' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByError3Rows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError3Row, error) {
+	var res []*CompositePrimaryKeysByError3Row
+	columns := []string{
+		"Error",
+		"Y",
+		"Z",
+		"PKey1",
+		"PKey2",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError3", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanCompositePrimaryKeysByError3Row(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError3Rows", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// scanCompositePrimaryKeysByError3Row decodes row having the columns of CompositePrimaryKeysByError3Row in order.
+func scanCompositePrimaryKeysByError3Row(row *spanner.Row) (*CompositePrimaryKeysByError3Row, error) {
+	var r CompositePrimaryKeysByError3Row
+	if err := row.Columns(&r.Error, &r.Y, &r.Z, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindCompositePrimaryKeysByError3Rows retrieves multiple rows from index 'CompositePrimaryKeysByError3' as a
+// slice of CompositePrimaryKeysByError3Row by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByError3Rows(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError3Row, error) {
+	const sqlstr = "SELECT " +
+		"Error, Y, Z, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByError3Rows", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*CompositePrimaryKeysByError3Row{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByError3Rows", "CompositePrimaryKeys", err)
+		}
+
+		r, err := scanCompositePrimaryKeysByError3Row(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError3Rows", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListCompositePrimaryKeysByYZErrorPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByError3' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByYZErrorPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 string
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", err)
+		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3}",
+		[]string{"Error", "PKey1", "PKey2"},
+		[]bool{false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByYZErrorPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.Error, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
+}
+
+// FindCompositePrimaryKeysByXY retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByXY' (X, Y).
+func FindCompositePrimaryKeysByXY(ctx context.Context, db YORODB, x string, y string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0 AND Y = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+	stmt.Params["param1"] = y
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByXY", stmt, x, y)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
+		}
+
+		cpk, err := ScanCompositePrimaryKey(row)
 		if err != nil {
 			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
 		}
@@ -480,14 +1939,35 @@ func FindCompositePrimaryKeysByXY(ctx context.Context, db YORODB, x string, y st
 	return res, nil
 }
 
+// CountCompositePrimaryKeysByXY returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByXY.
+func CountCompositePrimaryKeysByXY(ctx context.Context, db YORODB, x string, y string, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0 AND Y = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+	stmt.Params["param1"] = y
+
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByXY", stmt, x, y)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
 // ReadCompositePrimaryKeysByXY retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
 //
 // This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'CompositePrimaryKeysByXY'.
-func ReadCompositePrimaryKeysByXY(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
+// Generated from index 'CompositePrimaryKeysByXY' (X, Y).
+func ReadCompositePrimaryKeysByXY(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
 	var res []*CompositePrimaryKey
 	columns := []string{
 		"PKey1",
@@ -496,11 +1976,9 @@ func ReadCompositePrimaryKeysByXY(ctx context.Context, db YORODB, keys spanner.K
 		"Y",
 	}
 
-	decoder := newCompositePrimaryKey_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByXY", keys, columns)
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByXY", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
+		cpk, err := ScanCompositePrimaryKey(row)
 		if err != nil {
 			return err
 		}
@@ -514,3 +1992,195 @@ func ReadCompositePrimaryKeysByXY(ctx context.Context, db YORODB, keys spanner.K
 
 	return res, nil
 }
+
+// CompositePrimaryKeysByXYRow represents a row of index 'CompositePrimaryKeysByXY' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByXYRow struct {
+	X     string `spanner:"X" json:"X"`         // X
+	Y     string `spanner:"Y" json:"Y"`         // Y
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
+
+// ReadCompositePrimaryKeysByXYRows retrieves multiples rows from index 'CompositePrimaryKeysByXY' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByXYRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByXYRow, error) {
+	var res []*CompositePrimaryKeysByXYRow
+	columns := []string{
+		"X",
+		"Y",
+		"PKey1",
+		"PKey2",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByXY", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanCompositePrimaryKeysByXYRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByXYRows", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// scanCompositePrimaryKeysByXYRow decodes row having the columns of CompositePrimaryKeysByXYRow in order.
+func scanCompositePrimaryKeysByXYRow(row *spanner.Row) (*CompositePrimaryKeysByXYRow, error) {
+	var r CompositePrimaryKeysByXYRow
+	if err := row.Columns(&r.X, &r.Y, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindCompositePrimaryKeysByXYRows retrieves multiple rows from index 'CompositePrimaryKeysByXY' as a
+// slice of CompositePrimaryKeysByXYRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByXYRows(ctx context.Context, db YORODB, x string, y string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByXYRow, error) {
+	const sqlstr = "SELECT " +
+		"X, Y, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0 AND Y = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+	stmt.Params["param1"] = y
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByXYRows", stmt, x, y)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*CompositePrimaryKeysByXYRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByXYRows", "CompositePrimaryKeys", err)
+		}
+
+		r, err := scanCompositePrimaryKeysByXYRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByXYRows", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListCompositePrimaryKeysByXYPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByXY' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByXYPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 string
+		var k2 string
+		var k3 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2, &k3); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", err)
+		}
+		key = []interface{}{k0, k1, k2, k3}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY}",
+		[]string{"X", "Y", "PKey1", "PKey2"},
+		[]bool{false, false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByXYPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.X, last.Y, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
+}
+
+// FindCompositePrimaryKeysByX retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+// The rows are ordered by the rest of the index key.
+//
+// Generated from a prefix of the key of index 'CompositePrimaryKeysByXY' (X, Y).
+func FindCompositePrimaryKeysByX(ctx context.Context, db YORODB, x string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0" +
+		" ORDER BY Y"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByX", stmt, x)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByX", "CompositePrimaryKeys", err)
+		}
+
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByX", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, cpk)
+	}
+
+	return res, nil
+}
+
+// CountCompositePrimaryKeysByX returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByX.
+func CountCompositePrimaryKeysByX(ctx context.Context, db YORODB, x string, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByX", stmt, x)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeysByX", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
@@ -4,9 +4,12 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 )
 
@@ -17,6 +20,14 @@ type FereignItem struct {
 	Category int64 `spanner:"Category" json:"Category"` // Category
 }
 
+// FereignItemTableName is the name of 'FereignItems', which is qualified by the
+// schema if the table is in a named schema.
+const FereignItemTableName = "FereignItems"
+
+// ErrFereignItemNotFound is the error matched by errors.Is when the row is not
+// found in 'FereignItems'.
+var ErrFereignItemNotFound = errors.New("yo: FereignItems not found")
+
 func FereignItemPrimaryKeys() []string {
 	return []string{
 		"ID",
@@ -31,6 +42,16 @@ func FereignItemColumns() []string {
 	}
 }
 
+// FereignItemColumn is a column of 'FereignItems'.
+type FereignItemColumn string
+
+// Columns of 'FereignItems'.
+const (
+	FereignItemColumnID       FereignItemColumn = "ID"
+	FereignItemColumnItemID   FereignItemColumn = "ItemID"
+	FereignItemColumnCategory FereignItemColumn = "Category"
+)
+
 func FereignItemWritableColumns() []string {
 	return []string{
 		"ID",
@@ -99,6 +120,401 @@ func newFereignItem_Decoder(cols []string) func(*spanner.Row) (*FereignItem, err
 	}
 }
 
+// ScanFereignItem decodes row into FereignItem. The row may have any subset of
+// the columns of 'FereignItems', such as the result of a query or a read.
+func ScanFereignItem(row *spanner.Row) (*FereignItem, error) {
+	return newFereignItem_Decoder(row.ColumnNames())(row)
+}
+
+// ScanFereignItems decodes all the rows of iter into a slice of FereignItem.
+// iter is stopped when it returns.
+func ScanFereignItems(iter *spanner.RowIterator) ([]*FereignItem, error) {
+	var res []*FereignItem
+	var decoder func(*spanner.Row) (*FereignItem, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newFereignItem_Decoder(row.ColumnNames())
+		}
+
+		fi, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, fi)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FereignItemIterator iterates over the rows of a partition of 'FereignItems'
+// decoded into FereignItem.
+type FereignItemIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*FereignItem, error)
+}
+
+// ExecuteFereignItemPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllFereignItems or FereignItemQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteFereignItemPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *FereignItemIterator {
+	return &FereignItemIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *FereignItemIterator) Next() (*FereignItem, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("FereignItemIterator.Next", "FereignItems", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newFereignItem_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FereignItemIterator.Next", "FereignItems", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *FereignItemIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldFereignItemRows decodes the rows of rows into FereignItem and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldFereignItemRows(rows *spanner.RowIterator, method string, yield func(*FereignItem, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*FereignItem, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "FereignItems", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newFereignItem_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "FereignItems", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountFereignItems returns the number of the rows of 'FereignItems'.
+func CountFereignItems(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM FereignItems")
+
+	defer yoLogQuery(ctx, "CountFereignItems", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFereignItems", "FereignItems", err)
+	}
+
+	return n, nil
+}
+
+// AllFereignItemsSeq returns an iterator of all the rows of 'FereignItems',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*FereignItem, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllFereignItemsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FereignItem, error) bool) {
+	return func(yield func(*FereignItem, error) bool) {
+		rows := yoRead(ctx, db, "FereignItems", "", spanner.AllKeys(), FereignItemColumns(), opts)
+		yieldFereignItemRows(rows, "AllFereignItemsSeq", yield)
+	}
+}
+
+// PartitionReadAllFereignItems partitions the read of all the rows of
+// 'FereignItems' in btx, so that the partitions are read in parallel by
+// ExecuteFereignItemPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllFereignItems(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "FereignItems", spanner.AllKeys(), FereignItemColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllFereignItems", "FereignItems", err)
+	}
+
+	return ps, nil
+}
+
+// FereignItemQuery is a query builder for 'FereignItems'. The conditions are
+// given by the predicates of FereignItemWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of FereignItemColumn.
+type FereignItemQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewFereignItemQuery returns a FereignItemQuery filtered by preds.
+func NewFereignItemQuery(preds ...YOPredicate) *FereignItemQuery {
+	return &FereignItemQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *FereignItemQuery) Where(preds ...YOPredicate) *FereignItemQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *FereignItemQuery) OrderBy(col FereignItemColumn) *FereignItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *FereignItemQuery) OrderByDesc(col FereignItemColumn) *FereignItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *FereignItemQuery) Limit(n int) *FereignItemQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *FereignItemQuery) Statement() spanner.Statement {
+	return yoStatement("ID, ItemID, Category", "FereignItems", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *FereignItemQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "FereignItemQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FereignItem{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FereignItemQuery.Query", "FereignItems", err)
+		}
+
+		v, err := ScanFereignItem(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FereignItemQuery.Query", "FereignItems", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *FereignItemQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "FereignItems", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "FereignItemQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("FereignItemQuery.Count", "FereignItems", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*FereignItem, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *FereignItemQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FereignItem, error) bool) {
+	return func(yield func(*FereignItem, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "FereignItemQuery.Seq", stmt)()
+		yieldFereignItemRows(yoQuery(ctx, db, stmt, opts), "FereignItemQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteFereignItemPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *FereignItemQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "FereignItemQuery.PartitionQuery", "FereignItems", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "FereignItemQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("FereignItemQuery.PartitionQuery", "FereignItems", err)
+	}
+
+	return ps, nil
+}
+
+// FereignItemWhere has the typed predicate constructors of the columns of
+// 'FereignItems'.
+var FereignItemWhere = struct {
+	ID       FereignItem_IDColumn
+	ItemID   FereignItem_ItemIDColumn
+	Category FereignItem_CategoryColumn
+}{}
+
+// FereignItem_IDColumn has the predicate constructors of 'ID'.
+type FereignItem_IDColumn struct{}
+
+// Eq returns a predicate that 'ID' is equal to v.
+func (FereignItem_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ID' is not equal to v.
+func (FereignItem_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ID' is less than v.
+func (FereignItem_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ID' is less than or equal to v.
+func (FereignItem_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ID' is greater than v.
+func (FereignItem_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ID' is greater than or equal to v.
+func (FereignItem_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ID' is equal to any of vs.
+func (FereignItem_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "IN", value: vs}
+}
+
+// FereignItem_ItemIDColumn has the predicate constructors of 'ItemID'.
+type FereignItem_ItemIDColumn struct{}
+
+// Eq returns a predicate that 'ItemID' is equal to v.
+func (FereignItem_ItemIDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ItemID' is not equal to v.
+func (FereignItem_ItemIDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ItemID' is less than v.
+func (FereignItem_ItemIDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ItemID' is less than or equal to v.
+func (FereignItem_ItemIDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ItemID' is greater than v.
+func (FereignItem_ItemIDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ItemID' is greater than or equal to v.
+func (FereignItem_ItemIDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ItemID' is equal to any of vs.
+func (FereignItem_ItemIDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "IN", value: vs}
+}
+
+// FereignItem_CategoryColumn has the predicate constructors of 'Category'.
+type FereignItem_CategoryColumn struct{}
+
+// Eq returns a predicate that 'Category' is equal to v.
+func (FereignItem_CategoryColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Category' is not equal to v.
+func (FereignItem_CategoryColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Category' is less than v.
+func (FereignItem_CategoryColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Category' is less than or equal to v.
+func (FereignItem_CategoryColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Category' is greater than v.
+func (FereignItem_CategoryColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Category' is greater than or equal to v.
+func (FereignItem_CategoryColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Category' is equal to any of vs.
+func (FereignItem_CategoryColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "IN", value: vs}
+}
+
 // Insert returns a Mutation to insert a row into a table. If the row already
 // exists, the write or transaction fails.
 func (fi *FereignItem) Insert(ctx context.Context) *spanner.Mutation {
@@ -106,6 +522,31 @@ func (fi *FereignItem) Insert(ctx context.Context) *spanner.Mutation {
 	return spanner.Insert("FereignItems", FereignItemWritableColumns(), values)
 }
 
+// InsertAllFereignItems inserts rows into 'FereignItems' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllFereignItems(ctx context.Context, client *spanner.Client, rows []*FereignItem) (int, error) {
+	ms := FereignItemsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(FereignItemWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllFereignItems", "FereignItems", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// FereignItemsInsert returns Mutations to insert the rows into
+// 'FereignItems', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllFereignItems, the Mutations are
+// not split into batches.
+func FereignItemsInsert(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
 // Update returns a Mutation to update a row in a table. If the row does not
 // already exist, the write or transaction fails.
 func (fi *FereignItem) Update(ctx context.Context) *spanner.Mutation {
@@ -121,6 +562,63 @@ func (fi *FereignItem) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
 	return spanner.InsertOrUpdate("FereignItems", FereignItemWritableColumns(), values)
 }
 
+// FereignItemsUpdate returns Mutations to update the rows in
+// 'FereignItems', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FereignItemsUpdate(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// FereignItemsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'FereignItems', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func FereignItemsInsertOrUpdate(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the FereignItem with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (fi *FereignItem) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{fi.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteFereignItems inserts or updates rows in 'FereignItems' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteFereignItems(ctx context.Context, client *spanner.Client, rows []*FereignItem) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteFereignItems", "FereignItems", groups)
+}
+
+// InsertOrUpdateFereignItemAtLeastOnce inserts or updates row in 'FereignItems' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateFereignItemAtLeastOnce(ctx context.Context, client *spanner.Client, row *FereignItem) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateFereignItemAtLeastOnce", "FereignItems", err)
+	}
+
+	return nil
+}
+
 // UpdateColumns returns a Mutation to update specified columns of a row in a table.
 func (fi *FereignItem) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
 	// add primary keys to columns to update by primary keys
@@ -134,16 +632,82 @@ func (fi *FereignItem) UpdateColumns(ctx context.Context, cols ...string) (*span
 	return spanner.Update("FereignItems", colsWithPKeys, values), nil
 }
 
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'FereignItems' can be specified.
+func (fi *FereignItem) MutationForColumns(ctx context.Context, cols ...FereignItemColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return fi.UpdateColumns(ctx, names...)
+}
+
+// FereignItemTracker tracks the changes of a FereignItem, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type FereignItemTracker struct {
+	row  *FereignItem
+	orig FereignItem
+}
+
+// TrackFereignItem returns a FereignItemTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackFereignItem(row *FereignItem) *FereignItemTracker {
+	tr := &FereignItemTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked FereignItem.
+func (tr *FereignItemTracker) Row() *FereignItem {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *FereignItemTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.ItemID = yoClone(tr.row.ItemID)
+	tr.orig.Category = yoClone(tr.row.Category)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'FereignItems'. The primary key columns and the
+// generated columns are never included.
+func (tr *FereignItemTracker) Changed() []FereignItemColumn {
+	var cols []FereignItemColumn
+	if !reflect.DeepEqual(tr.orig.ItemID, tr.row.ItemID) {
+		cols = append(cols, FereignItemColumnItemID)
+	}
+	if !reflect.DeepEqual(tr.orig.Category, tr.row.Category) {
+		cols = append(cols, FereignItemColumnCategory)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *FereignItemTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
 // FindFereignItem gets a FereignItem by primary key
-func FindFereignItem(ctx context.Context, db YORODB, id int64) (*FereignItem, error) {
+func FindFereignItem(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*FereignItem, error) {
 	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "FereignItems", key, FereignItemColumns())
+	row, err := yoReadRow(ctx, db, "FereignItems", key, FereignItemColumns(), opts)
 	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFereignItem", "FereignItems", key, ErrFereignItemNotFound, nil)
+		}
 		return nil, newError("FindFereignItem", "FereignItems", err)
 	}
 
-	decoder := newFereignItem_Decoder(FereignItemColumns())
-	fi, err := decoder(row)
+	fi, err := ScanFereignItem(row)
 	if err != nil {
 		return nil, newErrorWithCode(codes.Internal, "FindFereignItem", "FereignItems", err)
 	}
@@ -151,15 +715,194 @@ func FindFereignItem(ctx context.Context, db YORODB, id int64) (*FereignItem, er
 	return fi, nil
 }
 
+// FereignItemExists reports whether the row of the primary key exists in
+// 'FereignItems', which reads only the primary key columns.
+func FereignItemExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "FereignItems", key, FereignItemPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("FereignItemExists", "FereignItems", err)
+	}
+
+	return true, nil
+}
+
 // ReadFereignItem retrieves multiples rows from FereignItem by KeySet as a slice.
-func ReadFereignItem(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FereignItem, error) {
+func ReadFereignItem(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
 	var res []*FereignItem
+	rows := yoRead(ctx, db, "FereignItems", "", keys, FereignItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		fi, err := ScanFereignItem(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, fi)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFereignItem", "FereignItems", err)
+	}
+
+	return res, nil
+}
+
+// FereignItemPrimaryKey is the primary key of 'FereignItems'.
+type FereignItemPrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadFereignItem.
+func (k FereignItemPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseFereignItemPrimaryKey parses key of the columns of the primary key of
+// 'FereignItems' in order, such as the key of a mutation.
+func ParseFereignItemPrimaryKey(key spanner.Key) (FereignItemPrimaryKey, error) {
+	var k FereignItemPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'FereignItems' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'ID' of the key of 'FereignItems' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of fi.
+func (fi *FereignItem) PrimaryKey() FereignItemPrimaryKey {
+	return FereignItemPrimaryKey{
+		ID: fi.ID,
+	}
+}
+
+// FindFereignItemByKey gets a FereignItem by the primary key of key.
+func FindFereignItemByKey(ctx context.Context, db YORODB, key FereignItemPrimaryKey, opts ...*spanner.ReadOptions) (*FereignItem, error) {
+	row, err := yoReadRow(ctx, db, "FereignItems", key.ToSpannerKey(), FereignItemColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFereignItemByKey", "FereignItems", key.ToSpannerKey(), ErrFereignItemNotFound, nil)
+		}
+		return nil, newError("FindFereignItemByKey", "FereignItems", err)
+	}
+
+	fi, err := ScanFereignItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFereignItemByKey", "FereignItems", err)
+	}
+
+	return fi, nil
+}
+
+// DeleteFereignItemByKey returns a Mutation to delete the row of key from
+// 'FereignItems'.
+func DeleteFereignItemByKey(ctx context.Context, key FereignItemPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("FereignItems", key.ToSpannerKey())
+}
+
+// FindFereignItemsByKeys retrieves the rows of keys from 'FereignItems' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindFereignItemsByKeys(ctx context.Context, db YORODB, keys []FereignItemPrimaryKey, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
 
-	decoder := newFereignItem_Decoder(FereignItemColumns())
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
 
-	rows := db.Read(ctx, "FereignItems", keys, FereignItemColumns())
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*FereignItem, len(keys))
+	rows := yoRead(ctx, db, "FereignItems", "", spanner.KeySets(keySets...), FereignItemColumns(), opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		fi, err := decoder(row)
+		fi, err := ScanFereignItem(row)
+		if err != nil {
+			return err
+		}
+		byKey[fi.primaryKey().String()] = fi
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFereignItemsByKeys", "FereignItems", err)
+	}
+
+	res := make([]*FereignItem, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if fi, ok := byKey[s]; ok {
+			res = append(res, fi)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListFereignItemsPage retrieves a page of at most pageSize rows from 'FereignItems'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListFereignItemsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FereignItem, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFereignItemsPage", "FereignItems", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFereignItemsPage", "FereignItems", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT ID, ItemID, Category FROM FereignItems",
+		[]string{"ID"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFereignItemsPage", stmt)()
+	res, err := ScanFereignItems(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFereignItemsPage", "FereignItems", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFereignItemsPage", "FereignItems", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadFereignItemRange retrieves multiple rows from FereignItem whose
+// 'ID' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadFereignItemRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	var res []*FereignItem
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "FereignItems", "", keys, FereignItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		fi, err := ScanFereignItem(row)
 		if err != nil {
 			return err
 		}
@@ -168,14 +911,141 @@ func ReadFereignItem(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*Fe
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFereignItem", "FereignItems", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadFereignItemRange", "FereignItems", err)
 	}
 
 	return res, nil
 }
 
+// primaryKey returns the key of the FereignItem, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (fi *FereignItem) primaryKey() spanner.Key {
+	return spanner.Key{fi.ID}
+}
+
 // Delete deletes the FereignItem from the database.
 func (fi *FereignItem) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := fi.columnsToValues(FereignItemPrimaryKeys())
-	return spanner.Delete("FereignItems", spanner.Key(values))
+	return spanner.Delete("FereignItems", fi.primaryKey())
+}
+
+// FereignItemsDelete returns Mutations to delete the rows from
+// 'FereignItems', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FereignItemsDelete(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// FetchItem retrieves the row of 'Items' referenced by the
+// FereignItem as a Item.
+//
+// If no row is present, including when the referencing columns are NULL, then
+// an error is returned where errors.Is(err, ErrNotFound) is true.
+//
+// Generated from foreign key 'FK_ItemID_ForeignItems'.
+func (fi *FereignItem) FetchItem(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (*Item, error) {
+	const sqlstr = "SELECT " +
+		"ID, Price " +
+		"FROM Items " +
+		"WHERE ID = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fi.ItemID
+
+	// run query
+	defer yoLogQuery(ctx, "FetchItem", stmt, fi.ItemID)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("FetchItem", "Items", spanner.Key{fi.ItemID}, ErrItemNotFound, nil)
+		}
+		return nil, newError("FetchItem", "Items", err)
+	}
+
+	res, err := ScanItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FetchItem", "Items", err)
+	}
+
+	return res, nil
+}
+
+// ListFereignItems retrieves the rows of 'FereignItems' referencing the
+// Item as a slice.
+//
+// Generated from foreign key 'FK_ItemID_ForeignItems'.
+func (i *Item) ListFereignItems(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	const sqlstr = "SELECT " +
+		"ID, ItemID, Category " +
+		"FROM FereignItems " +
+		"WHERE ItemID = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = i.ID
+
+	// run query
+	defer yoLogQuery(ctx, "ListFereignItems", stmt, i.ID)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FereignItem{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("ListFereignItems", "FereignItems", err)
+		}
+
+		fi, err := ScanFereignItem(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "ListFereignItems", "FereignItems", err)
+		}
+
+		res = append(res, fi)
+	}
+
+	return res, nil
+}
+
+// FereignItemDescriptor describes 'FereignItems' for YORepository.
+var FereignItemDescriptor = &YODescriptor[FereignItem, FereignItemPrimaryKey]{
+	Table:       "FereignItems",
+	Columns:     FereignItemColumns(),
+	PrimaryKeys: FereignItemPrimaryKeys(),
+	Key: func(row *FereignItem) FereignItemPrimaryKey {
+		return FereignItemPrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: FereignItemPrimaryKey.ToSpannerKey,
+	Scan:       ScanFereignItem,
+	Find:       FindFereignItemByKey,
+	Read:       ReadFereignItem,
+	Insert: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewFereignItemRepository returns a YORepository of 'FereignItems' by client.
+func NewFereignItemRepository(client *spanner.Client) *YORepository[FereignItem, FereignItemPrimaryKey] {
+	return NewYORepository(client, FereignItemDescriptor)
 }
@@ -4,7 +4,9 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
@@ -18,6 +20,14 @@ type SnakeCase struct {
 	FooBarBaz int64  `spanner:"foo_bar_baz" json:"foo_bar_baz"` // foo_bar_baz
 }
 
+// SnakeCaseTableName is the name of 'snake_cases', which is qualified by the
+// schema if the table is in a named schema.
+const SnakeCaseTableName = "snake_cases"
+
+// ErrSnakeCaseNotFound is the error matched by errors.Is when the row is not
+// found in 'snake_cases'.
+var ErrSnakeCaseNotFound = errors.New("yo: snake_cases not found")
+
 func SnakeCasePrimaryKeys() []string {
 	return []string{
 		"id",
@@ -32,6 +42,16 @@ func SnakeCaseColumns() []string {
 	}
 }
 
+// SnakeCaseColumn is a column of 'snake_cases'.
+type SnakeCaseColumn string
+
+// Columns of 'snake_cases'.
+const (
+	SnakeCaseColumnID        SnakeCaseColumn = "id"
+	SnakeCaseColumnStringID  SnakeCaseColumn = "string_id"
+	SnakeCaseColumnFooBarBaz SnakeCaseColumn = "foo_bar_baz"
+)
+
 func SnakeCaseWritableColumns() []string {
 	return []string{
 		"id",
@@ -100,6 +120,401 @@ func newSnakeCase_Decoder(cols []string) func(*spanner.Row) (*SnakeCase, error)
 	}
 }
 
+// ScanSnakeCase decodes row into SnakeCase. The row may have any subset of
+// the columns of 'snake_cases', such as the result of a query or a read.
+func ScanSnakeCase(row *spanner.Row) (*SnakeCase, error) {
+	return newSnakeCase_Decoder(row.ColumnNames())(row)
+}
+
+// ScanSnakeCases decodes all the rows of iter into a slice of SnakeCase.
+// iter is stopped when it returns.
+func ScanSnakeCases(iter *spanner.RowIterator) ([]*SnakeCase, error) {
+	var res []*SnakeCase
+	var decoder func(*spanner.Row) (*SnakeCase, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newSnakeCase_Decoder(row.ColumnNames())
+		}
+
+		sc, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, sc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SnakeCaseIterator iterates over the rows of a partition of 'snake_cases'
+// decoded into SnakeCase.
+type SnakeCaseIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*SnakeCase, error)
+}
+
+// ExecuteSnakeCasePartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllSnakeCases or SnakeCaseQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteSnakeCasePartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *SnakeCaseIterator {
+	return &SnakeCaseIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *SnakeCaseIterator) Next() (*SnakeCase, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("SnakeCaseIterator.Next", "snake_cases", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newSnakeCase_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "SnakeCaseIterator.Next", "snake_cases", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *SnakeCaseIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldSnakeCaseRows decodes the rows of rows into SnakeCase and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldSnakeCaseRows(rows *spanner.RowIterator, method string, yield func(*SnakeCase, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*SnakeCase, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "snake_cases", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newSnakeCase_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "snake_cases", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountSnakeCases returns the number of the rows of 'snake_cases'.
+func CountSnakeCases(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM snake_cases")
+
+	defer yoLogQuery(ctx, "CountSnakeCases", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountSnakeCases", "snake_cases", err)
+	}
+
+	return n, nil
+}
+
+// AllSnakeCasesSeq returns an iterator of all the rows of 'snake_cases',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*SnakeCase, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllSnakeCasesSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*SnakeCase, error) bool) {
+	return func(yield func(*SnakeCase, error) bool) {
+		rows := yoRead(ctx, db, "snake_cases", "", spanner.AllKeys(), SnakeCaseColumns(), opts)
+		yieldSnakeCaseRows(rows, "AllSnakeCasesSeq", yield)
+	}
+}
+
+// PartitionReadAllSnakeCases partitions the read of all the rows of
+// 'snake_cases' in btx, so that the partitions are read in parallel by
+// ExecuteSnakeCasePartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllSnakeCases(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "snake_cases", spanner.AllKeys(), SnakeCaseColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllSnakeCases", "snake_cases", err)
+	}
+
+	return ps, nil
+}
+
+// SnakeCaseQuery is a query builder for 'snake_cases'. The conditions are
+// given by the predicates of SnakeCaseWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of SnakeCaseColumn.
+type SnakeCaseQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewSnakeCaseQuery returns a SnakeCaseQuery filtered by preds.
+func NewSnakeCaseQuery(preds ...YOPredicate) *SnakeCaseQuery {
+	return &SnakeCaseQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *SnakeCaseQuery) Where(preds ...YOPredicate) *SnakeCaseQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *SnakeCaseQuery) OrderBy(col SnakeCaseColumn) *SnakeCaseQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *SnakeCaseQuery) OrderByDesc(col SnakeCaseColumn) *SnakeCaseQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *SnakeCaseQuery) Limit(n int) *SnakeCaseQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *SnakeCaseQuery) Statement() spanner.Statement {
+	return yoStatement("id, string_id, foo_bar_baz", "snake_cases", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *SnakeCaseQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "SnakeCaseQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*SnakeCase{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("SnakeCaseQuery.Query", "snake_cases", err)
+		}
+
+		v, err := ScanSnakeCase(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "SnakeCaseQuery.Query", "snake_cases", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *SnakeCaseQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "snake_cases", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "SnakeCaseQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("SnakeCaseQuery.Count", "snake_cases", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*SnakeCase, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *SnakeCaseQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*SnakeCase, error) bool) {
+	return func(yield func(*SnakeCase, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "SnakeCaseQuery.Seq", stmt)()
+		yieldSnakeCaseRows(yoQuery(ctx, db, stmt, opts), "SnakeCaseQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteSnakeCasePartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *SnakeCaseQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "SnakeCaseQuery.PartitionQuery", "snake_cases", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "SnakeCaseQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("SnakeCaseQuery.PartitionQuery", "snake_cases", err)
+	}
+
+	return ps, nil
+}
+
+// SnakeCaseWhere has the typed predicate constructors of the columns of
+// 'snake_cases'.
+var SnakeCaseWhere = struct {
+	ID        SnakeCase_IDColumn
+	StringID  SnakeCase_StringIDColumn
+	FooBarBaz SnakeCase_FooBarBazColumn
+}{}
+
+// SnakeCase_IDColumn has the predicate constructors of 'id'.
+type SnakeCase_IDColumn struct{}
+
+// Eq returns a predicate that 'id' is equal to v.
+func (SnakeCase_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'id' is not equal to v.
+func (SnakeCase_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'id' is less than v.
+func (SnakeCase_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "<", value: v}
+}
+
+// Le returns a predicate that 'id' is less than or equal to v.
+func (SnakeCase_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'id' is greater than v.
+func (SnakeCase_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'id' is greater than or equal to v.
+func (SnakeCase_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: ">=", value: v}
+}
+
+// In returns a predicate that 'id' is equal to any of vs.
+func (SnakeCase_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "id", op: "IN", value: vs}
+}
+
+// SnakeCase_StringIDColumn has the predicate constructors of 'string_id'.
+type SnakeCase_StringIDColumn struct{}
+
+// Eq returns a predicate that 'string_id' is equal to v.
+func (SnakeCase_StringIDColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'string_id' is not equal to v.
+func (SnakeCase_StringIDColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'string_id' is less than v.
+func (SnakeCase_StringIDColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "<", value: v}
+}
+
+// Le returns a predicate that 'string_id' is less than or equal to v.
+func (SnakeCase_StringIDColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'string_id' is greater than v.
+func (SnakeCase_StringIDColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'string_id' is greater than or equal to v.
+func (SnakeCase_StringIDColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: ">=", value: v}
+}
+
+// In returns a predicate that 'string_id' is equal to any of vs.
+func (SnakeCase_StringIDColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "IN", value: vs}
+}
+
+// SnakeCase_FooBarBazColumn has the predicate constructors of 'foo_bar_baz'.
+type SnakeCase_FooBarBazColumn struct{}
+
+// Eq returns a predicate that 'foo_bar_baz' is equal to v.
+func (SnakeCase_FooBarBazColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'foo_bar_baz' is not equal to v.
+func (SnakeCase_FooBarBazColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'foo_bar_baz' is less than v.
+func (SnakeCase_FooBarBazColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "<", value: v}
+}
+
+// Le returns a predicate that 'foo_bar_baz' is less than or equal to v.
+func (SnakeCase_FooBarBazColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'foo_bar_baz' is greater than v.
+func (SnakeCase_FooBarBazColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'foo_bar_baz' is greater than or equal to v.
+func (SnakeCase_FooBarBazColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: ">=", value: v}
+}
+
+// In returns a predicate that 'foo_bar_baz' is equal to any of vs.
+func (SnakeCase_FooBarBazColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "IN", value: vs}
+}
+
 // Insert returns a Mutation to insert a row into a table. If the row already
 // exists, the write or transaction fails.
 func (sc *SnakeCase) Insert(ctx context.Context) *spanner.Mutation {
@@ -107,6 +522,31 @@ func (sc *SnakeCase) Insert(ctx context.Context) *spanner.Mutation {
 	return spanner.Insert("snake_cases", SnakeCaseWritableColumns(), values)
 }
 
+// InsertAllSnakeCases inserts rows into 'snake_cases' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllSnakeCases(ctx context.Context, client *spanner.Client, rows []*SnakeCase) (int, error) {
+	ms := SnakeCasesInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(SnakeCaseWritableColumns()) * (1 + 1)
+
+	return yoApplyInBatches(ctx, client, "InsertAllSnakeCases", "snake_cases", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// SnakeCasesInsert returns Mutations to insert the rows into
+// 'snake_cases', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllSnakeCases, the Mutations are
+// not split into batches.
+func SnakeCasesInsert(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
 // Update returns a Mutation to update a row in a table. If the row does not
 // already exist, the write or transaction fails.
 func (sc *SnakeCase) Update(ctx context.Context) *spanner.Mutation {
@@ -122,6 +562,63 @@ func (sc *SnakeCase) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
 	return spanner.InsertOrUpdate("snake_cases", SnakeCaseWritableColumns(), values)
 }
 
+// SnakeCasesUpdate returns Mutations to update the rows in
+// 'snake_cases', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func SnakeCasesUpdate(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// SnakeCasesInsertOrUpdate returns Mutations to insert or update the
+// rows in 'snake_cases', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func SnakeCasesInsertOrUpdate(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the SnakeCase with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (sc *SnakeCase) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{sc.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteSnakeCases inserts or updates rows in 'snake_cases' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteSnakeCases(ctx context.Context, client *spanner.Client, rows []*SnakeCase) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteSnakeCases", "snake_cases", groups)
+}
+
+// InsertOrUpdateSnakeCaseAtLeastOnce inserts or updates row in 'snake_cases' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateSnakeCaseAtLeastOnce(ctx context.Context, client *spanner.Client, row *SnakeCase) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateSnakeCaseAtLeastOnce", "snake_cases", err)
+	}
+
+	return nil
+}
+
 // UpdateColumns returns a Mutation to update specified columns of a row in a table.
 func (sc *SnakeCase) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
 	// add primary keys to columns to update by primary keys
@@ -135,16 +632,82 @@ func (sc *SnakeCase) UpdateColumns(ctx context.Context, cols ...string) (*spanne
 	return spanner.Update("snake_cases", colsWithPKeys, values), nil
 }
 
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'snake_cases' can be specified.
+func (sc *SnakeCase) MutationForColumns(ctx context.Context, cols ...SnakeCaseColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return sc.UpdateColumns(ctx, names...)
+}
+
+// SnakeCaseTracker tracks the changes of a SnakeCase, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type SnakeCaseTracker struct {
+	row  *SnakeCase
+	orig SnakeCase
+}
+
+// TrackSnakeCase returns a SnakeCaseTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackSnakeCase(row *SnakeCase) *SnakeCaseTracker {
+	tr := &SnakeCaseTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked SnakeCase.
+func (tr *SnakeCaseTracker) Row() *SnakeCase {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *SnakeCaseTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.StringID = yoClone(tr.row.StringID)
+	tr.orig.FooBarBaz = yoClone(tr.row.FooBarBaz)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'snake_cases'. The primary key columns and the
+// generated columns are never included.
+func (tr *SnakeCaseTracker) Changed() []SnakeCaseColumn {
+	var cols []SnakeCaseColumn
+	if !reflect.DeepEqual(tr.orig.StringID, tr.row.StringID) {
+		cols = append(cols, SnakeCaseColumnStringID)
+	}
+	if !reflect.DeepEqual(tr.orig.FooBarBaz, tr.row.FooBarBaz) {
+		cols = append(cols, SnakeCaseColumnFooBarBaz)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *SnakeCaseTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
 // FindSnakeCase gets a SnakeCase by primary key
-func FindSnakeCase(ctx context.Context, db YORODB, id int64) (*SnakeCase, error) {
+func FindSnakeCase(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*SnakeCase, error) {
 	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "snake_cases", key, SnakeCaseColumns())
+	row, err := yoReadRow(ctx, db, "snake_cases", key, SnakeCaseColumns(), opts)
 	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindSnakeCase", "snake_cases", key, ErrSnakeCaseNotFound, nil)
+		}
 		return nil, newError("FindSnakeCase", "snake_cases", err)
 	}
 
-	decoder := newSnakeCase_Decoder(SnakeCaseColumns())
-	sc, err := decoder(row)
+	sc, err := ScanSnakeCase(row)
 	if err != nil {
 		return nil, newErrorWithCode(codes.Internal, "FindSnakeCase", "snake_cases", err)
 	}
@@ -152,15 +715,194 @@ func FindSnakeCase(ctx context.Context, db YORODB, id int64) (*SnakeCase, error)
 	return sc, nil
 }
 
+// SnakeCaseExists reports whether the row of the primary key exists in
+// 'snake_cases', which reads only the primary key columns.
+func SnakeCaseExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "snake_cases", key, SnakeCasePrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("SnakeCaseExists", "snake_cases", err)
+	}
+
+	return true, nil
+}
+
 // ReadSnakeCase retrieves multiples rows from SnakeCase by KeySet as a slice.
-func ReadSnakeCase(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*SnakeCase, error) {
+func ReadSnakeCase(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
 	var res []*SnakeCase
+	rows := yoRead(ctx, db, "snake_cases", "", keys, SnakeCaseColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		sc, err := ScanSnakeCase(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, sc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCase", "snake_cases", err)
+	}
+
+	return res, nil
+}
+
+// SnakeCasePrimaryKey is the primary key of 'snake_cases'.
+type SnakeCasePrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadSnakeCase.
+func (k SnakeCasePrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseSnakeCasePrimaryKey parses key of the columns of the primary key of
+// 'snake_cases' in order, such as the key of a mutation.
+func ParseSnakeCasePrimaryKey(key spanner.Key) (SnakeCasePrimaryKey, error) {
+	var k SnakeCasePrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'snake_cases' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'id' of the key of 'snake_cases' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of sc.
+func (sc *SnakeCase) PrimaryKey() SnakeCasePrimaryKey {
+	return SnakeCasePrimaryKey{
+		ID: sc.ID,
+	}
+}
 
-	decoder := newSnakeCase_Decoder(SnakeCaseColumns())
+// FindSnakeCaseByKey gets a SnakeCase by the primary key of key.
+func FindSnakeCaseByKey(ctx context.Context, db YORODB, key SnakeCasePrimaryKey, opts ...*spanner.ReadOptions) (*SnakeCase, error) {
+	row, err := yoReadRow(ctx, db, "snake_cases", key.ToSpannerKey(), SnakeCaseColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindSnakeCaseByKey", "snake_cases", key.ToSpannerKey(), ErrSnakeCaseNotFound, nil)
+		}
+		return nil, newError("FindSnakeCaseByKey", "snake_cases", err)
+	}
+
+	sc, err := ScanSnakeCase(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindSnakeCaseByKey", "snake_cases", err)
+	}
+
+	return sc, nil
+}
+
+// DeleteSnakeCaseByKey returns a Mutation to delete the row of key from
+// 'snake_cases'.
+func DeleteSnakeCaseByKey(ctx context.Context, key SnakeCasePrimaryKey) *spanner.Mutation {
+	return spanner.Delete("snake_cases", key.ToSpannerKey())
+}
 
-	rows := db.Read(ctx, "snake_cases", keys, SnakeCaseColumns())
+// FindSnakeCasesByKeys retrieves the rows of keys from 'snake_cases' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindSnakeCasesByKeys(ctx context.Context, db YORODB, keys []SnakeCasePrimaryKey, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*SnakeCase, len(keys))
+	rows := yoRead(ctx, db, "snake_cases", "", spanner.KeySets(keySets...), SnakeCaseColumns(), opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		sc, err := decoder(row)
+		sc, err := ScanSnakeCase(row)
+		if err != nil {
+			return err
+		}
+		byKey[sc.primaryKey().String()] = sc
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByKeys", "snake_cases", err)
+	}
+
+	res := make([]*SnakeCase, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if sc, ok := byKey[s]; ok {
+			res = append(res, sc)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListSnakeCasesPage retrieves a page of at most pageSize rows from 'snake_cases'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListSnakeCasesPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*SnakeCase, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesPage", "snake_cases", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesPage", "snake_cases", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT id, string_id, foo_bar_baz FROM snake_cases",
+		[]string{"id"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListSnakeCasesPage", stmt)()
+	res, err := ScanSnakeCases(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListSnakeCasesPage", "snake_cases", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListSnakeCasesPage", "snake_cases", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadSnakeCaseRange retrieves multiple rows from SnakeCase whose
+// 'id' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadSnakeCaseRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	var res []*SnakeCase
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "snake_cases", "", keys, SnakeCaseColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		sc, err := ScanSnakeCase(row)
 		if err != nil {
 			return err
 		}
@@ -169,22 +911,72 @@ func ReadSnakeCase(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*Snak
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCase", "snake_cases", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCaseRange", "snake_cases", err)
 	}
 
 	return res, nil
 }
 
+// primaryKey returns the key of the SnakeCase, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (sc *SnakeCase) primaryKey() spanner.Key {
+	return spanner.Key{sc.ID}
+}
+
 // Delete deletes the SnakeCase from the database.
 func (sc *SnakeCase) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := sc.columnsToValues(SnakeCasePrimaryKeys())
-	return spanner.Delete("snake_cases", spanner.Key(values))
+	return spanner.Delete("snake_cases", sc.primaryKey())
+}
+
+// SnakeCasesDelete returns Mutations to delete the rows from
+// 'snake_cases', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func SnakeCasesDelete(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// SnakeCaseDescriptor describes 'snake_cases' for YORepository.
+var SnakeCaseDescriptor = &YODescriptor[SnakeCase, SnakeCasePrimaryKey]{
+	Table:       "snake_cases",
+	Columns:     SnakeCaseColumns(),
+	PrimaryKeys: SnakeCasePrimaryKeys(),
+	Key: func(row *SnakeCase) SnakeCasePrimaryKey {
+		return SnakeCasePrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: SnakeCasePrimaryKey.ToSpannerKey,
+	Scan:       ScanSnakeCase,
+	Find:       FindSnakeCaseByKey,
+	Read:       ReadSnakeCase,
+	Insert: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewSnakeCaseRepository returns a YORepository of 'snake_cases' by client.
+func NewSnakeCaseRepository(client *spanner.Client) *YORepository[SnakeCase, SnakeCasePrimaryKey] {
+	return NewYORepository(client, SnakeCaseDescriptor)
 }
 
 // FindSnakeCasesByStringIDFooBarBaz retrieves multiple rows from 'snake_cases' as a slice of SnakeCase.
 //
-// Generated from index 'snake_cases_by_string_id'.
-func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID string, fooBarBaz int64) ([]*SnakeCase, error) {
+// Generated from index 'snake_cases_by_string_id' (string_id, foo_bar_baz).
+func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID string, fooBarBaz int64, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
 	const sqlstr = "SELECT " +
 		"id, string_id, foo_bar_baz " +
 		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
@@ -194,11 +986,9 @@ func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID
 	stmt.Params["param0"] = stringID
 	stmt.Params["param1"] = fooBarBaz
 
-	decoder := newSnakeCase_Decoder(SnakeCaseColumns())
-
 	// run query
-	YOLog(ctx, sqlstr, stringID, fooBarBaz)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindSnakeCasesByStringIDFooBarBaz", stmt, stringID, fooBarBaz)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
@@ -212,7 +1002,7 @@ func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID
 			return nil, newError("FindSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
 		}
 
-		sc, err := decoder(row)
+		sc, err := ScanSnakeCase(row)
 		if err != nil {
 			return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
 		}
@@ -223,14 +1013,35 @@ func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID
 	return res, nil
 }
 
+// CountSnakeCasesByStringIDFooBarBaz returns the number of the rows of 'snake_cases' found by
+// FindSnakeCasesByStringIDFooBarBaz.
+func CountSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID string, fooBarBaz int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0 AND foo_bar_baz = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = stringID
+	stmt.Params["param1"] = fooBarBaz
+
+	// run query
+	defer yoLogQuery(ctx, "CountSnakeCasesByStringIDFooBarBaz", stmt, stringID, fooBarBaz)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
+	}
+
+	return n, nil
+}
+
 // ReadSnakeCasesByStringIDFooBarBaz retrieves multiples rows from 'snake_cases' by KeySet as a slice.
 //
 // This does not retrieve all columns of 'snake_cases' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'snake_cases_by_string_id'.
-func ReadSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*SnakeCase, error) {
+// Generated from index 'snake_cases_by_string_id' (string_id, foo_bar_baz).
+func ReadSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
 	var res []*SnakeCase
 	columns := []string{
 		"id",
@@ -238,11 +1049,9 @@ func ReadSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, keys span
 		"foo_bar_baz",
 	}
 
-	decoder := newSnakeCase_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "snake_cases", "snake_cases_by_string_id", keys, columns)
+	rows := yoRead(ctx, db, "snake_cases", "snake_cases_by_string_id", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		sc, err := decoder(row)
+		sc, err := ScanSnakeCase(row)
 		if err != nil {
 			return err
 		}
@@ -256,3 +1065,192 @@ func ReadSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, keys span
 
 	return res, nil
 }
+
+// SnakeCasesByStringIDRow represents a row of index 'snake_cases_by_string_id' of 'snake_cases',
+// which has the index key, the storing columns and the primary key.
+type SnakeCasesByStringIDRow struct {
+	StringID  string `spanner:"string_id" json:"string_id"`     // string_id
+	FooBarBaz int64  `spanner:"foo_bar_baz" json:"foo_bar_baz"` // foo_bar_baz
+	ID        int64  `spanner:"id" json:"id"`                   // id
+}
+
+// ReadSnakeCasesByStringIDRows retrieves multiples rows from index 'snake_cases_by_string_id' by
+// KeySet as a slice. This reads only the index and never reads 'snake_cases'.
+func ReadSnakeCasesByStringIDRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*SnakeCasesByStringIDRow, error) {
+	var res []*SnakeCasesByStringIDRow
+	columns := []string{
+		"string_id",
+		"foo_bar_baz",
+		"id",
+	}
+
+	rows := yoRead(ctx, db, "snake_cases", "snake_cases_by_string_id", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanSnakeCasesByStringIDRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCasesByStringIDRows", "snake_cases", err)
+	}
+
+	return res, nil
+}
+
+// scanSnakeCasesByStringIDRow decodes row having the columns of SnakeCasesByStringIDRow in order.
+func scanSnakeCasesByStringIDRow(row *spanner.Row) (*SnakeCasesByStringIDRow, error) {
+	var r SnakeCasesByStringIDRow
+	if err := row.Columns(&r.StringID, &r.FooBarBaz, &r.ID); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindSnakeCasesByStringIDRows retrieves multiple rows from index 'snake_cases_by_string_id' as a
+// slice of SnakeCasesByStringIDRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'snake_cases'.
+func FindSnakeCasesByStringIDRows(ctx context.Context, db YORODB, stringID string, fooBarBaz int64, opts ...*spanner.ReadOptions) ([]*SnakeCasesByStringIDRow, error) {
+	const sqlstr = "SELECT " +
+		"string_id, foo_bar_baz, id " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0 AND foo_bar_baz = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = stringID
+	stmt.Params["param1"] = fooBarBaz
+
+	// run query
+	defer yoLogQuery(ctx, "FindSnakeCasesByStringIDRows", stmt, stringID, fooBarBaz)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*SnakeCasesByStringIDRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindSnakeCasesByStringIDRows", "snake_cases", err)
+		}
+
+		r, err := scanSnakeCasesByStringIDRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByStringIDRows", "snake_cases", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListSnakeCasesByStringIDFooBarBazPage retrieves a page of at most pageSize rows from 'snake_cases'
+// ordered by the key of index 'snake_cases_by_string_id' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListSnakeCasesByStringIDFooBarBazPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*SnakeCase, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 int64
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", err)
+		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT id, string_id, foo_bar_baz FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id}",
+		[]string{"string_id", "foo_bar_baz", "id"},
+		[]bool{false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListSnakeCasesByStringIDFooBarBazPage", stmt)()
+	res, err := ScanSnakeCases(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.StringID, last.FooBarBaz, last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", err)
+	}
+
+	return res, token, nil
+}
+
+// FindSnakeCasesByStringID retrieves multiple rows from 'snake_cases' as a slice of SnakeCase.
+// The rows are ordered by the rest of the index key.
+//
+// Generated from a prefix of the key of index 'snake_cases_by_string_id' (string_id, foo_bar_baz).
+func FindSnakeCasesByStringID(ctx context.Context, db YORODB, stringID string, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	const sqlstr = "SELECT " +
+		"id, string_id, foo_bar_baz " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0" +
+		" ORDER BY foo_bar_baz"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = stringID
+
+	// run query
+	defer yoLogQuery(ctx, "FindSnakeCasesByStringID", stmt, stringID)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*SnakeCase{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindSnakeCasesByStringID", "snake_cases", err)
+		}
+
+		sc, err := ScanSnakeCase(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByStringID", "snake_cases", err)
+		}
+
+		res = append(res, sc)
+	}
+
+	return res, nil
+}
+
+// CountSnakeCasesByStringID returns the number of the rows of 'snake_cases' found by
+// FindSnakeCasesByStringID.
+func CountSnakeCasesByStringID(ctx context.Context, db YORODB, stringID string, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = stringID
+
+	// run query
+	defer yoLogQuery(ctx, "CountSnakeCasesByStringID", stmt, stringID)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountSnakeCasesByStringID", "snake_cases", err)
+	}
+
+	return n, nil
+}
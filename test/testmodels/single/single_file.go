@@ -4,62 +4,73 @@ package single
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/civil"
 	"cloud.google.com/go/spanner"
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
 	"github.com/googleapis/gax-go/v2/apierror"
 	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-// CompositePrimaryKey represents a row from 'CompositePrimaryKeys'.
-type CompositePrimaryKey struct {
-	ID    int64  `spanner:"Id" json:"Id"`       // Id
-	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
-	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
-	Error int64  `spanner:"Error" json:"Error"` // Error
-	X     string `spanner:"X" json:"X"`         // X
-	Y     string `spanner:"Y" json:"Y"`         // Y
-	Z     string `spanner:"Z" json:"Z"`         // Z
+// Article represents a row from 'Articles'.
+type Article struct {
+	ID        int64            `spanner:"ID" json:"ID"`               // ID
+	Title     string           `spanner:"Title" json:"Title"`         // Title
+	UpdatedAt spanner.NullTime `spanner:"UpdatedAt" json:"UpdatedAt"` // UpdatedAt
 }
 
-func CompositePrimaryKeyPrimaryKeys() []string {
+// ArticleTableName is the name of 'Articles', which is qualified by the
+// schema if the table is in a named schema.
+const ArticleTableName = "Articles"
+
+// ErrArticleNotFound is the error matched by errors.Is when the row is not
+// found in 'Articles'.
+var ErrArticleNotFound = errors.New("yo: Articles not found")
+
+func ArticlePrimaryKeys() []string {
 	return []string{
-		"PKey1",
-		"PKey2",
+		"ID",
 	}
 }
 
-func CompositePrimaryKeyColumns() []string {
+func ArticleColumns() []string {
 	return []string{
-		"Id",
-		"PKey1",
-		"PKey2",
-		"Error",
-		"X",
-		"Y",
-		"Z",
+		"ID",
+		"Title",
+		"UpdatedAt",
 	}
 }
 
-func CompositePrimaryKeyWritableColumns() []string {
+// ArticleColumn is a column of 'Articles'.
+type ArticleColumn string
+
+// Columns of 'Articles'.
+const (
+	ArticleColumnID        ArticleColumn = "ID"
+	ArticleColumnTitle     ArticleColumn = "Title"
+	ArticleColumnUpdatedAt ArticleColumn = "UpdatedAt"
+)
+
+func ArticleWritableColumns() []string {
 	return []string{
-		"Id",
-		"PKey1",
-		"PKey2",
-		"Error",
-		"X",
-		"Y",
-		"Z",
+		"ID",
+		"Title",
+		"UpdatedAt",
 	}
 }
 
-func (cpk *CompositePrimaryKey) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+func (a *Article) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
 	ret := make([]interface{}, 0, len(cols))
 	for _, col := range cols {
 		if val, ok := customPtrs[col]; ok {
@@ -68,20 +79,12 @@ func (cpk *CompositePrimaryKey) columnsToPtrs(cols []string, customPtrs map[stri
 		}
 
 		switch col {
-		case "Id":
-			ret = append(ret, &cpk.ID)
-		case "PKey1":
-			ret = append(ret, &cpk.PKey1)
-		case "PKey2":
-			ret = append(ret, &cpk.PKey2)
-		case "Error":
-			ret = append(ret, &cpk.Error)
-		case "X":
-			ret = append(ret, &cpk.X)
-		case "Y":
-			ret = append(ret, &cpk.Y)
-		case "Z":
-			ret = append(ret, &cpk.Z)
+		case "ID":
+			ret = append(ret, &a.ID)
+		case "Title":
+			ret = append(ret, &a.Title)
+		case "UpdatedAt":
+			ret = append(ret, &a.UpdatedAt)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
@@ -89,24 +92,16 @@ func (cpk *CompositePrimaryKey) columnsToPtrs(cols []string, customPtrs map[stri
 	return ret, nil
 }
 
-func (cpk *CompositePrimaryKey) columnsToValues(cols []string) ([]interface{}, error) {
+func (a *Article) columnsToValues(cols []string) ([]interface{}, error) {
 	ret := make([]interface{}, 0, len(cols))
 	for _, col := range cols {
 		switch col {
-		case "Id":
-			ret = append(ret, cpk.ID)
-		case "PKey1":
-			ret = append(ret, cpk.PKey1)
-		case "PKey2":
-			ret = append(ret, cpk.PKey2)
-		case "Error":
-			ret = append(ret, cpk.Error)
-		case "X":
-			ret = append(ret, cpk.X)
-		case "Y":
-			ret = append(ret, cpk.Y)
-		case "Z":
-			ret = append(ret, cpk.Z)
+		case "ID":
+			ret = append(ret, a.ID)
+		case "Title":
+			ret = append(ret, a.Title)
+		case "UpdatedAt":
+			ret = append(ret, a.UpdatedAt)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
@@ -115,14 +110,32 @@ func (cpk *CompositePrimaryKey) columnsToValues(cols []string) ([]interface{}, e
 	return ret, nil
 }
 
-// newCompositePrimaryKey_Decoder returns a decoder which reads a row from *spanner.Row
-// into CompositePrimaryKey. The decoder is not goroutine-safe. Don't use it concurrently.
-func newCompositePrimaryKey_Decoder(cols []string) func(*spanner.Row) (*CompositePrimaryKey, error) {
+// mutationValues returns the values of cols to write. The values of the
+// commit timestamp columns are spanner.CommitTimestamp so that Cloud Spanner
+// writes the commit timestamps of the transactions into them.
+func (a *Article) mutationValues(cols []string) ([]interface{}, error) {
+	ret, err := a.columnsToValues(cols)
+	if err != nil {
+		return nil, err
+	}
+	for i, col := range cols {
+		switch col {
+		case "UpdatedAt":
+			ret[i] = spanner.CommitTimestamp
+		}
+	}
+
+	return ret, nil
+}
+
+// newArticle_Decoder returns a decoder which reads a row from *spanner.Row
+// into Article. The decoder is not goroutine-safe. Don't use it concurrently.
+func newArticle_Decoder(cols []string) func(*spanner.Row) (*Article, error) {
 	customPtrs := map[string]interface{}{}
 
-	return func(row *spanner.Row) (*CompositePrimaryKey, error) {
-		var cpk CompositePrimaryKey
-		ptrs, err := cpk.columnsToPtrs(cols, customPtrs)
+	return func(row *spanner.Row) (*Article, error) {
+		var a Article
+		ptrs, err := a.columnsToPtrs(cols, customPtrs)
 		if err != nil {
 			return nil, err
 		}
@@ -131,455 +144,960 @@ func newCompositePrimaryKey_Decoder(cols []string) func(*spanner.Row) (*Composit
 			return nil, err
 		}
 
-		return &cpk, nil
+		return &a, nil
 	}
 }
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (cpk *CompositePrimaryKey) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
-	return spanner.Insert("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
-}
-
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (cpk *CompositePrimaryKey) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
-	return spanner.Update("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
+// ScanArticle decodes row into Article. The row may have any subset of
+// the columns of 'Articles', such as the result of a query or a read.
+func ScanArticle(row *spanner.Row) (*Article, error) {
+	return newArticle_Decoder(row.ColumnNames())(row)
 }
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (cpk *CompositePrimaryKey) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
-	return spanner.InsertOrUpdate("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
-}
+// ScanArticles decodes all the rows of iter into a slice of Article.
+// iter is stopped when it returns.
+func ScanArticles(iter *spanner.RowIterator) ([]*Article, error) {
+	var res []*Article
+	var decoder func(*spanner.Row) (*Article, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newArticle_Decoder(row.ColumnNames())
+		}
 
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (cpk *CompositePrimaryKey) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, CompositePrimaryKeyPrimaryKeys()...)
+		a, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, a)
 
-	values, err := cpk.columnsToValues(colsWithPKeys)
+		return nil
+	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "CompositePrimaryKey.UpdateColumns", "CompositePrimaryKeys", err)
+		return nil, err
 	}
 
-	return spanner.Update("CompositePrimaryKeys", colsWithPKeys, values), nil
+	return res, nil
 }
 
-// FindCompositePrimaryKey gets a CompositePrimaryKey by primary key
-func FindCompositePrimaryKey(ctx context.Context, db YORODB, pKey1 string, pKey2 int64) (*CompositePrimaryKey, error) {
-	key := spanner.Key{pKey1, pKey2}
-	row, err := db.ReadRow(ctx, "CompositePrimaryKeys", key, CompositePrimaryKeyColumns())
+// ArticleIterator iterates over the rows of a partition of 'Articles'
+// decoded into Article.
+type ArticleIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*Article, error)
+}
+
+// ExecuteArticlePartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllArticles or ArticleQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteArticlePartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *ArticleIterator {
+	return &ArticleIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *ArticleIterator) Next() (*Article, error) {
+	row, err := it.iter.Next()
 	if err != nil {
-		return nil, newError("FindCompositePrimaryKey", "CompositePrimaryKeys", err)
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("ArticleIterator.Next", "Articles", err)
 	}
 
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
-	cpk, err := decoder(row)
+	if it.decoder == nil {
+		it.decoder = newArticle_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKey", "CompositePrimaryKeys", err)
+		return nil, newErrorWithCode(codes.Internal, "ArticleIterator.Next", "Articles", err)
 	}
 
-	return cpk, nil
+	return v, nil
 }
 
-// ReadCompositePrimaryKey retrieves multiples rows from CompositePrimaryKey by KeySet as a slice.
-func ReadCompositePrimaryKey(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *ArticleIterator) Stop() {
+	it.iter.Stop()
+}
 
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+// yieldArticleRows decodes the rows of rows into Article and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldArticleRows(rows *spanner.RowIterator, method string, yield func(*Article, error) bool) {
+	defer rows.Stop()
 
-	rows := db.Read(ctx, "CompositePrimaryKeys", keys, CompositePrimaryKeyColumns())
-	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
+	var decoder func(*spanner.Row) (*Article, error)
+	for {
+		row, err := rows.Next()
 		if err != nil {
-			return err
+			if err != iterator.Done {
+				yield(nil, newError(method, "Articles", err))
+			}
+			return
 		}
-		res = append(res, cpk)
 
-		return nil
-	})
+		if decoder == nil {
+			decoder = newArticle_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "Articles", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountArticles returns the number of the rows of 'Articles'.
+func CountArticles(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM Articles")
+
+	defer yoLogQuery(ctx, "CountArticles", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKey", "CompositePrimaryKeys", err)
+		return 0, newError("CountArticles", "Articles", err)
 	}
 
-	return res, nil
+	return n, nil
 }
 
-// Delete deletes the CompositePrimaryKey from the database.
-func (cpk *CompositePrimaryKey) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := cpk.columnsToValues(CompositePrimaryKeyPrimaryKeys())
-	return spanner.Delete("CompositePrimaryKeys", spanner.Key(values))
+// AllArticlesSeq returns an iterator of all the rows of 'Articles',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*Article, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllArticlesSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*Article, error) bool) {
+	return func(yield func(*Article, error) bool) {
+		rows := yoRead(ctx, db, "Articles", "", spanner.AllKeys(), ArticleColumns(), opts)
+		yieldArticleRows(rows, "AllArticlesSeq", yield)
+	}
 }
 
-// FereignItem represents a row from 'FereignItems'.
-type FereignItem struct {
-	ID       int64 `spanner:"ID" json:"ID"`             // ID
-	ItemID   int64 `spanner:"ItemID" json:"ItemID"`     // ItemID
-	Category int64 `spanner:"Category" json:"Category"` // Category
+// PartitionReadAllArticles partitions the read of all the rows of
+// 'Articles' in btx, so that the partitions are read in parallel by
+// ExecuteArticlePartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllArticles(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "Articles", spanner.AllKeys(), ArticleColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllArticles", "Articles", err)
+	}
+
+	return ps, nil
 }
 
-func FereignItemPrimaryKeys() []string {
-	return []string{
-		"ID",
-	}
+// ArticleQuery is a query builder for 'Articles'. The conditions are
+// given by the predicates of ArticleWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of ArticleColumn.
+type ArticleQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
 }
 
-func FereignItemColumns() []string {
-	return []string{
-		"ID",
-		"ItemID",
-		"Category",
-	}
+// NewArticleQuery returns a ArticleQuery filtered by preds.
+func NewArticleQuery(preds ...YOPredicate) *ArticleQuery {
+	return &ArticleQuery{preds: preds}
 }
 
-func FereignItemWritableColumns() []string {
-	return []string{
-		"ID",
-		"ItemID",
-		"Category",
-	}
+// Where adds preds to the conditions which rows must satisfy.
+func (q *ArticleQuery) Where(preds ...YOPredicate) *ArticleQuery {
+	q.preds = append(q.preds, preds...)
+	return q
 }
 
-func (fi *FereignItem) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		if val, ok := customPtrs[col]; ok {
-			ret = append(ret, val)
-			continue
-		}
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *ArticleQuery) OrderBy(col ArticleColumn) *ArticleQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
 
-		switch col {
-		case "ID":
-			ret = append(ret, &fi.ID)
-		case "ItemID":
-			ret = append(ret, &fi.ItemID)
-		case "Category":
-			ret = append(ret, &fi.Category)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
-		}
-	}
-	return ret, nil
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *ArticleQuery) OrderByDesc(col ArticleColumn) *ArticleQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
 }
 
-func (fi *FereignItem) columnsToValues(cols []string) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		switch col {
-		case "ID":
-			ret = append(ret, fi.ID)
-		case "ItemID":
-			ret = append(ret, fi.ItemID)
-		case "Category":
-			ret = append(ret, fi.Category)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
-		}
-	}
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *ArticleQuery) Limit(n int) *ArticleQuery {
+	q.limit = n
+	return q
+}
 
-	return ret, nil
+// Statement returns the parameterized statement of the query.
+func (q *ArticleQuery) Statement() spanner.Statement {
+	return yoStatement("ID, Title, UpdatedAt", "Articles", q.preds, q.orders, q.limit)
 }
 
-// newFereignItem_Decoder returns a decoder which reads a row from *spanner.Row
-// into FereignItem. The decoder is not goroutine-safe. Don't use it concurrently.
-func newFereignItem_Decoder(cols []string) func(*spanner.Row) (*FereignItem, error) {
-	customPtrs := map[string]interface{}{}
+// Query runs the query and returns the matched rows as a slice.
+func (q *ArticleQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*Article, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
 
-	return func(row *spanner.Row) (*FereignItem, error) {
-		var fi FereignItem
-		ptrs, err := fi.columnsToPtrs(cols, customPtrs)
+	// run query
+	defer yoLogQuery(ctx, "ArticleQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*Article{}
+	for {
+		row, err := iter.Next()
 		if err != nil {
-			return nil, err
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("ArticleQuery.Query", "Articles", err)
 		}
 
-		if err := row.Columns(ptrs...); err != nil {
-			return nil, err
+		v, err := ScanArticle(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "ArticleQuery.Query", "Articles", err)
 		}
 
-		return &fi, nil
+		res = append(res, v)
 	}
+
+	return res, nil
 }
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (fi *FereignItem) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := fi.columnsToValues(FereignItemWritableColumns())
-	return spanner.Insert("FereignItems", FereignItemWritableColumns(), values)
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *ArticleQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "Articles", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "ArticleQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("ArticleQuery.Count", "Articles", err)
+	}
+
+	return n, nil
 }
 
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (fi *FereignItem) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := fi.columnsToValues(FereignItemWritableColumns())
-	return spanner.Update("FereignItems", FereignItemWritableColumns(), values)
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*Article, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *ArticleQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*Article, error) bool) {
+	return func(yield func(*Article, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "ArticleQuery.Seq", stmt)()
+		yieldArticleRows(yoQuery(ctx, db, stmt, opts), "ArticleQuery.Seq", yield)
+	}
 }
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteArticlePartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *ArticleQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "ArticleQuery.PartitionQuery", "Articles", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "ArticleQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("ArticleQuery.PartitionQuery", "Articles", err)
+	}
+
+	return ps, nil
+}
+
+// ArticleWhere has the typed predicate constructors of the columns of
+// 'Articles'.
+var ArticleWhere = struct {
+	ID        Article_IDColumn
+	Title     Article_TitleColumn
+	UpdatedAt Article_UpdatedAtColumn
+}{}
+
+// Article_IDColumn has the predicate constructors of 'ID'.
+type Article_IDColumn struct{}
+
+// Eq returns a predicate that 'ID' is equal to v.
+func (Article_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ID' is not equal to v.
+func (Article_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ID' is less than v.
+func (Article_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ID' is less than or equal to v.
+func (Article_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ID' is greater than v.
+func (Article_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ID' is greater than or equal to v.
+func (Article_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ID' is equal to any of vs.
+func (Article_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "IN", value: vs}
+}
+
+// Article_TitleColumn has the predicate constructors of 'Title'.
+type Article_TitleColumn struct{}
+
+// Eq returns a predicate that 'Title' is equal to v.
+func (Article_TitleColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "Title", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Title' is not equal to v.
+func (Article_TitleColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "Title", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Title' is less than v.
+func (Article_TitleColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "Title", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Title' is less than or equal to v.
+func (Article_TitleColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "Title", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Title' is greater than v.
+func (Article_TitleColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "Title", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Title' is greater than or equal to v.
+func (Article_TitleColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "Title", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Title' is equal to any of vs.
+func (Article_TitleColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "Title", op: "IN", value: vs}
+}
+
+// Article_UpdatedAtColumn has the predicate constructors of 'UpdatedAt'.
+type Article_UpdatedAtColumn struct{}
+
+// Eq returns a predicate that 'UpdatedAt' is equal to v.
+func (Article_UpdatedAtColumn) Eq(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'UpdatedAt' is not equal to v.
+func (Article_UpdatedAtColumn) Ne(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'UpdatedAt' is less than v.
+func (Article_UpdatedAtColumn) Lt(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: "<", value: v}
+}
+
+// Le returns a predicate that 'UpdatedAt' is less than or equal to v.
+func (Article_UpdatedAtColumn) Le(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'UpdatedAt' is greater than v.
+func (Article_UpdatedAtColumn) Gt(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'UpdatedAt' is greater than or equal to v.
+func (Article_UpdatedAtColumn) Ge(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: ">=", value: v}
+}
+
+// In returns a predicate that 'UpdatedAt' is equal to any of vs.
+func (Article_UpdatedAtColumn) In(vs ...spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'UpdatedAt' is NULL.
+func (Article_UpdatedAtColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'UpdatedAt' is not NULL.
+func (Article_UpdatedAtColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "UpdatedAt", op: "IS NOT NULL"}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (a *Article) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := a.mutationValues(ArticleWritableColumns())
+	return spanner.Insert("Articles", ArticleWritableColumns(), values)
+}
+
+// InsertAllArticles inserts rows into 'Articles' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllArticles(ctx context.Context, client *spanner.Client, rows []*Article) (int, error) {
+	ms := ArticlesInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(ArticleWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllArticles", "Articles", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// ArticlesInsert returns Mutations to insert the rows into
+// 'Articles', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllArticles, the Mutations are
+// not split into batches.
+func ArticlesInsert(ctx context.Context, rows []*Article) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (a *Article) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := a.mutationValues(ArticleWritableColumns())
+	return spanner.Update("Articles", ArticleWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
 // already exists, it updates it instead. Any column values not explicitly
 // written are preserved.
-func (fi *FereignItem) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := fi.columnsToValues(FereignItemWritableColumns())
-	return spanner.InsertOrUpdate("FereignItems", FereignItemWritableColumns(), values)
+func (a *Article) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := a.mutationValues(ArticleWritableColumns())
+	return spanner.InsertOrUpdate("Articles", ArticleWritableColumns(), values)
+}
+
+// ArticlesUpdate returns Mutations to update the rows in
+// 'Articles', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func ArticlesUpdate(ctx context.Context, rows []*Article) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// ArticlesInsertOrUpdate returns Mutations to insert or update the
+// rows in 'Articles', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func ArticlesInsertOrUpdate(ctx context.Context, rows []*Article) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the Article with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (a *Article) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{a.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteArticles inserts or updates rows in 'Articles' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteArticles(ctx context.Context, client *spanner.Client, rows []*Article) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteArticles", "Articles", groups)
+}
+
+// InsertOrUpdateArticleAtLeastOnce inserts or updates row in 'Articles' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateArticleAtLeastOnce(ctx context.Context, client *spanner.Client, row *Article) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateArticleAtLeastOnce", "Articles", err)
+	}
+
+	return nil
 }
 
 // UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (fi *FereignItem) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+func (a *Article) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
 	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, FereignItemPrimaryKeys()...)
+	colsWithPKeys := append(cols, ArticlePrimaryKeys()...)
 
-	values, err := fi.columnsToValues(colsWithPKeys)
+	values, err := a.mutationValues(colsWithPKeys)
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "FereignItem.UpdateColumns", "FereignItems", err)
+		return nil, newErrorWithCode(codes.InvalidArgument, "Article.UpdateColumns", "Articles", err)
 	}
 
-	return spanner.Update("FereignItems", colsWithPKeys, values), nil
+	return spanner.Update("Articles", colsWithPKeys, values), nil
 }
 
-// FindFereignItem gets a FereignItem by primary key
-func FindFereignItem(ctx context.Context, db YORODB, id int64) (*FereignItem, error) {
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'Articles' can be specified.
+func (a *Article) MutationForColumns(ctx context.Context, cols ...ArticleColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return a.UpdateColumns(ctx, names...)
+}
+
+// ArticleTracker tracks the changes of a Article, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type ArticleTracker struct {
+	row  *Article
+	orig Article
+}
+
+// TrackArticle returns a ArticleTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackArticle(row *Article) *ArticleTracker {
+	tr := &ArticleTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked Article.
+func (tr *ArticleTracker) Row() *Article {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *ArticleTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.Title = yoClone(tr.row.Title)
+	tr.orig.UpdatedAt = yoClone(tr.row.UpdatedAt)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'Articles'. The primary key columns and the
+// generated columns are never included.
+func (tr *ArticleTracker) Changed() []ArticleColumn {
+	var cols []ArticleColumn
+	if !reflect.DeepEqual(tr.orig.Title, tr.row.Title) {
+		cols = append(cols, ArticleColumnTitle)
+	}
+	if !reflect.DeepEqual(tr.orig.UpdatedAt, tr.row.UpdatedAt) {
+		cols = append(cols, ArticleColumnUpdatedAt)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *ArticleTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindArticle gets a Article by primary key
+func FindArticle(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*Article, error) {
 	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "FereignItems", key, FereignItemColumns())
+	row, err := yoReadRow(ctx, db, "Articles", key, ArticleColumns(), opts)
 	if err != nil {
-		return nil, newError("FindFereignItem", "FereignItems", err)
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindArticle", "Articles", key, ErrArticleNotFound, nil)
+		}
+		return nil, newError("FindArticle", "Articles", err)
 	}
 
-	decoder := newFereignItem_Decoder(FereignItemColumns())
-	fi, err := decoder(row)
+	a, err := ScanArticle(row)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindFereignItem", "FereignItems", err)
+		return nil, newErrorWithCode(codes.Internal, "FindArticle", "Articles", err)
 	}
 
-	return fi, nil
+	return a, nil
 }
 
-// ReadFereignItem retrieves multiples rows from FereignItem by KeySet as a slice.
-func ReadFereignItem(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FereignItem, error) {
-	var res []*FereignItem
+// ArticleExists reports whether the row of the primary key exists in
+// 'Articles', which reads only the primary key columns.
+func ArticleExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "Articles", key, ArticlePrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("ArticleExists", "Articles", err)
+	}
 
-	decoder := newFereignItem_Decoder(FereignItemColumns())
+	return true, nil
+}
 
-	rows := db.Read(ctx, "FereignItems", keys, FereignItemColumns())
+// ReadArticle retrieves multiples rows from Article by KeySet as a slice.
+func ReadArticle(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*Article, error) {
+	var res []*Article
+	rows := yoRead(ctx, db, "Articles", "", keys, ArticleColumns(), opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		fi, err := decoder(row)
+		a, err := ScanArticle(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, fi)
+		res = append(res, a)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFereignItem", "FereignItems", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadArticle", "Articles", err)
 	}
 
 	return res, nil
 }
 
-// Delete deletes the FereignItem from the database.
-func (fi *FereignItem) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := fi.columnsToValues(FereignItemPrimaryKeys())
-	return spanner.Delete("FereignItems", spanner.Key(values))
+// ArticlePrimaryKey is the primary key of 'Articles'.
+type ArticlePrimaryKey struct {
+	ID int64
 }
 
-// FullType represents a row from 'FullTypes'.
-type FullType struct {
-	PKey                 string              `spanner:"PKey" json:"PKey"`                                 // PKey
-	FTString             string              `spanner:"FTString" json:"FTString"`                         // FTString
-	FTStringNull         spanner.NullString  `spanner:"FTStringNull" json:"FTStringNull"`                 // FTStringNull
-	FTBool               bool                `spanner:"FTBool" json:"FTBool"`                             // FTBool
-	FTBoolNull           spanner.NullBool    `spanner:"FTBoolNull" json:"FTBoolNull"`                     // FTBoolNull
-	FTBytes              []byte              `spanner:"FTBytes" json:"FTBytes"`                           // FTBytes
-	FTBytesNull          []byte              `spanner:"FTBytesNull" json:"FTBytesNull"`                   // FTBytesNull
-	FTTimestamp          time.Time           `spanner:"FTTimestamp" json:"FTTimestamp"`                   // FTTimestamp
-	FTTimestampNull      spanner.NullTime    `spanner:"FTTimestampNull" json:"FTTimestampNull"`           // FTTimestampNull
-	FTInt                int64               `spanner:"FTInt" json:"FTInt"`                               // FTInt
-	FTIntNull            spanner.NullInt64   `spanner:"FTIntNull" json:"FTIntNull"`                       // FTIntNull
-	FTFloat              float64             `spanner:"FTFloat" json:"FTFloat"`                           // FTFloat
-	FTFloatNull          spanner.NullFloat64 `spanner:"FTFloatNull" json:"FTFloatNull"`                   // FTFloatNull
-	FTDate               civil.Date          `spanner:"FTDate" json:"FTDate"`                             // FTDate
-	FTDateNull           spanner.NullDate    `spanner:"FTDateNull" json:"FTDateNull"`                     // FTDateNull
-	FTJSON               spanner.NullJSON    `spanner:"FTJson" json:"FTJson"`                             // FTJson
-	FTJSONNull           spanner.NullJSON    `spanner:"FTJsonNull" json:"FTJsonNull"`                     // FTJsonNull
-	FTArrayStringNull    []string            `spanner:"FTArrayStringNull" json:"FTArrayStringNull"`       // FTArrayStringNull
-	FTArrayString        []string            `spanner:"FTArrayString" json:"FTArrayString"`               // FTArrayString
-	FTArrayBoolNull      []bool              `spanner:"FTArrayBoolNull" json:"FTArrayBoolNull"`           // FTArrayBoolNull
-	FTArrayBool          []bool              `spanner:"FTArrayBool" json:"FTArrayBool"`                   // FTArrayBool
-	FTArrayBytesNull     [][]byte            `spanner:"FTArrayBytesNull" json:"FTArrayBytesNull"`         // FTArrayBytesNull
-	FTArrayBytes         [][]byte            `spanner:"FTArrayBytes" json:"FTArrayBytes"`                 // FTArrayBytes
-	FTArrayTimestampNull []time.Time         `spanner:"FTArrayTimestampNull" json:"FTArrayTimestampNull"` // FTArrayTimestampNull
-	FTArrayTimestamp     []time.Time         `spanner:"FTArrayTimestamp" json:"FTArrayTimestamp"`         // FTArrayTimestamp
-	FTArrayIntNull       []int64             `spanner:"FTArrayIntNull" json:"FTArrayIntNull"`             // FTArrayIntNull
-	FTArrayInt           []int64             `spanner:"FTArrayInt" json:"FTArrayInt"`                     // FTArrayInt
-	FTArrayFloatNull     []float64           `spanner:"FTArrayFloatNull" json:"FTArrayFloatNull"`         // FTArrayFloatNull
-	FTArrayFloat         []float64           `spanner:"FTArrayFloat" json:"FTArrayFloat"`                 // FTArrayFloat
-	FTArrayDateNull      []civil.Date        `spanner:"FTArrayDateNull" json:"FTArrayDateNull"`           // FTArrayDateNull
-	FTArrayDate          []civil.Date        `spanner:"FTArrayDate" json:"FTArrayDate"`                   // FTArrayDate
-	FTArrayJSONNull      []spanner.NullJSON  `spanner:"FTArrayJsonNull" json:"FTArrayJsonNull"`           // FTArrayJsonNull
-	FTArrayJSON          []spanner.NullJSON  `spanner:"FTArrayJson" json:"FTArrayJson"`                   // FTArrayJson
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadArticle.
+func (k ArticlePrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
 }
 
-func FullTypePrimaryKeys() []string {
-	return []string{
-		"PKey",
+// ParseArticlePrimaryKey parses key of the columns of the primary key of
+// 'Articles' in order, such as the key of a mutation.
+func ParseArticlePrimaryKey(key spanner.Key) (ArticlePrimaryKey, error) {
+	var k ArticlePrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'Articles' must have 1 columns, but got %d", len(key))
 	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'ID' of the key of 'Articles' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
 }
 
-func FullTypeColumns() []string {
-	return []string{
-		"PKey",
-		"FTString",
-		"FTStringNull",
-		"FTBool",
-		"FTBoolNull",
-		"FTBytes",
-		"FTBytesNull",
-		"FTTimestamp",
-		"FTTimestampNull",
-		"FTInt",
-		"FTIntNull",
-		"FTFloat",
-		"FTFloatNull",
-		"FTDate",
-		"FTDateNull",
-		"FTJson",
-		"FTJsonNull",
-		"FTArrayStringNull",
-		"FTArrayString",
-		"FTArrayBoolNull",
-		"FTArrayBool",
-		"FTArrayBytesNull",
-		"FTArrayBytes",
-		"FTArrayTimestampNull",
-		"FTArrayTimestamp",
-		"FTArrayIntNull",
-		"FTArrayInt",
-		"FTArrayFloatNull",
-		"FTArrayFloat",
-		"FTArrayDateNull",
-		"FTArrayDate",
-		"FTArrayJsonNull",
-		"FTArrayJson",
+// PrimaryKey returns the primary key of a.
+func (a *Article) PrimaryKey() ArticlePrimaryKey {
+	return ArticlePrimaryKey{
+		ID: a.ID,
 	}
 }
 
-func FullTypeWritableColumns() []string {
-	return []string{
-		"PKey",
-		"FTString",
-		"FTStringNull",
-		"FTBool",
-		"FTBoolNull",
-		"FTBytes",
-		"FTBytesNull",
-		"FTTimestamp",
-		"FTTimestampNull",
-		"FTInt",
-		"FTIntNull",
-		"FTFloat",
-		"FTFloatNull",
-		"FTDate",
-		"FTDateNull",
-		"FTJson",
-		"FTJsonNull",
-		"FTArrayStringNull",
-		"FTArrayString",
-		"FTArrayBoolNull",
-		"FTArrayBool",
-		"FTArrayBytesNull",
-		"FTArrayBytes",
-		"FTArrayTimestampNull",
-		"FTArrayTimestamp",
-		"FTArrayIntNull",
-		"FTArrayInt",
-		"FTArrayFloatNull",
-		"FTArrayFloat",
-		"FTArrayDateNull",
-		"FTArrayDate",
-		"FTArrayJsonNull",
-		"FTArrayJson",
+// FindArticleByKey gets a Article by the primary key of key.
+func FindArticleByKey(ctx context.Context, db YORODB, key ArticlePrimaryKey, opts ...*spanner.ReadOptions) (*Article, error) {
+	row, err := yoReadRow(ctx, db, "Articles", key.ToSpannerKey(), ArticleColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindArticleByKey", "Articles", key.ToSpannerKey(), ErrArticleNotFound, nil)
+		}
+		return nil, newError("FindArticleByKey", "Articles", err)
 	}
+
+	a, err := ScanArticle(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindArticleByKey", "Articles", err)
+	}
+
+	return a, nil
 }
 
-func (ft *FullType) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		if val, ok := customPtrs[col]; ok {
-			ret = append(ret, val)
-			continue
+// DeleteArticleByKey returns a Mutation to delete the row of key from
+// 'Articles'.
+func DeleteArticleByKey(ctx context.Context, key ArticlePrimaryKey) *spanner.Mutation {
+	return spanner.Delete("Articles", key.ToSpannerKey())
+}
+
+// FindArticlesByKeys retrieves the rows of keys from 'Articles' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindArticlesByKeys(ctx context.Context, db YORODB, keys []ArticlePrimaryKey, opts ...*spanner.ReadOptions) ([]*Article, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*Article, len(keys))
+	rows := yoRead(ctx, db, "Articles", "", spanner.KeySets(keySets...), ArticleColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		a, err := ScanArticle(row)
+		if err != nil {
+			return err
 		}
+		byKey[a.primaryKey().String()] = a
 
-		switch col {
-		case "PKey":
-			ret = append(ret, &ft.PKey)
-		case "FTString":
-			ret = append(ret, &ft.FTString)
-		case "FTStringNull":
-			ret = append(ret, &ft.FTStringNull)
-		case "FTBool":
-			ret = append(ret, &ft.FTBool)
-		case "FTBoolNull":
-			ret = append(ret, &ft.FTBoolNull)
-		case "FTBytes":
-			ret = append(ret, &ft.FTBytes)
-		case "FTBytesNull":
-			ret = append(ret, &ft.FTBytesNull)
-		case "FTTimestamp":
-			ret = append(ret, &ft.FTTimestamp)
-		case "FTTimestampNull":
-			ret = append(ret, &ft.FTTimestampNull)
-		case "FTInt":
-			ret = append(ret, &ft.FTInt)
-		case "FTIntNull":
-			ret = append(ret, &ft.FTIntNull)
-		case "FTFloat":
-			ret = append(ret, &ft.FTFloat)
-		case "FTFloatNull":
-			ret = append(ret, &ft.FTFloatNull)
-		case "FTDate":
-			ret = append(ret, &ft.FTDate)
-		case "FTDateNull":
-			ret = append(ret, &ft.FTDateNull)
-		case "FTJson":
-			ret = append(ret, &ft.FTJSON)
-		case "FTJsonNull":
-			ret = append(ret, &ft.FTJSONNull)
-		case "FTArrayStringNull":
-			ret = append(ret, &ft.FTArrayStringNull)
-		case "FTArrayString":
-			ret = append(ret, &ft.FTArrayString)
-		case "FTArrayBoolNull":
-			ret = append(ret, &ft.FTArrayBoolNull)
-		case "FTArrayBool":
-			ret = append(ret, &ft.FTArrayBool)
-		case "FTArrayBytesNull":
-			ret = append(ret, &ft.FTArrayBytesNull)
-		case "FTArrayBytes":
-			ret = append(ret, &ft.FTArrayBytes)
-		case "FTArrayTimestampNull":
-			ret = append(ret, &ft.FTArrayTimestampNull)
-		case "FTArrayTimestamp":
-			ret = append(ret, &ft.FTArrayTimestamp)
-		case "FTArrayIntNull":
-			ret = append(ret, &ft.FTArrayIntNull)
-		case "FTArrayInt":
-			ret = append(ret, &ft.FTArrayInt)
-		case "FTArrayFloatNull":
-			ret = append(ret, &ft.FTArrayFloatNull)
-		case "FTArrayFloat":
-			ret = append(ret, &ft.FTArrayFloat)
-		case "FTArrayDateNull":
-			ret = append(ret, &ft.FTArrayDateNull)
-		case "FTArrayDate":
-			ret = append(ret, &ft.FTArrayDate)
-		case "FTArrayJsonNull":
-			ret = append(ret, &ft.FTArrayJSONNull)
-		case "FTArrayJson":
-			ret = append(ret, &ft.FTArrayJSON)
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindArticlesByKeys", "Articles", err)
+	}
+
+	res := make([]*Article, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if a, ok := byKey[s]; ok {
+			res = append(res, a)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListArticlesPage retrieves a page of at most pageSize rows from 'Articles'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListArticlesPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*Article, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListArticlesPage", "Articles", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListArticlesPage", "Articles", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT ID, Title, UpdatedAt FROM Articles",
+		[]string{"ID"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListArticlesPage", stmt)()
+	res, err := ScanArticles(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListArticlesPage", "Articles", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListArticlesPage", "Articles", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadArticleRange retrieves multiple rows from Article whose
+// 'ID' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadArticleRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*Article, error) {
+	var res []*Article
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "Articles", "", keys, ArticleColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		a, err := ScanArticle(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, a)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadArticleRange", "Articles", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the Article, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (a *Article) primaryKey() spanner.Key {
+	return spanner.Key{a.ID}
+}
+
+// Delete deletes the Article from the database.
+func (a *Article) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("Articles", a.primaryKey())
+}
+
+// ArticlesDelete returns Mutations to delete the rows from
+// 'Articles', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func ArticlesDelete(ctx context.Context, rows []*Article) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// ArticleDescriptor describes 'Articles' for YORepository.
+var ArticleDescriptor = &YODescriptor[Article, ArticlePrimaryKey]{
+	Table:       "Articles",
+	Columns:     ArticleColumns(),
+	PrimaryKeys: ArticlePrimaryKeys(),
+	Key: func(row *Article) ArticlePrimaryKey {
+		return ArticlePrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: ArticlePrimaryKey.ToSpannerKey,
+	Scan:       ScanArticle,
+	Find:       FindArticleByKey,
+	Read:       ReadArticle,
+	Insert: func(ctx context.Context, row *Article) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *Article) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *Article) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *Article) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewArticleRepository returns a YORepository of 'Articles' by client.
+func NewArticleRepository(client *spanner.Client) *YORepository[Article, ArticlePrimaryKey] {
+	return NewYORepository(client, ArticleDescriptor)
+}
+
+// CompositePrimaryKey represents a row from 'CompositePrimaryKeys'.
+type CompositePrimaryKey struct {
+	ID    int64  `spanner:"Id" json:"Id"`       // Id
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+	Error int64  `spanner:"Error" json:"Error"` // Error
+	X     string `spanner:"X" json:"X"`         // X
+	Y     string `spanner:"Y" json:"Y"`         // Y
+	Z     string `spanner:"Z" json:"Z"`         // Z
+}
+
+// CompositePrimaryKeyTableName is the name of 'CompositePrimaryKeys', which is qualified by the
+// schema if the table is in a named schema.
+const CompositePrimaryKeyTableName = "CompositePrimaryKeys"
+
+// ErrCompositePrimaryKeyNotFound is the error matched by errors.Is when the row is not
+// found in 'CompositePrimaryKeys'.
+var ErrCompositePrimaryKeyNotFound = errors.New("yo: CompositePrimaryKeys not found")
+
+func CompositePrimaryKeyPrimaryKeys() []string {
+	return []string{
+		"PKey1",
+		"PKey2",
+	}
+}
+
+func CompositePrimaryKeyColumns() []string {
+	return []string{
+		"Id",
+		"PKey1",
+		"PKey2",
+		"Error",
+		"X",
+		"Y",
+		"Z",
+	}
+}
+
+// CompositePrimaryKeyColumn is a column of 'CompositePrimaryKeys'.
+type CompositePrimaryKeyColumn string
+
+// Columns of 'CompositePrimaryKeys'.
+const (
+	CompositePrimaryKeyColumnID    CompositePrimaryKeyColumn = "Id"
+	CompositePrimaryKeyColumnPKey1 CompositePrimaryKeyColumn = "PKey1"
+	CompositePrimaryKeyColumnPKey2 CompositePrimaryKeyColumn = "PKey2"
+	CompositePrimaryKeyColumnError CompositePrimaryKeyColumn = "Error"
+	CompositePrimaryKeyColumnX     CompositePrimaryKeyColumn = "X"
+	CompositePrimaryKeyColumnY     CompositePrimaryKeyColumn = "Y"
+	CompositePrimaryKeyColumnZ     CompositePrimaryKeyColumn = "Z"
+)
+
+func CompositePrimaryKeyWritableColumns() []string {
+	return []string{
+		"Id",
+		"PKey1",
+		"PKey2",
+		"Error",
+		"X",
+		"Y",
+		"Z",
+	}
+}
+
+func (cpk *CompositePrimaryKey) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "Id":
+			ret = append(ret, &cpk.ID)
+		case "PKey1":
+			ret = append(ret, &cpk.PKey1)
+		case "PKey2":
+			ret = append(ret, &cpk.PKey2)
+		case "Error":
+			ret = append(ret, &cpk.Error)
+		case "X":
+			ret = append(ret, &cpk.X)
+		case "Y":
+			ret = append(ret, &cpk.Y)
+		case "Z":
+			ret = append(ret, &cpk.Z)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
@@ -587,1363 +1105,10392 @@ func (ft *FullType) columnsToPtrs(cols []string, customPtrs map[string]interface
 	return ret, nil
 }
 
-func (ft *FullType) columnsToValues(cols []string) ([]interface{}, error) {
+func (cpk *CompositePrimaryKey) columnsToValues(cols []string) ([]interface{}, error) {
 	ret := make([]interface{}, 0, len(cols))
 	for _, col := range cols {
 		switch col {
-		case "PKey":
-			ret = append(ret, ft.PKey)
-		case "FTString":
-			ret = append(ret, ft.FTString)
-		case "FTStringNull":
-			ret = append(ret, ft.FTStringNull)
-		case "FTBool":
-			ret = append(ret, ft.FTBool)
-		case "FTBoolNull":
-			ret = append(ret, ft.FTBoolNull)
-		case "FTBytes":
-			ret = append(ret, ft.FTBytes)
-		case "FTBytesNull":
-			ret = append(ret, ft.FTBytesNull)
-		case "FTTimestamp":
-			ret = append(ret, ft.FTTimestamp)
-		case "FTTimestampNull":
-			ret = append(ret, ft.FTTimestampNull)
-		case "FTInt":
-			ret = append(ret, ft.FTInt)
-		case "FTIntNull":
-			ret = append(ret, ft.FTIntNull)
-		case "FTFloat":
-			ret = append(ret, ft.FTFloat)
-		case "FTFloatNull":
-			ret = append(ret, ft.FTFloatNull)
-		case "FTDate":
-			ret = append(ret, ft.FTDate)
-		case "FTDateNull":
-			ret = append(ret, ft.FTDateNull)
-		case "FTJson":
-			ret = append(ret, ft.FTJSON)
-		case "FTJsonNull":
-			ret = append(ret, ft.FTJSONNull)
-		case "FTArrayStringNull":
-			ret = append(ret, ft.FTArrayStringNull)
-		case "FTArrayString":
-			ret = append(ret, ft.FTArrayString)
-		case "FTArrayBoolNull":
-			ret = append(ret, ft.FTArrayBoolNull)
-		case "FTArrayBool":
-			ret = append(ret, ft.FTArrayBool)
-		case "FTArrayBytesNull":
-			ret = append(ret, ft.FTArrayBytesNull)
-		case "FTArrayBytes":
-			ret = append(ret, ft.FTArrayBytes)
-		case "FTArrayTimestampNull":
-			ret = append(ret, ft.FTArrayTimestampNull)
-		case "FTArrayTimestamp":
-			ret = append(ret, ft.FTArrayTimestamp)
-		case "FTArrayIntNull":
-			ret = append(ret, ft.FTArrayIntNull)
-		case "FTArrayInt":
-			ret = append(ret, ft.FTArrayInt)
-		case "FTArrayFloatNull":
-			ret = append(ret, ft.FTArrayFloatNull)
-		case "FTArrayFloat":
-			ret = append(ret, ft.FTArrayFloat)
-		case "FTArrayDateNull":
-			ret = append(ret, ft.FTArrayDateNull)
-		case "FTArrayDate":
-			ret = append(ret, ft.FTArrayDate)
-		case "FTArrayJsonNull":
-			ret = append(ret, ft.FTArrayJSONNull)
-		case "FTArrayJson":
-			ret = append(ret, ft.FTArrayJSON)
+		case "Id":
+			ret = append(ret, cpk.ID)
+		case "PKey1":
+			ret = append(ret, cpk.PKey1)
+		case "PKey2":
+			ret = append(ret, cpk.PKey2)
+		case "Error":
+			ret = append(ret, cpk.Error)
+		case "X":
+			ret = append(ret, cpk.X)
+		case "Y":
+			ret = append(ret, cpk.Y)
+		case "Z":
+			ret = append(ret, cpk.Z)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
 	}
 
-	return ret, nil
+	return ret, nil
+}
+
+// newCompositePrimaryKey_Decoder returns a decoder which reads a row from *spanner.Row
+// into CompositePrimaryKey. The decoder is not goroutine-safe. Don't use it concurrently.
+func newCompositePrimaryKey_Decoder(cols []string) func(*spanner.Row) (*CompositePrimaryKey, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*CompositePrimaryKey, error) {
+		var cpk CompositePrimaryKey
+		ptrs, err := cpk.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &cpk, nil
+	}
+}
+
+// ScanCompositePrimaryKey decodes row into CompositePrimaryKey. The row may have any subset of
+// the columns of 'CompositePrimaryKeys', such as the result of a query or a read.
+func ScanCompositePrimaryKey(row *spanner.Row) (*CompositePrimaryKey, error) {
+	return newCompositePrimaryKey_Decoder(row.ColumnNames())(row)
+}
+
+// ScanCompositePrimaryKeys decodes all the rows of iter into a slice of CompositePrimaryKey.
+// iter is stopped when it returns.
+func ScanCompositePrimaryKeys(iter *spanner.RowIterator) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	var decoder func(*spanner.Row) (*CompositePrimaryKey, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newCompositePrimaryKey_Decoder(row.ColumnNames())
+		}
+
+		cpk, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CompositePrimaryKeyIterator iterates over the rows of a partition of 'CompositePrimaryKeys'
+// decoded into CompositePrimaryKey.
+type CompositePrimaryKeyIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*CompositePrimaryKey, error)
+}
+
+// ExecuteCompositePrimaryKeyPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllCompositePrimaryKeys or CompositePrimaryKeyQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteCompositePrimaryKeyPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *CompositePrimaryKeyIterator {
+	return &CompositePrimaryKeyIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *CompositePrimaryKeyIterator) Next() (*CompositePrimaryKey, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("CompositePrimaryKeyIterator.Next", "CompositePrimaryKeys", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newCompositePrimaryKey_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "CompositePrimaryKeyIterator.Next", "CompositePrimaryKeys", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *CompositePrimaryKeyIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldCompositePrimaryKeyRows decodes the rows of rows into CompositePrimaryKey and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldCompositePrimaryKeyRows(rows *spanner.RowIterator, method string, yield func(*CompositePrimaryKey, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*CompositePrimaryKey, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "CompositePrimaryKeys", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newCompositePrimaryKey_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "CompositePrimaryKeys", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountCompositePrimaryKeys returns the number of the rows of 'CompositePrimaryKeys'.
+func CountCompositePrimaryKeys(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM CompositePrimaryKeys")
+
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeys", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeys", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// AllCompositePrimaryKeysSeq returns an iterator of all the rows of 'CompositePrimaryKeys',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*CompositePrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllCompositePrimaryKeysSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*CompositePrimaryKey, error) bool) {
+	return func(yield func(*CompositePrimaryKey, error) bool) {
+		rows := yoRead(ctx, db, "CompositePrimaryKeys", "", spanner.AllKeys(), CompositePrimaryKeyColumns(), opts)
+		yieldCompositePrimaryKeyRows(rows, "AllCompositePrimaryKeysSeq", yield)
+	}
+}
+
+// PartitionReadAllCompositePrimaryKeys partitions the read of all the rows of
+// 'CompositePrimaryKeys' in btx, so that the partitions are read in parallel by
+// ExecuteCompositePrimaryKeyPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllCompositePrimaryKeys(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "CompositePrimaryKeys", spanner.AllKeys(), CompositePrimaryKeyColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllCompositePrimaryKeys", "CompositePrimaryKeys", err)
+	}
+
+	return ps, nil
+}
+
+// CompositePrimaryKeyQuery is a query builder for 'CompositePrimaryKeys'. The conditions are
+// given by the predicates of CompositePrimaryKeyWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of CompositePrimaryKeyColumn.
+type CompositePrimaryKeyQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewCompositePrimaryKeyQuery returns a CompositePrimaryKeyQuery filtered by preds.
+func NewCompositePrimaryKeyQuery(preds ...YOPredicate) *CompositePrimaryKeyQuery {
+	return &CompositePrimaryKeyQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *CompositePrimaryKeyQuery) Where(preds ...YOPredicate) *CompositePrimaryKeyQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *CompositePrimaryKeyQuery) OrderBy(col CompositePrimaryKeyColumn) *CompositePrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *CompositePrimaryKeyQuery) OrderByDesc(col CompositePrimaryKeyColumn) *CompositePrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *CompositePrimaryKeyQuery) Limit(n int) *CompositePrimaryKeyQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *CompositePrimaryKeyQuery) Statement() spanner.Statement {
+	return yoStatement("Id, PKey1, PKey2, Error, X, Y, Z", "CompositePrimaryKeys", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *CompositePrimaryKeyQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("CompositePrimaryKeyQuery.Query", "CompositePrimaryKeys", err)
+		}
+
+		v, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "CompositePrimaryKeyQuery.Query", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *CompositePrimaryKeyQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "CompositePrimaryKeys", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CompositePrimaryKeyQuery.Count", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*CompositePrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *CompositePrimaryKeyQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*CompositePrimaryKey, error) bool) {
+	return func(yield func(*CompositePrimaryKey, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.Seq", stmt)()
+		yieldCompositePrimaryKeyRows(yoQuery(ctx, db, stmt, opts), "CompositePrimaryKeyQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteCompositePrimaryKeyPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *CompositePrimaryKeyQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "CompositePrimaryKeyQuery.PartitionQuery", "CompositePrimaryKeys", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "CompositePrimaryKeyQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("CompositePrimaryKeyQuery.PartitionQuery", "CompositePrimaryKeys", err)
+	}
+
+	return ps, nil
+}
+
+// CompositePrimaryKeyWhere has the typed predicate constructors of the columns of
+// 'CompositePrimaryKeys'.
+var CompositePrimaryKeyWhere = struct {
+	ID    CompositePrimaryKey_IDColumn
+	PKey1 CompositePrimaryKey_PKey1Column
+	PKey2 CompositePrimaryKey_PKey2Column
+	Error CompositePrimaryKey_ErrorColumn
+	X     CompositePrimaryKey_XColumn
+	Y     CompositePrimaryKey_YColumn
+	Z     CompositePrimaryKey_ZColumn
+}{}
+
+// CompositePrimaryKey_IDColumn has the predicate constructors of 'Id'.
+type CompositePrimaryKey_IDColumn struct{}
+
+// Eq returns a predicate that 'Id' is equal to v.
+func (CompositePrimaryKey_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Id' is not equal to v.
+func (CompositePrimaryKey_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Id' is less than v.
+func (CompositePrimaryKey_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Id' is less than or equal to v.
+func (CompositePrimaryKey_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Id' is greater than v.
+func (CompositePrimaryKey_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Id' is greater than or equal to v.
+func (CompositePrimaryKey_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Id", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Id' is equal to any of vs.
+func (CompositePrimaryKey_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Id", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_PKey1Column has the predicate constructors of 'PKey1'.
+type CompositePrimaryKey_PKey1Column struct{}
+
+// Eq returns a predicate that 'PKey1' is equal to v.
+func (CompositePrimaryKey_PKey1Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey1' is not equal to v.
+func (CompositePrimaryKey_PKey1Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey1' is less than v.
+func (CompositePrimaryKey_PKey1Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey1' is less than or equal to v.
+func (CompositePrimaryKey_PKey1Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey1' is greater than v.
+func (CompositePrimaryKey_PKey1Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey1' is greater than or equal to v.
+func (CompositePrimaryKey_PKey1Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey1' is equal to any of vs.
+func (CompositePrimaryKey_PKey1Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_PKey2Column has the predicate constructors of 'PKey2'.
+type CompositePrimaryKey_PKey2Column struct{}
+
+// Eq returns a predicate that 'PKey2' is equal to v.
+func (CompositePrimaryKey_PKey2Column) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey2' is not equal to v.
+func (CompositePrimaryKey_PKey2Column) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey2' is less than v.
+func (CompositePrimaryKey_PKey2Column) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey2' is less than or equal to v.
+func (CompositePrimaryKey_PKey2Column) Le(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey2' is greater than v.
+func (CompositePrimaryKey_PKey2Column) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey2' is greater than or equal to v.
+func (CompositePrimaryKey_PKey2Column) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey2' is equal to any of vs.
+func (CompositePrimaryKey_PKey2Column) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_ErrorColumn has the predicate constructors of 'Error'.
+type CompositePrimaryKey_ErrorColumn struct{}
+
+// Eq returns a predicate that 'Error' is equal to v.
+func (CompositePrimaryKey_ErrorColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Error' is not equal to v.
+func (CompositePrimaryKey_ErrorColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Error' is less than v.
+func (CompositePrimaryKey_ErrorColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Error' is less than or equal to v.
+func (CompositePrimaryKey_ErrorColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Error' is greater than v.
+func (CompositePrimaryKey_ErrorColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Error' is greater than or equal to v.
+func (CompositePrimaryKey_ErrorColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Error", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Error' is equal to any of vs.
+func (CompositePrimaryKey_ErrorColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Error", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_XColumn has the predicate constructors of 'X'.
+type CompositePrimaryKey_XColumn struct{}
+
+// Eq returns a predicate that 'X' is equal to v.
+func (CompositePrimaryKey_XColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'X' is not equal to v.
+func (CompositePrimaryKey_XColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'X' is less than v.
+func (CompositePrimaryKey_XColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "<", value: v}
+}
+
+// Le returns a predicate that 'X' is less than or equal to v.
+func (CompositePrimaryKey_XColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "X", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'X' is greater than v.
+func (CompositePrimaryKey_XColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "X", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'X' is greater than or equal to v.
+func (CompositePrimaryKey_XColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "X", op: ">=", value: v}
+}
+
+// In returns a predicate that 'X' is equal to any of vs.
+func (CompositePrimaryKey_XColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "X", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_YColumn has the predicate constructors of 'Y'.
+type CompositePrimaryKey_YColumn struct{}
+
+// Eq returns a predicate that 'Y' is equal to v.
+func (CompositePrimaryKey_YColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Y' is not equal to v.
+func (CompositePrimaryKey_YColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Y' is less than v.
+func (CompositePrimaryKey_YColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Y' is less than or equal to v.
+func (CompositePrimaryKey_YColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Y' is greater than v.
+func (CompositePrimaryKey_YColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Y' is greater than or equal to v.
+func (CompositePrimaryKey_YColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "Y", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Y' is equal to any of vs.
+func (CompositePrimaryKey_YColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "Y", op: "IN", value: vs}
+}
+
+// CompositePrimaryKey_ZColumn has the predicate constructors of 'Z'.
+type CompositePrimaryKey_ZColumn struct{}
+
+// Eq returns a predicate that 'Z' is equal to v.
+func (CompositePrimaryKey_ZColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Z' is not equal to v.
+func (CompositePrimaryKey_ZColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Z' is less than v.
+func (CompositePrimaryKey_ZColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Z' is less than or equal to v.
+func (CompositePrimaryKey_ZColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Z' is greater than v.
+func (CompositePrimaryKey_ZColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Z' is greater than or equal to v.
+func (CompositePrimaryKey_ZColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "Z", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Z' is equal to any of vs.
+func (CompositePrimaryKey_ZColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "Z", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (cpk *CompositePrimaryKey) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
+	return spanner.Insert("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
+}
+
+// InsertAllCompositePrimaryKeys inserts rows into 'CompositePrimaryKeys' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllCompositePrimaryKeys(ctx context.Context, client *spanner.Client, rows []*CompositePrimaryKey) (int, error) {
+	ms := CompositePrimaryKeysInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(CompositePrimaryKeyWritableColumns()) * (1 + 4)
+
+	return yoApplyInBatches(ctx, client, "InsertAllCompositePrimaryKeys", "CompositePrimaryKeys", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// CompositePrimaryKeysInsert returns Mutations to insert the rows into
+// 'CompositePrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllCompositePrimaryKeys, the Mutations are
+// not split into batches.
+func CompositePrimaryKeysInsert(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (cpk *CompositePrimaryKey) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
+	return spanner.Update("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (cpk *CompositePrimaryKey) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := cpk.columnsToValues(CompositePrimaryKeyWritableColumns())
+	return spanner.InsertOrUpdate("CompositePrimaryKeys", CompositePrimaryKeyWritableColumns(), values)
+}
+
+// CompositePrimaryKeysUpdate returns Mutations to update the rows in
+// 'CompositePrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func CompositePrimaryKeysUpdate(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// CompositePrimaryKeysInsertOrUpdate returns Mutations to insert or update the
+// rows in 'CompositePrimaryKeys', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func CompositePrimaryKeysInsertOrUpdate(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the CompositePrimaryKey with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (cpk *CompositePrimaryKey) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{cpk.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteCompositePrimaryKeys inserts or updates rows in 'CompositePrimaryKeys' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteCompositePrimaryKeys(ctx context.Context, client *spanner.Client, rows []*CompositePrimaryKey) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteCompositePrimaryKeys", "CompositePrimaryKeys", groups)
+}
+
+// InsertOrUpdateCompositePrimaryKeyAtLeastOnce inserts or updates row in 'CompositePrimaryKeys' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateCompositePrimaryKeyAtLeastOnce(ctx context.Context, client *spanner.Client, row *CompositePrimaryKey) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateCompositePrimaryKeyAtLeastOnce", "CompositePrimaryKeys", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (cpk *CompositePrimaryKey) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, CompositePrimaryKeyPrimaryKeys()...)
+
+	values, err := cpk.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "CompositePrimaryKey.UpdateColumns", "CompositePrimaryKeys", err)
+	}
+
+	return spanner.Update("CompositePrimaryKeys", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'CompositePrimaryKeys' can be specified.
+func (cpk *CompositePrimaryKey) MutationForColumns(ctx context.Context, cols ...CompositePrimaryKeyColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return cpk.UpdateColumns(ctx, names...)
+}
+
+// CompositePrimaryKeyTracker tracks the changes of a CompositePrimaryKey, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type CompositePrimaryKeyTracker struct {
+	row  *CompositePrimaryKey
+	orig CompositePrimaryKey
+}
+
+// TrackCompositePrimaryKey returns a CompositePrimaryKeyTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackCompositePrimaryKey(row *CompositePrimaryKey) *CompositePrimaryKeyTracker {
+	tr := &CompositePrimaryKeyTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked CompositePrimaryKey.
+func (tr *CompositePrimaryKeyTracker) Row() *CompositePrimaryKey {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *CompositePrimaryKeyTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.ID = yoClone(tr.row.ID)
+	tr.orig.Error = yoClone(tr.row.Error)
+	tr.orig.X = yoClone(tr.row.X)
+	tr.orig.Y = yoClone(tr.row.Y)
+	tr.orig.Z = yoClone(tr.row.Z)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'CompositePrimaryKeys'. The primary key columns and the
+// generated columns are never included.
+func (tr *CompositePrimaryKeyTracker) Changed() []CompositePrimaryKeyColumn {
+	var cols []CompositePrimaryKeyColumn
+	if !reflect.DeepEqual(tr.orig.ID, tr.row.ID) {
+		cols = append(cols, CompositePrimaryKeyColumnID)
+	}
+	if !reflect.DeepEqual(tr.orig.Error, tr.row.Error) {
+		cols = append(cols, CompositePrimaryKeyColumnError)
+	}
+	if !reflect.DeepEqual(tr.orig.X, tr.row.X) {
+		cols = append(cols, CompositePrimaryKeyColumnX)
+	}
+	if !reflect.DeepEqual(tr.orig.Y, tr.row.Y) {
+		cols = append(cols, CompositePrimaryKeyColumnY)
+	}
+	if !reflect.DeepEqual(tr.orig.Z, tr.row.Z) {
+		cols = append(cols, CompositePrimaryKeyColumnZ)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *CompositePrimaryKeyTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindCompositePrimaryKey gets a CompositePrimaryKey by primary key
+func FindCompositePrimaryKey(ctx context.Context, db YORODB, pKey1 string, pKey2 int64, opts ...*spanner.ReadOptions) (*CompositePrimaryKey, error) {
+	key := spanner.Key{pKey1, pKey2}
+	row, err := yoReadRow(ctx, db, "CompositePrimaryKeys", key, CompositePrimaryKeyColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindCompositePrimaryKey", "CompositePrimaryKeys", key, ErrCompositePrimaryKeyNotFound, nil)
+		}
+		return nil, newError("FindCompositePrimaryKey", "CompositePrimaryKeys", err)
+	}
+
+	cpk, err := ScanCompositePrimaryKey(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKey", "CompositePrimaryKeys", err)
+	}
+
+	return cpk, nil
+}
+
+// CompositePrimaryKeyExists reports whether the row of the primary key exists in
+// 'CompositePrimaryKeys', which reads only the primary key columns.
+func CompositePrimaryKeyExists(ctx context.Context, db YORODB, pKey1 string, pKey2 int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{pKey1, pKey2}
+	if _, err := yoReadRow(ctx, db, "CompositePrimaryKeys", key, CompositePrimaryKeyPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("CompositePrimaryKeyExists", "CompositePrimaryKeys", err)
+	}
+
+	return true, nil
+}
+
+// ReadCompositePrimaryKey retrieves multiples rows from CompositePrimaryKey by KeySet as a slice.
+func ReadCompositePrimaryKey(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKey", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// CompositePrimaryKeyPrimaryKey is the primary key of 'CompositePrimaryKeys'.
+type CompositePrimaryKeyPrimaryKey struct {
+	PKey1 string
+	PKey2 int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadCompositePrimaryKey.
+func (k CompositePrimaryKeyPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.PKey1, k.PKey2}
+}
+
+// ParseCompositePrimaryKeyPrimaryKey parses key of the columns of the primary key of
+// 'CompositePrimaryKeys' in order, such as the key of a mutation.
+func ParseCompositePrimaryKeyPrimaryKey(key spanner.Key) (CompositePrimaryKeyPrimaryKey, error) {
+	var k CompositePrimaryKeyPrimaryKey
+	if len(key) != 2 {
+		return k, fmt.Errorf("key of 'CompositePrimaryKeys' must have 2 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(string)
+	if !ok {
+		return k, fmt.Errorf("column 'PKey1' of the key of 'CompositePrimaryKeys' must be string, but got %T", key[0])
+	}
+	k.PKey1 = v0
+	v1, ok := key[1].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'PKey2' of the key of 'CompositePrimaryKeys' must be int64, but got %T", key[1])
+	}
+	k.PKey2 = v1
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of cpk.
+func (cpk *CompositePrimaryKey) PrimaryKey() CompositePrimaryKeyPrimaryKey {
+	return CompositePrimaryKeyPrimaryKey{
+		PKey1: cpk.PKey1,
+		PKey2: cpk.PKey2,
+	}
+}
+
+// FindCompositePrimaryKeyByKey gets a CompositePrimaryKey by the primary key of key.
+func FindCompositePrimaryKeyByKey(ctx context.Context, db YORODB, key CompositePrimaryKeyPrimaryKey, opts ...*spanner.ReadOptions) (*CompositePrimaryKey, error) {
+	row, err := yoReadRow(ctx, db, "CompositePrimaryKeys", key.ToSpannerKey(), CompositePrimaryKeyColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindCompositePrimaryKeyByKey", "CompositePrimaryKeys", key.ToSpannerKey(), ErrCompositePrimaryKeyNotFound, nil)
+		}
+		return nil, newError("FindCompositePrimaryKeyByKey", "CompositePrimaryKeys", err)
+	}
+
+	cpk, err := ScanCompositePrimaryKey(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeyByKey", "CompositePrimaryKeys", err)
+	}
+
+	return cpk, nil
+}
+
+// DeleteCompositePrimaryKeyByKey returns a Mutation to delete the row of key from
+// 'CompositePrimaryKeys'.
+func DeleteCompositePrimaryKeyByKey(ctx context.Context, key CompositePrimaryKeyPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("CompositePrimaryKeys", key.ToSpannerKey())
+}
+
+// FindCompositePrimaryKeysByKeys retrieves the rows of keys from 'CompositePrimaryKeys' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindCompositePrimaryKeysByKeys(ctx context.Context, db YORODB, keys []CompositePrimaryKeyPrimaryKey, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*CompositePrimaryKey, len(keys))
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", spanner.KeySets(keySets...), CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		byKey[cpk.primaryKey().String()] = cpk
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByKeys", "CompositePrimaryKeys", err)
+	}
+
+	res := make([]*CompositePrimaryKey, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if cpk, ok := byKey[s]; ok {
+			res = append(res, cpk)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListCompositePrimaryKeysPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListCompositePrimaryKeysPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysPage", "CompositePrimaryKeys", err)
+		}
+		key = []interface{}{k0, k1}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys",
+		[]string{"PKey1", "PKey2"},
+		[]bool{false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadCompositePrimaryKeyByPKey1 retrieves multiples rows from CompositePrimaryKey whose primary key
+// starts with the given key columns as a slice.
+func ReadCompositePrimaryKeyByPKey1(ctx context.Context, db YORODB, pKey1 string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+
+	keys := spanner.Key{pKey1}.AsPrefix()
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeyByPKey1", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// ReadCompositePrimaryKeyRange retrieves multiple rows from CompositePrimaryKey whose
+// 'PKey1' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadCompositePrimaryKeyRange(ctx context.Context, db YORODB, start, end string, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeyRange", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// ReadCompositePrimaryKeyByPKey1Range retrieves multiple rows from CompositePrimaryKey whose
+// 'PKey2' is between start and end as a slice.
+// The rows are limited to the ones whose primary key starts with the given
+// key columns.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadCompositePrimaryKeyByPKey1Range(ctx context.Context, db YORODB, pKey1 string, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{pKey1, start},
+		End:   spanner.Key{pKey1, end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "", keys, CompositePrimaryKeyColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeyByPKey1Range", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the CompositePrimaryKey, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (cpk *CompositePrimaryKey) primaryKey() spanner.Key {
+	return spanner.Key{cpk.PKey1, cpk.PKey2}
+}
+
+// Delete deletes the CompositePrimaryKey from the database.
+func (cpk *CompositePrimaryKey) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("CompositePrimaryKeys", cpk.primaryKey())
+}
+
+// CompositePrimaryKeysDelete returns Mutations to delete the rows from
+// 'CompositePrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func CompositePrimaryKeysDelete(ctx context.Context, rows []*CompositePrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// CompositePrimaryKeyDescriptor describes 'CompositePrimaryKeys' for YORepository.
+var CompositePrimaryKeyDescriptor = &YODescriptor[CompositePrimaryKey, CompositePrimaryKeyPrimaryKey]{
+	Table:       "CompositePrimaryKeys",
+	Columns:     CompositePrimaryKeyColumns(),
+	PrimaryKeys: CompositePrimaryKeyPrimaryKeys(),
+	Key: func(row *CompositePrimaryKey) CompositePrimaryKeyPrimaryKey {
+		return CompositePrimaryKeyPrimaryKey{
+			PKey1: row.PKey1,
+			PKey2: row.PKey2,
+		}
+	},
+	SpannerKey: CompositePrimaryKeyPrimaryKey.ToSpannerKey,
+	Scan:       ScanCompositePrimaryKey,
+	Find:       FindCompositePrimaryKeyByKey,
+	Read:       ReadCompositePrimaryKey,
+	Insert: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *CompositePrimaryKey) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewCompositePrimaryKeyRepository returns a YORepository of 'CompositePrimaryKeys' by client.
+func NewCompositePrimaryKeyRepository(client *spanner.Client) *YORepository[CompositePrimaryKey, CompositePrimaryKeyPrimaryKey] {
+	return NewYORepository(client, CompositePrimaryKeyDescriptor)
+}
+
+// FereignItem represents a row from 'FereignItems'.
+type FereignItem struct {
+	ID       int64 `spanner:"ID" json:"ID"`             // ID
+	ItemID   int64 `spanner:"ItemID" json:"ItemID"`     // ItemID
+	Category int64 `spanner:"Category" json:"Category"` // Category
+}
+
+// FereignItemTableName is the name of 'FereignItems', which is qualified by the
+// schema if the table is in a named schema.
+const FereignItemTableName = "FereignItems"
+
+// ErrFereignItemNotFound is the error matched by errors.Is when the row is not
+// found in 'FereignItems'.
+var ErrFereignItemNotFound = errors.New("yo: FereignItems not found")
+
+func FereignItemPrimaryKeys() []string {
+	return []string{
+		"ID",
+	}
+}
+
+func FereignItemColumns() []string {
+	return []string{
+		"ID",
+		"ItemID",
+		"Category",
+	}
+}
+
+// FereignItemColumn is a column of 'FereignItems'.
+type FereignItemColumn string
+
+// Columns of 'FereignItems'.
+const (
+	FereignItemColumnID       FereignItemColumn = "ID"
+	FereignItemColumnItemID   FereignItemColumn = "ItemID"
+	FereignItemColumnCategory FereignItemColumn = "Category"
+)
+
+func FereignItemWritableColumns() []string {
+	return []string{
+		"ID",
+		"ItemID",
+		"Category",
+	}
+}
+
+func (fi *FereignItem) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "ID":
+			ret = append(ret, &fi.ID)
+		case "ItemID":
+			ret = append(ret, &fi.ItemID)
+		case "Category":
+			ret = append(ret, &fi.Category)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (fi *FereignItem) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "ID":
+			ret = append(ret, fi.ID)
+		case "ItemID":
+			ret = append(ret, fi.ItemID)
+		case "Category":
+			ret = append(ret, fi.Category)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newFereignItem_Decoder returns a decoder which reads a row from *spanner.Row
+// into FereignItem. The decoder is not goroutine-safe. Don't use it concurrently.
+func newFereignItem_Decoder(cols []string) func(*spanner.Row) (*FereignItem, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*FereignItem, error) {
+		var fi FereignItem
+		ptrs, err := fi.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &fi, nil
+	}
+}
+
+// ScanFereignItem decodes row into FereignItem. The row may have any subset of
+// the columns of 'FereignItems', such as the result of a query or a read.
+func ScanFereignItem(row *spanner.Row) (*FereignItem, error) {
+	return newFereignItem_Decoder(row.ColumnNames())(row)
+}
+
+// ScanFereignItems decodes all the rows of iter into a slice of FereignItem.
+// iter is stopped when it returns.
+func ScanFereignItems(iter *spanner.RowIterator) ([]*FereignItem, error) {
+	var res []*FereignItem
+	var decoder func(*spanner.Row) (*FereignItem, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newFereignItem_Decoder(row.ColumnNames())
+		}
+
+		fi, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, fi)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FereignItemIterator iterates over the rows of a partition of 'FereignItems'
+// decoded into FereignItem.
+type FereignItemIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*FereignItem, error)
+}
+
+// ExecuteFereignItemPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllFereignItems or FereignItemQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteFereignItemPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *FereignItemIterator {
+	return &FereignItemIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *FereignItemIterator) Next() (*FereignItem, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("FereignItemIterator.Next", "FereignItems", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newFereignItem_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FereignItemIterator.Next", "FereignItems", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *FereignItemIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldFereignItemRows decodes the rows of rows into FereignItem and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldFereignItemRows(rows *spanner.RowIterator, method string, yield func(*FereignItem, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*FereignItem, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "FereignItems", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newFereignItem_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "FereignItems", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountFereignItems returns the number of the rows of 'FereignItems'.
+func CountFereignItems(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM FereignItems")
+
+	defer yoLogQuery(ctx, "CountFereignItems", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFereignItems", "FereignItems", err)
+	}
+
+	return n, nil
+}
+
+// AllFereignItemsSeq returns an iterator of all the rows of 'FereignItems',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*FereignItem, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllFereignItemsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FereignItem, error) bool) {
+	return func(yield func(*FereignItem, error) bool) {
+		rows := yoRead(ctx, db, "FereignItems", "", spanner.AllKeys(), FereignItemColumns(), opts)
+		yieldFereignItemRows(rows, "AllFereignItemsSeq", yield)
+	}
+}
+
+// PartitionReadAllFereignItems partitions the read of all the rows of
+// 'FereignItems' in btx, so that the partitions are read in parallel by
+// ExecuteFereignItemPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllFereignItems(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "FereignItems", spanner.AllKeys(), FereignItemColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllFereignItems", "FereignItems", err)
+	}
+
+	return ps, nil
+}
+
+// FereignItemQuery is a query builder for 'FereignItems'. The conditions are
+// given by the predicates of FereignItemWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of FereignItemColumn.
+type FereignItemQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewFereignItemQuery returns a FereignItemQuery filtered by preds.
+func NewFereignItemQuery(preds ...YOPredicate) *FereignItemQuery {
+	return &FereignItemQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *FereignItemQuery) Where(preds ...YOPredicate) *FereignItemQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *FereignItemQuery) OrderBy(col FereignItemColumn) *FereignItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *FereignItemQuery) OrderByDesc(col FereignItemColumn) *FereignItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *FereignItemQuery) Limit(n int) *FereignItemQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *FereignItemQuery) Statement() spanner.Statement {
+	return yoStatement("ID, ItemID, Category", "FereignItems", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *FereignItemQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "FereignItemQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FereignItem{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FereignItemQuery.Query", "FereignItems", err)
+		}
+
+		v, err := ScanFereignItem(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FereignItemQuery.Query", "FereignItems", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *FereignItemQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "FereignItems", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "FereignItemQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("FereignItemQuery.Count", "FereignItems", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*FereignItem, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *FereignItemQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FereignItem, error) bool) {
+	return func(yield func(*FereignItem, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "FereignItemQuery.Seq", stmt)()
+		yieldFereignItemRows(yoQuery(ctx, db, stmt, opts), "FereignItemQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteFereignItemPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *FereignItemQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "FereignItemQuery.PartitionQuery", "FereignItems", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "FereignItemQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("FereignItemQuery.PartitionQuery", "FereignItems", err)
+	}
+
+	return ps, nil
+}
+
+// FereignItemWhere has the typed predicate constructors of the columns of
+// 'FereignItems'.
+var FereignItemWhere = struct {
+	ID       FereignItem_IDColumn
+	ItemID   FereignItem_ItemIDColumn
+	Category FereignItem_CategoryColumn
+}{}
+
+// FereignItem_IDColumn has the predicate constructors of 'ID'.
+type FereignItem_IDColumn struct{}
+
+// Eq returns a predicate that 'ID' is equal to v.
+func (FereignItem_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ID' is not equal to v.
+func (FereignItem_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ID' is less than v.
+func (FereignItem_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ID' is less than or equal to v.
+func (FereignItem_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ID' is greater than v.
+func (FereignItem_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ID' is greater than or equal to v.
+func (FereignItem_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ID' is equal to any of vs.
+func (FereignItem_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "IN", value: vs}
+}
+
+// FereignItem_ItemIDColumn has the predicate constructors of 'ItemID'.
+type FereignItem_ItemIDColumn struct{}
+
+// Eq returns a predicate that 'ItemID' is equal to v.
+func (FereignItem_ItemIDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ItemID' is not equal to v.
+func (FereignItem_ItemIDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ItemID' is less than v.
+func (FereignItem_ItemIDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ItemID' is less than or equal to v.
+func (FereignItem_ItemIDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ItemID' is greater than v.
+func (FereignItem_ItemIDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ItemID' is greater than or equal to v.
+func (FereignItem_ItemIDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ItemID' is equal to any of vs.
+func (FereignItem_ItemIDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ItemID", op: "IN", value: vs}
+}
+
+// FereignItem_CategoryColumn has the predicate constructors of 'Category'.
+type FereignItem_CategoryColumn struct{}
+
+// Eq returns a predicate that 'Category' is equal to v.
+func (FereignItem_CategoryColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Category' is not equal to v.
+func (FereignItem_CategoryColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Category' is less than v.
+func (FereignItem_CategoryColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Category' is less than or equal to v.
+func (FereignItem_CategoryColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Category' is greater than v.
+func (FereignItem_CategoryColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Category' is greater than or equal to v.
+func (FereignItem_CategoryColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Category", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Category' is equal to any of vs.
+func (FereignItem_CategoryColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Category", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (fi *FereignItem) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := fi.columnsToValues(FereignItemWritableColumns())
+	return spanner.Insert("FereignItems", FereignItemWritableColumns(), values)
+}
+
+// InsertAllFereignItems inserts rows into 'FereignItems' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllFereignItems(ctx context.Context, client *spanner.Client, rows []*FereignItem) (int, error) {
+	ms := FereignItemsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(FereignItemWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllFereignItems", "FereignItems", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// FereignItemsInsert returns Mutations to insert the rows into
+// 'FereignItems', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllFereignItems, the Mutations are
+// not split into batches.
+func FereignItemsInsert(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (fi *FereignItem) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := fi.columnsToValues(FereignItemWritableColumns())
+	return spanner.Update("FereignItems", FereignItemWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (fi *FereignItem) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := fi.columnsToValues(FereignItemWritableColumns())
+	return spanner.InsertOrUpdate("FereignItems", FereignItemWritableColumns(), values)
+}
+
+// FereignItemsUpdate returns Mutations to update the rows in
+// 'FereignItems', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FereignItemsUpdate(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// FereignItemsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'FereignItems', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func FereignItemsInsertOrUpdate(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the FereignItem with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (fi *FereignItem) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{fi.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteFereignItems inserts or updates rows in 'FereignItems' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteFereignItems(ctx context.Context, client *spanner.Client, rows []*FereignItem) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteFereignItems", "FereignItems", groups)
+}
+
+// InsertOrUpdateFereignItemAtLeastOnce inserts or updates row in 'FereignItems' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateFereignItemAtLeastOnce(ctx context.Context, client *spanner.Client, row *FereignItem) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateFereignItemAtLeastOnce", "FereignItems", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (fi *FereignItem) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, FereignItemPrimaryKeys()...)
+
+	values, err := fi.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "FereignItem.UpdateColumns", "FereignItems", err)
+	}
+
+	return spanner.Update("FereignItems", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'FereignItems' can be specified.
+func (fi *FereignItem) MutationForColumns(ctx context.Context, cols ...FereignItemColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return fi.UpdateColumns(ctx, names...)
+}
+
+// FereignItemTracker tracks the changes of a FereignItem, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type FereignItemTracker struct {
+	row  *FereignItem
+	orig FereignItem
+}
+
+// TrackFereignItem returns a FereignItemTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackFereignItem(row *FereignItem) *FereignItemTracker {
+	tr := &FereignItemTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked FereignItem.
+func (tr *FereignItemTracker) Row() *FereignItem {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *FereignItemTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.ItemID = yoClone(tr.row.ItemID)
+	tr.orig.Category = yoClone(tr.row.Category)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'FereignItems'. The primary key columns and the
+// generated columns are never included.
+func (tr *FereignItemTracker) Changed() []FereignItemColumn {
+	var cols []FereignItemColumn
+	if !reflect.DeepEqual(tr.orig.ItemID, tr.row.ItemID) {
+		cols = append(cols, FereignItemColumnItemID)
+	}
+	if !reflect.DeepEqual(tr.orig.Category, tr.row.Category) {
+		cols = append(cols, FereignItemColumnCategory)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *FereignItemTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindFereignItem gets a FereignItem by primary key
+func FindFereignItem(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*FereignItem, error) {
+	key := spanner.Key{id}
+	row, err := yoReadRow(ctx, db, "FereignItems", key, FereignItemColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFereignItem", "FereignItems", key, ErrFereignItemNotFound, nil)
+		}
+		return nil, newError("FindFereignItem", "FereignItems", err)
+	}
+
+	fi, err := ScanFereignItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFereignItem", "FereignItems", err)
+	}
+
+	return fi, nil
+}
+
+// FereignItemExists reports whether the row of the primary key exists in
+// 'FereignItems', which reads only the primary key columns.
+func FereignItemExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "FereignItems", key, FereignItemPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("FereignItemExists", "FereignItems", err)
+	}
+
+	return true, nil
+}
+
+// ReadFereignItem retrieves multiples rows from FereignItem by KeySet as a slice.
+func ReadFereignItem(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	var res []*FereignItem
+	rows := yoRead(ctx, db, "FereignItems", "", keys, FereignItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		fi, err := ScanFereignItem(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, fi)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFereignItem", "FereignItems", err)
+	}
+
+	return res, nil
+}
+
+// FereignItemPrimaryKey is the primary key of 'FereignItems'.
+type FereignItemPrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadFereignItem.
+func (k FereignItemPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseFereignItemPrimaryKey parses key of the columns of the primary key of
+// 'FereignItems' in order, such as the key of a mutation.
+func ParseFereignItemPrimaryKey(key spanner.Key) (FereignItemPrimaryKey, error) {
+	var k FereignItemPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'FereignItems' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'ID' of the key of 'FereignItems' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of fi.
+func (fi *FereignItem) PrimaryKey() FereignItemPrimaryKey {
+	return FereignItemPrimaryKey{
+		ID: fi.ID,
+	}
+}
+
+// FindFereignItemByKey gets a FereignItem by the primary key of key.
+func FindFereignItemByKey(ctx context.Context, db YORODB, key FereignItemPrimaryKey, opts ...*spanner.ReadOptions) (*FereignItem, error) {
+	row, err := yoReadRow(ctx, db, "FereignItems", key.ToSpannerKey(), FereignItemColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFereignItemByKey", "FereignItems", key.ToSpannerKey(), ErrFereignItemNotFound, nil)
+		}
+		return nil, newError("FindFereignItemByKey", "FereignItems", err)
+	}
+
+	fi, err := ScanFereignItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFereignItemByKey", "FereignItems", err)
+	}
+
+	return fi, nil
+}
+
+// DeleteFereignItemByKey returns a Mutation to delete the row of key from
+// 'FereignItems'.
+func DeleteFereignItemByKey(ctx context.Context, key FereignItemPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("FereignItems", key.ToSpannerKey())
+}
+
+// FindFereignItemsByKeys retrieves the rows of keys from 'FereignItems' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindFereignItemsByKeys(ctx context.Context, db YORODB, keys []FereignItemPrimaryKey, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*FereignItem, len(keys))
+	rows := yoRead(ctx, db, "FereignItems", "", spanner.KeySets(keySets...), FereignItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		fi, err := ScanFereignItem(row)
+		if err != nil {
+			return err
+		}
+		byKey[fi.primaryKey().String()] = fi
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFereignItemsByKeys", "FereignItems", err)
+	}
+
+	res := make([]*FereignItem, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if fi, ok := byKey[s]; ok {
+			res = append(res, fi)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListFereignItemsPage retrieves a page of at most pageSize rows from 'FereignItems'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListFereignItemsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FereignItem, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFereignItemsPage", "FereignItems", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFereignItemsPage", "FereignItems", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT ID, ItemID, Category FROM FereignItems",
+		[]string{"ID"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFereignItemsPage", stmt)()
+	res, err := ScanFereignItems(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFereignItemsPage", "FereignItems", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFereignItemsPage", "FereignItems", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadFereignItemRange retrieves multiple rows from FereignItem whose
+// 'ID' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadFereignItemRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	var res []*FereignItem
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "FereignItems", "", keys, FereignItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		fi, err := ScanFereignItem(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, fi)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFereignItemRange", "FereignItems", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the FereignItem, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (fi *FereignItem) primaryKey() spanner.Key {
+	return spanner.Key{fi.ID}
+}
+
+// Delete deletes the FereignItem from the database.
+func (fi *FereignItem) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("FereignItems", fi.primaryKey())
+}
+
+// FereignItemsDelete returns Mutations to delete the rows from
+// 'FereignItems', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FereignItemsDelete(ctx context.Context, rows []*FereignItem) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// FetchItem retrieves the row of 'Items' referenced by the
+// FereignItem as a Item.
+//
+// If no row is present, including when the referencing columns are NULL, then
+// an error is returned where errors.Is(err, ErrNotFound) is true.
+//
+// Generated from foreign key 'FK_ItemID_ForeignItems'.
+func (fi *FereignItem) FetchItem(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (*Item, error) {
+	const sqlstr = "SELECT " +
+		"ID, Price " +
+		"FROM Items " +
+		"WHERE ID = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fi.ItemID
+
+	// run query
+	defer yoLogQuery(ctx, "FetchItem", stmt, fi.ItemID)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("FetchItem", "Items", spanner.Key{fi.ItemID}, ErrItemNotFound, nil)
+		}
+		return nil, newError("FetchItem", "Items", err)
+	}
+
+	res, err := ScanItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FetchItem", "Items", err)
+	}
+
+	return res, nil
+}
+
+// ListFereignItems retrieves the rows of 'FereignItems' referencing the
+// Item as a slice.
+//
+// Generated from foreign key 'FK_ItemID_ForeignItems'.
+func (i *Item) ListFereignItems(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*FereignItem, error) {
+	const sqlstr = "SELECT " +
+		"ID, ItemID, Category " +
+		"FROM FereignItems " +
+		"WHERE ItemID = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = i.ID
+
+	// run query
+	defer yoLogQuery(ctx, "ListFereignItems", stmt, i.ID)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FereignItem{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("ListFereignItems", "FereignItems", err)
+		}
+
+		fi, err := ScanFereignItem(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "ListFereignItems", "FereignItems", err)
+		}
+
+		res = append(res, fi)
+	}
+
+	return res, nil
+}
+
+// FereignItemDescriptor describes 'FereignItems' for YORepository.
+var FereignItemDescriptor = &YODescriptor[FereignItem, FereignItemPrimaryKey]{
+	Table:       "FereignItems",
+	Columns:     FereignItemColumns(),
+	PrimaryKeys: FereignItemPrimaryKeys(),
+	Key: func(row *FereignItem) FereignItemPrimaryKey {
+		return FereignItemPrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: FereignItemPrimaryKey.ToSpannerKey,
+	Scan:       ScanFereignItem,
+	Find:       FindFereignItemByKey,
+	Read:       ReadFereignItem,
+	Insert: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *FereignItem) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewFereignItemRepository returns a YORepository of 'FereignItems' by client.
+func NewFereignItemRepository(client *spanner.Client) *YORepository[FereignItem, FereignItemPrimaryKey] {
+	return NewYORepository(client, FereignItemDescriptor)
+}
+
+// FullType represents a row from 'FullTypes'.
+type FullType struct {
+	PKey                 string                `spanner:"PKey" json:"PKey"`                                 // PKey
+	FTString             string                `spanner:"FTString" json:"FTString"`                         // FTString
+	FTStringNull         spanner.NullString    `spanner:"FTStringNull" json:"FTStringNull"`                 // FTStringNull
+	FTBool               bool                  `spanner:"FTBool" json:"FTBool"`                             // FTBool
+	FTBoolNull           spanner.NullBool      `spanner:"FTBoolNull" json:"FTBoolNull"`                     // FTBoolNull
+	FTBytes              []byte                `spanner:"FTBytes" json:"FTBytes"`                           // FTBytes
+	FTBytesNull          []byte                `spanner:"FTBytesNull" json:"FTBytesNull"`                   // FTBytesNull
+	FTTimestamp          time.Time             `spanner:"FTTimestamp" json:"FTTimestamp"`                   // FTTimestamp
+	FTTimestampNull      spanner.NullTime      `spanner:"FTTimestampNull" json:"FTTimestampNull"`           // FTTimestampNull
+	FTInt                int64                 `spanner:"FTInt" json:"FTInt"`                               // FTInt
+	FTIntNull            spanner.NullInt64     `spanner:"FTIntNull" json:"FTIntNull"`                       // FTIntNull
+	FTFloat              float64               `spanner:"FTFloat" json:"FTFloat"`                           // FTFloat
+	FTFloatNull          spanner.NullFloat64   `spanner:"FTFloatNull" json:"FTFloatNull"`                   // FTFloatNull
+	FTDate               civil.Date            `spanner:"FTDate" json:"FTDate"`                             // FTDate
+	FTDateNull           spanner.NullDate      `spanner:"FTDateNull" json:"FTDateNull"`                     // FTDateNull
+	FTJSON               spanner.NullJSON      `spanner:"FTJson" json:"FTJson"`                             // FTJson
+	FTJSONNull           spanner.NullJSON      `spanner:"FTJsonNull" json:"FTJsonNull"`                     // FTJsonNull
+	FTArrayStringNull    []spanner.NullString  `spanner:"FTArrayStringNull" json:"FTArrayStringNull"`       // FTArrayStringNull
+	FTArrayString        []spanner.NullString  `spanner:"FTArrayString" json:"FTArrayString"`               // FTArrayString
+	FTArrayBoolNull      []spanner.NullBool    `spanner:"FTArrayBoolNull" json:"FTArrayBoolNull"`           // FTArrayBoolNull
+	FTArrayBool          []spanner.NullBool    `spanner:"FTArrayBool" json:"FTArrayBool"`                   // FTArrayBool
+	FTArrayBytesNull     [][]byte              `spanner:"FTArrayBytesNull" json:"FTArrayBytesNull"`         // FTArrayBytesNull
+	FTArrayBytes         [][]byte              `spanner:"FTArrayBytes" json:"FTArrayBytes"`                 // FTArrayBytes
+	FTArrayTimestampNull []spanner.NullTime    `spanner:"FTArrayTimestampNull" json:"FTArrayTimestampNull"` // FTArrayTimestampNull
+	FTArrayTimestamp     []spanner.NullTime    `spanner:"FTArrayTimestamp" json:"FTArrayTimestamp"`         // FTArrayTimestamp
+	FTArrayIntNull       []spanner.NullInt64   `spanner:"FTArrayIntNull" json:"FTArrayIntNull"`             // FTArrayIntNull
+	FTArrayInt           []spanner.NullInt64   `spanner:"FTArrayInt" json:"FTArrayInt"`                     // FTArrayInt
+	FTArrayFloatNull     []spanner.NullFloat64 `spanner:"FTArrayFloatNull" json:"FTArrayFloatNull"`         // FTArrayFloatNull
+	FTArrayFloat         []spanner.NullFloat64 `spanner:"FTArrayFloat" json:"FTArrayFloat"`                 // FTArrayFloat
+	FTArrayDateNull      []spanner.NullDate    `spanner:"FTArrayDateNull" json:"FTArrayDateNull"`           // FTArrayDateNull
+	FTArrayDate          []spanner.NullDate    `spanner:"FTArrayDate" json:"FTArrayDate"`                   // FTArrayDate
+	FTArrayJSONNull      []spanner.NullJSON    `spanner:"FTArrayJsonNull" json:"FTArrayJsonNull"`           // FTArrayJsonNull
+	FTArrayJSON          []spanner.NullJSON    `spanner:"FTArrayJson" json:"FTArrayJson"`                   // FTArrayJson
+}
+
+// FullTypeTableName is the name of 'FullTypes', which is qualified by the
+// schema if the table is in a named schema.
+const FullTypeTableName = "FullTypes"
+
+// ErrFullTypeNotFound is the error matched by errors.Is when the row is not
+// found in 'FullTypes'.
+var ErrFullTypeNotFound = errors.New("yo: FullTypes not found")
+
+func FullTypePrimaryKeys() []string {
+	return []string{
+		"PKey",
+	}
+}
+
+func FullTypeColumns() []string {
+	return []string{
+		"PKey",
+		"FTString",
+		"FTStringNull",
+		"FTBool",
+		"FTBoolNull",
+		"FTBytes",
+		"FTBytesNull",
+		"FTTimestamp",
+		"FTTimestampNull",
+		"FTInt",
+		"FTIntNull",
+		"FTFloat",
+		"FTFloatNull",
+		"FTDate",
+		"FTDateNull",
+		"FTJson",
+		"FTJsonNull",
+		"FTArrayStringNull",
+		"FTArrayString",
+		"FTArrayBoolNull",
+		"FTArrayBool",
+		"FTArrayBytesNull",
+		"FTArrayBytes",
+		"FTArrayTimestampNull",
+		"FTArrayTimestamp",
+		"FTArrayIntNull",
+		"FTArrayInt",
+		"FTArrayFloatNull",
+		"FTArrayFloat",
+		"FTArrayDateNull",
+		"FTArrayDate",
+		"FTArrayJsonNull",
+		"FTArrayJson",
+	}
+}
+
+// FullTypeColumn is a column of 'FullTypes'.
+type FullTypeColumn string
+
+// Columns of 'FullTypes'.
+const (
+	FullTypeColumnPKey                 FullTypeColumn = "PKey"
+	FullTypeColumnFTString             FullTypeColumn = "FTString"
+	FullTypeColumnFTStringNull         FullTypeColumn = "FTStringNull"
+	FullTypeColumnFTBool               FullTypeColumn = "FTBool"
+	FullTypeColumnFTBoolNull           FullTypeColumn = "FTBoolNull"
+	FullTypeColumnFTBytes              FullTypeColumn = "FTBytes"
+	FullTypeColumnFTBytesNull          FullTypeColumn = "FTBytesNull"
+	FullTypeColumnFTTimestamp          FullTypeColumn = "FTTimestamp"
+	FullTypeColumnFTTimestampNull      FullTypeColumn = "FTTimestampNull"
+	FullTypeColumnFTInt                FullTypeColumn = "FTInt"
+	FullTypeColumnFTIntNull            FullTypeColumn = "FTIntNull"
+	FullTypeColumnFTFloat              FullTypeColumn = "FTFloat"
+	FullTypeColumnFTFloatNull          FullTypeColumn = "FTFloatNull"
+	FullTypeColumnFTDate               FullTypeColumn = "FTDate"
+	FullTypeColumnFTDateNull           FullTypeColumn = "FTDateNull"
+	FullTypeColumnFTJSON               FullTypeColumn = "FTJson"
+	FullTypeColumnFTJSONNull           FullTypeColumn = "FTJsonNull"
+	FullTypeColumnFTArrayStringNull    FullTypeColumn = "FTArrayStringNull"
+	FullTypeColumnFTArrayString        FullTypeColumn = "FTArrayString"
+	FullTypeColumnFTArrayBoolNull      FullTypeColumn = "FTArrayBoolNull"
+	FullTypeColumnFTArrayBool          FullTypeColumn = "FTArrayBool"
+	FullTypeColumnFTArrayBytesNull     FullTypeColumn = "FTArrayBytesNull"
+	FullTypeColumnFTArrayBytes         FullTypeColumn = "FTArrayBytes"
+	FullTypeColumnFTArrayTimestampNull FullTypeColumn = "FTArrayTimestampNull"
+	FullTypeColumnFTArrayTimestamp     FullTypeColumn = "FTArrayTimestamp"
+	FullTypeColumnFTArrayIntNull       FullTypeColumn = "FTArrayIntNull"
+	FullTypeColumnFTArrayInt           FullTypeColumn = "FTArrayInt"
+	FullTypeColumnFTArrayFloatNull     FullTypeColumn = "FTArrayFloatNull"
+	FullTypeColumnFTArrayFloat         FullTypeColumn = "FTArrayFloat"
+	FullTypeColumnFTArrayDateNull      FullTypeColumn = "FTArrayDateNull"
+	FullTypeColumnFTArrayDate          FullTypeColumn = "FTArrayDate"
+	FullTypeColumnFTArrayJSONNull      FullTypeColumn = "FTArrayJsonNull"
+	FullTypeColumnFTArrayJSON          FullTypeColumn = "FTArrayJson"
+)
+
+func FullTypeWritableColumns() []string {
+	return []string{
+		"PKey",
+		"FTString",
+		"FTStringNull",
+		"FTBool",
+		"FTBoolNull",
+		"FTBytes",
+		"FTBytesNull",
+		"FTTimestamp",
+		"FTTimestampNull",
+		"FTInt",
+		"FTIntNull",
+		"FTFloat",
+		"FTFloatNull",
+		"FTDate",
+		"FTDateNull",
+		"FTJson",
+		"FTJsonNull",
+		"FTArrayStringNull",
+		"FTArrayString",
+		"FTArrayBoolNull",
+		"FTArrayBool",
+		"FTArrayBytesNull",
+		"FTArrayBytes",
+		"FTArrayTimestampNull",
+		"FTArrayTimestamp",
+		"FTArrayIntNull",
+		"FTArrayInt",
+		"FTArrayFloatNull",
+		"FTArrayFloat",
+		"FTArrayDateNull",
+		"FTArrayDate",
+		"FTArrayJsonNull",
+		"FTArrayJson",
+	}
+}
+
+func (ft *FullType) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "PKey":
+			ret = append(ret, &ft.PKey)
+		case "FTString":
+			ret = append(ret, &ft.FTString)
+		case "FTStringNull":
+			ret = append(ret, &ft.FTStringNull)
+		case "FTBool":
+			ret = append(ret, &ft.FTBool)
+		case "FTBoolNull":
+			ret = append(ret, &ft.FTBoolNull)
+		case "FTBytes":
+			ret = append(ret, &ft.FTBytes)
+		case "FTBytesNull":
+			ret = append(ret, &ft.FTBytesNull)
+		case "FTTimestamp":
+			ret = append(ret, &ft.FTTimestamp)
+		case "FTTimestampNull":
+			ret = append(ret, &ft.FTTimestampNull)
+		case "FTInt":
+			ret = append(ret, &ft.FTInt)
+		case "FTIntNull":
+			ret = append(ret, &ft.FTIntNull)
+		case "FTFloat":
+			ret = append(ret, &ft.FTFloat)
+		case "FTFloatNull":
+			ret = append(ret, &ft.FTFloatNull)
+		case "FTDate":
+			ret = append(ret, &ft.FTDate)
+		case "FTDateNull":
+			ret = append(ret, &ft.FTDateNull)
+		case "FTJson":
+			ret = append(ret, &ft.FTJSON)
+		case "FTJsonNull":
+			ret = append(ret, &ft.FTJSONNull)
+		case "FTArrayStringNull":
+			ret = append(ret, &ft.FTArrayStringNull)
+		case "FTArrayString":
+			ret = append(ret, &ft.FTArrayString)
+		case "FTArrayBoolNull":
+			ret = append(ret, &ft.FTArrayBoolNull)
+		case "FTArrayBool":
+			ret = append(ret, &ft.FTArrayBool)
+		case "FTArrayBytesNull":
+			ret = append(ret, &ft.FTArrayBytesNull)
+		case "FTArrayBytes":
+			ret = append(ret, &ft.FTArrayBytes)
+		case "FTArrayTimestampNull":
+			ret = append(ret, &ft.FTArrayTimestampNull)
+		case "FTArrayTimestamp":
+			ret = append(ret, &ft.FTArrayTimestamp)
+		case "FTArrayIntNull":
+			ret = append(ret, &ft.FTArrayIntNull)
+		case "FTArrayInt":
+			ret = append(ret, &ft.FTArrayInt)
+		case "FTArrayFloatNull":
+			ret = append(ret, &ft.FTArrayFloatNull)
+		case "FTArrayFloat":
+			ret = append(ret, &ft.FTArrayFloat)
+		case "FTArrayDateNull":
+			ret = append(ret, &ft.FTArrayDateNull)
+		case "FTArrayDate":
+			ret = append(ret, &ft.FTArrayDate)
+		case "FTArrayJsonNull":
+			ret = append(ret, &ft.FTArrayJSONNull)
+		case "FTArrayJson":
+			ret = append(ret, &ft.FTArrayJSON)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (ft *FullType) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "PKey":
+			ret = append(ret, ft.PKey)
+		case "FTString":
+			ret = append(ret, ft.FTString)
+		case "FTStringNull":
+			ret = append(ret, ft.FTStringNull)
+		case "FTBool":
+			ret = append(ret, ft.FTBool)
+		case "FTBoolNull":
+			ret = append(ret, ft.FTBoolNull)
+		case "FTBytes":
+			ret = append(ret, ft.FTBytes)
+		case "FTBytesNull":
+			ret = append(ret, ft.FTBytesNull)
+		case "FTTimestamp":
+			ret = append(ret, ft.FTTimestamp)
+		case "FTTimestampNull":
+			ret = append(ret, ft.FTTimestampNull)
+		case "FTInt":
+			ret = append(ret, ft.FTInt)
+		case "FTIntNull":
+			ret = append(ret, ft.FTIntNull)
+		case "FTFloat":
+			ret = append(ret, ft.FTFloat)
+		case "FTFloatNull":
+			ret = append(ret, ft.FTFloatNull)
+		case "FTDate":
+			ret = append(ret, ft.FTDate)
+		case "FTDateNull":
+			ret = append(ret, ft.FTDateNull)
+		case "FTJson":
+			ret = append(ret, ft.FTJSON)
+		case "FTJsonNull":
+			ret = append(ret, ft.FTJSONNull)
+		case "FTArrayStringNull":
+			ret = append(ret, ft.FTArrayStringNull)
+		case "FTArrayString":
+			ret = append(ret, ft.FTArrayString)
+		case "FTArrayBoolNull":
+			ret = append(ret, ft.FTArrayBoolNull)
+		case "FTArrayBool":
+			ret = append(ret, ft.FTArrayBool)
+		case "FTArrayBytesNull":
+			ret = append(ret, ft.FTArrayBytesNull)
+		case "FTArrayBytes":
+			ret = append(ret, ft.FTArrayBytes)
+		case "FTArrayTimestampNull":
+			ret = append(ret, ft.FTArrayTimestampNull)
+		case "FTArrayTimestamp":
+			ret = append(ret, ft.FTArrayTimestamp)
+		case "FTArrayIntNull":
+			ret = append(ret, ft.FTArrayIntNull)
+		case "FTArrayInt":
+			ret = append(ret, ft.FTArrayInt)
+		case "FTArrayFloatNull":
+			ret = append(ret, ft.FTArrayFloatNull)
+		case "FTArrayFloat":
+			ret = append(ret, ft.FTArrayFloat)
+		case "FTArrayDateNull":
+			ret = append(ret, ft.FTArrayDateNull)
+		case "FTArrayDate":
+			ret = append(ret, ft.FTArrayDate)
+		case "FTArrayJsonNull":
+			ret = append(ret, ft.FTArrayJSONNull)
+		case "FTArrayJson":
+			ret = append(ret, ft.FTArrayJSON)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newFullType_Decoder returns a decoder which reads a row from *spanner.Row
+// into FullType. The decoder is not goroutine-safe. Don't use it concurrently.
+func newFullType_Decoder(cols []string) func(*spanner.Row) (*FullType, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*FullType, error) {
+		var ft FullType
+		ptrs, err := ft.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &ft, nil
+	}
+}
+
+// ScanFullType decodes row into FullType. The row may have any subset of
+// the columns of 'FullTypes', such as the result of a query or a read.
+func ScanFullType(row *spanner.Row) (*FullType, error) {
+	return newFullType_Decoder(row.ColumnNames())(row)
+}
+
+// ScanFullTypes decodes all the rows of iter into a slice of FullType.
+// iter is stopped when it returns.
+func ScanFullTypes(iter *spanner.RowIterator) ([]*FullType, error) {
+	var res []*FullType
+	var decoder func(*spanner.Row) (*FullType, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newFullType_Decoder(row.ColumnNames())
+		}
+
+		ft, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FullTypeIterator iterates over the rows of a partition of 'FullTypes'
+// decoded into FullType.
+type FullTypeIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*FullType, error)
+}
+
+// ExecuteFullTypePartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllFullTypes or FullTypeQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteFullTypePartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *FullTypeIterator {
+	return &FullTypeIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *FullTypeIterator) Next() (*FullType, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("FullTypeIterator.Next", "FullTypes", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newFullType_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FullTypeIterator.Next", "FullTypes", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *FullTypeIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldFullTypeRows decodes the rows of rows into FullType and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldFullTypeRows(rows *spanner.RowIterator, method string, yield func(*FullType, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*FullType, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "FullTypes", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newFullType_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "FullTypes", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountFullTypes returns the number of the rows of 'FullTypes'.
+func CountFullTypes(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM FullTypes")
+
+	defer yoLogQuery(ctx, "CountFullTypes", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypes", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// AllFullTypesSeq returns an iterator of all the rows of 'FullTypes',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*FullType, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllFullTypesSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FullType, error) bool) {
+	return func(yield func(*FullType, error) bool) {
+		rows := yoRead(ctx, db, "FullTypes", "", spanner.AllKeys(), FullTypeColumns(), opts)
+		yieldFullTypeRows(rows, "AllFullTypesSeq", yield)
+	}
+}
+
+// PartitionReadAllFullTypes partitions the read of all the rows of
+// 'FullTypes' in btx, so that the partitions are read in parallel by
+// ExecuteFullTypePartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllFullTypes(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "FullTypes", spanner.AllKeys(), FullTypeColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllFullTypes", "FullTypes", err)
+	}
+
+	return ps, nil
+}
+
+// FullTypeQuery is a query builder for 'FullTypes'. The conditions are
+// given by the predicates of FullTypeWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of FullTypeColumn.
+type FullTypeQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewFullTypeQuery returns a FullTypeQuery filtered by preds.
+func NewFullTypeQuery(preds ...YOPredicate) *FullTypeQuery {
+	return &FullTypeQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *FullTypeQuery) Where(preds ...YOPredicate) *FullTypeQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *FullTypeQuery) OrderBy(col FullTypeColumn) *FullTypeQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *FullTypeQuery) OrderByDesc(col FullTypeColumn) *FullTypeQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *FullTypeQuery) Limit(n int) *FullTypeQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *FullTypeQuery) Statement() spanner.Statement {
+	return yoStatement("PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson", "FullTypes", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *FullTypeQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "FullTypeQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FullType{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FullTypeQuery.Query", "FullTypes", err)
+		}
+
+		v, err := ScanFullType(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FullTypeQuery.Query", "FullTypes", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *FullTypeQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "FullTypes", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "FullTypeQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("FullTypeQuery.Count", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*FullType, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *FullTypeQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FullType, error) bool) {
+	return func(yield func(*FullType, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "FullTypeQuery.Seq", stmt)()
+		yieldFullTypeRows(yoQuery(ctx, db, stmt, opts), "FullTypeQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteFullTypePartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *FullTypeQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "FullTypeQuery.PartitionQuery", "FullTypes", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "FullTypeQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("FullTypeQuery.PartitionQuery", "FullTypes", err)
+	}
+
+	return ps, nil
+}
+
+// FullTypeWhere has the typed predicate constructors of the columns of
+// 'FullTypes'.
+var FullTypeWhere = struct {
+	PKey                 FullType_PKeyColumn
+	FTString             FullType_FTStringColumn
+	FTStringNull         FullType_FTStringNullColumn
+	FTBool               FullType_FTBoolColumn
+	FTBoolNull           FullType_FTBoolNullColumn
+	FTBytes              FullType_FTBytesColumn
+	FTBytesNull          FullType_FTBytesNullColumn
+	FTTimestamp          FullType_FTTimestampColumn
+	FTTimestampNull      FullType_FTTimestampNullColumn
+	FTInt                FullType_FTIntColumn
+	FTIntNull            FullType_FTIntNullColumn
+	FTFloat              FullType_FTFloatColumn
+	FTFloatNull          FullType_FTFloatNullColumn
+	FTDate               FullType_FTDateColumn
+	FTDateNull           FullType_FTDateNullColumn
+	FTJSON               FullType_FTJSONColumn
+	FTJSONNull           FullType_FTJSONNullColumn
+	FTArrayStringNull    FullType_FTArrayStringNullColumn
+	FTArrayString        FullType_FTArrayStringColumn
+	FTArrayBoolNull      FullType_FTArrayBoolNullColumn
+	FTArrayBool          FullType_FTArrayBoolColumn
+	FTArrayBytesNull     FullType_FTArrayBytesNullColumn
+	FTArrayBytes         FullType_FTArrayBytesColumn
+	FTArrayTimestampNull FullType_FTArrayTimestampNullColumn
+	FTArrayTimestamp     FullType_FTArrayTimestampColumn
+	FTArrayIntNull       FullType_FTArrayIntNullColumn
+	FTArrayInt           FullType_FTArrayIntColumn
+	FTArrayFloatNull     FullType_FTArrayFloatNullColumn
+	FTArrayFloat         FullType_FTArrayFloatColumn
+	FTArrayDateNull      FullType_FTArrayDateNullColumn
+	FTArrayDate          FullType_FTArrayDateColumn
+	FTArrayJSONNull      FullType_FTArrayJSONNullColumn
+	FTArrayJSON          FullType_FTArrayJSONColumn
+}{}
+
+// FullType_PKeyColumn has the predicate constructors of 'PKey'.
+type FullType_PKeyColumn struct{}
+
+// Eq returns a predicate that 'PKey' is equal to v.
+func (FullType_PKeyColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey' is not equal to v.
+func (FullType_PKeyColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey' is less than v.
+func (FullType_PKeyColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey' is less than or equal to v.
+func (FullType_PKeyColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey' is greater than v.
+func (FullType_PKeyColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey' is greater than or equal to v.
+func (FullType_PKeyColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey' is equal to any of vs.
+func (FullType_PKeyColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "IN", value: vs}
+}
+
+// FullType_FTStringColumn has the predicate constructors of 'FTString'.
+type FullType_FTStringColumn struct{}
+
+// Eq returns a predicate that 'FTString' is equal to v.
+func (FullType_FTStringColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTString' is not equal to v.
+func (FullType_FTStringColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTString' is less than v.
+func (FullType_FTStringColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTString' is less than or equal to v.
+func (FullType_FTStringColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTString' is greater than v.
+func (FullType_FTStringColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTString' is greater than or equal to v.
+func (FullType_FTStringColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTString' is equal to any of vs.
+func (FullType_FTStringColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "IN", value: vs}
+}
+
+// FullType_FTStringNullColumn has the predicate constructors of 'FTStringNull'.
+type FullType_FTStringNullColumn struct{}
+
+// Eq returns a predicate that 'FTStringNull' is equal to v.
+func (FullType_FTStringNullColumn) Eq(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTStringNull' is not equal to v.
+func (FullType_FTStringNullColumn) Ne(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTStringNull' is less than v.
+func (FullType_FTStringNullColumn) Lt(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTStringNull' is less than or equal to v.
+func (FullType_FTStringNullColumn) Le(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTStringNull' is greater than v.
+func (FullType_FTStringNullColumn) Gt(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTStringNull' is greater than or equal to v.
+func (FullType_FTStringNullColumn) Ge(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTStringNull' is equal to any of vs.
+func (FullType_FTStringNullColumn) In(vs ...spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTStringNull' is NULL.
+func (FullType_FTStringNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTStringNull' is not NULL.
+func (FullType_FTStringNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTBoolColumn has the predicate constructors of 'FTBool'.
+type FullType_FTBoolColumn struct{}
+
+// Eq returns a predicate that 'FTBool' is equal to v.
+func (FullType_FTBoolColumn) Eq(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBool' is not equal to v.
+func (FullType_FTBoolColumn) Ne(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBool' is less than v.
+func (FullType_FTBoolColumn) Lt(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBool' is less than or equal to v.
+func (FullType_FTBoolColumn) Le(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBool' is greater than v.
+func (FullType_FTBoolColumn) Gt(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBool' is greater than or equal to v.
+func (FullType_FTBoolColumn) Ge(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBool' is equal to any of vs.
+func (FullType_FTBoolColumn) In(vs ...bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "IN", value: vs}
+}
+
+// FullType_FTBoolNullColumn has the predicate constructors of 'FTBoolNull'.
+type FullType_FTBoolNullColumn struct{}
+
+// Eq returns a predicate that 'FTBoolNull' is equal to v.
+func (FullType_FTBoolNullColumn) Eq(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBoolNull' is not equal to v.
+func (FullType_FTBoolNullColumn) Ne(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBoolNull' is less than v.
+func (FullType_FTBoolNullColumn) Lt(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBoolNull' is less than or equal to v.
+func (FullType_FTBoolNullColumn) Le(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBoolNull' is greater than v.
+func (FullType_FTBoolNullColumn) Gt(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBoolNull' is greater than or equal to v.
+func (FullType_FTBoolNullColumn) Ge(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBoolNull' is equal to any of vs.
+func (FullType_FTBoolNullColumn) In(vs ...spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTBoolNull' is NULL.
+func (FullType_FTBoolNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTBoolNull' is not NULL.
+func (FullType_FTBoolNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTBytesColumn has the predicate constructors of 'FTBytes'.
+type FullType_FTBytesColumn struct{}
+
+// Eq returns a predicate that 'FTBytes' is equal to v.
+func (FullType_FTBytesColumn) Eq(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBytes' is not equal to v.
+func (FullType_FTBytesColumn) Ne(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBytes' is less than v.
+func (FullType_FTBytesColumn) Lt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBytes' is less than or equal to v.
+func (FullType_FTBytesColumn) Le(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBytes' is greater than v.
+func (FullType_FTBytesColumn) Gt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBytes' is greater than or equal to v.
+func (FullType_FTBytesColumn) Ge(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBytes' is equal to any of vs.
+func (FullType_FTBytesColumn) In(vs ...[]byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "IN", value: vs}
+}
+
+// FullType_FTBytesNullColumn has the predicate constructors of 'FTBytesNull'.
+type FullType_FTBytesNullColumn struct{}
+
+// Eq returns a predicate that 'FTBytesNull' is equal to v.
+func (FullType_FTBytesNullColumn) Eq(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBytesNull' is not equal to v.
+func (FullType_FTBytesNullColumn) Ne(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBytesNull' is less than v.
+func (FullType_FTBytesNullColumn) Lt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBytesNull' is less than or equal to v.
+func (FullType_FTBytesNullColumn) Le(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBytesNull' is greater than v.
+func (FullType_FTBytesNullColumn) Gt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBytesNull' is greater than or equal to v.
+func (FullType_FTBytesNullColumn) Ge(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBytesNull' is equal to any of vs.
+func (FullType_FTBytesNullColumn) In(vs ...[]byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTBytesNull' is NULL.
+func (FullType_FTBytesNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTBytesNull' is not NULL.
+func (FullType_FTBytesNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTTimestampColumn has the predicate constructors of 'FTTimestamp'.
+type FullType_FTTimestampColumn struct{}
+
+// Eq returns a predicate that 'FTTimestamp' is equal to v.
+func (FullType_FTTimestampColumn) Eq(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTTimestamp' is not equal to v.
+func (FullType_FTTimestampColumn) Ne(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTTimestamp' is less than v.
+func (FullType_FTTimestampColumn) Lt(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTTimestamp' is less than or equal to v.
+func (FullType_FTTimestampColumn) Le(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTTimestamp' is greater than v.
+func (FullType_FTTimestampColumn) Gt(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTTimestamp' is greater than or equal to v.
+func (FullType_FTTimestampColumn) Ge(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTTimestamp' is equal to any of vs.
+func (FullType_FTTimestampColumn) In(vs ...time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "IN", value: vs}
+}
+
+// FullType_FTTimestampNullColumn has the predicate constructors of 'FTTimestampNull'.
+type FullType_FTTimestampNullColumn struct{}
+
+// Eq returns a predicate that 'FTTimestampNull' is equal to v.
+func (FullType_FTTimestampNullColumn) Eq(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTTimestampNull' is not equal to v.
+func (FullType_FTTimestampNullColumn) Ne(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTTimestampNull' is less than v.
+func (FullType_FTTimestampNullColumn) Lt(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTTimestampNull' is less than or equal to v.
+func (FullType_FTTimestampNullColumn) Le(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTTimestampNull' is greater than v.
+func (FullType_FTTimestampNullColumn) Gt(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTTimestampNull' is greater than or equal to v.
+func (FullType_FTTimestampNullColumn) Ge(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTTimestampNull' is equal to any of vs.
+func (FullType_FTTimestampNullColumn) In(vs ...spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTTimestampNull' is NULL.
+func (FullType_FTTimestampNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTTimestampNull' is not NULL.
+func (FullType_FTTimestampNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTIntColumn has the predicate constructors of 'FTInt'.
+type FullType_FTIntColumn struct{}
+
+// Eq returns a predicate that 'FTInt' is equal to v.
+func (FullType_FTIntColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTInt' is not equal to v.
+func (FullType_FTIntColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTInt' is less than v.
+func (FullType_FTIntColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTInt' is less than or equal to v.
+func (FullType_FTIntColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTInt' is greater than v.
+func (FullType_FTIntColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTInt' is greater than or equal to v.
+func (FullType_FTIntColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTInt' is equal to any of vs.
+func (FullType_FTIntColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "IN", value: vs}
+}
+
+// FullType_FTIntNullColumn has the predicate constructors of 'FTIntNull'.
+type FullType_FTIntNullColumn struct{}
+
+// Eq returns a predicate that 'FTIntNull' is equal to v.
+func (FullType_FTIntNullColumn) Eq(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTIntNull' is not equal to v.
+func (FullType_FTIntNullColumn) Ne(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTIntNull' is less than v.
+func (FullType_FTIntNullColumn) Lt(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTIntNull' is less than or equal to v.
+func (FullType_FTIntNullColumn) Le(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTIntNull' is greater than v.
+func (FullType_FTIntNullColumn) Gt(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTIntNull' is greater than or equal to v.
+func (FullType_FTIntNullColumn) Ge(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTIntNull' is equal to any of vs.
+func (FullType_FTIntNullColumn) In(vs ...spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTIntNull' is NULL.
+func (FullType_FTIntNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTIntNull' is not NULL.
+func (FullType_FTIntNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTFloatColumn has the predicate constructors of 'FTFloat'.
+type FullType_FTFloatColumn struct{}
+
+// Eq returns a predicate that 'FTFloat' is equal to v.
+func (FullType_FTFloatColumn) Eq(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTFloat' is not equal to v.
+func (FullType_FTFloatColumn) Ne(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTFloat' is less than v.
+func (FullType_FTFloatColumn) Lt(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTFloat' is less than or equal to v.
+func (FullType_FTFloatColumn) Le(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTFloat' is greater than v.
+func (FullType_FTFloatColumn) Gt(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTFloat' is greater than or equal to v.
+func (FullType_FTFloatColumn) Ge(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTFloat' is equal to any of vs.
+func (FullType_FTFloatColumn) In(vs ...float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "IN", value: vs}
+}
+
+// FullType_FTFloatNullColumn has the predicate constructors of 'FTFloatNull'.
+type FullType_FTFloatNullColumn struct{}
+
+// Eq returns a predicate that 'FTFloatNull' is equal to v.
+func (FullType_FTFloatNullColumn) Eq(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTFloatNull' is not equal to v.
+func (FullType_FTFloatNullColumn) Ne(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTFloatNull' is less than v.
+func (FullType_FTFloatNullColumn) Lt(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTFloatNull' is less than or equal to v.
+func (FullType_FTFloatNullColumn) Le(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTFloatNull' is greater than v.
+func (FullType_FTFloatNullColumn) Gt(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTFloatNull' is greater than or equal to v.
+func (FullType_FTFloatNullColumn) Ge(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTFloatNull' is equal to any of vs.
+func (FullType_FTFloatNullColumn) In(vs ...spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTFloatNull' is NULL.
+func (FullType_FTFloatNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTFloatNull' is not NULL.
+func (FullType_FTFloatNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTDateColumn has the predicate constructors of 'FTDate'.
+type FullType_FTDateColumn struct{}
+
+// Eq returns a predicate that 'FTDate' is equal to v.
+func (FullType_FTDateColumn) Eq(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTDate' is not equal to v.
+func (FullType_FTDateColumn) Ne(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTDate' is less than v.
+func (FullType_FTDateColumn) Lt(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTDate' is less than or equal to v.
+func (FullType_FTDateColumn) Le(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTDate' is greater than v.
+func (FullType_FTDateColumn) Gt(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTDate' is greater than or equal to v.
+func (FullType_FTDateColumn) Ge(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTDate' is equal to any of vs.
+func (FullType_FTDateColumn) In(vs ...civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "IN", value: vs}
+}
+
+// FullType_FTDateNullColumn has the predicate constructors of 'FTDateNull'.
+type FullType_FTDateNullColumn struct{}
+
+// Eq returns a predicate that 'FTDateNull' is equal to v.
+func (FullType_FTDateNullColumn) Eq(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTDateNull' is not equal to v.
+func (FullType_FTDateNullColumn) Ne(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTDateNull' is less than v.
+func (FullType_FTDateNullColumn) Lt(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTDateNull' is less than or equal to v.
+func (FullType_FTDateNullColumn) Le(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTDateNull' is greater than v.
+func (FullType_FTDateNullColumn) Gt(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTDateNull' is greater than or equal to v.
+func (FullType_FTDateNullColumn) Ge(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTDateNull' is equal to any of vs.
+func (FullType_FTDateNullColumn) In(vs ...spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTDateNull' is NULL.
+func (FullType_FTDateNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTDateNull' is not NULL.
+func (FullType_FTDateNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTJSONColumn has the predicate constructors of 'FTJson'.
+type FullType_FTJSONColumn struct{}
+
+// FullType_FTJSONNullColumn has the predicate constructors of 'FTJsonNull'.
+type FullType_FTJSONNullColumn struct{}
+
+// IsNull returns a predicate that 'FTJsonNull' is NULL.
+func (FullType_FTJSONNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTJsonNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTJsonNull' is not NULL.
+func (FullType_FTJSONNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTJsonNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayStringNullColumn has the predicate constructors of 'FTArrayStringNull'.
+type FullType_FTArrayStringNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayStringNull' is NULL.
+func (FullType_FTArrayStringNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayStringNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayStringNull' is not NULL.
+func (FullType_FTArrayStringNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayStringNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayStringColumn has the predicate constructors of 'FTArrayString'.
+type FullType_FTArrayStringColumn struct{}
+
+// FullType_FTArrayBoolNullColumn has the predicate constructors of 'FTArrayBoolNull'.
+type FullType_FTArrayBoolNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayBoolNull' is NULL.
+func (FullType_FTArrayBoolNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBoolNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayBoolNull' is not NULL.
+func (FullType_FTArrayBoolNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBoolNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayBoolColumn has the predicate constructors of 'FTArrayBool'.
+type FullType_FTArrayBoolColumn struct{}
+
+// FullType_FTArrayBytesNullColumn has the predicate constructors of 'FTArrayBytesNull'.
+type FullType_FTArrayBytesNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayBytesNull' is NULL.
+func (FullType_FTArrayBytesNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBytesNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayBytesNull' is not NULL.
+func (FullType_FTArrayBytesNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBytesNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayBytesColumn has the predicate constructors of 'FTArrayBytes'.
+type FullType_FTArrayBytesColumn struct{}
+
+// FullType_FTArrayTimestampNullColumn has the predicate constructors of 'FTArrayTimestampNull'.
+type FullType_FTArrayTimestampNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayTimestampNull' is NULL.
+func (FullType_FTArrayTimestampNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayTimestampNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayTimestampNull' is not NULL.
+func (FullType_FTArrayTimestampNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayTimestampNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayTimestampColumn has the predicate constructors of 'FTArrayTimestamp'.
+type FullType_FTArrayTimestampColumn struct{}
+
+// FullType_FTArrayIntNullColumn has the predicate constructors of 'FTArrayIntNull'.
+type FullType_FTArrayIntNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayIntNull' is NULL.
+func (FullType_FTArrayIntNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayIntNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayIntNull' is not NULL.
+func (FullType_FTArrayIntNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayIntNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayIntColumn has the predicate constructors of 'FTArrayInt'.
+type FullType_FTArrayIntColumn struct{}
+
+// FullType_FTArrayFloatNullColumn has the predicate constructors of 'FTArrayFloatNull'.
+type FullType_FTArrayFloatNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayFloatNull' is NULL.
+func (FullType_FTArrayFloatNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayFloatNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayFloatNull' is not NULL.
+func (FullType_FTArrayFloatNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayFloatNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayFloatColumn has the predicate constructors of 'FTArrayFloat'.
+type FullType_FTArrayFloatColumn struct{}
+
+// FullType_FTArrayDateNullColumn has the predicate constructors of 'FTArrayDateNull'.
+type FullType_FTArrayDateNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayDateNull' is NULL.
+func (FullType_FTArrayDateNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayDateNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayDateNull' is not NULL.
+func (FullType_FTArrayDateNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayDateNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayDateColumn has the predicate constructors of 'FTArrayDate'.
+type FullType_FTArrayDateColumn struct{}
+
+// FullType_FTArrayJSONNullColumn has the predicate constructors of 'FTArrayJsonNull'.
+type FullType_FTArrayJSONNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayJsonNull' is NULL.
+func (FullType_FTArrayJSONNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayJsonNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayJsonNull' is not NULL.
+func (FullType_FTArrayJSONNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayJsonNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayJSONColumn has the predicate constructors of 'FTArrayJson'.
+type FullType_FTArrayJSONColumn struct{}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (ft *FullType) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := ft.columnsToValues(FullTypeWritableColumns())
+	return spanner.Insert("FullTypes", FullTypeWritableColumns(), values)
+}
+
+// InsertAllFullTypes inserts rows into 'FullTypes' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllFullTypes(ctx context.Context, client *spanner.Client, rows []*FullType) (int, error) {
+	ms := FullTypesInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(FullTypeWritableColumns()) * (1 + 5)
+
+	return yoApplyInBatches(ctx, client, "InsertAllFullTypes", "FullTypes", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// FullTypesInsert returns Mutations to insert the rows into
+// 'FullTypes', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllFullTypes, the Mutations are
+// not split into batches.
+func FullTypesInsert(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (ft *FullType) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := ft.columnsToValues(FullTypeWritableColumns())
+	return spanner.Update("FullTypes", FullTypeWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (ft *FullType) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := ft.columnsToValues(FullTypeWritableColumns())
+	return spanner.InsertOrUpdate("FullTypes", FullTypeWritableColumns(), values)
+}
+
+// FullTypesUpdate returns Mutations to update the rows in
+// 'FullTypes', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FullTypesUpdate(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// FullTypesInsertOrUpdate returns Mutations to insert or update the
+// rows in 'FullTypes', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func FullTypesInsertOrUpdate(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the FullType with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (ft *FullType) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{ft.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteFullTypes inserts or updates rows in 'FullTypes' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteFullTypes(ctx context.Context, client *spanner.Client, rows []*FullType) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteFullTypes", "FullTypes", groups)
+}
+
+// InsertOrUpdateFullTypeAtLeastOnce inserts or updates row in 'FullTypes' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateFullTypeAtLeastOnce(ctx context.Context, client *spanner.Client, row *FullType) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateFullTypeAtLeastOnce", "FullTypes", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (ft *FullType) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, FullTypePrimaryKeys()...)
+
+	values, err := ft.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "FullType.UpdateColumns", "FullTypes", err)
+	}
+
+	return spanner.Update("FullTypes", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'FullTypes' can be specified.
+func (ft *FullType) MutationForColumns(ctx context.Context, cols ...FullTypeColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return ft.UpdateColumns(ctx, names...)
+}
+
+// FullTypeTracker tracks the changes of a FullType, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type FullTypeTracker struct {
+	row  *FullType
+	orig FullType
+}
+
+// TrackFullType returns a FullTypeTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackFullType(row *FullType) *FullTypeTracker {
+	tr := &FullTypeTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked FullType.
+func (tr *FullTypeTracker) Row() *FullType {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *FullTypeTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.FTString = yoClone(tr.row.FTString)
+	tr.orig.FTStringNull = yoClone(tr.row.FTStringNull)
+	tr.orig.FTBool = yoClone(tr.row.FTBool)
+	tr.orig.FTBoolNull = yoClone(tr.row.FTBoolNull)
+	tr.orig.FTBytes = yoClone(tr.row.FTBytes)
+	tr.orig.FTBytesNull = yoClone(tr.row.FTBytesNull)
+	tr.orig.FTTimestamp = yoClone(tr.row.FTTimestamp)
+	tr.orig.FTTimestampNull = yoClone(tr.row.FTTimestampNull)
+	tr.orig.FTInt = yoClone(tr.row.FTInt)
+	tr.orig.FTIntNull = yoClone(tr.row.FTIntNull)
+	tr.orig.FTFloat = yoClone(tr.row.FTFloat)
+	tr.orig.FTFloatNull = yoClone(tr.row.FTFloatNull)
+	tr.orig.FTDate = yoClone(tr.row.FTDate)
+	tr.orig.FTDateNull = yoClone(tr.row.FTDateNull)
+	tr.orig.FTJSON = yoClone(tr.row.FTJSON)
+	tr.orig.FTJSONNull = yoClone(tr.row.FTJSONNull)
+	tr.orig.FTArrayStringNull = yoClone(tr.row.FTArrayStringNull)
+	tr.orig.FTArrayString = yoClone(tr.row.FTArrayString)
+	tr.orig.FTArrayBoolNull = yoClone(tr.row.FTArrayBoolNull)
+	tr.orig.FTArrayBool = yoClone(tr.row.FTArrayBool)
+	tr.orig.FTArrayBytesNull = yoClone(tr.row.FTArrayBytesNull)
+	tr.orig.FTArrayBytes = yoClone(tr.row.FTArrayBytes)
+	tr.orig.FTArrayTimestampNull = yoClone(tr.row.FTArrayTimestampNull)
+	tr.orig.FTArrayTimestamp = yoClone(tr.row.FTArrayTimestamp)
+	tr.orig.FTArrayIntNull = yoClone(tr.row.FTArrayIntNull)
+	tr.orig.FTArrayInt = yoClone(tr.row.FTArrayInt)
+	tr.orig.FTArrayFloatNull = yoClone(tr.row.FTArrayFloatNull)
+	tr.orig.FTArrayFloat = yoClone(tr.row.FTArrayFloat)
+	tr.orig.FTArrayDateNull = yoClone(tr.row.FTArrayDateNull)
+	tr.orig.FTArrayDate = yoClone(tr.row.FTArrayDate)
+	tr.orig.FTArrayJSONNull = yoClone(tr.row.FTArrayJSONNull)
+	tr.orig.FTArrayJSON = yoClone(tr.row.FTArrayJSON)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'FullTypes'. The primary key columns and the
+// generated columns are never included.
+func (tr *FullTypeTracker) Changed() []FullTypeColumn {
+	var cols []FullTypeColumn
+	if !reflect.DeepEqual(tr.orig.FTString, tr.row.FTString) {
+		cols = append(cols, FullTypeColumnFTString)
+	}
+	if !reflect.DeepEqual(tr.orig.FTStringNull, tr.row.FTStringNull) {
+		cols = append(cols, FullTypeColumnFTStringNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBool, tr.row.FTBool) {
+		cols = append(cols, FullTypeColumnFTBool)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBoolNull, tr.row.FTBoolNull) {
+		cols = append(cols, FullTypeColumnFTBoolNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBytes, tr.row.FTBytes) {
+		cols = append(cols, FullTypeColumnFTBytes)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBytesNull, tr.row.FTBytesNull) {
+		cols = append(cols, FullTypeColumnFTBytesNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTTimestamp, tr.row.FTTimestamp) {
+		cols = append(cols, FullTypeColumnFTTimestamp)
+	}
+	if !reflect.DeepEqual(tr.orig.FTTimestampNull, tr.row.FTTimestampNull) {
+		cols = append(cols, FullTypeColumnFTTimestampNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTInt, tr.row.FTInt) {
+		cols = append(cols, FullTypeColumnFTInt)
+	}
+	if !reflect.DeepEqual(tr.orig.FTIntNull, tr.row.FTIntNull) {
+		cols = append(cols, FullTypeColumnFTIntNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTFloat, tr.row.FTFloat) {
+		cols = append(cols, FullTypeColumnFTFloat)
+	}
+	if !reflect.DeepEqual(tr.orig.FTFloatNull, tr.row.FTFloatNull) {
+		cols = append(cols, FullTypeColumnFTFloatNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTDate, tr.row.FTDate) {
+		cols = append(cols, FullTypeColumnFTDate)
+	}
+	if !reflect.DeepEqual(tr.orig.FTDateNull, tr.row.FTDateNull) {
+		cols = append(cols, FullTypeColumnFTDateNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTJSON, tr.row.FTJSON) {
+		cols = append(cols, FullTypeColumnFTJSON)
+	}
+	if !reflect.DeepEqual(tr.orig.FTJSONNull, tr.row.FTJSONNull) {
+		cols = append(cols, FullTypeColumnFTJSONNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayStringNull, tr.row.FTArrayStringNull) {
+		cols = append(cols, FullTypeColumnFTArrayStringNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayString, tr.row.FTArrayString) {
+		cols = append(cols, FullTypeColumnFTArrayString)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBoolNull, tr.row.FTArrayBoolNull) {
+		cols = append(cols, FullTypeColumnFTArrayBoolNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBool, tr.row.FTArrayBool) {
+		cols = append(cols, FullTypeColumnFTArrayBool)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBytesNull, tr.row.FTArrayBytesNull) {
+		cols = append(cols, FullTypeColumnFTArrayBytesNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBytes, tr.row.FTArrayBytes) {
+		cols = append(cols, FullTypeColumnFTArrayBytes)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayTimestampNull, tr.row.FTArrayTimestampNull) {
+		cols = append(cols, FullTypeColumnFTArrayTimestampNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayTimestamp, tr.row.FTArrayTimestamp) {
+		cols = append(cols, FullTypeColumnFTArrayTimestamp)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayIntNull, tr.row.FTArrayIntNull) {
+		cols = append(cols, FullTypeColumnFTArrayIntNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayInt, tr.row.FTArrayInt) {
+		cols = append(cols, FullTypeColumnFTArrayInt)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayFloatNull, tr.row.FTArrayFloatNull) {
+		cols = append(cols, FullTypeColumnFTArrayFloatNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayFloat, tr.row.FTArrayFloat) {
+		cols = append(cols, FullTypeColumnFTArrayFloat)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayDateNull, tr.row.FTArrayDateNull) {
+		cols = append(cols, FullTypeColumnFTArrayDateNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayDate, tr.row.FTArrayDate) {
+		cols = append(cols, FullTypeColumnFTArrayDate)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayJSONNull, tr.row.FTArrayJSONNull) {
+		cols = append(cols, FullTypeColumnFTArrayJSONNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayJSON, tr.row.FTArrayJSON) {
+		cols = append(cols, FullTypeColumnFTArrayJSON)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *FullTypeTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindFullType gets a FullType by primary key
+func FindFullType(ctx context.Context, db YORODB, pKey string, opts ...*spanner.ReadOptions) (*FullType, error) {
+	key := spanner.Key{pKey}
+	row, err := yoReadRow(ctx, db, "FullTypes", key, FullTypeColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFullType", "FullTypes", key, ErrFullTypeNotFound, nil)
+		}
+		return nil, newError("FindFullType", "FullTypes", err)
+	}
+
+	ft, err := ScanFullType(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullType", "FullTypes", err)
+	}
+
+	return ft, nil
+}
+
+// FullTypeExists reports whether the row of the primary key exists in
+// 'FullTypes', which reads only the primary key columns.
+func FullTypeExists(ctx context.Context, db YORODB, pKey string, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{pKey}
+	if _, err := yoReadRow(ctx, db, "FullTypes", key, FullTypePrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("FullTypeExists", "FullTypes", err)
+	}
+
+	return true, nil
+}
+
+// ReadFullType retrieves multiples rows from FullType by KeySet as a slice.
+func ReadFullType(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+	rows := yoRead(ctx, db, "FullTypes", "", keys, FullTypeColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullType", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// FullTypePrimaryKey is the primary key of 'FullTypes'.
+type FullTypePrimaryKey struct {
+	PKey string
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadFullType.
+func (k FullTypePrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.PKey}
+}
+
+// ParseFullTypePrimaryKey parses key of the columns of the primary key of
+// 'FullTypes' in order, such as the key of a mutation.
+func ParseFullTypePrimaryKey(key spanner.Key) (FullTypePrimaryKey, error) {
+	var k FullTypePrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'FullTypes' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(string)
+	if !ok {
+		return k, fmt.Errorf("column 'PKey' of the key of 'FullTypes' must be string, but got %T", key[0])
+	}
+	k.PKey = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of ft.
+func (ft *FullType) PrimaryKey() FullTypePrimaryKey {
+	return FullTypePrimaryKey{
+		PKey: ft.PKey,
+	}
+}
+
+// FindFullTypeByKey gets a FullType by the primary key of key.
+func FindFullTypeByKey(ctx context.Context, db YORODB, key FullTypePrimaryKey, opts ...*spanner.ReadOptions) (*FullType, error) {
+	row, err := yoReadRow(ctx, db, "FullTypes", key.ToSpannerKey(), FullTypeColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFullTypeByKey", "FullTypes", key.ToSpannerKey(), ErrFullTypeNotFound, nil)
+		}
+		return nil, newError("FindFullTypeByKey", "FullTypes", err)
+	}
+
+	ft, err := ScanFullType(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypeByKey", "FullTypes", err)
+	}
+
+	return ft, nil
+}
+
+// DeleteFullTypeByKey returns a Mutation to delete the row of key from
+// 'FullTypes'.
+func DeleteFullTypeByKey(ctx context.Context, key FullTypePrimaryKey) *spanner.Mutation {
+	return spanner.Delete("FullTypes", key.ToSpannerKey())
+}
+
+// FindFullTypesByKeys retrieves the rows of keys from 'FullTypes' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindFullTypesByKeys(ctx context.Context, db YORODB, keys []FullTypePrimaryKey, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*FullType, len(keys))
+	rows := yoRead(ctx, db, "FullTypes", "", spanner.KeySets(keySets...), FullTypeColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		byKey[ft.primaryKey().String()] = ft
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypesByKeys", "FullTypes", err)
+	}
+
+	res := make([]*FullType, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if ft, ok := byKey[s]; ok {
+			res = append(res, ft)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListFullTypesPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListFullTypesPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesPage", "FullTypes", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes",
+		[]string{"PKey"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesPage", "FullTypes", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadFullTypeRange retrieves multiple rows from FullType whose
+// 'PKey' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadFullTypeRange(ctx context.Context, db YORODB, start, end string, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "FullTypes", "", keys, FullTypeColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypeRange", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the FullType, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (ft *FullType) primaryKey() spanner.Key {
+	return spanner.Key{ft.PKey}
+}
+
+// Delete deletes the FullType from the database.
+func (ft *FullType) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("FullTypes", ft.primaryKey())
+}
+
+// FullTypesDelete returns Mutations to delete the rows from
+// 'FullTypes', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FullTypesDelete(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// FullTypeDescriptor describes 'FullTypes' for YORepository.
+var FullTypeDescriptor = &YODescriptor[FullType, FullTypePrimaryKey]{
+	Table:       "FullTypes",
+	Columns:     FullTypeColumns(),
+	PrimaryKeys: FullTypePrimaryKeys(),
+	Key: func(row *FullType) FullTypePrimaryKey {
+		return FullTypePrimaryKey{
+			PKey: row.PKey,
+		}
+	},
+	SpannerKey: FullTypePrimaryKey.ToSpannerKey,
+	Scan:       ScanFullType,
+	Find:       FindFullTypeByKey,
+	Read:       ReadFullType,
+	Insert: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewFullTypeRepository returns a YORepository of 'FullTypes' by client.
+func NewFullTypeRepository(client *spanner.Client) *YORepository[FullType, FullTypePrimaryKey] {
+	return NewYORepository(client, FullTypeDescriptor)
+}
+
+// GeneratedColumn represents a row from 'GeneratedColumns'.
+type GeneratedColumn struct {
+	ID        int64  `spanner:"ID" json:"ID"`               // ID
+	FirstName string `spanner:"FirstName" json:"FirstName"` // FirstName
+	LastName  string `spanner:"LastName" json:"LastName"`   // LastName
+	FullName  string `spanner:"FullName" json:"FullName"`   // FullName
+}
+
+// GeneratedColumnTableName is the name of 'GeneratedColumns', which is qualified by the
+// schema if the table is in a named schema.
+const GeneratedColumnTableName = "GeneratedColumns"
+
+// ErrGeneratedColumnNotFound is the error matched by errors.Is when the row is not
+// found in 'GeneratedColumns'.
+var ErrGeneratedColumnNotFound = errors.New("yo: GeneratedColumns not found")
+
+func GeneratedColumnPrimaryKeys() []string {
+	return []string{
+		"ID",
+	}
+}
+
+func GeneratedColumnColumns() []string {
+	return []string{
+		"ID",
+		"FirstName",
+		"LastName",
+		"FullName",
+	}
+}
+
+// GeneratedColumnColumn is a column of 'GeneratedColumns'.
+type GeneratedColumnColumn string
+
+// Columns of 'GeneratedColumns'.
+const (
+	GeneratedColumnColumnID        GeneratedColumnColumn = "ID"
+	GeneratedColumnColumnFirstName GeneratedColumnColumn = "FirstName"
+	GeneratedColumnColumnLastName  GeneratedColumnColumn = "LastName"
+	GeneratedColumnColumnFullName  GeneratedColumnColumn = "FullName"
+)
+
+func GeneratedColumnWritableColumns() []string {
+	return []string{
+		"ID",
+		"FirstName",
+		"LastName",
+	}
+}
+
+func (gc *GeneratedColumn) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "ID":
+			ret = append(ret, &gc.ID)
+		case "FirstName":
+			ret = append(ret, &gc.FirstName)
+		case "LastName":
+			ret = append(ret, &gc.LastName)
+		case "FullName":
+			ret = append(ret, &gc.FullName)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (gc *GeneratedColumn) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "ID":
+			ret = append(ret, gc.ID)
+		case "FirstName":
+			ret = append(ret, gc.FirstName)
+		case "LastName":
+			ret = append(ret, gc.LastName)
+		case "FullName":
+			ret = append(ret, gc.FullName)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newGeneratedColumn_Decoder returns a decoder which reads a row from *spanner.Row
+// into GeneratedColumn. The decoder is not goroutine-safe. Don't use it concurrently.
+func newGeneratedColumn_Decoder(cols []string) func(*spanner.Row) (*GeneratedColumn, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*GeneratedColumn, error) {
+		var gc GeneratedColumn
+		ptrs, err := gc.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &gc, nil
+	}
+}
+
+// ScanGeneratedColumn decodes row into GeneratedColumn. The row may have any subset of
+// the columns of 'GeneratedColumns', such as the result of a query or a read.
+func ScanGeneratedColumn(row *spanner.Row) (*GeneratedColumn, error) {
+	return newGeneratedColumn_Decoder(row.ColumnNames())(row)
+}
+
+// ScanGeneratedColumns decodes all the rows of iter into a slice of GeneratedColumn.
+// iter is stopped when it returns.
+func ScanGeneratedColumns(iter *spanner.RowIterator) ([]*GeneratedColumn, error) {
+	var res []*GeneratedColumn
+	var decoder func(*spanner.Row) (*GeneratedColumn, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newGeneratedColumn_Decoder(row.ColumnNames())
+		}
+
+		gc, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, gc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GeneratedColumnIterator iterates over the rows of a partition of 'GeneratedColumns'
+// decoded into GeneratedColumn.
+type GeneratedColumnIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*GeneratedColumn, error)
+}
+
+// ExecuteGeneratedColumnPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllGeneratedColumns or GeneratedColumnQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteGeneratedColumnPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *GeneratedColumnIterator {
+	return &GeneratedColumnIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *GeneratedColumnIterator) Next() (*GeneratedColumn, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("GeneratedColumnIterator.Next", "GeneratedColumns", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newGeneratedColumn_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "GeneratedColumnIterator.Next", "GeneratedColumns", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *GeneratedColumnIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldGeneratedColumnRows decodes the rows of rows into GeneratedColumn and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldGeneratedColumnRows(rows *spanner.RowIterator, method string, yield func(*GeneratedColumn, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*GeneratedColumn, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "GeneratedColumns", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newGeneratedColumn_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "GeneratedColumns", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountGeneratedColumns returns the number of the rows of 'GeneratedColumns'.
+func CountGeneratedColumns(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM GeneratedColumns")
+
+	defer yoLogQuery(ctx, "CountGeneratedColumns", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountGeneratedColumns", "GeneratedColumns", err)
+	}
+
+	return n, nil
+}
+
+// AllGeneratedColumnsSeq returns an iterator of all the rows of 'GeneratedColumns',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*GeneratedColumn, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllGeneratedColumnsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*GeneratedColumn, error) bool) {
+	return func(yield func(*GeneratedColumn, error) bool) {
+		rows := yoRead(ctx, db, "GeneratedColumns", "", spanner.AllKeys(), GeneratedColumnColumns(), opts)
+		yieldGeneratedColumnRows(rows, "AllGeneratedColumnsSeq", yield)
+	}
+}
+
+// PartitionReadAllGeneratedColumns partitions the read of all the rows of
+// 'GeneratedColumns' in btx, so that the partitions are read in parallel by
+// ExecuteGeneratedColumnPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllGeneratedColumns(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "GeneratedColumns", spanner.AllKeys(), GeneratedColumnColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllGeneratedColumns", "GeneratedColumns", err)
+	}
+
+	return ps, nil
+}
+
+// GeneratedColumnQuery is a query builder for 'GeneratedColumns'. The conditions are
+// given by the predicates of GeneratedColumnWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of GeneratedColumnColumn.
+type GeneratedColumnQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewGeneratedColumnQuery returns a GeneratedColumnQuery filtered by preds.
+func NewGeneratedColumnQuery(preds ...YOPredicate) *GeneratedColumnQuery {
+	return &GeneratedColumnQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *GeneratedColumnQuery) Where(preds ...YOPredicate) *GeneratedColumnQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *GeneratedColumnQuery) OrderBy(col GeneratedColumnColumn) *GeneratedColumnQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *GeneratedColumnQuery) OrderByDesc(col GeneratedColumnColumn) *GeneratedColumnQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *GeneratedColumnQuery) Limit(n int) *GeneratedColumnQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *GeneratedColumnQuery) Statement() spanner.Statement {
+	return yoStatement("ID, FirstName, LastName, FullName", "GeneratedColumns", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *GeneratedColumnQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "GeneratedColumnQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*GeneratedColumn{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("GeneratedColumnQuery.Query", "GeneratedColumns", err)
+		}
+
+		v, err := ScanGeneratedColumn(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "GeneratedColumnQuery.Query", "GeneratedColumns", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *GeneratedColumnQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "GeneratedColumns", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "GeneratedColumnQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("GeneratedColumnQuery.Count", "GeneratedColumns", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*GeneratedColumn, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *GeneratedColumnQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*GeneratedColumn, error) bool) {
+	return func(yield func(*GeneratedColumn, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "GeneratedColumnQuery.Seq", stmt)()
+		yieldGeneratedColumnRows(yoQuery(ctx, db, stmt, opts), "GeneratedColumnQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteGeneratedColumnPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *GeneratedColumnQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "GeneratedColumnQuery.PartitionQuery", "GeneratedColumns", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "GeneratedColumnQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("GeneratedColumnQuery.PartitionQuery", "GeneratedColumns", err)
+	}
+
+	return ps, nil
+}
+
+// GeneratedColumnWhere has the typed predicate constructors of the columns of
+// 'GeneratedColumns'.
+var GeneratedColumnWhere = struct {
+	ID        GeneratedColumn_IDColumn
+	FirstName GeneratedColumn_FirstNameColumn
+	LastName  GeneratedColumn_LastNameColumn
+	FullName  GeneratedColumn_FullNameColumn
+}{}
+
+// GeneratedColumn_IDColumn has the predicate constructors of 'ID'.
+type GeneratedColumn_IDColumn struct{}
+
+// Eq returns a predicate that 'ID' is equal to v.
+func (GeneratedColumn_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ID' is not equal to v.
+func (GeneratedColumn_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ID' is less than v.
+func (GeneratedColumn_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ID' is less than or equal to v.
+func (GeneratedColumn_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ID' is greater than v.
+func (GeneratedColumn_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ID' is greater than or equal to v.
+func (GeneratedColumn_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ID' is equal to any of vs.
+func (GeneratedColumn_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "IN", value: vs}
+}
+
+// GeneratedColumn_FirstNameColumn has the predicate constructors of 'FirstName'.
+type GeneratedColumn_FirstNameColumn struct{}
+
+// Eq returns a predicate that 'FirstName' is equal to v.
+func (GeneratedColumn_FirstNameColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FirstName' is not equal to v.
+func (GeneratedColumn_FirstNameColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FirstName' is less than v.
+func (GeneratedColumn_FirstNameColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FirstName' is less than or equal to v.
+func (GeneratedColumn_FirstNameColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FirstName' is greater than v.
+func (GeneratedColumn_FirstNameColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FirstName' is greater than or equal to v.
+func (GeneratedColumn_FirstNameColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FirstName' is equal to any of vs.
+func (GeneratedColumn_FirstNameColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "IN", value: vs}
+}
+
+// GeneratedColumn_LastNameColumn has the predicate constructors of 'LastName'.
+type GeneratedColumn_LastNameColumn struct{}
+
+// Eq returns a predicate that 'LastName' is equal to v.
+func (GeneratedColumn_LastNameColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'LastName' is not equal to v.
+func (GeneratedColumn_LastNameColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'LastName' is less than v.
+func (GeneratedColumn_LastNameColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "<", value: v}
+}
+
+// Le returns a predicate that 'LastName' is less than or equal to v.
+func (GeneratedColumn_LastNameColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'LastName' is greater than v.
+func (GeneratedColumn_LastNameColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'LastName' is greater than or equal to v.
+func (GeneratedColumn_LastNameColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: ">=", value: v}
+}
+
+// In returns a predicate that 'LastName' is equal to any of vs.
+func (GeneratedColumn_LastNameColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "IN", value: vs}
+}
+
+// GeneratedColumn_FullNameColumn has the predicate constructors of 'FullName'.
+type GeneratedColumn_FullNameColumn struct{}
+
+// Eq returns a predicate that 'FullName' is equal to v.
+func (GeneratedColumn_FullNameColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FullName' is not equal to v.
+func (GeneratedColumn_FullNameColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FullName' is less than v.
+func (GeneratedColumn_FullNameColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FullName' is less than or equal to v.
+func (GeneratedColumn_FullNameColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FullName' is greater than v.
+func (GeneratedColumn_FullNameColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FullName' is greater than or equal to v.
+func (GeneratedColumn_FullNameColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FullName' is equal to any of vs.
+func (GeneratedColumn_FullNameColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (gc *GeneratedColumn) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := gc.columnsToValues(GeneratedColumnWritableColumns())
+	return spanner.Insert("GeneratedColumns", GeneratedColumnWritableColumns(), values)
+}
+
+// InsertAllGeneratedColumns inserts rows into 'GeneratedColumns' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllGeneratedColumns(ctx context.Context, client *spanner.Client, rows []*GeneratedColumn) (int, error) {
+	ms := GeneratedColumnsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(GeneratedColumnWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllGeneratedColumns", "GeneratedColumns", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// GeneratedColumnsInsert returns Mutations to insert the rows into
+// 'GeneratedColumns', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllGeneratedColumns, the Mutations are
+// not split into batches.
+func GeneratedColumnsInsert(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (gc *GeneratedColumn) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := gc.columnsToValues(GeneratedColumnWritableColumns())
+	return spanner.Update("GeneratedColumns", GeneratedColumnWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (gc *GeneratedColumn) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := gc.columnsToValues(GeneratedColumnWritableColumns())
+	return spanner.InsertOrUpdate("GeneratedColumns", GeneratedColumnWritableColumns(), values)
+}
+
+// GeneratedColumnsUpdate returns Mutations to update the rows in
+// 'GeneratedColumns', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func GeneratedColumnsUpdate(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// GeneratedColumnsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'GeneratedColumns', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func GeneratedColumnsInsertOrUpdate(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the GeneratedColumn with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (gc *GeneratedColumn) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{gc.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteGeneratedColumns inserts or updates rows in 'GeneratedColumns' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteGeneratedColumns(ctx context.Context, client *spanner.Client, rows []*GeneratedColumn) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteGeneratedColumns", "GeneratedColumns", groups)
+}
+
+// InsertOrUpdateGeneratedColumnAtLeastOnce inserts or updates row in 'GeneratedColumns' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateGeneratedColumnAtLeastOnce(ctx context.Context, client *spanner.Client, row *GeneratedColumn) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateGeneratedColumnAtLeastOnce", "GeneratedColumns", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (gc *GeneratedColumn) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, GeneratedColumnPrimaryKeys()...)
+
+	values, err := gc.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "GeneratedColumn.UpdateColumns", "GeneratedColumns", err)
+	}
+
+	return spanner.Update("GeneratedColumns", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'GeneratedColumns' can be specified.
+func (gc *GeneratedColumn) MutationForColumns(ctx context.Context, cols ...GeneratedColumnColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return gc.UpdateColumns(ctx, names...)
+}
+
+// GeneratedColumnTracker tracks the changes of a GeneratedColumn, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type GeneratedColumnTracker struct {
+	row  *GeneratedColumn
+	orig GeneratedColumn
+}
+
+// TrackGeneratedColumn returns a GeneratedColumnTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackGeneratedColumn(row *GeneratedColumn) *GeneratedColumnTracker {
+	tr := &GeneratedColumnTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked GeneratedColumn.
+func (tr *GeneratedColumnTracker) Row() *GeneratedColumn {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *GeneratedColumnTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.FirstName = yoClone(tr.row.FirstName)
+	tr.orig.LastName = yoClone(tr.row.LastName)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'GeneratedColumns'. The primary key columns and the
+// generated columns are never included.
+func (tr *GeneratedColumnTracker) Changed() []GeneratedColumnColumn {
+	var cols []GeneratedColumnColumn
+	if !reflect.DeepEqual(tr.orig.FirstName, tr.row.FirstName) {
+		cols = append(cols, GeneratedColumnColumnFirstName)
+	}
+	if !reflect.DeepEqual(tr.orig.LastName, tr.row.LastName) {
+		cols = append(cols, GeneratedColumnColumnLastName)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *GeneratedColumnTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindGeneratedColumn gets a GeneratedColumn by primary key
+func FindGeneratedColumn(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*GeneratedColumn, error) {
+	key := spanner.Key{id}
+	row, err := yoReadRow(ctx, db, "GeneratedColumns", key, GeneratedColumnColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindGeneratedColumn", "GeneratedColumns", key, ErrGeneratedColumnNotFound, nil)
+		}
+		return nil, newError("FindGeneratedColumn", "GeneratedColumns", err)
+	}
+
+	gc, err := ScanGeneratedColumn(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindGeneratedColumn", "GeneratedColumns", err)
+	}
+
+	return gc, nil
+}
+
+// GeneratedColumnExists reports whether the row of the primary key exists in
+// 'GeneratedColumns', which reads only the primary key columns.
+func GeneratedColumnExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "GeneratedColumns", key, GeneratedColumnPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("GeneratedColumnExists", "GeneratedColumns", err)
+	}
+
+	return true, nil
+}
+
+// ReadGeneratedColumn retrieves multiples rows from GeneratedColumn by KeySet as a slice.
+func ReadGeneratedColumn(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
+	var res []*GeneratedColumn
+	rows := yoRead(ctx, db, "GeneratedColumns", "", keys, GeneratedColumnColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		gc, err := ScanGeneratedColumn(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, gc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadGeneratedColumn", "GeneratedColumns", err)
+	}
+
+	return res, nil
+}
+
+// GeneratedColumnPrimaryKey is the primary key of 'GeneratedColumns'.
+type GeneratedColumnPrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadGeneratedColumn.
+func (k GeneratedColumnPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseGeneratedColumnPrimaryKey parses key of the columns of the primary key of
+// 'GeneratedColumns' in order, such as the key of a mutation.
+func ParseGeneratedColumnPrimaryKey(key spanner.Key) (GeneratedColumnPrimaryKey, error) {
+	var k GeneratedColumnPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'GeneratedColumns' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'ID' of the key of 'GeneratedColumns' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of gc.
+func (gc *GeneratedColumn) PrimaryKey() GeneratedColumnPrimaryKey {
+	return GeneratedColumnPrimaryKey{
+		ID: gc.ID,
+	}
+}
+
+// FindGeneratedColumnByKey gets a GeneratedColumn by the primary key of key.
+func FindGeneratedColumnByKey(ctx context.Context, db YORODB, key GeneratedColumnPrimaryKey, opts ...*spanner.ReadOptions) (*GeneratedColumn, error) {
+	row, err := yoReadRow(ctx, db, "GeneratedColumns", key.ToSpannerKey(), GeneratedColumnColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindGeneratedColumnByKey", "GeneratedColumns", key.ToSpannerKey(), ErrGeneratedColumnNotFound, nil)
+		}
+		return nil, newError("FindGeneratedColumnByKey", "GeneratedColumns", err)
+	}
+
+	gc, err := ScanGeneratedColumn(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindGeneratedColumnByKey", "GeneratedColumns", err)
+	}
+
+	return gc, nil
+}
+
+// DeleteGeneratedColumnByKey returns a Mutation to delete the row of key from
+// 'GeneratedColumns'.
+func DeleteGeneratedColumnByKey(ctx context.Context, key GeneratedColumnPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("GeneratedColumns", key.ToSpannerKey())
+}
+
+// FindGeneratedColumnsByKeys retrieves the rows of keys from 'GeneratedColumns' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindGeneratedColumnsByKeys(ctx context.Context, db YORODB, keys []GeneratedColumnPrimaryKey, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*GeneratedColumn, len(keys))
+	rows := yoRead(ctx, db, "GeneratedColumns", "", spanner.KeySets(keySets...), GeneratedColumnColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		gc, err := ScanGeneratedColumn(row)
+		if err != nil {
+			return err
+		}
+		byKey[gc.primaryKey().String()] = gc
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindGeneratedColumnsByKeys", "GeneratedColumns", err)
+	}
+
+	res := make([]*GeneratedColumn, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if gc, ok := byKey[s]; ok {
+			res = append(res, gc)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListGeneratedColumnsPage retrieves a page of at most pageSize rows from 'GeneratedColumns'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListGeneratedColumnsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListGeneratedColumnsPage", "GeneratedColumns", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListGeneratedColumnsPage", "GeneratedColumns", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT ID, FirstName, LastName, FullName FROM GeneratedColumns",
+		[]string{"ID"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListGeneratedColumnsPage", stmt)()
+	res, err := ScanGeneratedColumns(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListGeneratedColumnsPage", "GeneratedColumns", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListGeneratedColumnsPage", "GeneratedColumns", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadGeneratedColumnRange retrieves multiple rows from GeneratedColumn whose
+// 'ID' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadGeneratedColumnRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
+	var res []*GeneratedColumn
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "GeneratedColumns", "", keys, GeneratedColumnColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		gc, err := ScanGeneratedColumn(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, gc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadGeneratedColumnRange", "GeneratedColumns", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the GeneratedColumn, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (gc *GeneratedColumn) primaryKey() spanner.Key {
+	return spanner.Key{gc.ID}
+}
+
+// Delete deletes the GeneratedColumn from the database.
+func (gc *GeneratedColumn) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("GeneratedColumns", gc.primaryKey())
+}
+
+// GeneratedColumnsDelete returns Mutations to delete the rows from
+// 'GeneratedColumns', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func GeneratedColumnsDelete(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// GeneratedColumnDescriptor describes 'GeneratedColumns' for YORepository.
+var GeneratedColumnDescriptor = &YODescriptor[GeneratedColumn, GeneratedColumnPrimaryKey]{
+	Table:       "GeneratedColumns",
+	Columns:     GeneratedColumnColumns(),
+	PrimaryKeys: GeneratedColumnPrimaryKeys(),
+	Key: func(row *GeneratedColumn) GeneratedColumnPrimaryKey {
+		return GeneratedColumnPrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: GeneratedColumnPrimaryKey.ToSpannerKey,
+	Scan:       ScanGeneratedColumn,
+	Find:       FindGeneratedColumnByKey,
+	Read:       ReadGeneratedColumn,
+	Insert: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewGeneratedColumnRepository returns a YORepository of 'GeneratedColumns' by client.
+func NewGeneratedColumnRepository(client *spanner.Client) *YORepository[GeneratedColumn, GeneratedColumnPrimaryKey] {
+	return NewYORepository(client, GeneratedColumnDescriptor)
+}
+
+// Item represents a row from 'Items'.
+type Item struct {
+	ID    int64 `spanner:"ID" json:"ID"`       // ID
+	Price int64 `spanner:"Price" json:"Price"` // Price
+}
+
+// ItemTableName is the name of 'Items', which is qualified by the
+// schema if the table is in a named schema.
+const ItemTableName = "Items"
+
+// ErrItemNotFound is the error matched by errors.Is when the row is not
+// found in 'Items'.
+var ErrItemNotFound = errors.New("yo: Items not found")
+
+func ItemPrimaryKeys() []string {
+	return []string{
+		"ID",
+	}
+}
+
+func ItemColumns() []string {
+	return []string{
+		"ID",
+		"Price",
+	}
+}
+
+// ItemColumn is a column of 'Items'.
+type ItemColumn string
+
+// Columns of 'Items'.
+const (
+	ItemColumnID    ItemColumn = "ID"
+	ItemColumnPrice ItemColumn = "Price"
+)
+
+func ItemWritableColumns() []string {
+	return []string{
+		"ID",
+		"Price",
+	}
+}
+
+func (i *Item) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "ID":
+			ret = append(ret, &i.ID)
+		case "Price":
+			ret = append(ret, &i.Price)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (i *Item) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "ID":
+			ret = append(ret, i.ID)
+		case "Price":
+			ret = append(ret, i.Price)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newItem_Decoder returns a decoder which reads a row from *spanner.Row
+// into Item. The decoder is not goroutine-safe. Don't use it concurrently.
+func newItem_Decoder(cols []string) func(*spanner.Row) (*Item, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*Item, error) {
+		var i Item
+		ptrs, err := i.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &i, nil
+	}
+}
+
+// ScanItem decodes row into Item. The row may have any subset of
+// the columns of 'Items', such as the result of a query or a read.
+func ScanItem(row *spanner.Row) (*Item, error) {
+	return newItem_Decoder(row.ColumnNames())(row)
+}
+
+// ScanItems decodes all the rows of iter into a slice of Item.
+// iter is stopped when it returns.
+func ScanItems(iter *spanner.RowIterator) ([]*Item, error) {
+	var res []*Item
+	var decoder func(*spanner.Row) (*Item, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newItem_Decoder(row.ColumnNames())
+		}
+
+		i, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, i)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ItemIterator iterates over the rows of a partition of 'Items'
+// decoded into Item.
+type ItemIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*Item, error)
+}
+
+// ExecuteItemPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllItems or ItemQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteItemPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *ItemIterator {
+	return &ItemIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *ItemIterator) Next() (*Item, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("ItemIterator.Next", "Items", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newItem_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ItemIterator.Next", "Items", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *ItemIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldItemRows decodes the rows of rows into Item and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldItemRows(rows *spanner.RowIterator, method string, yield func(*Item, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*Item, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "Items", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newItem_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "Items", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountItems returns the number of the rows of 'Items'.
+func CountItems(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM Items")
+
+	defer yoLogQuery(ctx, "CountItems", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountItems", "Items", err)
+	}
+
+	return n, nil
+}
+
+// AllItemsSeq returns an iterator of all the rows of 'Items',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*Item, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllItemsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*Item, error) bool) {
+	return func(yield func(*Item, error) bool) {
+		rows := yoRead(ctx, db, "Items", "", spanner.AllKeys(), ItemColumns(), opts)
+		yieldItemRows(rows, "AllItemsSeq", yield)
+	}
+}
+
+// PartitionReadAllItems partitions the read of all the rows of
+// 'Items' in btx, so that the partitions are read in parallel by
+// ExecuteItemPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllItems(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "Items", spanner.AllKeys(), ItemColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllItems", "Items", err)
+	}
+
+	return ps, nil
+}
+
+// ItemQuery is a query builder for 'Items'. The conditions are
+// given by the predicates of ItemWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of ItemColumn.
+type ItemQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewItemQuery returns a ItemQuery filtered by preds.
+func NewItemQuery(preds ...YOPredicate) *ItemQuery {
+	return &ItemQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *ItemQuery) Where(preds ...YOPredicate) *ItemQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *ItemQuery) OrderBy(col ItemColumn) *ItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *ItemQuery) OrderByDesc(col ItemColumn) *ItemQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *ItemQuery) Limit(n int) *ItemQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *ItemQuery) Statement() spanner.Statement {
+	return yoStatement("ID, Price", "Items", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *ItemQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*Item, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "ItemQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*Item{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("ItemQuery.Query", "Items", err)
+		}
+
+		v, err := ScanItem(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "ItemQuery.Query", "Items", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *ItemQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "Items", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "ItemQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("ItemQuery.Count", "Items", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*Item, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *ItemQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*Item, error) bool) {
+	return func(yield func(*Item, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "ItemQuery.Seq", stmt)()
+		yieldItemRows(yoQuery(ctx, db, stmt, opts), "ItemQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteItemPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *ItemQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "ItemQuery.PartitionQuery", "Items", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "ItemQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("ItemQuery.PartitionQuery", "Items", err)
+	}
+
+	return ps, nil
+}
+
+// ItemWhere has the typed predicate constructors of the columns of
+// 'Items'.
+var ItemWhere = struct {
+	ID    Item_IDColumn
+	Price Item_PriceColumn
+}{}
+
+// Item_IDColumn has the predicate constructors of 'ID'.
+type Item_IDColumn struct{}
+
+// Eq returns a predicate that 'ID' is equal to v.
+func (Item_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ID' is not equal to v.
+func (Item_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ID' is less than v.
+func (Item_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ID' is less than or equal to v.
+func (Item_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ID' is greater than v.
+func (Item_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ID' is greater than or equal to v.
+func (Item_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ID' is equal to any of vs.
+func (Item_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "IN", value: vs}
+}
+
+// Item_PriceColumn has the predicate constructors of 'Price'.
+type Item_PriceColumn struct{}
+
+// Eq returns a predicate that 'Price' is equal to v.
+func (Item_PriceColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'Price' is not equal to v.
+func (Item_PriceColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'Price' is less than v.
+func (Item_PriceColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "<", value: v}
+}
+
+// Le returns a predicate that 'Price' is less than or equal to v.
+func (Item_PriceColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'Price' is greater than v.
+func (Item_PriceColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'Price' is greater than or equal to v.
+func (Item_PriceColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "Price", op: ">=", value: v}
+}
+
+// In returns a predicate that 'Price' is equal to any of vs.
+func (Item_PriceColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "Price", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (i *Item) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := i.columnsToValues(ItemWritableColumns())
+	return spanner.Insert("Items", ItemWritableColumns(), values)
+}
+
+// InsertAllItems inserts rows into 'Items' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllItems(ctx context.Context, client *spanner.Client, rows []*Item) (int, error) {
+	ms := ItemsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(ItemWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllItems", "Items", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// ItemsInsert returns Mutations to insert the rows into
+// 'Items', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllItems, the Mutations are
+// not split into batches.
+func ItemsInsert(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (i *Item) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := i.columnsToValues(ItemWritableColumns())
+	return spanner.Update("Items", ItemWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (i *Item) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := i.columnsToValues(ItemWritableColumns())
+	return spanner.InsertOrUpdate("Items", ItemWritableColumns(), values)
+}
+
+// ItemsUpdate returns Mutations to update the rows in
+// 'Items', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func ItemsUpdate(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// ItemsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'Items', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func ItemsInsertOrUpdate(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the Item with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (i *Item) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{i.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteItems inserts or updates rows in 'Items' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteItems(ctx context.Context, client *spanner.Client, rows []*Item) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteItems", "Items", groups)
+}
+
+// InsertOrUpdateItemAtLeastOnce inserts or updates row in 'Items' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateItemAtLeastOnce(ctx context.Context, client *spanner.Client, row *Item) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateItemAtLeastOnce", "Items", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (i *Item) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, ItemPrimaryKeys()...)
+
+	values, err := i.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "Item.UpdateColumns", "Items", err)
+	}
+
+	return spanner.Update("Items", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'Items' can be specified.
+func (i *Item) MutationForColumns(ctx context.Context, cols ...ItemColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return i.UpdateColumns(ctx, names...)
+}
+
+// ItemTracker tracks the changes of a Item, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type ItemTracker struct {
+	row  *Item
+	orig Item
+}
+
+// TrackItem returns a ItemTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackItem(row *Item) *ItemTracker {
+	tr := &ItemTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked Item.
+func (tr *ItemTracker) Row() *Item {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *ItemTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.Price = yoClone(tr.row.Price)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'Items'. The primary key columns and the
+// generated columns are never included.
+func (tr *ItemTracker) Changed() []ItemColumn {
+	var cols []ItemColumn
+	if !reflect.DeepEqual(tr.orig.Price, tr.row.Price) {
+		cols = append(cols, ItemColumnPrice)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *ItemTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindItem gets a Item by primary key
+func FindItem(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*Item, error) {
+	key := spanner.Key{id}
+	row, err := yoReadRow(ctx, db, "Items", key, ItemColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindItem", "Items", key, ErrItemNotFound, nil)
+		}
+		return nil, newError("FindItem", "Items", err)
+	}
+
+	i, err := ScanItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindItem", "Items", err)
+	}
+
+	return i, nil
+}
+
+// ItemExists reports whether the row of the primary key exists in
+// 'Items', which reads only the primary key columns.
+func ItemExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "Items", key, ItemPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("ItemExists", "Items", err)
+	}
+
+	return true, nil
+}
+
+// ReadItem retrieves multiples rows from Item by KeySet as a slice.
+func ReadItem(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*Item, error) {
+	var res []*Item
+	rows := yoRead(ctx, db, "Items", "", keys, ItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		i, err := ScanItem(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, i)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadItem", "Items", err)
+	}
+
+	return res, nil
+}
+
+// ItemPrimaryKey is the primary key of 'Items'.
+type ItemPrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadItem.
+func (k ItemPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseItemPrimaryKey parses key of the columns of the primary key of
+// 'Items' in order, such as the key of a mutation.
+func ParseItemPrimaryKey(key spanner.Key) (ItemPrimaryKey, error) {
+	var k ItemPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'Items' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'ID' of the key of 'Items' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of i.
+func (i *Item) PrimaryKey() ItemPrimaryKey {
+	return ItemPrimaryKey{
+		ID: i.ID,
+	}
+}
+
+// FindItemByKey gets a Item by the primary key of key.
+func FindItemByKey(ctx context.Context, db YORODB, key ItemPrimaryKey, opts ...*spanner.ReadOptions) (*Item, error) {
+	row, err := yoReadRow(ctx, db, "Items", key.ToSpannerKey(), ItemColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindItemByKey", "Items", key.ToSpannerKey(), ErrItemNotFound, nil)
+		}
+		return nil, newError("FindItemByKey", "Items", err)
+	}
+
+	i, err := ScanItem(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindItemByKey", "Items", err)
+	}
+
+	return i, nil
+}
+
+// DeleteItemByKey returns a Mutation to delete the row of key from
+// 'Items'.
+func DeleteItemByKey(ctx context.Context, key ItemPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("Items", key.ToSpannerKey())
+}
+
+// FindItemsByKeys retrieves the rows of keys from 'Items' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindItemsByKeys(ctx context.Context, db YORODB, keys []ItemPrimaryKey, opts ...*spanner.ReadOptions) ([]*Item, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*Item, len(keys))
+	rows := yoRead(ctx, db, "Items", "", spanner.KeySets(keySets...), ItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		i, err := ScanItem(row)
+		if err != nil {
+			return err
+		}
+		byKey[i.primaryKey().String()] = i
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindItemsByKeys", "Items", err)
+	}
+
+	res := make([]*Item, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if i, ok := byKey[s]; ok {
+			res = append(res, i)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListItemsPage retrieves a page of at most pageSize rows from 'Items'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListItemsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*Item, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListItemsPage", "Items", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListItemsPage", "Items", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT ID, Price FROM Items",
+		[]string{"ID"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListItemsPage", stmt)()
+	res, err := ScanItems(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListItemsPage", "Items", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListItemsPage", "Items", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadItemRange retrieves multiple rows from Item whose
+// 'ID' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadItemRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*Item, error) {
+	var res []*Item
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "Items", "", keys, ItemColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		i, err := ScanItem(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, i)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadItemRange", "Items", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the Item, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (i *Item) primaryKey() spanner.Key {
+	return spanner.Key{i.ID}
+}
+
+// Delete deletes the Item from the database.
+func (i *Item) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("Items", i.primaryKey())
+}
+
+// ItemsDelete returns Mutations to delete the rows from
+// 'Items', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func ItemsDelete(ctx context.Context, rows []*Item) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// ItemDescriptor describes 'Items' for YORepository.
+var ItemDescriptor = &YODescriptor[Item, ItemPrimaryKey]{
+	Table:       "Items",
+	Columns:     ItemColumns(),
+	PrimaryKeys: ItemPrimaryKeys(),
+	Key: func(row *Item) ItemPrimaryKey {
+		return ItemPrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: ItemPrimaryKey.ToSpannerKey,
+	Scan:       ScanItem,
+	Find:       FindItemByKey,
+	Read:       ReadItem,
+	Insert: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *Item) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewItemRepository returns a YORepository of 'Items' by client.
+func NewItemRepository(client *spanner.Client) *YORepository[Item, ItemPrimaryKey] {
+	return NewYORepository(client, ItemDescriptor)
+}
+
+// MaxLength represents a row from 'MaxLengths'.
+type MaxLength struct {
+	MaxString string `spanner:"MaxString" json:"MaxString"` // MaxString
+	MaxBytes  []byte `spanner:"MaxBytes" json:"MaxBytes"`   // MaxBytes
+}
+
+// MaxLengthTableName is the name of 'MaxLengths', which is qualified by the
+// schema if the table is in a named schema.
+const MaxLengthTableName = "MaxLengths"
+
+// ErrMaxLengthNotFound is the error matched by errors.Is when the row is not
+// found in 'MaxLengths'.
+var ErrMaxLengthNotFound = errors.New("yo: MaxLengths not found")
+
+func MaxLengthPrimaryKeys() []string {
+	return []string{
+		"MaxString",
+	}
+}
+
+func MaxLengthColumns() []string {
+	return []string{
+		"MaxString",
+		"MaxBytes",
+	}
+}
+
+// MaxLengthColumn is a column of 'MaxLengths'.
+type MaxLengthColumn string
+
+// Columns of 'MaxLengths'.
+const (
+	MaxLengthColumnMaxString MaxLengthColumn = "MaxString"
+	MaxLengthColumnMaxBytes  MaxLengthColumn = "MaxBytes"
+)
+
+func MaxLengthWritableColumns() []string {
+	return []string{
+		"MaxString",
+		"MaxBytes",
+	}
+}
+
+func (ml *MaxLength) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "MaxString":
+			ret = append(ret, &ml.MaxString)
+		case "MaxBytes":
+			ret = append(ret, &ml.MaxBytes)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (ml *MaxLength) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "MaxString":
+			ret = append(ret, ml.MaxString)
+		case "MaxBytes":
+			ret = append(ret, ml.MaxBytes)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newMaxLength_Decoder returns a decoder which reads a row from *spanner.Row
+// into MaxLength. The decoder is not goroutine-safe. Don't use it concurrently.
+func newMaxLength_Decoder(cols []string) func(*spanner.Row) (*MaxLength, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*MaxLength, error) {
+		var ml MaxLength
+		ptrs, err := ml.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &ml, nil
+	}
+}
+
+// ScanMaxLength decodes row into MaxLength. The row may have any subset of
+// the columns of 'MaxLengths', such as the result of a query or a read.
+func ScanMaxLength(row *spanner.Row) (*MaxLength, error) {
+	return newMaxLength_Decoder(row.ColumnNames())(row)
+}
+
+// ScanMaxLengths decodes all the rows of iter into a slice of MaxLength.
+// iter is stopped when it returns.
+func ScanMaxLengths(iter *spanner.RowIterator) ([]*MaxLength, error) {
+	var res []*MaxLength
+	var decoder func(*spanner.Row) (*MaxLength, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newMaxLength_Decoder(row.ColumnNames())
+		}
+
+		ml, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ml)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MaxLengthIterator iterates over the rows of a partition of 'MaxLengths'
+// decoded into MaxLength.
+type MaxLengthIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*MaxLength, error)
+}
+
+// ExecuteMaxLengthPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllMaxLengths or MaxLengthQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteMaxLengthPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *MaxLengthIterator {
+	return &MaxLengthIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *MaxLengthIterator) Next() (*MaxLength, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("MaxLengthIterator.Next", "MaxLengths", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newMaxLength_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "MaxLengthIterator.Next", "MaxLengths", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *MaxLengthIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldMaxLengthRows decodes the rows of rows into MaxLength and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldMaxLengthRows(rows *spanner.RowIterator, method string, yield func(*MaxLength, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*MaxLength, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "MaxLengths", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newMaxLength_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "MaxLengths", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountMaxLengths returns the number of the rows of 'MaxLengths'.
+func CountMaxLengths(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM MaxLengths")
+
+	defer yoLogQuery(ctx, "CountMaxLengths", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountMaxLengths", "MaxLengths", err)
+	}
+
+	return n, nil
+}
+
+// AllMaxLengthsSeq returns an iterator of all the rows of 'MaxLengths',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*MaxLength, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllMaxLengthsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*MaxLength, error) bool) {
+	return func(yield func(*MaxLength, error) bool) {
+		rows := yoRead(ctx, db, "MaxLengths", "", spanner.AllKeys(), MaxLengthColumns(), opts)
+		yieldMaxLengthRows(rows, "AllMaxLengthsSeq", yield)
+	}
+}
+
+// PartitionReadAllMaxLengths partitions the read of all the rows of
+// 'MaxLengths' in btx, so that the partitions are read in parallel by
+// ExecuteMaxLengthPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllMaxLengths(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "MaxLengths", spanner.AllKeys(), MaxLengthColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllMaxLengths", "MaxLengths", err)
+	}
+
+	return ps, nil
+}
+
+// MaxLengthQuery is a query builder for 'MaxLengths'. The conditions are
+// given by the predicates of MaxLengthWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of MaxLengthColumn.
+type MaxLengthQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewMaxLengthQuery returns a MaxLengthQuery filtered by preds.
+func NewMaxLengthQuery(preds ...YOPredicate) *MaxLengthQuery {
+	return &MaxLengthQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *MaxLengthQuery) Where(preds ...YOPredicate) *MaxLengthQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *MaxLengthQuery) OrderBy(col MaxLengthColumn) *MaxLengthQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *MaxLengthQuery) OrderByDesc(col MaxLengthColumn) *MaxLengthQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *MaxLengthQuery) Limit(n int) *MaxLengthQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *MaxLengthQuery) Statement() spanner.Statement {
+	return yoStatement("MaxString, MaxBytes", "MaxLengths", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *MaxLengthQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "MaxLengthQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*MaxLength{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("MaxLengthQuery.Query", "MaxLengths", err)
+		}
+
+		v, err := ScanMaxLength(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "MaxLengthQuery.Query", "MaxLengths", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *MaxLengthQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "MaxLengths", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "MaxLengthQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("MaxLengthQuery.Count", "MaxLengths", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*MaxLength, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *MaxLengthQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*MaxLength, error) bool) {
+	return func(yield func(*MaxLength, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "MaxLengthQuery.Seq", stmt)()
+		yieldMaxLengthRows(yoQuery(ctx, db, stmt, opts), "MaxLengthQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteMaxLengthPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *MaxLengthQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "MaxLengthQuery.PartitionQuery", "MaxLengths", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "MaxLengthQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("MaxLengthQuery.PartitionQuery", "MaxLengths", err)
+	}
+
+	return ps, nil
+}
+
+// MaxLengthWhere has the typed predicate constructors of the columns of
+// 'MaxLengths'.
+var MaxLengthWhere = struct {
+	MaxString MaxLength_MaxStringColumn
+	MaxBytes  MaxLength_MaxBytesColumn
+}{}
+
+// MaxLength_MaxStringColumn has the predicate constructors of 'MaxString'.
+type MaxLength_MaxStringColumn struct{}
+
+// Eq returns a predicate that 'MaxString' is equal to v.
+func (MaxLength_MaxStringColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'MaxString' is not equal to v.
+func (MaxLength_MaxStringColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'MaxString' is less than v.
+func (MaxLength_MaxStringColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "<", value: v}
+}
+
+// Le returns a predicate that 'MaxString' is less than or equal to v.
+func (MaxLength_MaxStringColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'MaxString' is greater than v.
+func (MaxLength_MaxStringColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'MaxString' is greater than or equal to v.
+func (MaxLength_MaxStringColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: ">=", value: v}
+}
+
+// In returns a predicate that 'MaxString' is equal to any of vs.
+func (MaxLength_MaxStringColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "MaxString", op: "IN", value: vs}
+}
+
+// MaxLength_MaxBytesColumn has the predicate constructors of 'MaxBytes'.
+type MaxLength_MaxBytesColumn struct{}
+
+// Eq returns a predicate that 'MaxBytes' is equal to v.
+func (MaxLength_MaxBytesColumn) Eq(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'MaxBytes' is not equal to v.
+func (MaxLength_MaxBytesColumn) Ne(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'MaxBytes' is less than v.
+func (MaxLength_MaxBytesColumn) Lt(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "<", value: v}
+}
+
+// Le returns a predicate that 'MaxBytes' is less than or equal to v.
+func (MaxLength_MaxBytesColumn) Le(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'MaxBytes' is greater than v.
+func (MaxLength_MaxBytesColumn) Gt(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'MaxBytes' is greater than or equal to v.
+func (MaxLength_MaxBytesColumn) Ge(v []byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: ">=", value: v}
+}
+
+// In returns a predicate that 'MaxBytes' is equal to any of vs.
+func (MaxLength_MaxBytesColumn) In(vs ...[]byte) YOPredicate {
+	return YOPredicate{column: "MaxBytes", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (ml *MaxLength) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := ml.columnsToValues(MaxLengthWritableColumns())
+	return spanner.Insert("MaxLengths", MaxLengthWritableColumns(), values)
+}
+
+// InsertAllMaxLengths inserts rows into 'MaxLengths' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllMaxLengths(ctx context.Context, client *spanner.Client, rows []*MaxLength) (int, error) {
+	ms := MaxLengthsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(MaxLengthWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllMaxLengths", "MaxLengths", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// MaxLengthsInsert returns Mutations to insert the rows into
+// 'MaxLengths', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllMaxLengths, the Mutations are
+// not split into batches.
+func MaxLengthsInsert(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (ml *MaxLength) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := ml.columnsToValues(MaxLengthWritableColumns())
+	return spanner.Update("MaxLengths", MaxLengthWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (ml *MaxLength) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := ml.columnsToValues(MaxLengthWritableColumns())
+	return spanner.InsertOrUpdate("MaxLengths", MaxLengthWritableColumns(), values)
+}
+
+// MaxLengthsUpdate returns Mutations to update the rows in
+// 'MaxLengths', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func MaxLengthsUpdate(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// MaxLengthsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'MaxLengths', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func MaxLengthsInsertOrUpdate(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the MaxLength with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (ml *MaxLength) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{ml.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteMaxLengths inserts or updates rows in 'MaxLengths' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteMaxLengths(ctx context.Context, client *spanner.Client, rows []*MaxLength) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteMaxLengths", "MaxLengths", groups)
+}
+
+// InsertOrUpdateMaxLengthAtLeastOnce inserts or updates row in 'MaxLengths' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateMaxLengthAtLeastOnce(ctx context.Context, client *spanner.Client, row *MaxLength) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateMaxLengthAtLeastOnce", "MaxLengths", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (ml *MaxLength) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, MaxLengthPrimaryKeys()...)
+
+	values, err := ml.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "MaxLength.UpdateColumns", "MaxLengths", err)
+	}
+
+	return spanner.Update("MaxLengths", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'MaxLengths' can be specified.
+func (ml *MaxLength) MutationForColumns(ctx context.Context, cols ...MaxLengthColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return ml.UpdateColumns(ctx, names...)
+}
+
+// MaxLengthTracker tracks the changes of a MaxLength, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type MaxLengthTracker struct {
+	row  *MaxLength
+	orig MaxLength
+}
+
+// TrackMaxLength returns a MaxLengthTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackMaxLength(row *MaxLength) *MaxLengthTracker {
+	tr := &MaxLengthTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked MaxLength.
+func (tr *MaxLengthTracker) Row() *MaxLength {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *MaxLengthTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.MaxBytes = yoClone(tr.row.MaxBytes)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'MaxLengths'. The primary key columns and the
+// generated columns are never included.
+func (tr *MaxLengthTracker) Changed() []MaxLengthColumn {
+	var cols []MaxLengthColumn
+	if !reflect.DeepEqual(tr.orig.MaxBytes, tr.row.MaxBytes) {
+		cols = append(cols, MaxLengthColumnMaxBytes)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *MaxLengthTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindMaxLength gets a MaxLength by primary key
+func FindMaxLength(ctx context.Context, db YORODB, maxString string, opts ...*spanner.ReadOptions) (*MaxLength, error) {
+	key := spanner.Key{maxString}
+	row, err := yoReadRow(ctx, db, "MaxLengths", key, MaxLengthColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindMaxLength", "MaxLengths", key, ErrMaxLengthNotFound, nil)
+		}
+		return nil, newError("FindMaxLength", "MaxLengths", err)
+	}
+
+	ml, err := ScanMaxLength(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindMaxLength", "MaxLengths", err)
+	}
+
+	return ml, nil
+}
+
+// MaxLengthExists reports whether the row of the primary key exists in
+// 'MaxLengths', which reads only the primary key columns.
+func MaxLengthExists(ctx context.Context, db YORODB, maxString string, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{maxString}
+	if _, err := yoReadRow(ctx, db, "MaxLengths", key, MaxLengthPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("MaxLengthExists", "MaxLengths", err)
+	}
+
+	return true, nil
+}
+
+// ReadMaxLength retrieves multiples rows from MaxLength by KeySet as a slice.
+func ReadMaxLength(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
+	var res []*MaxLength
+	rows := yoRead(ctx, db, "MaxLengths", "", keys, MaxLengthColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ml, err := ScanMaxLength(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ml)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadMaxLength", "MaxLengths", err)
+	}
+
+	return res, nil
+}
+
+// MaxLengthPrimaryKey is the primary key of 'MaxLengths'.
+type MaxLengthPrimaryKey struct {
+	MaxString string
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadMaxLength.
+func (k MaxLengthPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.MaxString}
+}
+
+// ParseMaxLengthPrimaryKey parses key of the columns of the primary key of
+// 'MaxLengths' in order, such as the key of a mutation.
+func ParseMaxLengthPrimaryKey(key spanner.Key) (MaxLengthPrimaryKey, error) {
+	var k MaxLengthPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'MaxLengths' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(string)
+	if !ok {
+		return k, fmt.Errorf("column 'MaxString' of the key of 'MaxLengths' must be string, but got %T", key[0])
+	}
+	k.MaxString = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of ml.
+func (ml *MaxLength) PrimaryKey() MaxLengthPrimaryKey {
+	return MaxLengthPrimaryKey{
+		MaxString: ml.MaxString,
+	}
+}
+
+// FindMaxLengthByKey gets a MaxLength by the primary key of key.
+func FindMaxLengthByKey(ctx context.Context, db YORODB, key MaxLengthPrimaryKey, opts ...*spanner.ReadOptions) (*MaxLength, error) {
+	row, err := yoReadRow(ctx, db, "MaxLengths", key.ToSpannerKey(), MaxLengthColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindMaxLengthByKey", "MaxLengths", key.ToSpannerKey(), ErrMaxLengthNotFound, nil)
+		}
+		return nil, newError("FindMaxLengthByKey", "MaxLengths", err)
+	}
+
+	ml, err := ScanMaxLength(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindMaxLengthByKey", "MaxLengths", err)
+	}
+
+	return ml, nil
+}
+
+// DeleteMaxLengthByKey returns a Mutation to delete the row of key from
+// 'MaxLengths'.
+func DeleteMaxLengthByKey(ctx context.Context, key MaxLengthPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("MaxLengths", key.ToSpannerKey())
+}
+
+// FindMaxLengthsByKeys retrieves the rows of keys from 'MaxLengths' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindMaxLengthsByKeys(ctx context.Context, db YORODB, keys []MaxLengthPrimaryKey, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*MaxLength, len(keys))
+	rows := yoRead(ctx, db, "MaxLengths", "", spanner.KeySets(keySets...), MaxLengthColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ml, err := ScanMaxLength(row)
+		if err != nil {
+			return err
+		}
+		byKey[ml.primaryKey().String()] = ml
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindMaxLengthsByKeys", "MaxLengths", err)
+	}
+
+	res := make([]*MaxLength, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if ml, ok := byKey[s]; ok {
+			res = append(res, ml)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListMaxLengthsPage retrieves a page of at most pageSize rows from 'MaxLengths'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListMaxLengthsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*MaxLength, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListMaxLengthsPage", "MaxLengths", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListMaxLengthsPage", "MaxLengths", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT MaxString, MaxBytes FROM MaxLengths",
+		[]string{"MaxString"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListMaxLengthsPage", stmt)()
+	res, err := ScanMaxLengths(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListMaxLengthsPage", "MaxLengths", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.MaxString)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListMaxLengthsPage", "MaxLengths", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadMaxLengthRange retrieves multiple rows from MaxLength whose
+// 'MaxString' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadMaxLengthRange(ctx context.Context, db YORODB, start, end string, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*MaxLength, error) {
+	var res []*MaxLength
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "MaxLengths", "", keys, MaxLengthColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ml, err := ScanMaxLength(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ml)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadMaxLengthRange", "MaxLengths", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the MaxLength, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (ml *MaxLength) primaryKey() spanner.Key {
+	return spanner.Key{ml.MaxString}
+}
+
+// Delete deletes the MaxLength from the database.
+func (ml *MaxLength) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("MaxLengths", ml.primaryKey())
+}
+
+// MaxLengthsDelete returns Mutations to delete the rows from
+// 'MaxLengths', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func MaxLengthsDelete(ctx context.Context, rows []*MaxLength) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// MaxLengthDescriptor describes 'MaxLengths' for YORepository.
+var MaxLengthDescriptor = &YODescriptor[MaxLength, MaxLengthPrimaryKey]{
+	Table:       "MaxLengths",
+	Columns:     MaxLengthColumns(),
+	PrimaryKeys: MaxLengthPrimaryKeys(),
+	Key: func(row *MaxLength) MaxLengthPrimaryKey {
+		return MaxLengthPrimaryKey{
+			MaxString: row.MaxString,
+		}
+	},
+	SpannerKey: MaxLengthPrimaryKey.ToSpannerKey,
+	Scan:       ScanMaxLength,
+	Find:       FindMaxLengthByKey,
+	Read:       ReadMaxLength,
+	Insert: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *MaxLength) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewMaxLengthRepository returns a YORepository of 'MaxLengths' by client.
+func NewMaxLengthRepository(client *spanner.Client) *YORepository[MaxLength, MaxLengthPrimaryKey] {
+	return NewYORepository(client, MaxLengthDescriptor)
+}
+
+// OutOfOrderPrimaryKey represents a row from 'OutOfOrderPrimaryKeys'.
+type OutOfOrderPrimaryKey struct {
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 string `spanner:"PKey2" json:"PKey2"` // PKey2
+	PKey3 string `spanner:"PKey3" json:"PKey3"` // PKey3
+}
+
+// OutOfOrderPrimaryKeyTableName is the name of 'OutOfOrderPrimaryKeys', which is qualified by the
+// schema if the table is in a named schema.
+const OutOfOrderPrimaryKeyTableName = "OutOfOrderPrimaryKeys"
+
+// ErrOutOfOrderPrimaryKeyNotFound is the error matched by errors.Is when the row is not
+// found in 'OutOfOrderPrimaryKeys'.
+var ErrOutOfOrderPrimaryKeyNotFound = errors.New("yo: OutOfOrderPrimaryKeys not found")
+
+func OutOfOrderPrimaryKeyPrimaryKeys() []string {
+	return []string{
+		"PKey2",
+		"PKey1",
+		"PKey3",
+	}
+}
+
+func OutOfOrderPrimaryKeyColumns() []string {
+	return []string{
+		"PKey1",
+		"PKey2",
+		"PKey3",
+	}
+}
+
+// OutOfOrderPrimaryKeyColumn is a column of 'OutOfOrderPrimaryKeys'.
+type OutOfOrderPrimaryKeyColumn string
+
+// Columns of 'OutOfOrderPrimaryKeys'.
+const (
+	OutOfOrderPrimaryKeyColumnPKey1 OutOfOrderPrimaryKeyColumn = "PKey1"
+	OutOfOrderPrimaryKeyColumnPKey2 OutOfOrderPrimaryKeyColumn = "PKey2"
+	OutOfOrderPrimaryKeyColumnPKey3 OutOfOrderPrimaryKeyColumn = "PKey3"
+)
+
+func OutOfOrderPrimaryKeyWritableColumns() []string {
+	return []string{
+		"PKey1",
+		"PKey2",
+		"PKey3",
+	}
+}
+
+func (ooopk *OutOfOrderPrimaryKey) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "PKey1":
+			ret = append(ret, &ooopk.PKey1)
+		case "PKey2":
+			ret = append(ret, &ooopk.PKey2)
+		case "PKey3":
+			ret = append(ret, &ooopk.PKey3)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (ooopk *OutOfOrderPrimaryKey) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "PKey1":
+			ret = append(ret, ooopk.PKey1)
+		case "PKey2":
+			ret = append(ret, ooopk.PKey2)
+		case "PKey3":
+			ret = append(ret, ooopk.PKey3)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newOutOfOrderPrimaryKey_Decoder returns a decoder which reads a row from *spanner.Row
+// into OutOfOrderPrimaryKey. The decoder is not goroutine-safe. Don't use it concurrently.
+func newOutOfOrderPrimaryKey_Decoder(cols []string) func(*spanner.Row) (*OutOfOrderPrimaryKey, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*OutOfOrderPrimaryKey, error) {
+		var ooopk OutOfOrderPrimaryKey
+		ptrs, err := ooopk.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &ooopk, nil
+	}
+}
+
+// ScanOutOfOrderPrimaryKey decodes row into OutOfOrderPrimaryKey. The row may have any subset of
+// the columns of 'OutOfOrderPrimaryKeys', such as the result of a query or a read.
+func ScanOutOfOrderPrimaryKey(row *spanner.Row) (*OutOfOrderPrimaryKey, error) {
+	return newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())(row)
+}
+
+// ScanOutOfOrderPrimaryKeys decodes all the rows of iter into a slice of OutOfOrderPrimaryKey.
+// iter is stopped when it returns.
+func ScanOutOfOrderPrimaryKeys(iter *spanner.RowIterator) ([]*OutOfOrderPrimaryKey, error) {
+	var res []*OutOfOrderPrimaryKey
+	var decoder func(*spanner.Row) (*OutOfOrderPrimaryKey, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())
+		}
+
+		ooopk, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ooopk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// OutOfOrderPrimaryKeyIterator iterates over the rows of a partition of 'OutOfOrderPrimaryKeys'
+// decoded into OutOfOrderPrimaryKey.
+type OutOfOrderPrimaryKeyIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*OutOfOrderPrimaryKey, error)
+}
+
+// ExecuteOutOfOrderPrimaryKeyPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllOutOfOrderPrimaryKeys or OutOfOrderPrimaryKeyQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteOutOfOrderPrimaryKeyPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *OutOfOrderPrimaryKeyIterator {
+	return &OutOfOrderPrimaryKeyIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *OutOfOrderPrimaryKeyIterator) Next() (*OutOfOrderPrimaryKey, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("OutOfOrderPrimaryKeyIterator.Next", "OutOfOrderPrimaryKeys", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "OutOfOrderPrimaryKeyIterator.Next", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *OutOfOrderPrimaryKeyIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldOutOfOrderPrimaryKeyRows decodes the rows of rows into OutOfOrderPrimaryKey and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldOutOfOrderPrimaryKeyRows(rows *spanner.RowIterator, method string, yield func(*OutOfOrderPrimaryKey, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*OutOfOrderPrimaryKey, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "OutOfOrderPrimaryKeys", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "OutOfOrderPrimaryKeys", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountOutOfOrderPrimaryKeys returns the number of the rows of 'OutOfOrderPrimaryKeys'.
+func CountOutOfOrderPrimaryKeys(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM OutOfOrderPrimaryKeys")
+
+	defer yoLogQuery(ctx, "CountOutOfOrderPrimaryKeys", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountOutOfOrderPrimaryKeys", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// AllOutOfOrderPrimaryKeysSeq returns an iterator of all the rows of 'OutOfOrderPrimaryKeys',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*OutOfOrderPrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllOutOfOrderPrimaryKeysSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+	return func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+		rows := yoRead(ctx, db, "OutOfOrderPrimaryKeys", "", spanner.AllKeys(), OutOfOrderPrimaryKeyColumns(), opts)
+		yieldOutOfOrderPrimaryKeyRows(rows, "AllOutOfOrderPrimaryKeysSeq", yield)
+	}
+}
+
+// PartitionReadAllOutOfOrderPrimaryKeys partitions the read of all the rows of
+// 'OutOfOrderPrimaryKeys' in btx, so that the partitions are read in parallel by
+// ExecuteOutOfOrderPrimaryKeyPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllOutOfOrderPrimaryKeys(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "OutOfOrderPrimaryKeys", spanner.AllKeys(), OutOfOrderPrimaryKeyColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllOutOfOrderPrimaryKeys", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return ps, nil
+}
+
+// OutOfOrderPrimaryKeyQuery is a query builder for 'OutOfOrderPrimaryKeys'. The conditions are
+// given by the predicates of OutOfOrderPrimaryKeyWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of OutOfOrderPrimaryKeyColumn.
+type OutOfOrderPrimaryKeyQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewOutOfOrderPrimaryKeyQuery returns a OutOfOrderPrimaryKeyQuery filtered by preds.
+func NewOutOfOrderPrimaryKeyQuery(preds ...YOPredicate) *OutOfOrderPrimaryKeyQuery {
+	return &OutOfOrderPrimaryKeyQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *OutOfOrderPrimaryKeyQuery) Where(preds ...YOPredicate) *OutOfOrderPrimaryKeyQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *OutOfOrderPrimaryKeyQuery) OrderBy(col OutOfOrderPrimaryKeyColumn) *OutOfOrderPrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *OutOfOrderPrimaryKeyQuery) OrderByDesc(col OutOfOrderPrimaryKeyColumn) *OutOfOrderPrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *OutOfOrderPrimaryKeyQuery) Limit(n int) *OutOfOrderPrimaryKeyQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *OutOfOrderPrimaryKeyQuery) Statement() spanner.Statement {
+	return yoStatement("PKey1, PKey2, PKey3", "OutOfOrderPrimaryKeys", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *OutOfOrderPrimaryKeyQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*OutOfOrderPrimaryKey, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*OutOfOrderPrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("OutOfOrderPrimaryKeyQuery.Query", "OutOfOrderPrimaryKeys", err)
+		}
+
+		v, err := ScanOutOfOrderPrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "OutOfOrderPrimaryKeyQuery.Query", "OutOfOrderPrimaryKeys", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *OutOfOrderPrimaryKeyQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "OutOfOrderPrimaryKeys", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("OutOfOrderPrimaryKeyQuery.Count", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*OutOfOrderPrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *OutOfOrderPrimaryKeyQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+	return func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.Seq", stmt)()
+		yieldOutOfOrderPrimaryKeyRows(yoQuery(ctx, db, stmt, opts), "OutOfOrderPrimaryKeyQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteOutOfOrderPrimaryKeyPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *OutOfOrderPrimaryKeyQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "OutOfOrderPrimaryKeyQuery.PartitionQuery", "OutOfOrderPrimaryKeys", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("OutOfOrderPrimaryKeyQuery.PartitionQuery", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return ps, nil
+}
+
+// OutOfOrderPrimaryKeyWhere has the typed predicate constructors of the columns of
+// 'OutOfOrderPrimaryKeys'.
+var OutOfOrderPrimaryKeyWhere = struct {
+	PKey1 OutOfOrderPrimaryKey_PKey1Column
+	PKey2 OutOfOrderPrimaryKey_PKey2Column
+	PKey3 OutOfOrderPrimaryKey_PKey3Column
+}{}
+
+// OutOfOrderPrimaryKey_PKey1Column has the predicate constructors of 'PKey1'.
+type OutOfOrderPrimaryKey_PKey1Column struct{}
+
+// Eq returns a predicate that 'PKey1' is equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey1' is not equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey1' is less than v.
+func (OutOfOrderPrimaryKey_PKey1Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey1' is less than or equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey1' is greater than v.
+func (OutOfOrderPrimaryKey_PKey1Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey1' is greater than or equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey1' is equal to any of vs.
+func (OutOfOrderPrimaryKey_PKey1Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "IN", value: vs}
+}
+
+// OutOfOrderPrimaryKey_PKey2Column has the predicate constructors of 'PKey2'.
+type OutOfOrderPrimaryKey_PKey2Column struct{}
+
+// Eq returns a predicate that 'PKey2' is equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey2' is not equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey2' is less than v.
+func (OutOfOrderPrimaryKey_PKey2Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey2' is less than or equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey2' is greater than v.
+func (OutOfOrderPrimaryKey_PKey2Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey2' is greater than or equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey2' is equal to any of vs.
+func (OutOfOrderPrimaryKey_PKey2Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "IN", value: vs}
+}
+
+// OutOfOrderPrimaryKey_PKey3Column has the predicate constructors of 'PKey3'.
+type OutOfOrderPrimaryKey_PKey3Column struct{}
+
+// Eq returns a predicate that 'PKey3' is equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey3' is not equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey3' is less than v.
+func (OutOfOrderPrimaryKey_PKey3Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey3' is less than or equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey3' is greater than v.
+func (OutOfOrderPrimaryKey_PKey3Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey3' is greater than or equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey3' is equal to any of vs.
+func (OutOfOrderPrimaryKey_PKey3Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (ooopk *OutOfOrderPrimaryKey) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := ooopk.columnsToValues(OutOfOrderPrimaryKeyWritableColumns())
+	return spanner.Insert("OutOfOrderPrimaryKeys", OutOfOrderPrimaryKeyWritableColumns(), values)
+}
+
+// InsertAllOutOfOrderPrimaryKeys inserts rows into 'OutOfOrderPrimaryKeys' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllOutOfOrderPrimaryKeys(ctx context.Context, client *spanner.Client, rows []*OutOfOrderPrimaryKey) (int, error) {
+	ms := OutOfOrderPrimaryKeysInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(OutOfOrderPrimaryKeyWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllOutOfOrderPrimaryKeys", "OutOfOrderPrimaryKeys", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// OutOfOrderPrimaryKeysInsert returns Mutations to insert the rows into
+// 'OutOfOrderPrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllOutOfOrderPrimaryKeys, the Mutations are
+// not split into batches.
+func OutOfOrderPrimaryKeysInsert(ctx context.Context, rows []*OutOfOrderPrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// primaryKey returns the key of the OutOfOrderPrimaryKey, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (ooopk *OutOfOrderPrimaryKey) primaryKey() spanner.Key {
+	return spanner.Key{ooopk.PKey2, ooopk.PKey1, ooopk.PKey3}
+}
+
+// Delete deletes the OutOfOrderPrimaryKey from the database.
+func (ooopk *OutOfOrderPrimaryKey) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("OutOfOrderPrimaryKeys", ooopk.primaryKey())
+}
+
+// OutOfOrderPrimaryKeysDelete returns Mutations to delete the rows from
+// 'OutOfOrderPrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func OutOfOrderPrimaryKeysDelete(ctx context.Context, rows []*OutOfOrderPrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// SnakeCase represents a row from 'snake_cases'.
+type SnakeCase struct {
+	ID        int64  `spanner:"id" json:"id"`                   // id
+	StringID  string `spanner:"string_id" json:"string_id"`     // string_id
+	FooBarBaz int64  `spanner:"foo_bar_baz" json:"foo_bar_baz"` // foo_bar_baz
+}
+
+// SnakeCaseTableName is the name of 'snake_cases', which is qualified by the
+// schema if the table is in a named schema.
+const SnakeCaseTableName = "snake_cases"
+
+// ErrSnakeCaseNotFound is the error matched by errors.Is when the row is not
+// found in 'snake_cases'.
+var ErrSnakeCaseNotFound = errors.New("yo: snake_cases not found")
+
+func SnakeCasePrimaryKeys() []string {
+	return []string{
+		"id",
+	}
+}
+
+func SnakeCaseColumns() []string {
+	return []string{
+		"id",
+		"string_id",
+		"foo_bar_baz",
+	}
+}
+
+// SnakeCaseColumn is a column of 'snake_cases'.
+type SnakeCaseColumn string
+
+// Columns of 'snake_cases'.
+const (
+	SnakeCaseColumnID        SnakeCaseColumn = "id"
+	SnakeCaseColumnStringID  SnakeCaseColumn = "string_id"
+	SnakeCaseColumnFooBarBaz SnakeCaseColumn = "foo_bar_baz"
+)
+
+func SnakeCaseWritableColumns() []string {
+	return []string{
+		"id",
+		"string_id",
+		"foo_bar_baz",
+	}
+}
+
+func (sc *SnakeCase) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if val, ok := customPtrs[col]; ok {
+			ret = append(ret, val)
+			continue
+		}
+
+		switch col {
+		case "id":
+			ret = append(ret, &sc.ID)
+		case "string_id":
+			ret = append(ret, &sc.StringID)
+		case "foo_bar_baz":
+			ret = append(ret, &sc.FooBarBaz)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+	return ret, nil
+}
+
+func (sc *SnakeCase) columnsToValues(cols []string) ([]interface{}, error) {
+	ret := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		switch col {
+		case "id":
+			ret = append(ret, sc.ID)
+		case "string_id":
+			ret = append(ret, sc.StringID)
+		case "foo_bar_baz":
+			ret = append(ret, sc.FooBarBaz)
+		default:
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+	}
+
+	return ret, nil
+}
+
+// newSnakeCase_Decoder returns a decoder which reads a row from *spanner.Row
+// into SnakeCase. The decoder is not goroutine-safe. Don't use it concurrently.
+func newSnakeCase_Decoder(cols []string) func(*spanner.Row) (*SnakeCase, error) {
+	customPtrs := map[string]interface{}{}
+
+	return func(row *spanner.Row) (*SnakeCase, error) {
+		var sc SnakeCase
+		ptrs, err := sc.columnsToPtrs(cols, customPtrs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.Columns(ptrs...); err != nil {
+			return nil, err
+		}
+
+		return &sc, nil
+	}
+}
+
+// ScanSnakeCase decodes row into SnakeCase. The row may have any subset of
+// the columns of 'snake_cases', such as the result of a query or a read.
+func ScanSnakeCase(row *spanner.Row) (*SnakeCase, error) {
+	return newSnakeCase_Decoder(row.ColumnNames())(row)
+}
+
+// ScanSnakeCases decodes all the rows of iter into a slice of SnakeCase.
+// iter is stopped when it returns.
+func ScanSnakeCases(iter *spanner.RowIterator) ([]*SnakeCase, error) {
+	var res []*SnakeCase
+	var decoder func(*spanner.Row) (*SnakeCase, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newSnakeCase_Decoder(row.ColumnNames())
+		}
+
+		sc, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, sc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SnakeCaseIterator iterates over the rows of a partition of 'snake_cases'
+// decoded into SnakeCase.
+type SnakeCaseIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*SnakeCase, error)
+}
+
+// ExecuteSnakeCasePartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllSnakeCases or SnakeCaseQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteSnakeCasePartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *SnakeCaseIterator {
+	return &SnakeCaseIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *SnakeCaseIterator) Next() (*SnakeCase, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("SnakeCaseIterator.Next", "snake_cases", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newSnakeCase_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "SnakeCaseIterator.Next", "snake_cases", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *SnakeCaseIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldSnakeCaseRows decodes the rows of rows into SnakeCase and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldSnakeCaseRows(rows *spanner.RowIterator, method string, yield func(*SnakeCase, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*SnakeCase, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "snake_cases", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newSnakeCase_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "snake_cases", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountSnakeCases returns the number of the rows of 'snake_cases'.
+func CountSnakeCases(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM snake_cases")
+
+	defer yoLogQuery(ctx, "CountSnakeCases", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountSnakeCases", "snake_cases", err)
+	}
+
+	return n, nil
+}
+
+// AllSnakeCasesSeq returns an iterator of all the rows of 'snake_cases',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*SnakeCase, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllSnakeCasesSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*SnakeCase, error) bool) {
+	return func(yield func(*SnakeCase, error) bool) {
+		rows := yoRead(ctx, db, "snake_cases", "", spanner.AllKeys(), SnakeCaseColumns(), opts)
+		yieldSnakeCaseRows(rows, "AllSnakeCasesSeq", yield)
+	}
+}
+
+// PartitionReadAllSnakeCases partitions the read of all the rows of
+// 'snake_cases' in btx, so that the partitions are read in parallel by
+// ExecuteSnakeCasePartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllSnakeCases(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "snake_cases", spanner.AllKeys(), SnakeCaseColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllSnakeCases", "snake_cases", err)
+	}
+
+	return ps, nil
+}
+
+// SnakeCaseQuery is a query builder for 'snake_cases'. The conditions are
+// given by the predicates of SnakeCaseWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of SnakeCaseColumn.
+type SnakeCaseQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewSnakeCaseQuery returns a SnakeCaseQuery filtered by preds.
+func NewSnakeCaseQuery(preds ...YOPredicate) *SnakeCaseQuery {
+	return &SnakeCaseQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *SnakeCaseQuery) Where(preds ...YOPredicate) *SnakeCaseQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *SnakeCaseQuery) OrderBy(col SnakeCaseColumn) *SnakeCaseQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *SnakeCaseQuery) OrderByDesc(col SnakeCaseColumn) *SnakeCaseQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *SnakeCaseQuery) Limit(n int) *SnakeCaseQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *SnakeCaseQuery) Statement() spanner.Statement {
+	return yoStatement("id, string_id, foo_bar_baz", "snake_cases", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *SnakeCaseQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "SnakeCaseQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*SnakeCase{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("SnakeCaseQuery.Query", "snake_cases", err)
+		}
+
+		v, err := ScanSnakeCase(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "SnakeCaseQuery.Query", "snake_cases", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *SnakeCaseQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "snake_cases", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "SnakeCaseQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("SnakeCaseQuery.Count", "snake_cases", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*SnakeCase, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *SnakeCaseQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*SnakeCase, error) bool) {
+	return func(yield func(*SnakeCase, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "SnakeCaseQuery.Seq", stmt)()
+		yieldSnakeCaseRows(yoQuery(ctx, db, stmt, opts), "SnakeCaseQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteSnakeCasePartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *SnakeCaseQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "SnakeCaseQuery.PartitionQuery", "snake_cases", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "SnakeCaseQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("SnakeCaseQuery.PartitionQuery", "snake_cases", err)
+	}
+
+	return ps, nil
+}
+
+// SnakeCaseWhere has the typed predicate constructors of the columns of
+// 'snake_cases'.
+var SnakeCaseWhere = struct {
+	ID        SnakeCase_IDColumn
+	StringID  SnakeCase_StringIDColumn
+	FooBarBaz SnakeCase_FooBarBazColumn
+}{}
+
+// SnakeCase_IDColumn has the predicate constructors of 'id'.
+type SnakeCase_IDColumn struct{}
+
+// Eq returns a predicate that 'id' is equal to v.
+func (SnakeCase_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'id' is not equal to v.
+func (SnakeCase_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'id' is less than v.
+func (SnakeCase_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "<", value: v}
+}
+
+// Le returns a predicate that 'id' is less than or equal to v.
+func (SnakeCase_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'id' is greater than v.
+func (SnakeCase_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'id' is greater than or equal to v.
+func (SnakeCase_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "id", op: ">=", value: v}
+}
+
+// In returns a predicate that 'id' is equal to any of vs.
+func (SnakeCase_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "id", op: "IN", value: vs}
+}
+
+// SnakeCase_StringIDColumn has the predicate constructors of 'string_id'.
+type SnakeCase_StringIDColumn struct{}
+
+// Eq returns a predicate that 'string_id' is equal to v.
+func (SnakeCase_StringIDColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'string_id' is not equal to v.
+func (SnakeCase_StringIDColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'string_id' is less than v.
+func (SnakeCase_StringIDColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "<", value: v}
+}
+
+// Le returns a predicate that 'string_id' is less than or equal to v.
+func (SnakeCase_StringIDColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'string_id' is greater than v.
+func (SnakeCase_StringIDColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'string_id' is greater than or equal to v.
+func (SnakeCase_StringIDColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "string_id", op: ">=", value: v}
+}
+
+// In returns a predicate that 'string_id' is equal to any of vs.
+func (SnakeCase_StringIDColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "string_id", op: "IN", value: vs}
+}
+
+// SnakeCase_FooBarBazColumn has the predicate constructors of 'foo_bar_baz'.
+type SnakeCase_FooBarBazColumn struct{}
+
+// Eq returns a predicate that 'foo_bar_baz' is equal to v.
+func (SnakeCase_FooBarBazColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'foo_bar_baz' is not equal to v.
+func (SnakeCase_FooBarBazColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'foo_bar_baz' is less than v.
+func (SnakeCase_FooBarBazColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "<", value: v}
+}
+
+// Le returns a predicate that 'foo_bar_baz' is less than or equal to v.
+func (SnakeCase_FooBarBazColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'foo_bar_baz' is greater than v.
+func (SnakeCase_FooBarBazColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'foo_bar_baz' is greater than or equal to v.
+func (SnakeCase_FooBarBazColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: ">=", value: v}
+}
+
+// In returns a predicate that 'foo_bar_baz' is equal to any of vs.
+func (SnakeCase_FooBarBazColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "foo_bar_baz", op: "IN", value: vs}
+}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (sc *SnakeCase) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := sc.columnsToValues(SnakeCaseWritableColumns())
+	return spanner.Insert("snake_cases", SnakeCaseWritableColumns(), values)
+}
+
+// InsertAllSnakeCases inserts rows into 'snake_cases' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllSnakeCases(ctx context.Context, client *spanner.Client, rows []*SnakeCase) (int, error) {
+	ms := SnakeCasesInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(SnakeCaseWritableColumns()) * (1 + 1)
+
+	return yoApplyInBatches(ctx, client, "InsertAllSnakeCases", "snake_cases", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// SnakeCasesInsert returns Mutations to insert the rows into
+// 'snake_cases', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllSnakeCases, the Mutations are
+// not split into batches.
+func SnakeCasesInsert(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (sc *SnakeCase) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := sc.columnsToValues(SnakeCaseWritableColumns())
+	return spanner.Update("snake_cases", SnakeCaseWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (sc *SnakeCase) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := sc.columnsToValues(SnakeCaseWritableColumns())
+	return spanner.InsertOrUpdate("snake_cases", SnakeCaseWritableColumns(), values)
+}
+
+// SnakeCasesUpdate returns Mutations to update the rows in
+// 'snake_cases', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func SnakeCasesUpdate(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// SnakeCasesInsertOrUpdate returns Mutations to insert or update the
+// rows in 'snake_cases', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func SnakeCasesInsertOrUpdate(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the SnakeCase with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (sc *SnakeCase) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{sc.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteSnakeCases inserts or updates rows in 'snake_cases' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteSnakeCases(ctx context.Context, client *spanner.Client, rows []*SnakeCase) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteSnakeCases", "snake_cases", groups)
+}
+
+// InsertOrUpdateSnakeCaseAtLeastOnce inserts or updates row in 'snake_cases' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateSnakeCaseAtLeastOnce(ctx context.Context, client *spanner.Client, row *SnakeCase) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateSnakeCaseAtLeastOnce", "snake_cases", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (sc *SnakeCase) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, SnakeCasePrimaryKeys()...)
+
+	values, err := sc.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "SnakeCase.UpdateColumns", "snake_cases", err)
+	}
+
+	return spanner.Update("snake_cases", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'snake_cases' can be specified.
+func (sc *SnakeCase) MutationForColumns(ctx context.Context, cols ...SnakeCaseColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return sc.UpdateColumns(ctx, names...)
+}
+
+// SnakeCaseTracker tracks the changes of a SnakeCase, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type SnakeCaseTracker struct {
+	row  *SnakeCase
+	orig SnakeCase
+}
+
+// TrackSnakeCase returns a SnakeCaseTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackSnakeCase(row *SnakeCase) *SnakeCaseTracker {
+	tr := &SnakeCaseTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked SnakeCase.
+func (tr *SnakeCaseTracker) Row() *SnakeCase {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *SnakeCaseTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.StringID = yoClone(tr.row.StringID)
+	tr.orig.FooBarBaz = yoClone(tr.row.FooBarBaz)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'snake_cases'. The primary key columns and the
+// generated columns are never included.
+func (tr *SnakeCaseTracker) Changed() []SnakeCaseColumn {
+	var cols []SnakeCaseColumn
+	if !reflect.DeepEqual(tr.orig.StringID, tr.row.StringID) {
+		cols = append(cols, SnakeCaseColumnStringID)
+	}
+	if !reflect.DeepEqual(tr.orig.FooBarBaz, tr.row.FooBarBaz) {
+		cols = append(cols, SnakeCaseColumnFooBarBaz)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *SnakeCaseTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindSnakeCase gets a SnakeCase by primary key
+func FindSnakeCase(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*SnakeCase, error) {
+	key := spanner.Key{id}
+	row, err := yoReadRow(ctx, db, "snake_cases", key, SnakeCaseColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindSnakeCase", "snake_cases", key, ErrSnakeCaseNotFound, nil)
+		}
+		return nil, newError("FindSnakeCase", "snake_cases", err)
+	}
+
+	sc, err := ScanSnakeCase(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindSnakeCase", "snake_cases", err)
+	}
+
+	return sc, nil
+}
+
+// SnakeCaseExists reports whether the row of the primary key exists in
+// 'snake_cases', which reads only the primary key columns.
+func SnakeCaseExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "snake_cases", key, SnakeCasePrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("SnakeCaseExists", "snake_cases", err)
+	}
+
+	return true, nil
+}
+
+// ReadSnakeCase retrieves multiples rows from SnakeCase by KeySet as a slice.
+func ReadSnakeCase(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	var res []*SnakeCase
+	rows := yoRead(ctx, db, "snake_cases", "", keys, SnakeCaseColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		sc, err := ScanSnakeCase(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, sc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCase", "snake_cases", err)
+	}
+
+	return res, nil
+}
+
+// SnakeCasePrimaryKey is the primary key of 'snake_cases'.
+type SnakeCasePrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadSnakeCase.
+func (k SnakeCasePrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseSnakeCasePrimaryKey parses key of the columns of the primary key of
+// 'snake_cases' in order, such as the key of a mutation.
+func ParseSnakeCasePrimaryKey(key spanner.Key) (SnakeCasePrimaryKey, error) {
+	var k SnakeCasePrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'snake_cases' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'id' of the key of 'snake_cases' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of sc.
+func (sc *SnakeCase) PrimaryKey() SnakeCasePrimaryKey {
+	return SnakeCasePrimaryKey{
+		ID: sc.ID,
+	}
+}
+
+// FindSnakeCaseByKey gets a SnakeCase by the primary key of key.
+func FindSnakeCaseByKey(ctx context.Context, db YORODB, key SnakeCasePrimaryKey, opts ...*spanner.ReadOptions) (*SnakeCase, error) {
+	row, err := yoReadRow(ctx, db, "snake_cases", key.ToSpannerKey(), SnakeCaseColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindSnakeCaseByKey", "snake_cases", key.ToSpannerKey(), ErrSnakeCaseNotFound, nil)
+		}
+		return nil, newError("FindSnakeCaseByKey", "snake_cases", err)
+	}
+
+	sc, err := ScanSnakeCase(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindSnakeCaseByKey", "snake_cases", err)
+	}
+
+	return sc, nil
+}
+
+// DeleteSnakeCaseByKey returns a Mutation to delete the row of key from
+// 'snake_cases'.
+func DeleteSnakeCaseByKey(ctx context.Context, key SnakeCasePrimaryKey) *spanner.Mutation {
+	return spanner.Delete("snake_cases", key.ToSpannerKey())
+}
+
+// FindSnakeCasesByKeys retrieves the rows of keys from 'snake_cases' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindSnakeCasesByKeys(ctx context.Context, db YORODB, keys []SnakeCasePrimaryKey, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*SnakeCase, len(keys))
+	rows := yoRead(ctx, db, "snake_cases", "", spanner.KeySets(keySets...), SnakeCaseColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		sc, err := ScanSnakeCase(row)
+		if err != nil {
+			return err
+		}
+		byKey[sc.primaryKey().String()] = sc
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByKeys", "snake_cases", err)
+	}
+
+	res := make([]*SnakeCase, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if sc, ok := byKey[s]; ok {
+			res = append(res, sc)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListSnakeCasesPage retrieves a page of at most pageSize rows from 'snake_cases'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListSnakeCasesPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*SnakeCase, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesPage", "snake_cases", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesPage", "snake_cases", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT id, string_id, foo_bar_baz FROM snake_cases",
+		[]string{"id"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListSnakeCasesPage", stmt)()
+	res, err := ScanSnakeCases(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListSnakeCasesPage", "snake_cases", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListSnakeCasesPage", "snake_cases", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadSnakeCaseRange retrieves multiple rows from SnakeCase whose
+// 'id' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadSnakeCaseRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	var res []*SnakeCase
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "snake_cases", "", keys, SnakeCaseColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		sc, err := ScanSnakeCase(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, sc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCaseRange", "snake_cases", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the SnakeCase, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (sc *SnakeCase) primaryKey() spanner.Key {
+	return spanner.Key{sc.ID}
+}
+
+// Delete deletes the SnakeCase from the database.
+func (sc *SnakeCase) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("snake_cases", sc.primaryKey())
+}
+
+// SnakeCasesDelete returns Mutations to delete the rows from
+// 'snake_cases', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func SnakeCasesDelete(ctx context.Context, rows []*SnakeCase) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// SnakeCaseDescriptor describes 'snake_cases' for YORepository.
+var SnakeCaseDescriptor = &YODescriptor[SnakeCase, SnakeCasePrimaryKey]{
+	Table:       "snake_cases",
+	Columns:     SnakeCaseColumns(),
+	PrimaryKeys: SnakeCasePrimaryKeys(),
+	Key: func(row *SnakeCase) SnakeCasePrimaryKey {
+		return SnakeCasePrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: SnakeCasePrimaryKey.ToSpannerKey,
+	Scan:       ScanSnakeCase,
+	Find:       FindSnakeCaseByKey,
+	Read:       ReadSnakeCase,
+	Insert: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *SnakeCase) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewSnakeCaseRepository returns a YORepository of 'snake_cases' by client.
+func NewSnakeCaseRepository(client *spanner.Client) *YORepository[SnakeCase, SnakeCasePrimaryKey] {
+	return NewYORepository(client, SnakeCaseDescriptor)
+}
+
+// FindCompositePrimaryKeysByError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByError' (Error).
+func FindCompositePrimaryKeysByError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByError", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+		}
+
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, cpk)
+	}
+
+	return res, nil
+}
+
+// CountCompositePrimaryKeysByError returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByError.
+func CountCompositePrimaryKeysByError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByError", stmt, e)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// ReadCompositePrimaryKeysByError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'CompositePrimaryKeysByError' (Error).
+func ReadCompositePrimaryKeysByError(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	columns := []string{
+		"PKey1",
+		"PKey2",
+		"Error",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// CompositePrimaryKeysByErrorRow represents a row of index 'CompositePrimaryKeysByError' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByErrorRow struct {
+	Error int64  `spanner:"Error" json:"Error"` // Error
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
+
+// ReadCompositePrimaryKeysByErrorRows retrieves multiples rows from index 'CompositePrimaryKeysByError' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByErrorRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByErrorRow, error) {
+	var res []*CompositePrimaryKeysByErrorRow
+	columns := []string{
+		"Error",
+		"PKey1",
+		"PKey2",
+	}
+
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanCompositePrimaryKeysByErrorRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByErrorRows", "CompositePrimaryKeys", err)
+	}
+
+	return res, nil
+}
+
+// scanCompositePrimaryKeysByErrorRow decodes row having the columns of CompositePrimaryKeysByErrorRow in order.
+func scanCompositePrimaryKeysByErrorRow(row *spanner.Row) (*CompositePrimaryKeysByErrorRow, error) {
+	var r CompositePrimaryKeysByErrorRow
+	if err := row.Columns(&r.Error, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindCompositePrimaryKeysByErrorRows retrieves multiple rows from index 'CompositePrimaryKeysByError' as a
+// slice of CompositePrimaryKeysByErrorRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByErrorRows(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByErrorRow, error) {
+	const sqlstr = "SELECT " +
+		"Error, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByErrorRows", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*CompositePrimaryKeysByErrorRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByErrorRows", "CompositePrimaryKeys", err)
+		}
+
+		r, err := scanCompositePrimaryKeysByErrorRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByErrorRows", "CompositePrimaryKeys", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListCompositePrimaryKeysByErrorPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByError' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByErrorPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 string
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", err)
+		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError}",
+		[]string{"Error", "PKey1", "PKey2"},
+		[]bool{false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByErrorPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.Error, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByErrorPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
 }
 
-// newFullType_Decoder returns a decoder which reads a row from *spanner.Row
-// into FullType. The decoder is not goroutine-safe. Don't use it concurrently.
-func newFullType_Decoder(cols []string) func(*spanner.Row) (*FullType, error) {
-	customPtrs := map[string]interface{}{}
+// FindCompositePrimaryKeysByZError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByError2' (Error).
+func FindCompositePrimaryKeysByZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
+		"WHERE Error = @param0"
 
-	return func(row *spanner.Row) (*FullType, error) {
-		var ft FullType
-		ptrs, err := ft.columnsToPtrs(cols, customPtrs)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByZError", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
 		if err != nil {
-			return nil, err
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
 		}
 
-		if err := row.Columns(ptrs...); err != nil {
-			return nil, err
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
 		}
 
-		return &ft, nil
+		res = append(res, cpk)
 	}
-}
-
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (ft *FullType) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypeWritableColumns())
-	return spanner.Insert("FullTypes", FullTypeWritableColumns(), values)
-}
 
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (ft *FullType) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypeWritableColumns())
-	return spanner.Update("FullTypes", FullTypeWritableColumns(), values)
+	return res, nil
 }
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (ft *FullType) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypeWritableColumns())
-	return spanner.InsertOrUpdate("FullTypes", FullTypeWritableColumns(), values)
-}
+// CountCompositePrimaryKeysByZError returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByZError.
+func CountCompositePrimaryKeysByZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
+		"WHERE Error = @param0"
 
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (ft *FullType) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, FullTypePrimaryKeys()...)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
 
-	values, err := ft.columnsToValues(colsWithPKeys)
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByZError", stmt, e)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "FullType.UpdateColumns", "FullTypes", err)
+		return 0, newError("CountCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
 	}
 
-	return spanner.Update("FullTypes", colsWithPKeys, values), nil
+	return n, nil
 }
 
-// FindFullType gets a FullType by primary key
-func FindFullType(ctx context.Context, db YORODB, pKey string) (*FullType, error) {
-	key := spanner.Key{pKey}
-	row, err := db.ReadRow(ctx, "FullTypes", key, FullTypeColumns())
-	if err != nil {
-		return nil, newError("FindFullType", "FullTypes", err)
+// ReadCompositePrimaryKeysByZError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'CompositePrimaryKeysByError2' (Error).
+func ReadCompositePrimaryKeysByZError(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	columns := []string{
+		"PKey1",
+		"PKey2",
+		"Error",
+		"Z",
 	}
 
-	decoder := newFullType_Decoder(FullTypeColumns())
-	ft, err := decoder(row)
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError2", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindFullType", "FullTypes", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
 	}
 
-	return ft, nil
+	return res, nil
 }
 
-// ReadFullType retrieves multiples rows from FullType by KeySet as a slice.
-func ReadFullType(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
+// CompositePrimaryKeysByError2Row represents a row of index 'CompositePrimaryKeysByError2' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByError2Row struct {
+	Error int64  `spanner:"Error" json:"Error"` // Error
+	Z     string `spanner:"Z" json:"Z"`         // Z
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
 
-	decoder := newFullType_Decoder(FullTypeColumns())
+// ReadCompositePrimaryKeysByError2Rows retrieves multiples rows from index 'CompositePrimaryKeysByError2' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByError2Rows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError2Row, error) {
+	var res []*CompositePrimaryKeysByError2Row
+	columns := []string{
+		"Error",
+		"Z",
+		"PKey1",
+		"PKey2",
+	}
 
-	rows := db.Read(ctx, "FullTypes", keys, FullTypeColumns())
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError2", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
+		r, err := scanCompositePrimaryKeysByError2Row(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, ft)
+		res = append(res, r)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullType", "FullTypes", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError2Rows", "CompositePrimaryKeys", err)
 	}
 
 	return res, nil
 }
 
-// Delete deletes the FullType from the database.
-func (ft *FullType) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypePrimaryKeys())
-	return spanner.Delete("FullTypes", spanner.Key(values))
-}
+// scanCompositePrimaryKeysByError2Row decodes row having the columns of CompositePrimaryKeysByError2Row in order.
+func scanCompositePrimaryKeysByError2Row(row *spanner.Row) (*CompositePrimaryKeysByError2Row, error) {
+	var r CompositePrimaryKeysByError2Row
+	if err := row.Columns(&r.Error, &r.Z, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
 
-// GeneratedColumn represents a row from 'GeneratedColumns'.
-type GeneratedColumn struct {
-	ID        int64  `spanner:"ID" json:"ID"`               // ID
-	FirstName string `spanner:"FirstName" json:"FirstName"` // FirstName
-	LastName  string `spanner:"LastName" json:"LastName"`   // LastName
-	FullName  string `spanner:"FullName" json:"FullName"`   // FullName
+	return &r, nil
 }
 
-func GeneratedColumnPrimaryKeys() []string {
-	return []string{
-		"ID",
-	}
-}
+// FindCompositePrimaryKeysByError2Rows retrieves multiple rows from index 'CompositePrimaryKeysByError2' as a
+// slice of CompositePrimaryKeysByError2Row by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByError2Rows(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError2Row, error) {
+	const sqlstr = "SELECT " +
+		"Error, Z, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
+		"WHERE Error = @param0"
 
-func GeneratedColumnColumns() []string {
-	return []string{
-		"ID",
-		"FirstName",
-		"LastName",
-		"FullName",
-	}
-}
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
 
-func GeneratedColumnWritableColumns() []string {
-	return []string{
-		"ID",
-		"FirstName",
-		"LastName",
-	}
-}
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByError2Rows", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
 
-func (gc *GeneratedColumn) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		if val, ok := customPtrs[col]; ok {
-			ret = append(ret, val)
-			continue
+	res := []*CompositePrimaryKeysByError2Row{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByError2Rows", "CompositePrimaryKeys", err)
 		}
 
-		switch col {
-		case "ID":
-			ret = append(ret, &gc.ID)
-		case "FirstName":
-			ret = append(ret, &gc.FirstName)
-		case "LastName":
-			ret = append(ret, &gc.LastName)
-		case "FullName":
-			ret = append(ret, &gc.FullName)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
+		r, err := scanCompositePrimaryKeysByError2Row(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError2Rows", "CompositePrimaryKeys", err)
 		}
-	}
-	return ret, nil
-}
 
-func (gc *GeneratedColumn) columnsToValues(cols []string) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		switch col {
-		case "ID":
-			ret = append(ret, gc.ID)
-		case "FirstName":
-			ret = append(ret, gc.FirstName)
-		case "LastName":
-			ret = append(ret, gc.LastName)
-		case "FullName":
-			ret = append(ret, gc.FullName)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
-		}
+		res = append(res, r)
 	}
 
-	return ret, nil
+	return res, nil
 }
 
-// newGeneratedColumn_Decoder returns a decoder which reads a row from *spanner.Row
-// into GeneratedColumn. The decoder is not goroutine-safe. Don't use it concurrently.
-func newGeneratedColumn_Decoder(cols []string) func(*spanner.Row) (*GeneratedColumn, error) {
-	customPtrs := map[string]interface{}{}
+// ListCompositePrimaryKeysByZErrorPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByError2' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByZErrorPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
 
-	return func(row *spanner.Row) (*GeneratedColumn, error) {
-		var gc GeneratedColumn
-		ptrs, err := gc.columnsToPtrs(cols, customPtrs)
-		if err != nil {
-			return nil, err
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 string
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", err)
 		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2}",
+		[]string{"Error", "PKey1", "PKey2"},
+		[]bool{false, false, false},
+		key, pageSize)
 
-		if err := row.Columns(ptrs...); err != nil {
-			return nil, err
-		}
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByZErrorPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
 
-		return &gc, nil
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.Error, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByZErrorPage", "CompositePrimaryKeys", err)
 	}
-}
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (gc *GeneratedColumn) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := gc.columnsToValues(GeneratedColumnWritableColumns())
-	return spanner.Insert("GeneratedColumns", GeneratedColumnWritableColumns(), values)
+	return res, token, nil
 }
 
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (gc *GeneratedColumn) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := gc.columnsToValues(GeneratedColumnWritableColumns())
-	return spanner.Update("GeneratedColumns", GeneratedColumnWritableColumns(), values)
-}
+// FindCompositePrimaryKeysByYZError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByError3' (Error).
+func FindCompositePrimaryKeysByYZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
+		"WHERE Error = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByYZError", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
+		}
+
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
+		}
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (gc *GeneratedColumn) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := gc.columnsToValues(GeneratedColumnWritableColumns())
-	return spanner.InsertOrUpdate("GeneratedColumns", GeneratedColumnWritableColumns(), values)
+		res = append(res, cpk)
+	}
+
+	return res, nil
 }
 
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (gc *GeneratedColumn) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, GeneratedColumnPrimaryKeys()...)
+// CountCompositePrimaryKeysByYZError returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByYZError.
+func CountCompositePrimaryKeysByYZError(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
+		"WHERE Error = @param0"
 
-	values, err := gc.columnsToValues(colsWithPKeys)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
+
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByYZError", stmt, e)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "GeneratedColumn.UpdateColumns", "GeneratedColumns", err)
+		return 0, newError("CountCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
 	}
 
-	return spanner.Update("GeneratedColumns", colsWithPKeys, values), nil
+	return n, nil
 }
 
-// FindGeneratedColumn gets a GeneratedColumn by primary key
-func FindGeneratedColumn(ctx context.Context, db YORODB, id int64) (*GeneratedColumn, error) {
-	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "GeneratedColumns", key, GeneratedColumnColumns())
-	if err != nil {
-		return nil, newError("FindGeneratedColumn", "GeneratedColumns", err)
+// ReadCompositePrimaryKeysByYZError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'CompositePrimaryKeysByError3' (Error).
+func ReadCompositePrimaryKeysByYZError(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	columns := []string{
+		"PKey1",
+		"PKey2",
+		"Error",
+		"Y",
+		"Z",
 	}
 
-	decoder := newGeneratedColumn_Decoder(GeneratedColumnColumns())
-	gc, err := decoder(row)
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError3", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindGeneratedColumn", "GeneratedColumns", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByYZError", "CompositePrimaryKeys", err)
 	}
 
-	return gc, nil
+	return res, nil
 }
 
-// ReadGeneratedColumn retrieves multiples rows from GeneratedColumn by KeySet as a slice.
-func ReadGeneratedColumn(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*GeneratedColumn, error) {
-	var res []*GeneratedColumn
+// CompositePrimaryKeysByError3Row represents a row of index 'CompositePrimaryKeysByError3' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByError3Row struct {
+	Error int64  `spanner:"Error" json:"Error"` // Error
+	Y     string `spanner:"Y" json:"Y"`         // Y
+	Z     string `spanner:"Z" json:"Z"`         // Z
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
 
-	decoder := newGeneratedColumn_Decoder(GeneratedColumnColumns())
+// ReadCompositePrimaryKeysByError3Rows retrieves multiples rows from index 'CompositePrimaryKeysByError3' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByError3Rows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError3Row, error) {
+	var res []*CompositePrimaryKeysByError3Row
+	columns := []string{
+		"Error",
+		"Y",
+		"Z",
+		"PKey1",
+		"PKey2",
+	}
 
-	rows := db.Read(ctx, "GeneratedColumns", keys, GeneratedColumnColumns())
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByError3", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		gc, err := decoder(row)
+		r, err := scanCompositePrimaryKeysByError3Row(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, gc)
+		res = append(res, r)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadGeneratedColumn", "GeneratedColumns", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError3Rows", "CompositePrimaryKeys", err)
 	}
 
 	return res, nil
 }
 
-// Delete deletes the GeneratedColumn from the database.
-func (gc *GeneratedColumn) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := gc.columnsToValues(GeneratedColumnPrimaryKeys())
-	return spanner.Delete("GeneratedColumns", spanner.Key(values))
-}
+// scanCompositePrimaryKeysByError3Row decodes row having the columns of CompositePrimaryKeysByError3Row in order.
+func scanCompositePrimaryKeysByError3Row(row *spanner.Row) (*CompositePrimaryKeysByError3Row, error) {
+	var r CompositePrimaryKeysByError3Row
+	if err := row.Columns(&r.Error, &r.Y, &r.Z, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
 
-// Item represents a row from 'Items'.
-type Item struct {
-	ID    int64 `spanner:"ID" json:"ID"`       // ID
-	Price int64 `spanner:"Price" json:"Price"` // Price
+	return &r, nil
 }
 
-func ItemPrimaryKeys() []string {
-	return []string{
-		"ID",
-	}
-}
+// FindCompositePrimaryKeysByError3Rows retrieves multiple rows from index 'CompositePrimaryKeysByError3' as a
+// slice of CompositePrimaryKeysByError3Row by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByError3Rows(ctx context.Context, db YORODB, e int64, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByError3Row, error) {
+	const sqlstr = "SELECT " +
+		"Error, Y, Z, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
+		"WHERE Error = @param0"
 
-func ItemColumns() []string {
-	return []string{
-		"ID",
-		"Price",
-	}
-}
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = e
 
-func ItemWritableColumns() []string {
-	return []string{
-		"ID",
-		"Price",
-	}
-}
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByError3Rows", stmt, e)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
 
-func (i *Item) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		if val, ok := customPtrs[col]; ok {
-			ret = append(ret, val)
-			continue
+	res := []*CompositePrimaryKeysByError3Row{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByError3Rows", "CompositePrimaryKeys", err)
 		}
 
-		switch col {
-		case "ID":
-			ret = append(ret, &i.ID)
-		case "Price":
-			ret = append(ret, &i.Price)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
+		r, err := scanCompositePrimaryKeysByError3Row(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError3Rows", "CompositePrimaryKeys", err)
 		}
+
+		res = append(res, r)
 	}
-	return ret, nil
+
+	return res, nil
 }
 
-func (i *Item) columnsToValues(cols []string) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		switch col {
-		case "ID":
-			ret = append(ret, i.ID)
-		case "Price":
-			ret = append(ret, i.Price)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
+// ListCompositePrimaryKeysByYZErrorPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByError3' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByYZErrorPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 string
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", err)
 		}
+		key = []interface{}{k0, k1, k2}
 	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3}",
+		[]string{"Error", "PKey1", "PKey2"},
+		[]bool{false, false, false},
+		key, pageSize)
 
-	return ret, nil
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByYZErrorPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.Error, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByYZErrorPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
 }
 
-// newItem_Decoder returns a decoder which reads a row from *spanner.Row
-// into Item. The decoder is not goroutine-safe. Don't use it concurrently.
-func newItem_Decoder(cols []string) func(*spanner.Row) (*Item, error) {
-	customPtrs := map[string]interface{}{}
+// FindCompositePrimaryKeysByXY retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+//
+// Generated from index 'CompositePrimaryKeysByXY' (X, Y).
+func FindCompositePrimaryKeysByXY(ctx context.Context, db YORODB, x string, y string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0 AND Y = @param1"
 
-	return func(row *spanner.Row) (*Item, error) {
-		var i Item
-		ptrs, err := i.columnsToPtrs(cols, customPtrs)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+	stmt.Params["param1"] = y
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByXY", stmt, x, y)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
 		if err != nil {
-			return nil, err
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
 		}
 
-		if err := row.Columns(ptrs...); err != nil {
-			return nil, err
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
 		}
 
-		return &i, nil
+		res = append(res, cpk)
 	}
-}
-
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (i *Item) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := i.columnsToValues(ItemWritableColumns())
-	return spanner.Insert("Items", ItemWritableColumns(), values)
-}
 
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (i *Item) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := i.columnsToValues(ItemWritableColumns())
-	return spanner.Update("Items", ItemWritableColumns(), values)
+	return res, nil
 }
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (i *Item) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := i.columnsToValues(ItemWritableColumns())
-	return spanner.InsertOrUpdate("Items", ItemWritableColumns(), values)
-}
+// CountCompositePrimaryKeysByXY returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByXY.
+func CountCompositePrimaryKeysByXY(ctx context.Context, db YORODB, x string, y string, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0 AND Y = @param1"
 
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (i *Item) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, ItemPrimaryKeys()...)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+	stmt.Params["param1"] = y
 
-	values, err := i.columnsToValues(colsWithPKeys)
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByXY", stmt, x, y)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "Item.UpdateColumns", "Items", err)
+		return 0, newError("CountCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
 	}
 
-	return spanner.Update("Items", colsWithPKeys, values), nil
+	return n, nil
 }
 
-// FindItem gets a Item by primary key
-func FindItem(ctx context.Context, db YORODB, id int64) (*Item, error) {
-	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "Items", key, ItemColumns())
-	if err != nil {
-		return nil, newError("FindItem", "Items", err)
+// ReadCompositePrimaryKeysByXY retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'CompositePrimaryKeysByXY' (X, Y).
+func ReadCompositePrimaryKeysByXY(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	var res []*CompositePrimaryKey
+	columns := []string{
+		"PKey1",
+		"PKey2",
+		"X",
+		"Y",
 	}
 
-	decoder := newItem_Decoder(ItemColumns())
-	i, err := decoder(row)
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByXY", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, cpk)
+
+		return nil
+	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindItem", "Items", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
 	}
 
-	return i, nil
+	return res, nil
 }
 
-// ReadItem retrieves multiples rows from Item by KeySet as a slice.
-func ReadItem(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*Item, error) {
-	var res []*Item
+// CompositePrimaryKeysByXYRow represents a row of index 'CompositePrimaryKeysByXY' of 'CompositePrimaryKeys',
+// which has the index key, the storing columns and the primary key.
+type CompositePrimaryKeysByXYRow struct {
+	X     string `spanner:"X" json:"X"`         // X
+	Y     string `spanner:"Y" json:"Y"`         // Y
+	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
+	PKey2 int64  `spanner:"PKey2" json:"PKey2"` // PKey2
+}
 
-	decoder := newItem_Decoder(ItemColumns())
+// ReadCompositePrimaryKeysByXYRows retrieves multiples rows from index 'CompositePrimaryKeysByXY' by
+// KeySet as a slice. This reads only the index and never reads 'CompositePrimaryKeys'.
+func ReadCompositePrimaryKeysByXYRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByXYRow, error) {
+	var res []*CompositePrimaryKeysByXYRow
+	columns := []string{
+		"X",
+		"Y",
+		"PKey1",
+		"PKey2",
+	}
 
-	rows := db.Read(ctx, "Items", keys, ItemColumns())
+	rows := yoRead(ctx, db, "CompositePrimaryKeys", "CompositePrimaryKeysByXY", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		i, err := decoder(row)
+		r, err := scanCompositePrimaryKeysByXYRow(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, i)
+		res = append(res, r)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadItem", "Items", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByXYRows", "CompositePrimaryKeys", err)
 	}
 
 	return res, nil
 }
 
-// Delete deletes the Item from the database.
-func (i *Item) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := i.columnsToValues(ItemPrimaryKeys())
-	return spanner.Delete("Items", spanner.Key(values))
-}
+// scanCompositePrimaryKeysByXYRow decodes row having the columns of CompositePrimaryKeysByXYRow in order.
+func scanCompositePrimaryKeysByXYRow(row *spanner.Row) (*CompositePrimaryKeysByXYRow, error) {
+	var r CompositePrimaryKeysByXYRow
+	if err := row.Columns(&r.X, &r.Y, &r.PKey1, &r.PKey2); err != nil {
+		return nil, err
+	}
 
-// MaxLength represents a row from 'MaxLengths'.
-type MaxLength struct {
-	MaxString string `spanner:"MaxString" json:"MaxString"` // MaxString
-	MaxBytes  []byte `spanner:"MaxBytes" json:"MaxBytes"`   // MaxBytes
+	return &r, nil
 }
 
-func MaxLengthPrimaryKeys() []string {
-	return []string{
-		"MaxString",
-	}
-}
+// FindCompositePrimaryKeysByXYRows retrieves multiple rows from index 'CompositePrimaryKeysByXY' as a
+// slice of CompositePrimaryKeysByXYRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'CompositePrimaryKeys'.
+func FindCompositePrimaryKeysByXYRows(ctx context.Context, db YORODB, x string, y string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKeysByXYRow, error) {
+	const sqlstr = "SELECT " +
+		"X, Y, PKey1, PKey2 " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0 AND Y = @param1"
 
-func MaxLengthColumns() []string {
-	return []string{
-		"MaxString",
-		"MaxBytes",
-	}
-}
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+	stmt.Params["param1"] = y
 
-func MaxLengthWritableColumns() []string {
-	return []string{
-		"MaxString",
-		"MaxBytes",
-	}
-}
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByXYRows", stmt, x, y)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
 
-func (ml *MaxLength) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		if val, ok := customPtrs[col]; ok {
-			ret = append(ret, val)
-			continue
+	res := []*CompositePrimaryKeysByXYRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByXYRows", "CompositePrimaryKeys", err)
 		}
 
-		switch col {
-		case "MaxString":
-			ret = append(ret, &ml.MaxString)
-		case "MaxBytes":
-			ret = append(ret, &ml.MaxBytes)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
+		r, err := scanCompositePrimaryKeysByXYRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByXYRows", "CompositePrimaryKeys", err)
 		}
+
+		res = append(res, r)
 	}
-	return ret, nil
+
+	return res, nil
 }
 
-func (ml *MaxLength) columnsToValues(cols []string) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		switch col {
-		case "MaxString":
-			ret = append(ret, ml.MaxString)
-		case "MaxBytes":
-			ret = append(ret, ml.MaxBytes)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
+// ListCompositePrimaryKeysByXYPage retrieves a page of at most pageSize rows from 'CompositePrimaryKeys'
+// ordered by the key of index 'CompositePrimaryKeysByXY' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListCompositePrimaryKeysByXYPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 string
+		var k2 string
+		var k3 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2, &k3); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", err)
 		}
+		key = []interface{}{k0, k1, k2, k3}
 	}
+	stmt := yoPageStatement("SELECT Id, PKey1, PKey2, Error, X, Y, Z FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY}",
+		[]string{"X", "Y", "PKey1", "PKey2"},
+		[]bool{false, false, false, false},
+		key, pageSize)
 
-	return ret, nil
+	// run query
+	defer yoLogQuery(ctx, "ListCompositePrimaryKeysByXYPage", stmt)()
+	res, err := ScanCompositePrimaryKeys(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.X, last.Y, last.PKey1, last.PKey2)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListCompositePrimaryKeysByXYPage", "CompositePrimaryKeys", err)
+	}
+
+	return res, token, nil
 }
 
-// newMaxLength_Decoder returns a decoder which reads a row from *spanner.Row
-// into MaxLength. The decoder is not goroutine-safe. Don't use it concurrently.
-func newMaxLength_Decoder(cols []string) func(*spanner.Row) (*MaxLength, error) {
-	customPtrs := map[string]interface{}{}
+// FindCompositePrimaryKeysByX retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+// The rows are ordered by the rest of the index key.
+//
+// Generated from a prefix of the key of index 'CompositePrimaryKeysByXY' (X, Y).
+func FindCompositePrimaryKeysByX(ctx context.Context, db YORODB, x string, opts ...*spanner.ReadOptions) ([]*CompositePrimaryKey, error) {
+	const sqlstr = "SELECT " +
+		"Id, PKey1, PKey2, Error, X, Y, Z " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0" +
+		" ORDER BY Y"
 
-	return func(row *spanner.Row) (*MaxLength, error) {
-		var ml MaxLength
-		ptrs, err := ml.columnsToPtrs(cols, customPtrs)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
+
+	// run query
+	defer yoLogQuery(ctx, "FindCompositePrimaryKeysByX", stmt, x)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*CompositePrimaryKey{}
+	for {
+		row, err := iter.Next()
 		if err != nil {
-			return nil, err
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindCompositePrimaryKeysByX", "CompositePrimaryKeys", err)
 		}
 
-		if err := row.Columns(ptrs...); err != nil {
-			return nil, err
+		cpk, err := ScanCompositePrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByX", "CompositePrimaryKeys", err)
 		}
 
-		return &ml, nil
+		res = append(res, cpk)
 	}
-}
-
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (ml *MaxLength) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := ml.columnsToValues(MaxLengthWritableColumns())
-	return spanner.Insert("MaxLengths", MaxLengthWritableColumns(), values)
-}
 
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (ml *MaxLength) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := ml.columnsToValues(MaxLengthWritableColumns())
-	return spanner.Update("MaxLengths", MaxLengthWritableColumns(), values)
+	return res, nil
 }
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (ml *MaxLength) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := ml.columnsToValues(MaxLengthWritableColumns())
-	return spanner.InsertOrUpdate("MaxLengths", MaxLengthWritableColumns(), values)
-}
+// CountCompositePrimaryKeysByX returns the number of the rows of 'CompositePrimaryKeys' found by
+// FindCompositePrimaryKeysByX.
+func CountCompositePrimaryKeysByX(ctx context.Context, db YORODB, x string, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
+		"WHERE X = @param0"
 
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (ml *MaxLength) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, MaxLengthPrimaryKeys()...)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = x
 
-	values, err := ml.columnsToValues(colsWithPKeys)
+	// run query
+	defer yoLogQuery(ctx, "CountCompositePrimaryKeysByX", stmt, x)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "MaxLength.UpdateColumns", "MaxLengths", err)
+		return 0, newError("CountCompositePrimaryKeysByX", "CompositePrimaryKeys", err)
 	}
 
-	return spanner.Update("MaxLengths", colsWithPKeys, values), nil
+	return n, nil
 }
 
-// FindMaxLength gets a MaxLength by primary key
-func FindMaxLength(ctx context.Context, db YORODB, maxString string) (*MaxLength, error) {
-	key := spanner.Key{maxString}
-	row, err := db.ReadRow(ctx, "MaxLengths", key, MaxLengthColumns())
+// FindFullTypeByFTString retrieves a row from 'FullTypes' as a FullType.
+//
+// If no row is present with the given key, then an error is returned where
+// errors.Is(err, ErrNotFound) is true.
+//
+// Generated from unique index 'FullTypesByFTString' (FTString).
+func FindFullTypeByFTString(ctx context.Context, db YORODB, fTString string, opts ...*spanner.ReadOptions) (*FullType, error) {
+	const sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByFTString} " +
+		"WHERE FTString = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTString
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypeByFTString", stmt, fTString)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
 	if err != nil {
-		return nil, newError("FindMaxLength", "MaxLengths", err)
+		if err == iterator.Done {
+			return nil, newNotFoundError("FindFullTypeByFTString", "FullTypes", spanner.Key{fTString}, ErrFullTypeNotFound, nil)
+		}
+		return nil, newError("FindFullTypeByFTString", "FullTypes", err)
 	}
 
-	decoder := newMaxLength_Decoder(MaxLengthColumns())
-	ml, err := decoder(row)
+	ft, err := ScanFullType(row)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindMaxLength", "MaxLengths", err)
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypeByFTString", "FullTypes", err)
 	}
 
-	return ml, nil
+	return ft, nil
 }
 
-// ReadMaxLength retrieves multiples rows from MaxLength by KeySet as a slice.
-func ReadMaxLength(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*MaxLength, error) {
-	var res []*MaxLength
-
-	decoder := newMaxLength_Decoder(MaxLengthColumns())
+// ReadFullTypeByFTString retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from unique index 'FullTypesByFTString' (FTString).
+func ReadFullTypeByFTString(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+	columns := []string{
+		"PKey",
+		"FTString",
+	}
 
-	rows := db.Read(ctx, "MaxLengths", keys, MaxLengthColumns())
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByFTString", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		ml, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, ml)
+		res = append(res, ft)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadMaxLength", "MaxLengths", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypeByFTString", "FullTypes", err)
 	}
 
 	return res, nil
 }
 
-// Delete deletes the MaxLength from the database.
-func (ml *MaxLength) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := ml.columnsToValues(MaxLengthPrimaryKeys())
-	return spanner.Delete("MaxLengths", spanner.Key(values))
-}
-
-// OutOfOrderPrimaryKey represents a row from 'OutOfOrderPrimaryKeys'.
-type OutOfOrderPrimaryKey struct {
-	PKey1 string `spanner:"PKey1" json:"PKey1"` // PKey1
-	PKey2 string `spanner:"PKey2" json:"PKey2"` // PKey2
-	PKey3 string `spanner:"PKey3" json:"PKey3"` // PKey3
-}
-
-func OutOfOrderPrimaryKeyPrimaryKeys() []string {
-	return []string{
-		"PKey2",
-		"PKey1",
-		"PKey3",
-	}
-}
-
-func OutOfOrderPrimaryKeyColumns() []string {
-	return []string{
-		"PKey1",
-		"PKey2",
-		"PKey3",
-	}
-}
-
-func OutOfOrderPrimaryKeyWritableColumns() []string {
-	return []string{
-		"PKey1",
-		"PKey2",
-		"PKey3",
-	}
+// FullTypesByFTStringRow represents a row of index 'FullTypesByFTString' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByFTStringRow struct {
+	FTString string `spanner:"FTString" json:"FTString"` // FTString
+	PKey     string `spanner:"PKey" json:"PKey"`         // PKey
 }
 
-func (ooopk *OutOfOrderPrimaryKey) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		if val, ok := customPtrs[col]; ok {
-			ret = append(ret, val)
-			continue
-		}
-
-		switch col {
-		case "PKey1":
-			ret = append(ret, &ooopk.PKey1)
-		case "PKey2":
-			ret = append(ret, &ooopk.PKey2)
-		case "PKey3":
-			ret = append(ret, &ooopk.PKey3)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
-		}
+// ReadFullTypesByFTStringRows retrieves multiples rows from index 'FullTypesByFTString' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByFTStringRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByFTStringRow, error) {
+	var res []*FullTypesByFTStringRow
+	columns := []string{
+		"FTString",
+		"PKey",
 	}
-	return ret, nil
-}
 
-func (ooopk *OutOfOrderPrimaryKey) columnsToValues(cols []string) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		switch col {
-		case "PKey1":
-			ret = append(ret, ooopk.PKey1)
-		case "PKey2":
-			ret = append(ret, ooopk.PKey2)
-		case "PKey3":
-			ret = append(ret, ooopk.PKey3)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByFTString", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByFTStringRow(row)
+		if err != nil {
+			return err
 		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTStringRows", "FullTypes", err)
 	}
 
-	return ret, nil
+	return res, nil
 }
 
-// newOutOfOrderPrimaryKey_Decoder returns a decoder which reads a row from *spanner.Row
-// into OutOfOrderPrimaryKey. The decoder is not goroutine-safe. Don't use it concurrently.
-func newOutOfOrderPrimaryKey_Decoder(cols []string) func(*spanner.Row) (*OutOfOrderPrimaryKey, error) {
-	customPtrs := map[string]interface{}{}
+// scanFullTypesByFTStringRow decodes row having the columns of FullTypesByFTStringRow in order.
+func scanFullTypesByFTStringRow(row *spanner.Row) (*FullTypesByFTStringRow, error) {
+	var r FullTypesByFTStringRow
+	if err := row.Columns(&r.FTString, &r.PKey); err != nil {
+		return nil, err
+	}
 
-	return func(row *spanner.Row) (*OutOfOrderPrimaryKey, error) {
-		var ooopk OutOfOrderPrimaryKey
-		ptrs, err := ooopk.columnsToPtrs(cols, customPtrs)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := row.Columns(ptrs...); err != nil {
-			return nil, err
+// FindFullTypesByFTStringRow retrieves a row from index 'FullTypesByFTString' as a
+// FullTypesByFTStringRow by the index key. It selects only the columns covered by the
+// index, so that the query never joins back to 'FullTypes'.
+//
+// If no row is present with the given key, then an error is returned where
+// errors.Is(err, ErrNotFound) is true.
+func FindFullTypesByFTStringRow(ctx context.Context, db YORODB, fTString string, opts ...*spanner.ReadOptions) (*FullTypesByFTStringRow, error) {
+	const sqlstr = "SELECT " +
+		"FTString, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByFTString} " +
+		"WHERE FTString = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTString
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByFTStringRow", stmt, fTString)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("FindFullTypesByFTStringRow", "FullTypes", spanner.Key{fTString}, ErrFullTypeNotFound, nil)
 		}
+		return nil, newError("FindFullTypesByFTStringRow", "FullTypes", err)
+	}
 
-		return &ooopk, nil
+	r, err := scanFullTypesByFTStringRow(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTStringRow", "FullTypes", err)
 	}
-}
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (ooopk *OutOfOrderPrimaryKey) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := ooopk.columnsToValues(OutOfOrderPrimaryKeyWritableColumns())
-	return spanner.Insert("OutOfOrderPrimaryKeys", OutOfOrderPrimaryKeyWritableColumns(), values)
+	return r, nil
 }
 
-// Delete deletes the OutOfOrderPrimaryKey from the database.
-func (ooopk *OutOfOrderPrimaryKey) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := ooopk.columnsToValues(OutOfOrderPrimaryKeyPrimaryKeys())
-	return spanner.Delete("OutOfOrderPrimaryKeys", spanner.Key(values))
-}
+// ListFullTypesByFTStringPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByFTString' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTStringPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTStringPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
+	}
 
-// SnakeCase represents a row from 'snake_cases'.
-type SnakeCase struct {
-	ID        int64  `spanner:"id" json:"id"`                   // id
-	StringID  string `spanner:"string_id" json:"string_id"`     // string_id
-	FooBarBaz int64  `spanner:"foo_bar_baz" json:"foo_bar_baz"` // foo_bar_baz
-}
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTStringPage", "FullTypes", err)
+		}
+		key = []interface{}{k0, k1}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByFTString}",
+		[]string{"FTString", "PKey"},
+		[]bool{false, false},
+		key, pageSize)
 
-func SnakeCasePrimaryKeys() []string {
-	return []string{
-		"id",
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTStringPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesByFTStringPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
 	}
-}
 
-func SnakeCaseColumns() []string {
-	return []string{
-		"id",
-		"string_id",
-		"foo_bar_baz",
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTString, last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTStringPage", "FullTypes", err)
 	}
+
+	return res, token, nil
 }
 
-func SnakeCaseWritableColumns() []string {
-	return []string{
-		"id",
-		"string_id",
-		"foo_bar_baz",
+// FindFullTypesByFTIntFTTimestampNull retrieves multiple rows from 'FullTypes' as a slice of FullType.
+//
+// Generated from index 'FullTypesByInTimestampNull' (FTInt, FTTimestampNull).
+func FindFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
+
+	conds := make([]string, 2)
+	conds[0] = "FTInt = @param0"
+	if fTTimestampNull.IsNull() {
+		conds[1] = "FTTimestampNull IS NULL"
+	} else {
+		conds[1] = "FTTimestampNull = @param1"
 	}
-}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
 
-func (sc *SnakeCase) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		if val, ok := customPtrs[col]; ok {
-			ret = append(ret, val)
-			continue
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestampNull
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByFTIntFTTimestampNull", stmt, fTInt, fTTimestampNull)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FullType{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
 		}
 
-		switch col {
-		case "id":
-			ret = append(ret, &sc.ID)
-		case "string_id":
-			ret = append(ret, &sc.StringID)
-		case "foo_bar_baz":
-			ret = append(ret, &sc.FooBarBaz)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
 		}
+
+		res = append(res, ft)
 	}
-	return ret, nil
+
+	return res, nil
 }
 
-func (sc *SnakeCase) columnsToValues(cols []string) ([]interface{}, error) {
-	ret := make([]interface{}, 0, len(cols))
-	for _, col := range cols {
-		switch col {
-		case "id":
-			ret = append(ret, sc.ID)
-		case "string_id":
-			ret = append(ret, sc.StringID)
-		case "foo_bar_baz":
-			ret = append(ret, sc.FooBarBaz)
-		default:
-			return nil, fmt.Errorf("unknown column: %s", col)
-		}
+// CountFullTypesByFTIntFTTimestampNull returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTIntFTTimestampNull.
+func CountFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime, opts ...*spanner.ReadOptions) (int64, error) {
+	var sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
+
+	conds := make([]string, 2)
+	conds[0] = "FTInt = @param0"
+	if fTTimestampNull.IsNull() {
+		conds[1] = "FTTimestampNull IS NULL"
+	} else {
+		conds[1] = "FTTimestampNull = @param1"
 	}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
 
-	return ret, nil
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestampNull
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTIntFTTimestampNull", stmt, fTInt, fTTimestampNull)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+	}
+
+	return n, nil
 }
 
-// newSnakeCase_Decoder returns a decoder which reads a row from *spanner.Row
-// into SnakeCase. The decoder is not goroutine-safe. Don't use it concurrently.
-func newSnakeCase_Decoder(cols []string) func(*spanner.Row) (*SnakeCase, error) {
-	customPtrs := map[string]interface{}{}
+// ReadFullTypesByFTIntFTTimestampNull retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'FullTypesByInTimestampNull' (FTInt, FTTimestampNull).
+func ReadFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+	columns := []string{
+		"PKey",
+		"FTInt",
+		"FTTimestampNull",
+	}
 
-	return func(row *spanner.Row) (*SnakeCase, error) {
-		var sc SnakeCase
-		ptrs, err := sc.columnsToPtrs(cols, customPtrs)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByInTimestampNull", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
 		if err != nil {
-			return nil, err
-		}
-
-		if err := row.Columns(ptrs...); err != nil {
-			return nil, err
+			return err
 		}
+		res = append(res, ft)
 
-		return &sc, nil
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTTimestampNull", "FullTypes", err)
 	}
-}
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (sc *SnakeCase) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := sc.columnsToValues(SnakeCaseWritableColumns())
-	return spanner.Insert("snake_cases", SnakeCaseWritableColumns(), values)
+	return res, nil
 }
 
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (sc *SnakeCase) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := sc.columnsToValues(SnakeCaseWritableColumns())
-	return spanner.Update("snake_cases", SnakeCaseWritableColumns(), values)
+// FullTypesByInTimestampNullRow represents a row of index 'FullTypesByInTimestampNull' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByInTimestampNullRow struct {
+	FTInt           int64            `spanner:"FTInt" json:"FTInt"`                     // FTInt
+	FTTimestampNull spanner.NullTime `spanner:"FTTimestampNull" json:"FTTimestampNull"` // FTTimestampNull
+	PKey            string           `spanner:"PKey" json:"PKey"`                       // PKey
 }
 
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (sc *SnakeCase) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := sc.columnsToValues(SnakeCaseWritableColumns())
-	return spanner.InsertOrUpdate("snake_cases", SnakeCaseWritableColumns(), values)
-}
+// ReadFullTypesByInTimestampNullRows retrieves multiples rows from index 'FullTypesByInTimestampNull' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByInTimestampNullRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByInTimestampNullRow, error) {
+	var res []*FullTypesByInTimestampNullRow
+	columns := []string{
+		"FTInt",
+		"FTTimestampNull",
+		"PKey",
+	}
 
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (sc *SnakeCase) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, SnakeCasePrimaryKeys()...)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByInTimestampNull", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByInTimestampNullRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
 
-	values, err := sc.columnsToValues(colsWithPKeys)
+		return nil
+	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "SnakeCase.UpdateColumns", "snake_cases", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByInTimestampNullRows", "FullTypes", err)
 	}
 
-	return spanner.Update("snake_cases", colsWithPKeys, values), nil
+	return res, nil
 }
 
-// FindSnakeCase gets a SnakeCase by primary key
-func FindSnakeCase(ctx context.Context, db YORODB, id int64) (*SnakeCase, error) {
-	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "snake_cases", key, SnakeCaseColumns())
-	if err != nil {
-		return nil, newError("FindSnakeCase", "snake_cases", err)
+// scanFullTypesByInTimestampNullRow decodes row having the columns of FullTypesByInTimestampNullRow in order.
+func scanFullTypesByInTimestampNullRow(row *spanner.Row) (*FullTypesByInTimestampNullRow, error) {
+	var r FullTypesByInTimestampNullRow
+	if err := row.Columns(&r.FTInt, &r.FTTimestampNull, &r.PKey); err != nil {
+		return nil, err
 	}
 
-	decoder := newSnakeCase_Decoder(SnakeCaseColumns())
-	sc, err := decoder(row)
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindSnakeCase", "snake_cases", err)
+	return &r, nil
+}
+
+// FindFullTypesByInTimestampNullRows retrieves multiple rows from index 'FullTypesByInTimestampNull' as a
+// slice of FullTypesByInTimestampNullRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByInTimestampNullRows(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime, opts ...*spanner.ReadOptions) ([]*FullTypesByInTimestampNullRow, error) {
+	var sqlstr = "SELECT " +
+		"FTInt, FTTimestampNull, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
+
+	conds := make([]string, 2)
+	conds[0] = "FTInt = @param0"
+	if fTTimestampNull.IsNull() {
+		conds[1] = "FTTimestampNull IS NULL"
+	} else {
+		conds[1] = "FTTimestampNull = @param1"
 	}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
 
-	return sc, nil
-}
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestampNull
 
-// ReadSnakeCase retrieves multiples rows from SnakeCase by KeySet as a slice.
-func ReadSnakeCase(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*SnakeCase, error) {
-	var res []*SnakeCase
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByInTimestampNullRows", stmt, fTInt, fTTimestampNull)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
 
-	decoder := newSnakeCase_Decoder(SnakeCaseColumns())
+	res := []*FullTypesByInTimestampNullRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByInTimestampNullRows", "FullTypes", err)
+		}
 
-	rows := db.Read(ctx, "snake_cases", keys, SnakeCaseColumns())
-	err := rows.Do(func(row *spanner.Row) error {
-		sc, err := decoder(row)
+		r, err := scanFullTypesByInTimestampNullRow(row)
 		if err != nil {
-			return err
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByInTimestampNullRows", "FullTypes", err)
 		}
-		res = append(res, sc)
 
-		return nil
-	})
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCase", "snake_cases", err)
+		res = append(res, r)
 	}
 
 	return res, nil
 }
 
-// Delete deletes the SnakeCase from the database.
-func (sc *SnakeCase) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := sc.columnsToValues(SnakeCasePrimaryKeys())
-	return spanner.Delete("snake_cases", spanner.Key(values))
-}
-
-// FindCompositePrimaryKeysByError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+// FindFullTypesByFTInt retrieves multiple rows from 'FullTypes' as a slice of FullType.
+// The rows are ordered by the rest of the index key.
 //
-// Generated from index 'CompositePrimaryKeysByError'.
-func FindCompositePrimaryKeysByError(ctx context.Context, db YORODB, e int64) ([]*CompositePrimaryKey, error) {
+// Generated from a prefix of the key of index 'FullTypesByInTimestampNull' (FTInt, FTTimestampNull).
+func FindFullTypesByFTInt(ctx context.Context, db YORODB, fTInt int64, opts ...*spanner.ReadOptions) ([]*FullType, error) {
 	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError} " +
-		"WHERE Error = @param0"
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} " +
+		"WHERE FTInt = @param0" +
+		" ORDER BY FTTimestampNull"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = e
-
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+	stmt.Params["param0"] = fTInt
 
 	// run query
-	YOLog(ctx, sqlstr, e)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByFTInt", stmt, fTInt)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
-	res := []*CompositePrimaryKey{}
+	res := []*FullType{}
 	for {
 		row, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+			return nil, newError("FindFullTypesByFTInt", "FullTypes", err)
 		}
 
-		cpk, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTInt", "FullTypes", err)
 		}
 
-		res = append(res, cpk)
+		res = append(res, ft)
 	}
 
 	return res, nil
 }
 
-// ReadCompositePrimaryKeysByError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'CompositePrimaryKeysByError'.
-func ReadCompositePrimaryKeysByError(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
-	columns := []string{
-		"PKey1",
-		"PKey2",
-		"Error",
-	}
-
-	decoder := newCompositePrimaryKey_Decoder(columns)
+// CountFullTypesByFTInt returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTInt.
+func CountFullTypesByFTInt(ctx context.Context, db YORODB, fTInt int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} " +
+		"WHERE FTInt = @param0"
 
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByError", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
-		if err != nil {
-			return err
-		}
-		res = append(res, cpk)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
 
-		return nil
-	})
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTInt", stmt, fTInt)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByError", "CompositePrimaryKeys", err)
+		return 0, newError("CountFullTypesByFTInt", "FullTypes", err)
 	}
 
-	return res, nil
+	return n, nil
 }
 
-// FindCompositePrimaryKeysByZError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+// FindFullTypesByFTIntFTDate retrieves multiple rows from 'FullTypes' as a slice of FullType.
 //
-// Generated from index 'CompositePrimaryKeysByError2'.
-func FindCompositePrimaryKeysByZError(ctx context.Context, db YORODB, e int64) ([]*CompositePrimaryKey, error) {
+// Generated from index 'FullTypesByIntDate' (FTInt, FTDate).
+func FindFullTypesByFTIntFTDate(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date, opts ...*spanner.ReadOptions) ([]*FullType, error) {
 	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError2} " +
-		"WHERE Error = @param0"
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
+		"WHERE FTInt = @param0 AND FTDate = @param1"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = e
-
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTDate
 
 	// run query
-	YOLog(ctx, sqlstr, e)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByFTIntFTDate", stmt, fTInt, fTDate)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
-	res := []*CompositePrimaryKey{}
+	res := []*FullType{}
 	for {
 		row, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+			return nil, newError("FindFullTypesByFTIntFTDate", "FullTypes", err)
 		}
 
-		cpk, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTDate", "FullTypes", err)
 		}
 
-		res = append(res, cpk)
+		res = append(res, ft)
 	}
 
 	return res, nil
 }
 
-// ReadCompositePrimaryKeysByZError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+// CountFullTypesByFTIntFTDate returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTIntFTDate.
+func CountFullTypesByFTIntFTDate(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
+		"WHERE FTInt = @param0 AND FTDate = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTDate
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTIntFTDate", stmt, fTInt, fTDate)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTIntFTDate", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// ReadFullTypesByFTIntFTDate retrieves multiples rows from 'FullTypes' by KeySet as a slice.
 //
-// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'CompositePrimaryKeysByError2'.
-func ReadCompositePrimaryKeysByZError(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
+// Generated from index 'FullTypesByIntDate' (FTInt, FTDate).
+func ReadFullTypesByFTIntFTDate(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
 	columns := []string{
-		"PKey1",
-		"PKey2",
-		"Error",
-		"Z",
+		"PKey",
+		"FTInt",
+		"FTDate",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntDate", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTDate", "FullTypes", err)
 	}
 
-	decoder := newCompositePrimaryKey_Decoder(columns)
+	return res, nil
+}
+
+// FullTypesByIntDateRow represents a row of index 'FullTypesByIntDate' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByIntDateRow struct {
+	FTInt  int64      `spanner:"FTInt" json:"FTInt"`   // FTInt
+	FTDate civil.Date `spanner:"FTDate" json:"FTDate"` // FTDate
+	PKey   string     `spanner:"PKey" json:"PKey"`     // PKey
+}
+
+// ReadFullTypesByIntDateRows retrieves multiples rows from index 'FullTypesByIntDate' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByIntDateRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByIntDateRow, error) {
+	var res []*FullTypesByIntDateRow
+	columns := []string{
+		"FTInt",
+		"FTDate",
+		"PKey",
+	}
 
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByError2", keys, columns)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntDate", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
+		r, err := scanFullTypesByIntDateRow(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, cpk)
+		res = append(res, r)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByZError", "CompositePrimaryKeys", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByIntDateRows", "FullTypes", err)
 	}
 
 	return res, nil
 }
 
-// FindCompositePrimaryKeysByZYError retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
-//
-// Generated from index 'CompositePrimaryKeysByError3'.
-func FindCompositePrimaryKeysByZYError(ctx context.Context, db YORODB, e int64) ([]*CompositePrimaryKey, error) {
+// scanFullTypesByIntDateRow decodes row having the columns of FullTypesByIntDateRow in order.
+func scanFullTypesByIntDateRow(row *spanner.Row) (*FullTypesByIntDateRow, error) {
+	var r FullTypesByIntDateRow
+	if err := row.Columns(&r.FTInt, &r.FTDate, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByIntDateRows retrieves multiple rows from index 'FullTypesByIntDate' as a
+// slice of FullTypesByIntDateRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByIntDateRows(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date, opts ...*spanner.ReadOptions) ([]*FullTypesByIntDateRow, error) {
 	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByError3} " +
-		"WHERE Error = @param0"
+		"FTInt, FTDate, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
+		"WHERE FTInt = @param0 AND FTDate = @param1"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = e
-
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTDate
 
 	// run query
-	YOLog(ctx, sqlstr, e)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByIntDateRows", stmt, fTInt, fTDate)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
-	// load results
-	res := []*CompositePrimaryKey{}
+	res := []*FullTypesByIntDateRow{}
 	for {
 		row, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindCompositePrimaryKeysByZYError", "CompositePrimaryKeys", err)
+			return nil, newError("FindFullTypesByIntDateRows", "FullTypes", err)
 		}
 
-		cpk, err := decoder(row)
+		r, err := scanFullTypesByIntDateRow(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByZYError", "CompositePrimaryKeys", err)
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByIntDateRows", "FullTypes", err)
 		}
 
-		res = append(res, cpk)
+		res = append(res, r)
 	}
 
 	return res, nil
 }
 
-// ReadCompositePrimaryKeysByZYError retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'CompositePrimaryKeysByError3'.
-func ReadCompositePrimaryKeysByZYError(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
-	columns := []string{
-		"PKey1",
-		"PKey2",
-		"Error",
-		"Z",
-		"Y",
+// ListFullTypesByFTIntFTDatePage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByIntDate' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTIntFTDatePage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTDatePage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
 	}
 
-	decoder := newCompositePrimaryKey_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByError3", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
-		if err != nil {
-			return err
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 civil.Date
+		var k2 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTDatePage", "FullTypes", err)
 		}
-		res = append(res, cpk)
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate}",
+		[]string{"FTInt", "FTDate", "PKey"},
+		[]bool{false, false, false},
+		key, pageSize)
 
-		return nil
-	})
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTIntFTDatePage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesByFTIntFTDatePage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTInt, last.FTDate, last.PKey)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByZYError", "CompositePrimaryKeys", err)
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTIntFTDatePage", "FullTypes", err)
 	}
 
-	return res, nil
+	return res, token, nil
 }
 
-// FindCompositePrimaryKeysByXY retrieves multiple rows from 'CompositePrimaryKeys' as a slice of CompositePrimaryKey.
+// FindFullTypesByFTIntFTTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
 //
-// Generated from index 'CompositePrimaryKeysByXY'.
-func FindCompositePrimaryKeysByXY(ctx context.Context, db YORODB, x string, y string) ([]*CompositePrimaryKey, error) {
+// Generated from index 'FullTypesByIntTimestamp' (FTInt, FTTimestamp).
+func FindFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullType, error) {
 	const sqlstr = "SELECT " +
-		"Id, PKey1, PKey2, Error, X, Y, Z " +
-		"FROM CompositePrimaryKeys@{FORCE_INDEX=CompositePrimaryKeysByXY} " +
-		"WHERE X = @param0 AND Y = @param1"
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
+		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = x
-	stmt.Params["param1"] = y
-
-	decoder := newCompositePrimaryKey_Decoder(CompositePrimaryKeyColumns())
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestamp
 
 	// run query
-	YOLog(ctx, sqlstr, x, y)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByFTIntFTTimestamp", stmt, fTInt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
-	res := []*CompositePrimaryKey{}
+	res := []*FullType{}
 	for {
 		row, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
+			return nil, newError("FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
 		}
 
-		cpk, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
 		}
 
-		res = append(res, cpk)
+		res = append(res, ft)
 	}
 
 	return res, nil
 }
 
-// ReadCompositePrimaryKeysByXY retrieves multiples rows from 'CompositePrimaryKeys' by KeySet as a slice.
+// CountFullTypesByFTIntFTTimestamp returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTIntFTTimestamp.
+func CountFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
+		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestamp
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTIntFTTimestamp", stmt, fTInt, fTTimestamp)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTIntFTTimestamp", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// ReadFullTypesByFTIntFTTimestamp retrieves multiples rows from 'FullTypes' by KeySet as a slice.
 //
-// This does not retrieve all columns of 'CompositePrimaryKeys' because an index has only columns
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'CompositePrimaryKeysByXY'.
-func ReadCompositePrimaryKeysByXY(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*CompositePrimaryKey, error) {
-	var res []*CompositePrimaryKey
+// Generated from index 'FullTypesByIntTimestamp' (FTInt, FTTimestamp).
+func ReadFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
 	columns := []string{
-		"PKey1",
-		"PKey2",
-		"X",
-		"Y",
+		"PKey",
+		"FTInt",
+		"FTTimestamp",
 	}
 
-	decoder := newCompositePrimaryKey_Decoder(columns)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntTimestamp", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTTimestamp", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// FullTypesByIntTimestampRow represents a row of index 'FullTypesByIntTimestamp' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByIntTimestampRow struct {
+	FTInt       int64     `spanner:"FTInt" json:"FTInt"`             // FTInt
+	FTTimestamp time.Time `spanner:"FTTimestamp" json:"FTTimestamp"` // FTTimestamp
+	PKey        string    `spanner:"PKey" json:"PKey"`               // PKey
+}
 
-	rows := db.ReadUsingIndex(ctx, "CompositePrimaryKeys", "CompositePrimaryKeysByXY", keys, columns)
+// ReadFullTypesByIntTimestampRows retrieves multiples rows from index 'FullTypesByIntTimestamp' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByIntTimestampRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByIntTimestampRow, error) {
+	var res []*FullTypesByIntTimestampRow
+	columns := []string{
+		"FTInt",
+		"FTTimestamp",
+		"PKey",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntTimestamp", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		cpk, err := decoder(row)
+		r, err := scanFullTypesByIntTimestampRow(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, cpk)
+		res = append(res, r)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadCompositePrimaryKeysByXY", "CompositePrimaryKeys", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByIntTimestampRows", "FullTypes", err)
 	}
 
 	return res, nil
 }
 
-// FindFullTypeByFTString retrieves a row from 'FullTypes' as a FullType.
-//
-// If no row is present with the given key, then ReadRow returns an error where
-// spanner.ErrCode(err) is codes.NotFound.
-//
-// Generated from unique index 'FullTypesByFTString'.
-func FindFullTypeByFTString(ctx context.Context, db YORODB, fTString string) (*FullType, error) {
+// scanFullTypesByIntTimestampRow decodes row having the columns of FullTypesByIntTimestampRow in order.
+func scanFullTypesByIntTimestampRow(row *spanner.Row) (*FullTypesByIntTimestampRow, error) {
+	var r FullTypesByIntTimestampRow
+	if err := row.Columns(&r.FTInt, &r.FTTimestamp, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByIntTimestampRows retrieves multiple rows from index 'FullTypesByIntTimestamp' as a
+// slice of FullTypesByIntTimestampRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByIntTimestampRows(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullTypesByIntTimestampRow, error) {
 	const sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByFTString} " +
-		"WHERE FTString = @param0"
+		"FTInt, FTTimestamp, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
+		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTString
-
-	decoder := newFullType_Decoder(FullTypeColumns())
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestamp
 
 	// run query
-	YOLog(ctx, sqlstr, fTString)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByIntTimestampRows", stmt, fTInt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
-	row, err := iter.Next()
-	if err != nil {
-		if err == iterator.Done {
-			return nil, newErrorWithCode(codes.NotFound, "FindFullTypeByFTString", "FullTypes", err)
+	res := []*FullTypesByIntTimestampRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByIntTimestampRows", "FullTypes", err)
 		}
-		return nil, newError("FindFullTypeByFTString", "FullTypes", err)
-	}
 
-	ft, err := decoder(row)
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindFullTypeByFTString", "FullTypes", err)
+		r, err := scanFullTypesByIntTimestampRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByIntTimestampRows", "FullTypes", err)
+		}
+
+		res = append(res, r)
 	}
 
-	return ft, nil
+	return res, nil
 }
 
-// ReadFullTypeByFTString retrieves multiples rows from 'FullTypes' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'FullTypes' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'FullTypesByFTString'.
-func ReadFullTypeByFTString(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
-	columns := []string{
-		"PKey",
-		"FTString",
+// ListFullTypesByFTIntFTTimestampPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByIntTimestamp' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTIntFTTimestampPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTTimestampPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
 	}
 
-	decoder := newFullType_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByFTString", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
-		if err != nil {
-			return err
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 time.Time
+		var k2 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTTimestampPage", "FullTypes", err)
 		}
-		res = append(res, ft)
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp}",
+		[]string{"FTInt", "FTTimestamp", "PKey"},
+		[]bool{false, false, false},
+		key, pageSize)
 
-		return nil
-	})
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTIntFTTimestampPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypeByFTString", "FullTypes", err)
+		return nil, "", newError("ListFullTypesByFTIntFTTimestampPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
 	}
 
-	return res, nil
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTInt, last.FTTimestamp, last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTIntFTTimestampPage", "FullTypes", err)
+	}
+
+	return res, token, nil
 }
 
-// FindFullTypesByFTIntFTTimestampNull retrieves multiple rows from 'FullTypes' as a slice of FullType.
+// FindFullTypesByFTTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
 //
-// Generated from index 'FullTypesByInTimestampNull'.
-func FindFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime) ([]*FullType, error) {
-	var sqlstr = "SELECT " +
+// Generated from index 'FullTypesByTimestamp' (FTTimestamp).
+func FindFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	const sqlstr = "SELECT " +
 		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
-
-	conds := make([]string, 2)
-	conds[0] = "FTInt = @param0"
-	if fTTimestampNull.IsNull() {
-		conds[1] = "FTTimestampNull IS NULL"
-	} else {
-		conds[1] = "FTTimestampNull = @param1"
-	}
-	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
+		"WHERE FTTimestamp = @param0"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTInt
-	stmt.Params["param1"] = fTTimestampNull
-
-	decoder := newFullType_Decoder(FullTypeColumns())
+	stmt.Params["param0"] = fTTimestamp
 
 	// run query
-	YOLog(ctx, sqlstr, fTInt, fTTimestampNull)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByFTTimestamp", stmt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
@@ -1954,12 +11501,12 @@ func FindFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt i
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+			return nil, newError("FindFullTypesByFTTimestamp", "FullTypes", err)
 		}
 
-		ft, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTTimestamp", "FullTypes", err)
 		}
 
 		res = append(res, ft)
@@ -1968,26 +11515,43 @@ func FindFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt i
 	return res, nil
 }
 
-// ReadFullTypesByFTIntFTTimestampNull retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+// CountFullTypesByFTTimestamp returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTTimestamp.
+func CountFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time.Time, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
+		"WHERE FTTimestamp = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTTimestamp
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTTimestamp", stmt, fTTimestamp)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTTimestamp", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// ReadFullTypesByFTTimestamp retrieves multiples rows from 'FullTypes' by KeySet as a slice.
 //
 // This does not retrieve all columns of 'FullTypes' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'FullTypesByInTimestampNull'.
-func ReadFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
+// Generated from index 'FullTypesByTimestamp' (FTTimestamp).
+func ReadFullTypesByFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
 	var res []*FullType
 	columns := []string{
 		"PKey",
-		"FTInt",
-		"FTTimestampNull",
+		"FTTimestamp",
 	}
 
-	decoder := newFullType_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByInTimestampNull", keys, columns)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByTimestamp", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
 			return err
 		}
@@ -1996,256 +11560,374 @@ func ReadFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, keys sp
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTTimestamp", "FullTypes", err)
 	}
 
 	return res, nil
 }
 
-// FindFullTypesByFTIntFTDate retrieves multiple rows from 'FullTypes' as a slice of FullType.
-//
-// Generated from index 'FullTypesByIntDate'.
-func FindFullTypesByFTIntFTDate(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date) ([]*FullType, error) {
+// FullTypesByTimestampRow represents a row of index 'FullTypesByTimestamp' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByTimestampRow struct {
+	FTTimestamp time.Time `spanner:"FTTimestamp" json:"FTTimestamp"` // FTTimestamp
+	PKey        string    `spanner:"PKey" json:"PKey"`               // PKey
+}
+
+// ReadFullTypesByTimestampRows retrieves multiples rows from index 'FullTypesByTimestamp' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByTimestampRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByTimestampRow, error) {
+	var res []*FullTypesByTimestampRow
+	columns := []string{
+		"FTTimestamp",
+		"PKey",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByTimestamp", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByTimestampRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByTimestampRows", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// scanFullTypesByTimestampRow decodes row having the columns of FullTypesByTimestampRow in order.
+func scanFullTypesByTimestampRow(row *spanner.Row) (*FullTypesByTimestampRow, error) {
+	var r FullTypesByTimestampRow
+	if err := row.Columns(&r.FTTimestamp, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByTimestampRows retrieves multiple rows from index 'FullTypesByTimestamp' as a
+// slice of FullTypesByTimestampRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByTimestampRows(ctx context.Context, db YORODB, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullTypesByTimestampRow, error) {
 	const sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
-		"WHERE FTInt = @param0 AND FTDate = @param1"
+		"FTTimestamp, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
+		"WHERE FTTimestamp = @param0"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTInt
-	stmt.Params["param1"] = fTDate
-
-	decoder := newFullType_Decoder(FullTypeColumns())
+	stmt.Params["param0"] = fTTimestamp
 
 	// run query
-	YOLog(ctx, sqlstr, fTInt, fTDate)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByTimestampRows", stmt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
-	// load results
-	res := []*FullType{}
+	res := []*FullTypesByTimestampRow{}
 	for {
 		row, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindFullTypesByFTIntFTDate", "FullTypes", err)
+			return nil, newError("FindFullTypesByTimestampRows", "FullTypes", err)
 		}
 
-		ft, err := decoder(row)
+		r, err := scanFullTypesByTimestampRow(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTDate", "FullTypes", err)
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByTimestampRows", "FullTypes", err)
 		}
 
-		res = append(res, ft)
+		res = append(res, r)
 	}
 
 	return res, nil
 }
 
-// ReadFullTypesByFTIntFTDate retrieves multiples rows from 'FullTypes' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'FullTypes' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'FullTypesByIntDate'.
-func ReadFullTypesByFTIntFTDate(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
-	columns := []string{
-		"PKey",
-		"FTInt",
-		"FTDate",
+// ListFullTypesByFTTimestampPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByTimestamp' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTTimestampPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTTimestampPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
 	}
 
-	decoder := newFullType_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByIntDate", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
-		if err != nil {
-			return err
+	var key []interface{}
+	if pageToken != "" {
+		var k0 time.Time
+		var k1 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTTimestampPage", "FullTypes", err)
 		}
-		res = append(res, ft)
+		key = []interface{}{k0, k1}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp}",
+		[]string{"FTTimestamp", "PKey"},
+		[]bool{false, false},
+		key, pageSize)
 
-		return nil
-	})
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTTimestampPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTDate", "FullTypes", err)
+		return nil, "", newError("ListFullTypesByFTTimestampPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
 	}
 
-	return res, nil
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTTimestamp, last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTTimestampPage", "FullTypes", err)
+	}
+
+	return res, token, nil
 }
 
-// FindFullTypesByFTIntFTTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
+// FindSnakeCasesByStringIDFooBarBaz retrieves multiple rows from 'snake_cases' as a slice of SnakeCase.
 //
-// Generated from index 'FullTypesByIntTimestamp'.
-func FindFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time) ([]*FullType, error) {
+// Generated from index 'snake_cases_by_string_id' (string_id, foo_bar_baz).
+func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID string, fooBarBaz int64, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
 	const sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
-		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
+		"id, string_id, foo_bar_baz " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0 AND foo_bar_baz = @param1"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTInt
-	stmt.Params["param1"] = fTTimestamp
-
-	decoder := newFullType_Decoder(FullTypeColumns())
+	stmt.Params["param0"] = stringID
+	stmt.Params["param1"] = fooBarBaz
 
 	// run query
-	YOLog(ctx, sqlstr, fTInt, fTTimestamp)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindSnakeCasesByStringIDFooBarBaz", stmt, stringID, fooBarBaz)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
-	res := []*FullType{}
+	res := []*SnakeCase{}
 	for {
 		row, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
+			return nil, newError("FindSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
 		}
 
-		ft, err := decoder(row)
+		sc, err := ScanSnakeCase(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
+			return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
 		}
 
-		res = append(res, ft)
+		res = append(res, sc)
 	}
 
 	return res, nil
 }
 
-// ReadFullTypesByFTIntFTTimestamp retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+// CountSnakeCasesByStringIDFooBarBaz returns the number of the rows of 'snake_cases' found by
+// FindSnakeCasesByStringIDFooBarBaz.
+func CountSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID string, fooBarBaz int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0 AND foo_bar_baz = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = stringID
+	stmt.Params["param1"] = fooBarBaz
+
+	// run query
+	defer yoLogQuery(ctx, "CountSnakeCasesByStringIDFooBarBaz", stmt, stringID, fooBarBaz)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
+	}
+
+	return n, nil
+}
+
+// ReadSnakeCasesByStringIDFooBarBaz retrieves multiples rows from 'snake_cases' by KeySet as a slice.
 //
-// This does not retrieve all columns of 'FullTypes' because an index has only columns
+// This does not retrieve all columns of 'snake_cases' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'FullTypesByIntTimestamp'.
-func ReadFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
+// Generated from index 'snake_cases_by_string_id' (string_id, foo_bar_baz).
+func ReadSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
+	var res []*SnakeCase
 	columns := []string{
-		"PKey",
-		"FTInt",
-		"FTTimestamp",
+		"id",
+		"string_id",
+		"foo_bar_baz",
+	}
+
+	rows := yoRead(ctx, db, "snake_cases", "snake_cases_by_string_id", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		sc, err := ScanSnakeCase(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, sc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
 	}
 
-	decoder := newFullType_Decoder(columns)
+	return res, nil
+}
+
+// SnakeCasesByStringIDRow represents a row of index 'snake_cases_by_string_id' of 'snake_cases',
+// which has the index key, the storing columns and the primary key.
+type SnakeCasesByStringIDRow struct {
+	StringID  string `spanner:"string_id" json:"string_id"`     // string_id
+	FooBarBaz int64  `spanner:"foo_bar_baz" json:"foo_bar_baz"` // foo_bar_baz
+	ID        int64  `spanner:"id" json:"id"`                   // id
+}
+
+// ReadSnakeCasesByStringIDRows retrieves multiples rows from index 'snake_cases_by_string_id' by
+// KeySet as a slice. This reads only the index and never reads 'snake_cases'.
+func ReadSnakeCasesByStringIDRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*SnakeCasesByStringIDRow, error) {
+	var res []*SnakeCasesByStringIDRow
+	columns := []string{
+		"string_id",
+		"foo_bar_baz",
+		"id",
+	}
 
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByIntTimestamp", keys, columns)
+	rows := yoRead(ctx, db, "snake_cases", "snake_cases_by_string_id", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
+		r, err := scanSnakeCasesByStringIDRow(row)
 		if err != nil {
 			return err
 		}
-		res = append(res, ft)
+		res = append(res, r)
 
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTTimestamp", "FullTypes", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCasesByStringIDRows", "snake_cases", err)
 	}
 
 	return res, nil
 }
 
-// FindFullTypesByFTTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
-//
-// Generated from index 'FullTypesByTimestamp'.
-func FindFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time.Time) ([]*FullType, error) {
+// scanSnakeCasesByStringIDRow decodes row having the columns of SnakeCasesByStringIDRow in order.
+func scanSnakeCasesByStringIDRow(row *spanner.Row) (*SnakeCasesByStringIDRow, error) {
+	var r SnakeCasesByStringIDRow
+	if err := row.Columns(&r.StringID, &r.FooBarBaz, &r.ID); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindSnakeCasesByStringIDRows retrieves multiple rows from index 'snake_cases_by_string_id' as a
+// slice of SnakeCasesByStringIDRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'snake_cases'.
+func FindSnakeCasesByStringIDRows(ctx context.Context, db YORODB, stringID string, fooBarBaz int64, opts ...*spanner.ReadOptions) ([]*SnakeCasesByStringIDRow, error) {
 	const sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
-		"WHERE FTTimestamp = @param0"
+		"string_id, foo_bar_baz, id " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0 AND foo_bar_baz = @param1"
 
 	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTTimestamp
-
-	decoder := newFullType_Decoder(FullTypeColumns())
+	stmt.Params["param0"] = stringID
+	stmt.Params["param1"] = fooBarBaz
 
 	// run query
-	YOLog(ctx, sqlstr, fTTimestamp)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindSnakeCasesByStringIDRows", stmt, stringID, fooBarBaz)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
-	// load results
-	res := []*FullType{}
+	res := []*SnakeCasesByStringIDRow{}
 	for {
 		row, err := iter.Next()
 		if err != nil {
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindFullTypesByFTTimestamp", "FullTypes", err)
+			return nil, newError("FindSnakeCasesByStringIDRows", "snake_cases", err)
 		}
 
-		ft, err := decoder(row)
+		r, err := scanSnakeCasesByStringIDRow(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTTimestamp", "FullTypes", err)
+			return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByStringIDRows", "snake_cases", err)
 		}
 
-		res = append(res, ft)
+		res = append(res, r)
 	}
 
 	return res, nil
 }
 
-// ReadFullTypesByFTTimestamp retrieves multiples rows from 'FullTypes' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'FullTypes' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'FullTypesByTimestamp'.
-func ReadFullTypesByFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
-	columns := []string{
-		"PKey",
-		"FTTimestamp",
+// ListSnakeCasesByStringIDFooBarBazPage retrieves a page of at most pageSize rows from 'snake_cases'
+// ordered by the key of index 'snake_cases_by_string_id' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListSnakeCasesByStringIDFooBarBazPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*SnakeCase, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", fmt.Errorf("invalid page size %d", pageSize))
 	}
 
-	decoder := newFullType_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByTimestamp", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
-		if err != nil {
-			return err
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 int64
+		var k2 int64
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", err)
 		}
-		res = append(res, ft)
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT id, string_id, foo_bar_baz FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id}",
+		[]string{"string_id", "foo_bar_baz", "id"},
+		[]bool{false, false, false},
+		key, pageSize)
 
-		return nil
-	})
+	// run query
+	defer yoLogQuery(ctx, "ListSnakeCasesByStringIDFooBarBazPage", stmt)()
+	res, err := ScanSnakeCases(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTTimestamp", "FullTypes", err)
+		return nil, "", newError("ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
 	}
 
-	return res, nil
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.StringID, last.FooBarBaz, last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListSnakeCasesByStringIDFooBarBazPage", "snake_cases", err)
+	}
+
+	return res, token, nil
 }
 
-// FindSnakeCasesByStringIDFooBarBaz retrieves multiple rows from 'snake_cases' as a slice of SnakeCase.
+// FindSnakeCasesByStringID retrieves multiple rows from 'snake_cases' as a slice of SnakeCase.
+// The rows are ordered by the rest of the index key.
 //
-// Generated from index 'snake_cases_by_string_id'.
-func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID string, fooBarBaz int64) ([]*SnakeCase, error) {
+// Generated from a prefix of the key of index 'snake_cases_by_string_id' (string_id, foo_bar_baz).
+func FindSnakeCasesByStringID(ctx context.Context, db YORODB, stringID string, opts ...*spanner.ReadOptions) ([]*SnakeCase, error) {
 	const sqlstr = "SELECT " +
 		"id, string_id, foo_bar_baz " +
 		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
-		"WHERE string_id = @param0 AND foo_bar_baz = @param1"
+		"WHERE string_id = @param0" +
+		" ORDER BY foo_bar_baz"
 
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = stringID
-	stmt.Params["param1"] = fooBarBaz
-
-	decoder := newSnakeCase_Decoder(SnakeCaseColumns())
 
 	// run query
-	YOLog(ctx, sqlstr, stringID, fooBarBaz)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindSnakeCasesByStringID", stmt, stringID)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
@@ -2256,12 +11938,12 @@ func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
+			return nil, newError("FindSnakeCasesByStringID", "snake_cases", err)
 		}
 
-		sc, err := decoder(row)
+		sc, err := ScanSnakeCase(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
+			return nil, newErrorWithCode(codes.Internal, "FindSnakeCasesByStringID", "snake_cases", err)
 		}
 
 		res = append(res, sc)
@@ -2270,38 +11952,24 @@ func FindSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, stringID
 	return res, nil
 }
 
-// ReadSnakeCasesByStringIDFooBarBaz retrieves multiples rows from 'snake_cases' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'snake_cases' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'snake_cases_by_string_id'.
-func ReadSnakeCasesByStringIDFooBarBaz(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*SnakeCase, error) {
-	var res []*SnakeCase
-	columns := []string{
-		"id",
-		"string_id",
-		"foo_bar_baz",
-	}
-
-	decoder := newSnakeCase_Decoder(columns)
+// CountSnakeCasesByStringID returns the number of the rows of 'snake_cases' found by
+// FindSnakeCasesByStringID.
+func CountSnakeCasesByStringID(ctx context.Context, db YORODB, stringID string, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM snake_cases@{FORCE_INDEX=snake_cases_by_string_id} " +
+		"WHERE string_id = @param0"
 
-	rows := db.ReadUsingIndex(ctx, "snake_cases", "snake_cases_by_string_id", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		sc, err := decoder(row)
-		if err != nil {
-			return err
-		}
-		res = append(res, sc)
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = stringID
 
-		return nil
-	})
+	// run query
+	defer yoLogQuery(ctx, "CountSnakeCasesByStringID", stmt, stringID)()
+	n, err := yoCount(ctx, db, stmt, opts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadSnakeCasesByStringIDFooBarBaz", "snake_cases", err)
+		return 0, newError("CountSnakeCasesByStringID", "snake_cases", err)
 	}
 
-	return res, nil
+	return n, nil
 }
 
 // YODB is the common interface for database operations.
@@ -2309,7 +11977,9 @@ type YODB interface {
 	YORODB
 }
 
-// YORODB is the common interface for database operations.
+// YORODB is the reader which all generated read functions take. It is
+// satisfied by *spanner.ReadOnlyTransaction, *spanner.ReadWriteTransaction
+// and *spanner.BatchReadOnlyTransaction, and by fakes of them in tests.
 type YORODB interface {
 	ReadRow(ctx context.Context, table string, key spanner.Key, columns []string) (*spanner.Row, error)
 	Read(ctx context.Context, table string, keys spanner.KeySet, columns []string) *spanner.RowIterator
@@ -2317,9 +11987,623 @@ type YORODB interface {
 	Query(ctx context.Context, statement spanner.Statement) *spanner.RowIterator
 }
 
+// yoOptionsRODB is the reader of YORODB taking the read and the query
+// options, which the generated read functions use when the options are given.
+// The transactions of the client satisfy it, but a YORODB such as a fake in
+// tests may not, and then the options are ignored except Limit.
+type yoOptionsRODB interface {
+	ReadWithOptions(ctx context.Context, table string, keys spanner.KeySet, columns []string, opts *spanner.ReadOptions) (ri *spanner.RowIterator)
+	QueryWithOptions(ctx context.Context, statement spanner.Statement, opts spanner.QueryOptions) *spanner.RowIterator
+}
+
+var (
+	_ YORODB = (*spanner.ReadOnlyTransaction)(nil)
+	_ YORODB = (*spanner.ReadWriteTransaction)(nil)
+	_ YORODB = (*spanner.BatchReadOnlyTransaction)(nil)
+
+	_ yoOptionsRODB = (*spanner.ReadOnlyTransaction)(nil)
+	_ yoOptionsRODB = (*spanner.ReadWriteTransaction)(nil)
+	_ yoOptionsRODB = (*spanner.BatchReadOnlyTransaction)(nil)
+)
+
+// YOStaleRead returns a single-use read-only transaction of client reading at
+// bound, such as spanner.ExactStaleness or spanner.MaxStaleness, which is
+// given to a generated read function as db. Its Timestamp returns the read
+// timestamp after the read.
+func YOStaleRead(client *spanner.Client, bound spanner.TimestampBound) *spanner.ReadOnlyTransaction {
+	return client.Single().WithTimestampBound(bound)
+}
+
+// yoReadOptions returns the options given to a generated reader, or nil if no
+// options are given. Only the first options are used.
+func yoReadOptions(opts []*spanner.ReadOptions) *spanner.ReadOptions {
+	if len(opts) == 0 {
+		return nil
+	}
+	return opts[0]
+}
+
+// YOPartitionOptions are the options of the generated partitioned reads and
+// queries in addition to spanner.PartitionOptions.
+type YOPartitionOptions struct {
+	// DataBoostEnabled executes the partitions by Data Boost, which runs
+	// analytical scans on independent compute resources instead of the
+	// provisioned ones of the instance.
+	DataBoostEnabled bool
+}
+
+// yoPartitionOptions returns the options given to a generated partitioned
+// read or query, or the zero value if no options are given. Only the first
+// options are used.
+func yoPartitionOptions(opts []*YOPartitionOptions) YOPartitionOptions {
+	if len(opts) == 0 || opts[0] == nil {
+		return YOPartitionOptions{}
+	}
+	return *opts[0]
+}
+
+// yoRead reads rows from table, or from index of table if index is not empty,
+// with opts if given.
+func yoRead(ctx context.Context, db YORODB, table, index string, keys spanner.KeySet, columns []string, opts []*spanner.ReadOptions) *spanner.RowIterator {
+	o := yoReadOptions(opts)
+	odb, ok := db.(yoOptionsRODB)
+	if o == nil || !ok {
+		if index == "" {
+			return db.Read(ctx, table, keys, columns)
+		}
+		return db.ReadUsingIndex(ctx, table, index, keys, columns)
+	}
+
+	ro := *o
+	ro.Index = index
+	return odb.ReadWithOptions(ctx, table, keys, columns, &ro)
+}
+
+// yoReadRow reads a row of key from table with opts if given. The error is
+// codes.NotFound if the row does not exist.
+func yoReadRow(ctx context.Context, db YORODB, table string, key spanner.Key, columns []string, opts []*spanner.ReadOptions) (*spanner.Row, error) {
+	if yoReadOptions(opts) == nil {
+		return db.ReadRow(ctx, table, key, columns)
+	}
+
+	iter := yoRead(ctx, db, table, "", key, columns, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, status.Errorf(codes.NotFound, "row not found(Table: %v, PrimaryKey: %v)", table, key)
+	}
+	return row, err
+}
+
+// yoQueryOptionsKey is the context key of the query options given by
+// YOWithQueryOptions.
+type yoQueryOptionsKey struct{}
+
+// YOWithQueryOptions returns a context whose generated queries are run with
+// opts, such as the optimizer version and statistics package of Options,
+// which are not in spanner.ReadOptions. The Priority, the RequestTag and the
+// DataBoostEnabled of the read options given to the functions take
+// precedence over the ones of opts.
+func YOWithQueryOptions(ctx context.Context, opts spanner.QueryOptions) context.Context {
+	return context.WithValue(ctx, yoQueryOptionsKey{}, opts)
+}
+
+// yoQuery runs stmt with opts if given. The Limit of opts limits the number
+// of rows, and the Priority, the RequestTag and the DataBoostEnabled are
+// passed to the query along with the query options of ctx.
+func yoQuery(ctx context.Context, db YORODB, stmt spanner.Statement, opts []*spanner.ReadOptions) *spanner.RowIterator {
+	o := yoReadOptions(opts)
+	if o != nil && o.Limit > 0 {
+		stmt.SQL += fmt.Sprintf(" LIMIT %d", o.Limit)
+	}
+	qo, hasQueryOptions := ctx.Value(yoQueryOptionsKey{}).(spanner.QueryOptions)
+	odb, ok := db.(yoOptionsRODB)
+	if !ok || o == nil && !hasQueryOptions {
+		return db.Query(ctx, stmt)
+	}
+
+	if o != nil {
+		if o.Priority != 0 {
+			qo.Priority = o.Priority
+		}
+		if o.RequestTag != "" {
+			qo.RequestTag = o.RequestTag
+		}
+		if o.DataBoostEnabled {
+			qo.DataBoostEnabled = true
+		}
+	}
+	return odb.QueryWithOptions(ctx, stmt, qo)
+}
+
+// yoClone returns a copy of v if it is a slice, so that the elements of v
+// modified in place are detected by comparing v with the copy.
+func yoClone[T any](v T) T {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice || rv.IsNil() {
+		return v
+	}
+
+	c := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+	reflect.Copy(c, rv)
+	return c.Interface().(T)
+}
+
 // YOLog provides the log func used by generated queries.
 var YOLog = func(context.Context, string, ...interface{}) {}
 
+// YOWarn provides the warning func used by generated finders, such as when
+// NULL is given to a finder of a null-filtered index, which never has the rows
+// having NULL in its key.
+var YOWarn = func(context.Context, string, ...interface{}) {}
+
+// YOLogger is the structured logger of the statements run by generated
+// functions. Each statement is logged at debug level with the method, the SQL,
+// the parameters and the latency after it finishes. It is nil by default,
+// which disables the logging.
+var YOLogger *slog.Logger
+
+// YORedactParams replaces the values of the parameters logged by YOLogger
+// with "REDACTED", such as when the values contain personal data.
+var YORedactParams = false
+
+// yoLogQuery logs stmt by YOLog, and returns the func logging stmt by YOLogger
+// with the latency since the call. It is deferred by generated functions.
+func yoLogQuery(ctx context.Context, method string, stmt spanner.Statement, args ...interface{}) func() {
+	YOLog(ctx, stmt.SQL, args...)
+
+	logger := YOLogger
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		names := make([]string, 0, len(stmt.Params))
+		for name := range stmt.Params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		params := make([]any, 0, len(names))
+		for _, name := range names {
+			if YORedactParams {
+				params = append(params, slog.String(name, "REDACTED"))
+			} else {
+				params = append(params, slog.Any(name, stmt.Params[name]))
+			}
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "yo: run statement",
+			slog.String("method", method),
+			slog.String("sql", stmt.SQL),
+			slog.Group("params", params...),
+			slog.Duration("latency", time.Since(start)),
+		)
+	}
+}
+
+// yoQuoteIdentifier quotes name as an identifier of a query, so that the
+// reserved keywords can be used as the names of columns.
+func yoQuoteIdentifier(name string) string {
+	return "`" + name + "`"
+}
+
+// yoBindParam binds v to params, and returns the placeholder of it in a query.
+func yoBindParam(params map[string]interface{}, name string, v interface{}) string {
+	params[name] = v
+	return "@" + name
+}
+
+// YOPredicate is a condition on a column used by generated query builders.
+// It is created only by the typed predicate constructors of the columns, so
+// that the column name is always valid and the value is always passed as a
+// query parameter.
+type YOPredicate struct {
+	column string
+	op     string
+	value  interface{}
+}
+
+// yoStatement builds a statement to select cols from table where all preds
+// are satisfied, sorted by orders and limited to limit rows if limit is
+// positive. The values of preds are bound to the parameters in the same manner
+// as the generated finders.
+func yoStatement(cols, table string, preds []YOPredicate, orders []string, limit int) spanner.Statement {
+	sqlstr := "SELECT " + cols + " FROM " + table
+	params := make(map[string]interface{}, len(preds))
+
+	conds := yoConditions(preds, params)
+	if len(conds) != 0 {
+		sqlstr += " WHERE " + strings.Join(conds, " AND ")
+	}
+	if len(orders) != 0 {
+		sqlstr += " ORDER BY " + strings.Join(orders, ", ")
+	}
+	if limit > 0 {
+		sqlstr += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	return spanner.Statement{SQL: sqlstr, Params: params}
+}
+
+// yoConditions returns the conditions of preds, and binds their values to
+// param0, param1, ... in params.
+func yoConditions(preds []YOPredicate, params map[string]interface{}) []string {
+	conds := make([]string, 0, len(preds))
+	for i, p := range preds {
+		name := fmt.Sprintf("param%d", i)
+		switch p.op {
+		case "IS NULL", "IS NOT NULL":
+			conds = append(conds, p.column+" "+p.op)
+		case "IN":
+			conds = append(conds, p.column+" IN UNNEST("+yoBindParam(params, name, p.value)+")")
+		default:
+			conds = append(conds, p.column+" "+p.op+" "+yoBindParam(params, name, p.value))
+		}
+	}
+
+	return conds
+}
+
+// yoCount runs stmt selecting COUNT(*) and returns the count. The Limit of
+// opts is ignored.
+func yoCount(ctx context.Context, db YORODB, stmt spanner.Statement, opts []*spanner.ReadOptions) (int64, error) {
+	iter := yoQuery(ctx, db, stmt, yoWithoutLimit(opts))
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	if err := row.Columns(&n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// yoWithoutLimit returns opts whose Limit is cleared, which is overridden by
+// the LIMIT clause of a query builder.
+func yoWithoutLimit(opts []*spanner.ReadOptions) []*spanner.ReadOptions {
+	o := yoReadOptions(opts)
+	if o == nil || o.Limit == 0 {
+		return opts
+	}
+	ro := *o
+	ro.Limit = 0
+	return []*spanner.ReadOptions{&ro}
+}
+
+// yoPageStatement builds the statement of a page of at most pageSize rows of
+// the query sqlstr, which are sorted by cols in the directions of desc. The
+// page starts after key, which is the values of cols of the last row of the
+// previous page, or at the first row if key is nil.
+func yoPageStatement(sqlstr string, cols []string, desc []bool, key []interface{}, pageSize int) spanner.Statement {
+	params := make(map[string]interface{}, len(key)+1)
+
+	if key != nil {
+		keys := make([]string, len(cols))
+		for i := range cols {
+			keys[i] = yoBindParam(params, fmt.Sprintf("key%d", i), key[i])
+		}
+
+		// (c0 > @key0) OR (c0 = @key0 AND c1 > @key1) OR ...
+		conds := make([]string, len(cols))
+		for i, col := range cols {
+			terms := make([]string, 0, i+1)
+			for j := 0; j < i; j++ {
+				terms = append(terms, fmt.Sprintf("%s = %s", cols[j], keys[j]))
+			}
+			op := ">"
+			if desc[i] {
+				op = "<"
+			}
+			terms = append(terms, fmt.Sprintf("%s %s %s", col, op, keys[i]))
+			conds[i] = "(" + strings.Join(terms, " AND ") + ")"
+		}
+		sqlstr += " WHERE " + strings.Join(conds, " OR ")
+	}
+
+	orders := make([]string, len(cols))
+	for i, col := range cols {
+		orders[i] = col
+		if desc[i] {
+			orders[i] += " DESC"
+		}
+	}
+	sqlstr += " ORDER BY " + strings.Join(orders, ", ") + " LIMIT " + yoBindParam(params, "limit", int64(pageSize))
+
+	return spanner.Statement{SQL: sqlstr, Params: params}
+}
+
+// yoEncodePageToken encodes the key of the last row of a page into the opaque
+// token of the next page.
+func yoEncodePageToken(key ...interface{}) (string, error) {
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// yoDecodePageToken decodes the key of token encoded by yoEncodePageToken into
+// ptrs.
+func yoDecodePageToken(token string, ptrs ...interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return fmt.Errorf("invalid page token: %v", err)
+	}
+	var key []json.RawMessage
+	if err := json.Unmarshal(b, &key); err != nil || len(key) != len(ptrs) {
+		return errors.New("invalid page token")
+	}
+	for i, v := range key {
+		if err := json.Unmarshal(v, ptrs[i]); err != nil {
+			return fmt.Errorf("invalid page token: %v", err)
+		}
+	}
+	return nil
+}
+
+// YOMutationLimit is the maximum number of mutations applied in a commit by
+// the generated bulk insert functions. Spanner limits the number of mutations
+// per commit, which counts the inserted columns and the index entries.
+var YOMutationLimit = 80000
+
+// yoApplyInBatches applies ms in batches of batchSize mutations, committing
+// each batch separately. It returns the number of mutations applied.
+func yoApplyInBatches(ctx context.Context, client *spanner.Client, method, table string, ms []*spanner.Mutation, batchSize int) (int, error) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	written := 0
+	for start := 0; start < len(ms); start += batchSize {
+		end := start + batchSize
+		if end > len(ms) {
+			end = len(ms)
+		}
+
+		if _, err := client.Apply(ctx, ms[start:end]); err != nil {
+			return written, newErrorWithCode(spanner.ErrCode(err), method, table,
+				fmt.Errorf("batch %d (rows %d to %d) failed after %d rows written: %w", start/batchSize, start, end-1, written, err))
+		}
+		written += end - start
+	}
+
+	return written, nil
+}
+
+// YOBatchWrite applies groups by the BatchWrite API, such as the groups of the
+// rows with their interleaved rows. Each group is applied atomically, but the
+// groups are applied independently in any order, so that it is intended for
+// high-throughput ingestion which does not need the atomicity of all the
+// groups. It returns the errors of the groups which failed by the indexes of
+// the groups. The other groups are applied unless the error is returned.
+func YOBatchWrite(ctx context.Context, client *spanner.Client, groups []*spanner.MutationGroup) (map[int]error, error) {
+	return yoBatchWrite(ctx, client, "YOBatchWrite", "", groups)
+}
+
+func yoBatchWrite(ctx context.Context, client *spanner.Client, method, table string, groups []*spanner.MutationGroup) (map[int]error, error) {
+	failed := make(map[int]error)
+	err := client.BatchWrite(ctx, groups).Do(func(res *spannerpb.BatchWriteResponse) error {
+		st := res.GetStatus()
+		if code := codes.Code(st.GetCode()); code != codes.OK {
+			for _, i := range res.GetIndexes() {
+				failed[int(i)] = newErrorWithCode(code, method, table, errors.New(st.GetMessage()))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return failed, newError(method, table, err)
+	}
+
+	return failed, nil
+}
+
+// YOTransactionTimeout bounds a transaction of YORunInTransaction including its
+// retries, unless the context already has an earlier deadline. Spanner retries
+// an aborted transaction until the context is done, so that a transaction
+// aborted repeatedly by contention would run forever without it.
+var YOTransactionTimeout = time.Minute
+
+// YOTransactionRetried is called with the transaction tag and the number of
+// the attempts so far, when a transaction of YORunInTransaction is aborted
+// and is retried, so that the aborts can be counted by the metrics. It is
+// nil by default.
+var YOTransactionRetried func(ctx context.Context, tag string, attempt int)
+
+// YORunInTransaction runs f in a read-write transaction of client tagged by
+// tag, and returns the commit timestamp. It is the intended entry point of the
+// generated functions taking a *spanner.ReadWriteTransaction. f is run again
+// when the transaction is aborted, so it must not have side effects other than
+// the writes to tx. The retries are reported to YOTransactionRetried, and are
+// logged by YOLogger at warn level.
+func YORunInTransaction(ctx context.Context, client *spanner.Client, tag string, f func(ctx context.Context, tx *spanner.ReadWriteTransaction) error) (time.Time, error) {
+	if YOTransactionTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, YOTransactionTimeout)
+		defer cancel()
+	}
+
+	attempt := 0
+	resp, err := client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		attempt++
+		if attempt > 1 {
+			if YOTransactionRetried != nil {
+				YOTransactionRetried(ctx, tag, attempt)
+			}
+			if logger := YOLogger; logger != nil {
+				logger.WarnContext(ctx, "yo: retry aborted transaction", slog.String("tag", tag), slog.Int("attempt", attempt))
+			}
+		}
+		return f(ctx, tx)
+	}, spanner.TransactionOptions{TransactionTag: tag})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return resp.CommitTs, nil
+}
+
+// YODescriptor describes a table for YORepository, which is generated as
+// XXXDescriptor for each table having a primary key. T is the struct of the
+// rows and K is the typed primary key of the table.
+type YODescriptor[T any, K any] struct {
+	// Table is the name of the table.
+	Table string
+
+	// Columns is the names of the columns read into T.
+	Columns []string
+
+	// PrimaryKeys is the names of the columns of the primary key.
+	PrimaryKeys []string
+
+	// Key returns the primary key of a row.
+	Key func(row *T) K
+
+	// SpannerKey returns the spanner.Key of a primary key.
+	SpannerKey func(key K) spanner.Key
+
+	// Scan scans a row of Columns into T.
+	Scan func(row *spanner.Row) (*T, error)
+
+	// Find gets a row by the primary key.
+	Find func(ctx context.Context, db YORODB, key K, opts ...*spanner.ReadOptions) (*T, error)
+
+	// Read retrieves the rows of keys.
+	Read func(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*T, error)
+
+	// Insert, Update, InsertOrUpdate and Delete return the Mutations to write
+	// a row.
+	Insert         func(ctx context.Context, row *T) *spanner.Mutation
+	Update         func(ctx context.Context, row *T) *spanner.Mutation
+	InsertOrUpdate func(ctx context.Context, row *T) *spanner.Mutation
+	Delete         func(ctx context.Context, row *T) *spanner.Mutation
+}
+
+// YORepository is the CRUD of the rows of a table described by a
+// YODescriptor, which is shared by the tables. The reads are strong reads by
+// single-use read-only transactions of the client, and the writes are applied
+// by the client. The generated functions of the table are still available.
+type YORepository[T any, K any] struct {
+	client *spanner.Client
+	desc   *YODescriptor[T, K]
+}
+
+// NewYORepository returns a YORepository of the table described by desc, such
+// as XXXDescriptor.
+func NewYORepository[T any, K any](client *spanner.Client, desc *YODescriptor[T, K]) *YORepository[T, K] {
+	return &YORepository[T, K]{client: client, desc: desc}
+}
+
+// Descriptor returns the YODescriptor of the table.
+func (r *YORepository[T, K]) Descriptor() *YODescriptor[T, K] {
+	return r.desc
+}
+
+// Get gets the row of key.
+func (r *YORepository[T, K]) Get(ctx context.Context, key K) (*T, error) {
+	return r.desc.Find(ctx, r.client.Single(), key)
+}
+
+// GetMulti gets the rows of keys. The rows not found are omitted.
+func (r *YORepository[T, K]) GetMulti(ctx context.Context, keys []K) ([]*T, error) {
+	ks := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		ks[i] = r.desc.SpannerKey(k)
+	}
+
+	return r.desc.Read(ctx, r.client.Single(), spanner.KeySets(ks...))
+}
+
+// Insert inserts rows.
+func (r *YORepository[T, K]) Insert(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "Insert", r.desc.Insert, rows)
+}
+
+// Update updates rows.
+func (r *YORepository[T, K]) Update(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "Update", r.desc.Update, rows)
+}
+
+// InsertOrUpdate inserts rows, or updates them if they already exist.
+func (r *YORepository[T, K]) InsertOrUpdate(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "InsertOrUpdate", r.desc.InsertOrUpdate, rows)
+}
+
+// Delete deletes rows.
+func (r *YORepository[T, K]) Delete(ctx context.Context, rows ...*T) error {
+	return r.apply(ctx, "Delete", r.desc.Delete, rows)
+}
+
+// apply applies the Mutations of rows built by mutation in a commit.
+func (r *YORepository[T, K]) apply(ctx context.Context, method string, mutation func(context.Context, *T) *spanner.Mutation, rows []*T) error {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i, row := range rows {
+		ms[i] = mutation(ctx, row)
+	}
+
+	if _, err := r.client.Apply(ctx, ms); err != nil {
+		return newError(method, r.desc.Table, err)
+	}
+
+	return nil
+}
+
+// ErrNotFound is the error matched by errors.Is when the row is not found.
+var ErrNotFound = errors.New("yo: not found")
+
+// ErrStaleWrite is the error matched by errors.Is when an update with a
+// version check finds the row changed or deleted since it was read.
+var ErrStaleWrite = errors.New("yo: stale write")
+
+// NotFoundError is the error of a row not found in a table, which is wrapped
+// by the errors of the generated functions. It is matched by errors.Is with
+// ErrNotFound and the ErrXXXNotFound of the table, and can be retrieved by
+// errors.As to get the table and the key of the row.
+type NotFoundError struct {
+	Table string
+	Key   spanner.Key
+	Code  codes.Code
+
+	sentinel error
+	err      error
+}
+
+func (e *NotFoundError) Error() string {
+	if e.err != nil {
+		return fmt.Sprintf("row not found(Table: %v, Key: %v): %v", e.Table, e.Key, e.err)
+	}
+	return fmt.Sprintf("row not found(Table: %v, Key: %v)", e.Table, e.Key)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether target is ErrNotFound or the ErrXXXNotFound of the table.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound || target == e.sentinel
+}
+
+// newNotFoundError returns the error of method where the row of key is not
+// found in table. sentinel is the ErrXXXNotFound of the table, and err is the
+// reason if any.
+func newNotFoundError(method, table string, key spanner.Key, sentinel, err error) error {
+	return newErrorWithCode(codes.NotFound, method, table, &NotFoundError{
+		Table:    table,
+		Key:      key,
+		Code:     codes.NotFound,
+		sentinel: sentinel,
+		err:      err,
+	})
+}
+
 func newError(method, table string, err error) error {
 	code := spanner.ErrCode(err)
 	return newErrorWithCode(code, method, table, err)
@@ -2349,6 +12633,11 @@ func (e yoError) Unwrap() error {
 	return e.err
 }
 
+// Is reports whether the error is ErrNotFound by the code of the error.
+func (e yoError) Is(target error) bool {
+	return target == ErrNotFound && e.code == codes.NotFound
+}
+
 func (e yoError) DBTableName() string {
 	return e.table
 }
@@ -4,7 +4,9 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -16,41 +18,49 @@ import (
 
 // FullType represents a row from 'FullTypes'.
 type FullType struct {
-	PKey                 string              `spanner:"PKey" json:"PKey"`                                 // PKey
-	FTString             string              `spanner:"FTString" json:"FTString"`                         // FTString
-	FTStringNull         spanner.NullString  `spanner:"FTStringNull" json:"FTStringNull"`                 // FTStringNull
-	FTBool               bool                `spanner:"FTBool" json:"FTBool"`                             // FTBool
-	FTBoolNull           spanner.NullBool    `spanner:"FTBoolNull" json:"FTBoolNull"`                     // FTBoolNull
-	FTBytes              []byte              `spanner:"FTBytes" json:"FTBytes"`                           // FTBytes
-	FTBytesNull          []byte              `spanner:"FTBytesNull" json:"FTBytesNull"`                   // FTBytesNull
-	FTTimestamp          time.Time           `spanner:"FTTimestamp" json:"FTTimestamp"`                   // FTTimestamp
-	FTTimestampNull      spanner.NullTime    `spanner:"FTTimestampNull" json:"FTTimestampNull"`           // FTTimestampNull
-	FTInt                int64               `spanner:"FTInt" json:"FTInt"`                               // FTInt
-	FTIntNull            spanner.NullInt64   `spanner:"FTIntNull" json:"FTIntNull"`                       // FTIntNull
-	FTFloat              float64             `spanner:"FTFloat" json:"FTFloat"`                           // FTFloat
-	FTFloatNull          spanner.NullFloat64 `spanner:"FTFloatNull" json:"FTFloatNull"`                   // FTFloatNull
-	FTDate               civil.Date          `spanner:"FTDate" json:"FTDate"`                             // FTDate
-	FTDateNull           spanner.NullDate    `spanner:"FTDateNull" json:"FTDateNull"`                     // FTDateNull
-	FTJSON               spanner.NullJSON    `spanner:"FTJson" json:"FTJson"`                             // FTJson
-	FTJSONNull           spanner.NullJSON    `spanner:"FTJsonNull" json:"FTJsonNull"`                     // FTJsonNull
-	FTArrayStringNull    []string            `spanner:"FTArrayStringNull" json:"FTArrayStringNull"`       // FTArrayStringNull
-	FTArrayString        []string            `spanner:"FTArrayString" json:"FTArrayString"`               // FTArrayString
-	FTArrayBoolNull      []bool              `spanner:"FTArrayBoolNull" json:"FTArrayBoolNull"`           // FTArrayBoolNull
-	FTArrayBool          []bool              `spanner:"FTArrayBool" json:"FTArrayBool"`                   // FTArrayBool
-	FTArrayBytesNull     [][]byte            `spanner:"FTArrayBytesNull" json:"FTArrayBytesNull"`         // FTArrayBytesNull
-	FTArrayBytes         [][]byte            `spanner:"FTArrayBytes" json:"FTArrayBytes"`                 // FTArrayBytes
-	FTArrayTimestampNull []time.Time         `spanner:"FTArrayTimestampNull" json:"FTArrayTimestampNull"` // FTArrayTimestampNull
-	FTArrayTimestamp     []time.Time         `spanner:"FTArrayTimestamp" json:"FTArrayTimestamp"`         // FTArrayTimestamp
-	FTArrayIntNull       []int64             `spanner:"FTArrayIntNull" json:"FTArrayIntNull"`             // FTArrayIntNull
-	FTArrayInt           []int64             `spanner:"FTArrayInt" json:"FTArrayInt"`                     // FTArrayInt
-	FTArrayFloatNull     []float64           `spanner:"FTArrayFloatNull" json:"FTArrayFloatNull"`         // FTArrayFloatNull
-	FTArrayFloat         []float64           `spanner:"FTArrayFloat" json:"FTArrayFloat"`                 // FTArrayFloat
-	FTArrayDateNull      []civil.Date        `spanner:"FTArrayDateNull" json:"FTArrayDateNull"`           // FTArrayDateNull
-	FTArrayDate          []civil.Date        `spanner:"FTArrayDate" json:"FTArrayDate"`                   // FTArrayDate
-	FTArrayJSONNull      []spanner.NullJSON  `spanner:"FTArrayJsonNull" json:"FTArrayJsonNull"`           // FTArrayJsonNull
-	FTArrayJSON          []spanner.NullJSON  `spanner:"FTArrayJson" json:"FTArrayJson"`                   // FTArrayJson
+	PKey                 string                `spanner:"PKey" json:"PKey"`                                 // PKey
+	FTString             string                `spanner:"FTString" json:"FTString"`                         // FTString
+	FTStringNull         spanner.NullString    `spanner:"FTStringNull" json:"FTStringNull"`                 // FTStringNull
+	FTBool               bool                  `spanner:"FTBool" json:"FTBool"`                             // FTBool
+	FTBoolNull           spanner.NullBool      `spanner:"FTBoolNull" json:"FTBoolNull"`                     // FTBoolNull
+	FTBytes              []byte                `spanner:"FTBytes" json:"FTBytes"`                           // FTBytes
+	FTBytesNull          []byte                `spanner:"FTBytesNull" json:"FTBytesNull"`                   // FTBytesNull
+	FTTimestamp          time.Time             `spanner:"FTTimestamp" json:"FTTimestamp"`                   // FTTimestamp
+	FTTimestampNull      spanner.NullTime      `spanner:"FTTimestampNull" json:"FTTimestampNull"`           // FTTimestampNull
+	FTInt                int64                 `spanner:"FTInt" json:"FTInt"`                               // FTInt
+	FTIntNull            spanner.NullInt64     `spanner:"FTIntNull" json:"FTIntNull"`                       // FTIntNull
+	FTFloat              float64               `spanner:"FTFloat" json:"FTFloat"`                           // FTFloat
+	FTFloatNull          spanner.NullFloat64   `spanner:"FTFloatNull" json:"FTFloatNull"`                   // FTFloatNull
+	FTDate               civil.Date            `spanner:"FTDate" json:"FTDate"`                             // FTDate
+	FTDateNull           spanner.NullDate      `spanner:"FTDateNull" json:"FTDateNull"`                     // FTDateNull
+	FTJSON               spanner.NullJSON      `spanner:"FTJson" json:"FTJson"`                             // FTJson
+	FTJSONNull           spanner.NullJSON      `spanner:"FTJsonNull" json:"FTJsonNull"`                     // FTJsonNull
+	FTArrayStringNull    []spanner.NullString  `spanner:"FTArrayStringNull" json:"FTArrayStringNull"`       // FTArrayStringNull
+	FTArrayString        []spanner.NullString  `spanner:"FTArrayString" json:"FTArrayString"`               // FTArrayString
+	FTArrayBoolNull      []spanner.NullBool    `spanner:"FTArrayBoolNull" json:"FTArrayBoolNull"`           // FTArrayBoolNull
+	FTArrayBool          []spanner.NullBool    `spanner:"FTArrayBool" json:"FTArrayBool"`                   // FTArrayBool
+	FTArrayBytesNull     [][]byte              `spanner:"FTArrayBytesNull" json:"FTArrayBytesNull"`         // FTArrayBytesNull
+	FTArrayBytes         [][]byte              `spanner:"FTArrayBytes" json:"FTArrayBytes"`                 // FTArrayBytes
+	FTArrayTimestampNull []spanner.NullTime    `spanner:"FTArrayTimestampNull" json:"FTArrayTimestampNull"` // FTArrayTimestampNull
+	FTArrayTimestamp     []spanner.NullTime    `spanner:"FTArrayTimestamp" json:"FTArrayTimestamp"`         // FTArrayTimestamp
+	FTArrayIntNull       []spanner.NullInt64   `spanner:"FTArrayIntNull" json:"FTArrayIntNull"`             // FTArrayIntNull
+	FTArrayInt           []spanner.NullInt64   `spanner:"FTArrayInt" json:"FTArrayInt"`                     // FTArrayInt
+	FTArrayFloatNull     []spanner.NullFloat64 `spanner:"FTArrayFloatNull" json:"FTArrayFloatNull"`         // FTArrayFloatNull
+	FTArrayFloat         []spanner.NullFloat64 `spanner:"FTArrayFloat" json:"FTArrayFloat"`                 // FTArrayFloat
+	FTArrayDateNull      []spanner.NullDate    `spanner:"FTArrayDateNull" json:"FTArrayDateNull"`           // FTArrayDateNull
+	FTArrayDate          []spanner.NullDate    `spanner:"FTArrayDate" json:"FTArrayDate"`                   // FTArrayDate
+	FTArrayJSONNull      []spanner.NullJSON    `spanner:"FTArrayJsonNull" json:"FTArrayJsonNull"`           // FTArrayJsonNull
+	FTArrayJSON          []spanner.NullJSON    `spanner:"FTArrayJson" json:"FTArrayJson"`                   // FTArrayJson
 }
 
+// FullTypeTableName is the name of 'FullTypes', which is qualified by the
+// schema if the table is in a named schema.
+const FullTypeTableName = "FullTypes"
+
+// ErrFullTypeNotFound is the error matched by errors.Is when the row is not
+// found in 'FullTypes'.
+var ErrFullTypeNotFound = errors.New("yo: FullTypes not found")
+
 func FullTypePrimaryKeys() []string {
 	return []string{
 		"PKey",
@@ -95,6 +105,46 @@ func FullTypeColumns() []string {
 	}
 }
 
+// FullTypeColumn is a column of 'FullTypes'.
+type FullTypeColumn string
+
+// Columns of 'FullTypes'.
+const (
+	FullTypeColumnPKey                 FullTypeColumn = "PKey"
+	FullTypeColumnFTString             FullTypeColumn = "FTString"
+	FullTypeColumnFTStringNull         FullTypeColumn = "FTStringNull"
+	FullTypeColumnFTBool               FullTypeColumn = "FTBool"
+	FullTypeColumnFTBoolNull           FullTypeColumn = "FTBoolNull"
+	FullTypeColumnFTBytes              FullTypeColumn = "FTBytes"
+	FullTypeColumnFTBytesNull          FullTypeColumn = "FTBytesNull"
+	FullTypeColumnFTTimestamp          FullTypeColumn = "FTTimestamp"
+	FullTypeColumnFTTimestampNull      FullTypeColumn = "FTTimestampNull"
+	FullTypeColumnFTInt                FullTypeColumn = "FTInt"
+	FullTypeColumnFTIntNull            FullTypeColumn = "FTIntNull"
+	FullTypeColumnFTFloat              FullTypeColumn = "FTFloat"
+	FullTypeColumnFTFloatNull          FullTypeColumn = "FTFloatNull"
+	FullTypeColumnFTDate               FullTypeColumn = "FTDate"
+	FullTypeColumnFTDateNull           FullTypeColumn = "FTDateNull"
+	FullTypeColumnFTJSON               FullTypeColumn = "FTJson"
+	FullTypeColumnFTJSONNull           FullTypeColumn = "FTJsonNull"
+	FullTypeColumnFTArrayStringNull    FullTypeColumn = "FTArrayStringNull"
+	FullTypeColumnFTArrayString        FullTypeColumn = "FTArrayString"
+	FullTypeColumnFTArrayBoolNull      FullTypeColumn = "FTArrayBoolNull"
+	FullTypeColumnFTArrayBool          FullTypeColumn = "FTArrayBool"
+	FullTypeColumnFTArrayBytesNull     FullTypeColumn = "FTArrayBytesNull"
+	FullTypeColumnFTArrayBytes         FullTypeColumn = "FTArrayBytes"
+	FullTypeColumnFTArrayTimestampNull FullTypeColumn = "FTArrayTimestampNull"
+	FullTypeColumnFTArrayTimestamp     FullTypeColumn = "FTArrayTimestamp"
+	FullTypeColumnFTArrayIntNull       FullTypeColumn = "FTArrayIntNull"
+	FullTypeColumnFTArrayInt           FullTypeColumn = "FTArrayInt"
+	FullTypeColumnFTArrayFloatNull     FullTypeColumn = "FTArrayFloatNull"
+	FullTypeColumnFTArrayFloat         FullTypeColumn = "FTArrayFloat"
+	FullTypeColumnFTArrayDateNull      FullTypeColumn = "FTArrayDateNull"
+	FullTypeColumnFTArrayDate          FullTypeColumn = "FTArrayDate"
+	FullTypeColumnFTArrayJSONNull      FullTypeColumn = "FTArrayJsonNull"
+	FullTypeColumnFTArrayJSON          FullTypeColumn = "FTArrayJson"
+)
+
 func FullTypeWritableColumns() []string {
 	return []string{
 		"PKey",
@@ -313,66 +363,22 @@ func newFullType_Decoder(cols []string) func(*spanner.Row) (*FullType, error) {
 	}
 }
 
-// Insert returns a Mutation to insert a row into a table. If the row already
-// exists, the write or transaction fails.
-func (ft *FullType) Insert(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypeWritableColumns())
-	return spanner.Insert("FullTypes", FullTypeWritableColumns(), values)
-}
-
-// Update returns a Mutation to update a row in a table. If the row does not
-// already exist, the write or transaction fails.
-func (ft *FullType) Update(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypeWritableColumns())
-	return spanner.Update("FullTypes", FullTypeWritableColumns(), values)
-}
-
-// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
-// already exists, it updates it instead. Any column values not explicitly
-// written are preserved.
-func (ft *FullType) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypeWritableColumns())
-	return spanner.InsertOrUpdate("FullTypes", FullTypeWritableColumns(), values)
-}
-
-// UpdateColumns returns a Mutation to update specified columns of a row in a table.
-func (ft *FullType) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
-	// add primary keys to columns to update by primary keys
-	colsWithPKeys := append(cols, FullTypePrimaryKeys()...)
-
-	values, err := ft.columnsToValues(colsWithPKeys)
-	if err != nil {
-		return nil, newErrorWithCode(codes.InvalidArgument, "FullType.UpdateColumns", "FullTypes", err)
-	}
-
-	return spanner.Update("FullTypes", colsWithPKeys, values), nil
-}
-
-// FindFullType gets a FullType by primary key
-func FindFullType(ctx context.Context, db YORODB, pKey string) (*FullType, error) {
-	key := spanner.Key{pKey}
-	row, err := db.ReadRow(ctx, "FullTypes", key, FullTypeColumns())
-	if err != nil {
-		return nil, newError("FindFullType", "FullTypes", err)
-	}
-
-	decoder := newFullType_Decoder(FullTypeColumns())
-	ft, err := decoder(row)
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindFullType", "FullTypes", err)
-	}
-
-	return ft, nil
+// ScanFullType decodes row into FullType. The row may have any subset of
+// the columns of 'FullTypes', such as the result of a query or a read.
+func ScanFullType(row *spanner.Row) (*FullType, error) {
+	return newFullType_Decoder(row.ColumnNames())(row)
 }
 
-// ReadFullType retrieves multiples rows from FullType by KeySet as a slice.
-func ReadFullType(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
+// ScanFullTypes decodes all the rows of iter into a slice of FullType.
+// iter is stopped when it returns.
+func ScanFullTypes(iter *spanner.RowIterator) ([]*FullType, error) {
 	var res []*FullType
+	var decoder func(*spanner.Row) (*FullType, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newFullType_Decoder(row.ColumnNames())
+		}
 
-	decoder := newFullType_Decoder(FullTypeColumns())
-
-	rows := db.Read(ctx, "FullTypes", keys, FullTypeColumns())
-	err := rows.Do(func(row *spanner.Row) error {
 		ft, err := decoder(row)
 		if err != nil {
 			return err
@@ -382,191 +388,179 @@ func ReadFullType(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullT
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullType", "FullTypes", err)
+		return nil, err
 	}
 
 	return res, nil
 }
 
-// Delete deletes the FullType from the database.
-func (ft *FullType) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := ft.columnsToValues(FullTypePrimaryKeys())
-	return spanner.Delete("FullTypes", spanner.Key(values))
+// FullTypeIterator iterates over the rows of a partition of 'FullTypes'
+// decoded into FullType.
+type FullTypeIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*FullType, error)
 }
 
-// FindFullTypeByFTString retrieves a row from 'FullTypes' as a FullType.
-//
-// If no row is present with the given key, then ReadRow returns an error where
-// spanner.ErrCode(err) is codes.NotFound.
-//
-// Generated from unique index 'FullTypesByFTString'.
-func FindFullTypeByFTString(ctx context.Context, db YORODB, fTString string) (*FullType, error) {
-	const sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByFTString} " +
-		"WHERE FTString = @param0"
-
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTString
-
-	decoder := newFullType_Decoder(FullTypeColumns())
-
-	// run query
-	YOLog(ctx, sqlstr, fTString)
-	iter := db.Query(ctx, stmt)
-	defer iter.Stop()
+// ExecuteFullTypePartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllFullTypes or FullTypeQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteFullTypePartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *FullTypeIterator {
+	return &FullTypeIterator{iter: btx.Execute(ctx, p)}
+}
 
-	row, err := iter.Next()
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *FullTypeIterator) Next() (*FullType, error) {
+	row, err := it.iter.Next()
 	if err != nil {
 		if err == iterator.Done {
-			return nil, newErrorWithCode(codes.NotFound, "FindFullTypeByFTString", "FullTypes", err)
+			return nil, err
 		}
-		return nil, newError("FindFullTypeByFTString", "FullTypes", err)
+		return nil, newError("FullTypeIterator.Next", "FullTypes", err)
 	}
 
-	ft, err := decoder(row)
+	if it.decoder == nil {
+		it.decoder = newFullType_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "FindFullTypeByFTString", "FullTypes", err)
+		return nil, newErrorWithCode(codes.Internal, "FullTypeIterator.Next", "FullTypes", err)
 	}
 
-	return ft, nil
+	return v, nil
 }
 
-// ReadFullTypeByFTString retrieves multiples rows from 'FullTypes' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'FullTypes' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'FullTypesByFTString'.
-func ReadFullTypeByFTString(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
-	columns := []string{
-		"PKey",
-		"FTString",
-	}
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *FullTypeIterator) Stop() {
+	it.iter.Stop()
+}
 
-	decoder := newFullType_Decoder(columns)
+// yieldFullTypeRows decodes the rows of rows into FullType and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldFullTypeRows(rows *spanner.RowIterator, method string, yield func(*FullType, error) bool) {
+	defer rows.Stop()
 
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByFTString", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
+	var decoder func(*spanner.Row) (*FullType, error)
+	for {
+		row, err := rows.Next()
 		if err != nil {
-			return err
+			if err != iterator.Done {
+				yield(nil, newError(method, "FullTypes", err))
+			}
+			return
 		}
-		res = append(res, ft)
 
-		return nil
-	})
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypeByFTString", "FullTypes", err)
+		if decoder == nil {
+			decoder = newFullType_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "FullTypes", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
 	}
-
-	return res, nil
 }
 
-// FindFullTypesByFTIntFTTimestampNull retrieves multiple rows from 'FullTypes' as a slice of FullType.
-//
-// Generated from index 'FullTypesByInTimestampNull'.
-func FindFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime) ([]*FullType, error) {
-	var sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
+// CountFullTypes returns the number of the rows of 'FullTypes'.
+func CountFullTypes(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM FullTypes")
 
-	conds := make([]string, 2)
-	conds[0] = "FTInt = @param0"
-	if fTTimestampNull.IsNull() {
-		conds[1] = "FTTimestampNull IS NULL"
-	} else {
-		conds[1] = "FTTimestampNull = @param1"
+	defer yoLogQuery(ctx, "CountFullTypes", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypes", "FullTypes", err)
 	}
-	sqlstr += "WHERE " + strings.Join(conds, " AND ")
-
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTInt
-	stmt.Params["param1"] = fTTimestampNull
-
-	decoder := newFullType_Decoder(FullTypeColumns())
 
-	// run query
-	YOLog(ctx, sqlstr, fTInt, fTTimestampNull)
-	iter := db.Query(ctx, stmt)
-	defer iter.Stop()
-
-	// load results
-	res := []*FullType{}
-	for {
-		row, err := iter.Next()
-		if err != nil {
-			if err == iterator.Done {
-				break
-			}
-			return nil, newError("FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
-		}
+	return n, nil
+}
 
-		ft, err := decoder(row)
-		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
-		}
+// AllFullTypesSeq returns an iterator of all the rows of 'FullTypes',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*FullType, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllFullTypesSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FullType, error) bool) {
+	return func(yield func(*FullType, error) bool) {
+		rows := yoRead(ctx, db, "FullTypes", "", spanner.AllKeys(), FullTypeColumns(), opts)
+		yieldFullTypeRows(rows, "AllFullTypesSeq", yield)
+	}
+}
 
-		res = append(res, ft)
+// PartitionReadAllFullTypes partitions the read of all the rows of
+// 'FullTypes' in btx, so that the partitions are read in parallel by
+// ExecuteFullTypePartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllFullTypes(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "FullTypes", spanner.AllKeys(), FullTypeColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllFullTypes", "FullTypes", err)
 	}
 
-	return res, nil
+	return ps, nil
 }
 
-// ReadFullTypesByFTIntFTTimestampNull retrieves multiples rows from 'FullTypes' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'FullTypes' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'FullTypesByInTimestampNull'.
-func ReadFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
-	columns := []string{
-		"PKey",
-		"FTInt",
-		"FTTimestampNull",
-	}
+// FullTypeQuery is a query builder for 'FullTypes'. The conditions are
+// given by the predicates of FullTypeWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of FullTypeColumn.
+type FullTypeQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
 
-	decoder := newFullType_Decoder(columns)
+// NewFullTypeQuery returns a FullTypeQuery filtered by preds.
+func NewFullTypeQuery(preds ...YOPredicate) *FullTypeQuery {
+	return &FullTypeQuery{preds: preds}
+}
 
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByInTimestampNull", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
-		if err != nil {
-			return err
-		}
-		res = append(res, ft)
+// Where adds preds to the conditions which rows must satisfy.
+func (q *FullTypeQuery) Where(preds ...YOPredicate) *FullTypeQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
 
-		return nil
-	})
-	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTTimestampNull", "FullTypes", err)
-	}
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *FullTypeQuery) OrderBy(col FullTypeColumn) *FullTypeQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
 
-	return res, nil
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *FullTypeQuery) OrderByDesc(col FullTypeColumn) *FullTypeQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
 }
 
-// FindFullTypesByFTIntFTDate retrieves multiple rows from 'FullTypes' as a slice of FullType.
-//
-// Generated from index 'FullTypesByIntDate'.
-func FindFullTypesByFTIntFTDate(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date) ([]*FullType, error) {
-	const sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
-		"WHERE FTInt = @param0 AND FTDate = @param1"
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *FullTypeQuery) Limit(n int) *FullTypeQuery {
+	q.limit = n
+	return q
+}
 
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTInt
-	stmt.Params["param1"] = fTDate
+// Statement returns the parameterized statement of the query.
+func (q *FullTypeQuery) Statement() spanner.Statement {
+	return yoStatement("PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson", "FullTypes", q.preds, q.orders, q.limit)
+}
 
-	decoder := newFullType_Decoder(FullTypeColumns())
+// Query runs the query and returns the matched rows as a slice.
+func (q *FullTypeQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
 
 	// run query
-	YOLog(ctx, sqlstr, fTInt, fTDate)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FullTypeQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
@@ -577,104 +571,2212 @@ func FindFullTypesByFTIntFTDate(ctx context.Context, db YORODB, fTInt int64, fTD
 			if err == iterator.Done {
 				break
 			}
-			return nil, newError("FindFullTypesByFTIntFTDate", "FullTypes", err)
+			return nil, newError("FullTypeQuery.Query", "FullTypes", err)
 		}
 
-		ft, err := decoder(row)
+		v, err := ScanFullType(row)
 		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTDate", "FullTypes", err)
+			return nil, newErrorWithCode(codes.Internal, "FullTypeQuery.Query", "FullTypes", err)
 		}
 
-		res = append(res, ft)
+		res = append(res, v)
 	}
 
 	return res, nil
 }
 
-// ReadFullTypesByFTIntFTDate retrieves multiples rows from 'FullTypes' by KeySet as a slice.
-//
-// This does not retrieve all columns of 'FullTypes' because an index has only columns
-// used for primary key, index key and storing columns. If you need more columns, add storing
-// columns or Read by primary key or Query with join.
-//
-// Generated from unique index 'FullTypesByIntDate'.
-func ReadFullTypesByFTIntFTDate(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
-	var res []*FullType
-	columns := []string{
-		"PKey",
-		"FTInt",
-		"FTDate",
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *FullTypeQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "FullTypes", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "FullTypeQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("FullTypeQuery.Count", "FullTypes", err)
 	}
 
-	decoder := newFullType_Decoder(columns)
+	return n, nil
+}
 
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByIntDate", keys, columns)
-	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
-		if err != nil {
-			return err
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*FullType, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *FullTypeQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*FullType, error) bool) {
+	return func(yield func(*FullType, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
 		}
-		res = append(res, ft)
 
-		return nil
-	})
+		defer yoLogQuery(ctx, "FullTypeQuery.Seq", stmt)()
+		yieldFullTypeRows(yoQuery(ctx, db, stmt, opts), "FullTypeQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteFullTypePartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *FullTypeQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "FullTypeQuery.PartitionQuery", "FullTypes", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "FullTypeQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTDate", "FullTypes", err)
+		return nil, newError("FullTypeQuery.PartitionQuery", "FullTypes", err)
 	}
 
-	return res, nil
+	return ps, nil
 }
 
-// FindFullTypesByFTIntFTTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
-//
-// Generated from index 'FullTypesByIntTimestamp'.
-func FindFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time) ([]*FullType, error) {
-	const sqlstr = "SELECT " +
-		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
-		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
-		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
+// FullTypeWhere has the typed predicate constructors of the columns of
+// 'FullTypes'.
+var FullTypeWhere = struct {
+	PKey                 FullType_PKeyColumn
+	FTString             FullType_FTStringColumn
+	FTStringNull         FullType_FTStringNullColumn
+	FTBool               FullType_FTBoolColumn
+	FTBoolNull           FullType_FTBoolNullColumn
+	FTBytes              FullType_FTBytesColumn
+	FTBytesNull          FullType_FTBytesNullColumn
+	FTTimestamp          FullType_FTTimestampColumn
+	FTTimestampNull      FullType_FTTimestampNullColumn
+	FTInt                FullType_FTIntColumn
+	FTIntNull            FullType_FTIntNullColumn
+	FTFloat              FullType_FTFloatColumn
+	FTFloatNull          FullType_FTFloatNullColumn
+	FTDate               FullType_FTDateColumn
+	FTDateNull           FullType_FTDateNullColumn
+	FTJSON               FullType_FTJSONColumn
+	FTJSONNull           FullType_FTJSONNullColumn
+	FTArrayStringNull    FullType_FTArrayStringNullColumn
+	FTArrayString        FullType_FTArrayStringColumn
+	FTArrayBoolNull      FullType_FTArrayBoolNullColumn
+	FTArrayBool          FullType_FTArrayBoolColumn
+	FTArrayBytesNull     FullType_FTArrayBytesNullColumn
+	FTArrayBytes         FullType_FTArrayBytesColumn
+	FTArrayTimestampNull FullType_FTArrayTimestampNullColumn
+	FTArrayTimestamp     FullType_FTArrayTimestampColumn
+	FTArrayIntNull       FullType_FTArrayIntNullColumn
+	FTArrayInt           FullType_FTArrayIntColumn
+	FTArrayFloatNull     FullType_FTArrayFloatNullColumn
+	FTArrayFloat         FullType_FTArrayFloatColumn
+	FTArrayDateNull      FullType_FTArrayDateNullColumn
+	FTArrayDate          FullType_FTArrayDateColumn
+	FTArrayJSONNull      FullType_FTArrayJSONNullColumn
+	FTArrayJSON          FullType_FTArrayJSONColumn
+}{}
+
+// FullType_PKeyColumn has the predicate constructors of 'PKey'.
+type FullType_PKeyColumn struct{}
+
+// Eq returns a predicate that 'PKey' is equal to v.
+func (FullType_PKeyColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "=", value: v}
+}
 
-	stmt := spanner.NewStatement(sqlstr)
-	stmt.Params["param0"] = fTInt
-	stmt.Params["param1"] = fTTimestamp
+// Ne returns a predicate that 'PKey' is not equal to v.
+func (FullType_PKeyColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "!=", value: v}
+}
 
-	decoder := newFullType_Decoder(FullTypeColumns())
+// Lt returns a predicate that 'PKey' is less than v.
+func (FullType_PKeyColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "<", value: v}
+}
 
-	// run query
-	YOLog(ctx, sqlstr, fTInt, fTTimestamp)
-	iter := db.Query(ctx, stmt)
-	defer iter.Stop()
+// Le returns a predicate that 'PKey' is less than or equal to v.
+func (FullType_PKeyColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "<=", value: v}
+}
 
-	// load results
-	res := []*FullType{}
-	for {
-		row, err := iter.Next()
-		if err != nil {
-			if err == iterator.Done {
-				break
-			}
-			return nil, newError("FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
-		}
+// Gt returns a predicate that 'PKey' is greater than v.
+func (FullType_PKeyColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: ">", value: v}
+}
 
-		ft, err := decoder(row)
-		if err != nil {
-			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
-		}
+// Ge returns a predicate that 'PKey' is greater than or equal to v.
+func (FullType_PKeyColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey", op: ">=", value: v}
+}
 
-		res = append(res, ft)
-	}
+// In returns a predicate that 'PKey' is equal to any of vs.
+func (FullType_PKeyColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey", op: "IN", value: vs}
+}
 
-	return res, nil
+// FullType_FTStringColumn has the predicate constructors of 'FTString'.
+type FullType_FTStringColumn struct{}
+
+// Eq returns a predicate that 'FTString' is equal to v.
+func (FullType_FTStringColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "=", value: v}
 }
 
-// ReadFullTypesByFTIntFTTimestamp retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+// Ne returns a predicate that 'FTString' is not equal to v.
+func (FullType_FTStringColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTString' is less than v.
+func (FullType_FTStringColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTString' is less than or equal to v.
+func (FullType_FTStringColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTString' is greater than v.
+func (FullType_FTStringColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTString' is greater than or equal to v.
+func (FullType_FTStringColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "FTString", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTString' is equal to any of vs.
+func (FullType_FTStringColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "FTString", op: "IN", value: vs}
+}
+
+// FullType_FTStringNullColumn has the predicate constructors of 'FTStringNull'.
+type FullType_FTStringNullColumn struct{}
+
+// Eq returns a predicate that 'FTStringNull' is equal to v.
+func (FullType_FTStringNullColumn) Eq(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTStringNull' is not equal to v.
+func (FullType_FTStringNullColumn) Ne(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTStringNull' is less than v.
+func (FullType_FTStringNullColumn) Lt(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTStringNull' is less than or equal to v.
+func (FullType_FTStringNullColumn) Le(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTStringNull' is greater than v.
+func (FullType_FTStringNullColumn) Gt(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTStringNull' is greater than or equal to v.
+func (FullType_FTStringNullColumn) Ge(v spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTStringNull' is equal to any of vs.
+func (FullType_FTStringNullColumn) In(vs ...spanner.NullString) YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTStringNull' is NULL.
+func (FullType_FTStringNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTStringNull' is not NULL.
+func (FullType_FTStringNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTStringNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTBoolColumn has the predicate constructors of 'FTBool'.
+type FullType_FTBoolColumn struct{}
+
+// Eq returns a predicate that 'FTBool' is equal to v.
+func (FullType_FTBoolColumn) Eq(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBool' is not equal to v.
+func (FullType_FTBoolColumn) Ne(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBool' is less than v.
+func (FullType_FTBoolColumn) Lt(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBool' is less than or equal to v.
+func (FullType_FTBoolColumn) Le(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBool' is greater than v.
+func (FullType_FTBoolColumn) Gt(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBool' is greater than or equal to v.
+func (FullType_FTBoolColumn) Ge(v bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBool' is equal to any of vs.
+func (FullType_FTBoolColumn) In(vs ...bool) YOPredicate {
+	return YOPredicate{column: "FTBool", op: "IN", value: vs}
+}
+
+// FullType_FTBoolNullColumn has the predicate constructors of 'FTBoolNull'.
+type FullType_FTBoolNullColumn struct{}
+
+// Eq returns a predicate that 'FTBoolNull' is equal to v.
+func (FullType_FTBoolNullColumn) Eq(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBoolNull' is not equal to v.
+func (FullType_FTBoolNullColumn) Ne(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBoolNull' is less than v.
+func (FullType_FTBoolNullColumn) Lt(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBoolNull' is less than or equal to v.
+func (FullType_FTBoolNullColumn) Le(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBoolNull' is greater than v.
+func (FullType_FTBoolNullColumn) Gt(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBoolNull' is greater than or equal to v.
+func (FullType_FTBoolNullColumn) Ge(v spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBoolNull' is equal to any of vs.
+func (FullType_FTBoolNullColumn) In(vs ...spanner.NullBool) YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTBoolNull' is NULL.
+func (FullType_FTBoolNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTBoolNull' is not NULL.
+func (FullType_FTBoolNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTBoolNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTBytesColumn has the predicate constructors of 'FTBytes'.
+type FullType_FTBytesColumn struct{}
+
+// Eq returns a predicate that 'FTBytes' is equal to v.
+func (FullType_FTBytesColumn) Eq(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBytes' is not equal to v.
+func (FullType_FTBytesColumn) Ne(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBytes' is less than v.
+func (FullType_FTBytesColumn) Lt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBytes' is less than or equal to v.
+func (FullType_FTBytesColumn) Le(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBytes' is greater than v.
+func (FullType_FTBytesColumn) Gt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBytes' is greater than or equal to v.
+func (FullType_FTBytesColumn) Ge(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBytes' is equal to any of vs.
+func (FullType_FTBytesColumn) In(vs ...[]byte) YOPredicate {
+	return YOPredicate{column: "FTBytes", op: "IN", value: vs}
+}
+
+// FullType_FTBytesNullColumn has the predicate constructors of 'FTBytesNull'.
+type FullType_FTBytesNullColumn struct{}
+
+// Eq returns a predicate that 'FTBytesNull' is equal to v.
+func (FullType_FTBytesNullColumn) Eq(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTBytesNull' is not equal to v.
+func (FullType_FTBytesNullColumn) Ne(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTBytesNull' is less than v.
+func (FullType_FTBytesNullColumn) Lt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTBytesNull' is less than or equal to v.
+func (FullType_FTBytesNullColumn) Le(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTBytesNull' is greater than v.
+func (FullType_FTBytesNullColumn) Gt(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTBytesNull' is greater than or equal to v.
+func (FullType_FTBytesNullColumn) Ge(v []byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTBytesNull' is equal to any of vs.
+func (FullType_FTBytesNullColumn) In(vs ...[]byte) YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTBytesNull' is NULL.
+func (FullType_FTBytesNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTBytesNull' is not NULL.
+func (FullType_FTBytesNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTBytesNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTTimestampColumn has the predicate constructors of 'FTTimestamp'.
+type FullType_FTTimestampColumn struct{}
+
+// Eq returns a predicate that 'FTTimestamp' is equal to v.
+func (FullType_FTTimestampColumn) Eq(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTTimestamp' is not equal to v.
+func (FullType_FTTimestampColumn) Ne(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTTimestamp' is less than v.
+func (FullType_FTTimestampColumn) Lt(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTTimestamp' is less than or equal to v.
+func (FullType_FTTimestampColumn) Le(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTTimestamp' is greater than v.
+func (FullType_FTTimestampColumn) Gt(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTTimestamp' is greater than or equal to v.
+func (FullType_FTTimestampColumn) Ge(v time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTTimestamp' is equal to any of vs.
+func (FullType_FTTimestampColumn) In(vs ...time.Time) YOPredicate {
+	return YOPredicate{column: "FTTimestamp", op: "IN", value: vs}
+}
+
+// FullType_FTTimestampNullColumn has the predicate constructors of 'FTTimestampNull'.
+type FullType_FTTimestampNullColumn struct{}
+
+// Eq returns a predicate that 'FTTimestampNull' is equal to v.
+func (FullType_FTTimestampNullColumn) Eq(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTTimestampNull' is not equal to v.
+func (FullType_FTTimestampNullColumn) Ne(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTTimestampNull' is less than v.
+func (FullType_FTTimestampNullColumn) Lt(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTTimestampNull' is less than or equal to v.
+func (FullType_FTTimestampNullColumn) Le(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTTimestampNull' is greater than v.
+func (FullType_FTTimestampNullColumn) Gt(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTTimestampNull' is greater than or equal to v.
+func (FullType_FTTimestampNullColumn) Ge(v spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTTimestampNull' is equal to any of vs.
+func (FullType_FTTimestampNullColumn) In(vs ...spanner.NullTime) YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTTimestampNull' is NULL.
+func (FullType_FTTimestampNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTTimestampNull' is not NULL.
+func (FullType_FTTimestampNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTTimestampNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTIntColumn has the predicate constructors of 'FTInt'.
+type FullType_FTIntColumn struct{}
+
+// Eq returns a predicate that 'FTInt' is equal to v.
+func (FullType_FTIntColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTInt' is not equal to v.
+func (FullType_FTIntColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTInt' is less than v.
+func (FullType_FTIntColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTInt' is less than or equal to v.
+func (FullType_FTIntColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTInt' is greater than v.
+func (FullType_FTIntColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTInt' is greater than or equal to v.
+func (FullType_FTIntColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTInt' is equal to any of vs.
+func (FullType_FTIntColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "FTInt", op: "IN", value: vs}
+}
+
+// FullType_FTIntNullColumn has the predicate constructors of 'FTIntNull'.
+type FullType_FTIntNullColumn struct{}
+
+// Eq returns a predicate that 'FTIntNull' is equal to v.
+func (FullType_FTIntNullColumn) Eq(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTIntNull' is not equal to v.
+func (FullType_FTIntNullColumn) Ne(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTIntNull' is less than v.
+func (FullType_FTIntNullColumn) Lt(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTIntNull' is less than or equal to v.
+func (FullType_FTIntNullColumn) Le(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTIntNull' is greater than v.
+func (FullType_FTIntNullColumn) Gt(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTIntNull' is greater than or equal to v.
+func (FullType_FTIntNullColumn) Ge(v spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTIntNull' is equal to any of vs.
+func (FullType_FTIntNullColumn) In(vs ...spanner.NullInt64) YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTIntNull' is NULL.
+func (FullType_FTIntNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTIntNull' is not NULL.
+func (FullType_FTIntNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTIntNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTFloatColumn has the predicate constructors of 'FTFloat'.
+type FullType_FTFloatColumn struct{}
+
+// Eq returns a predicate that 'FTFloat' is equal to v.
+func (FullType_FTFloatColumn) Eq(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTFloat' is not equal to v.
+func (FullType_FTFloatColumn) Ne(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTFloat' is less than v.
+func (FullType_FTFloatColumn) Lt(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTFloat' is less than or equal to v.
+func (FullType_FTFloatColumn) Le(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTFloat' is greater than v.
+func (FullType_FTFloatColumn) Gt(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTFloat' is greater than or equal to v.
+func (FullType_FTFloatColumn) Ge(v float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTFloat' is equal to any of vs.
+func (FullType_FTFloatColumn) In(vs ...float64) YOPredicate {
+	return YOPredicate{column: "FTFloat", op: "IN", value: vs}
+}
+
+// FullType_FTFloatNullColumn has the predicate constructors of 'FTFloatNull'.
+type FullType_FTFloatNullColumn struct{}
+
+// Eq returns a predicate that 'FTFloatNull' is equal to v.
+func (FullType_FTFloatNullColumn) Eq(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTFloatNull' is not equal to v.
+func (FullType_FTFloatNullColumn) Ne(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTFloatNull' is less than v.
+func (FullType_FTFloatNullColumn) Lt(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTFloatNull' is less than or equal to v.
+func (FullType_FTFloatNullColumn) Le(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTFloatNull' is greater than v.
+func (FullType_FTFloatNullColumn) Gt(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTFloatNull' is greater than or equal to v.
+func (FullType_FTFloatNullColumn) Ge(v spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTFloatNull' is equal to any of vs.
+func (FullType_FTFloatNullColumn) In(vs ...spanner.NullFloat64) YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTFloatNull' is NULL.
+func (FullType_FTFloatNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTFloatNull' is not NULL.
+func (FullType_FTFloatNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTFloatNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTDateColumn has the predicate constructors of 'FTDate'.
+type FullType_FTDateColumn struct{}
+
+// Eq returns a predicate that 'FTDate' is equal to v.
+func (FullType_FTDateColumn) Eq(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTDate' is not equal to v.
+func (FullType_FTDateColumn) Ne(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTDate' is less than v.
+func (FullType_FTDateColumn) Lt(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTDate' is less than or equal to v.
+func (FullType_FTDateColumn) Le(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTDate' is greater than v.
+func (FullType_FTDateColumn) Gt(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTDate' is greater than or equal to v.
+func (FullType_FTDateColumn) Ge(v civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTDate' is equal to any of vs.
+func (FullType_FTDateColumn) In(vs ...civil.Date) YOPredicate {
+	return YOPredicate{column: "FTDate", op: "IN", value: vs}
+}
+
+// FullType_FTDateNullColumn has the predicate constructors of 'FTDateNull'.
+type FullType_FTDateNullColumn struct{}
+
+// Eq returns a predicate that 'FTDateNull' is equal to v.
+func (FullType_FTDateNullColumn) Eq(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FTDateNull' is not equal to v.
+func (FullType_FTDateNullColumn) Ne(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FTDateNull' is less than v.
+func (FullType_FTDateNullColumn) Lt(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FTDateNull' is less than or equal to v.
+func (FullType_FTDateNullColumn) Le(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FTDateNull' is greater than v.
+func (FullType_FTDateNullColumn) Gt(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FTDateNull' is greater than or equal to v.
+func (FullType_FTDateNullColumn) Ge(v spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FTDateNull' is equal to any of vs.
+func (FullType_FTDateNullColumn) In(vs ...spanner.NullDate) YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "IN", value: vs}
+}
+
+// IsNull returns a predicate that 'FTDateNull' is NULL.
+func (FullType_FTDateNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTDateNull' is not NULL.
+func (FullType_FTDateNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTDateNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTJSONColumn has the predicate constructors of 'FTJson'.
+type FullType_FTJSONColumn struct{}
+
+// FullType_FTJSONNullColumn has the predicate constructors of 'FTJsonNull'.
+type FullType_FTJSONNullColumn struct{}
+
+// IsNull returns a predicate that 'FTJsonNull' is NULL.
+func (FullType_FTJSONNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTJsonNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTJsonNull' is not NULL.
+func (FullType_FTJSONNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTJsonNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayStringNullColumn has the predicate constructors of 'FTArrayStringNull'.
+type FullType_FTArrayStringNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayStringNull' is NULL.
+func (FullType_FTArrayStringNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayStringNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayStringNull' is not NULL.
+func (FullType_FTArrayStringNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayStringNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayStringColumn has the predicate constructors of 'FTArrayString'.
+type FullType_FTArrayStringColumn struct{}
+
+// FullType_FTArrayBoolNullColumn has the predicate constructors of 'FTArrayBoolNull'.
+type FullType_FTArrayBoolNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayBoolNull' is NULL.
+func (FullType_FTArrayBoolNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBoolNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayBoolNull' is not NULL.
+func (FullType_FTArrayBoolNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBoolNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayBoolColumn has the predicate constructors of 'FTArrayBool'.
+type FullType_FTArrayBoolColumn struct{}
+
+// FullType_FTArrayBytesNullColumn has the predicate constructors of 'FTArrayBytesNull'.
+type FullType_FTArrayBytesNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayBytesNull' is NULL.
+func (FullType_FTArrayBytesNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBytesNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayBytesNull' is not NULL.
+func (FullType_FTArrayBytesNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayBytesNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayBytesColumn has the predicate constructors of 'FTArrayBytes'.
+type FullType_FTArrayBytesColumn struct{}
+
+// FullType_FTArrayTimestampNullColumn has the predicate constructors of 'FTArrayTimestampNull'.
+type FullType_FTArrayTimestampNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayTimestampNull' is NULL.
+func (FullType_FTArrayTimestampNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayTimestampNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayTimestampNull' is not NULL.
+func (FullType_FTArrayTimestampNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayTimestampNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayTimestampColumn has the predicate constructors of 'FTArrayTimestamp'.
+type FullType_FTArrayTimestampColumn struct{}
+
+// FullType_FTArrayIntNullColumn has the predicate constructors of 'FTArrayIntNull'.
+type FullType_FTArrayIntNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayIntNull' is NULL.
+func (FullType_FTArrayIntNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayIntNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayIntNull' is not NULL.
+func (FullType_FTArrayIntNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayIntNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayIntColumn has the predicate constructors of 'FTArrayInt'.
+type FullType_FTArrayIntColumn struct{}
+
+// FullType_FTArrayFloatNullColumn has the predicate constructors of 'FTArrayFloatNull'.
+type FullType_FTArrayFloatNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayFloatNull' is NULL.
+func (FullType_FTArrayFloatNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayFloatNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayFloatNull' is not NULL.
+func (FullType_FTArrayFloatNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayFloatNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayFloatColumn has the predicate constructors of 'FTArrayFloat'.
+type FullType_FTArrayFloatColumn struct{}
+
+// FullType_FTArrayDateNullColumn has the predicate constructors of 'FTArrayDateNull'.
+type FullType_FTArrayDateNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayDateNull' is NULL.
+func (FullType_FTArrayDateNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayDateNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayDateNull' is not NULL.
+func (FullType_FTArrayDateNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayDateNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayDateColumn has the predicate constructors of 'FTArrayDate'.
+type FullType_FTArrayDateColumn struct{}
+
+// FullType_FTArrayJSONNullColumn has the predicate constructors of 'FTArrayJsonNull'.
+type FullType_FTArrayJSONNullColumn struct{}
+
+// IsNull returns a predicate that 'FTArrayJsonNull' is NULL.
+func (FullType_FTArrayJSONNullColumn) IsNull() YOPredicate {
+	return YOPredicate{column: "FTArrayJsonNull", op: "IS NULL"}
+}
+
+// IsNotNull returns a predicate that 'FTArrayJsonNull' is not NULL.
+func (FullType_FTArrayJSONNullColumn) IsNotNull() YOPredicate {
+	return YOPredicate{column: "FTArrayJsonNull", op: "IS NOT NULL"}
+}
+
+// FullType_FTArrayJSONColumn has the predicate constructors of 'FTArrayJson'.
+type FullType_FTArrayJSONColumn struct{}
+
+// Insert returns a Mutation to insert a row into a table. If the row already
+// exists, the write or transaction fails.
+func (ft *FullType) Insert(ctx context.Context) *spanner.Mutation {
+	values, _ := ft.columnsToValues(FullTypeWritableColumns())
+	return spanner.Insert("FullTypes", FullTypeWritableColumns(), values)
+}
+
+// InsertAllFullTypes inserts rows into 'FullTypes' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllFullTypes(ctx context.Context, client *spanner.Client, rows []*FullType) (int, error) {
+	ms := FullTypesInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(FullTypeWritableColumns()) * (1 + 5)
+
+	return yoApplyInBatches(ctx, client, "InsertAllFullTypes", "FullTypes", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// FullTypesInsert returns Mutations to insert the rows into
+// 'FullTypes', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllFullTypes, the Mutations are
+// not split into batches.
+func FullTypesInsert(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// Update returns a Mutation to update a row in a table. If the row does not
+// already exist, the write or transaction fails.
+func (ft *FullType) Update(ctx context.Context) *spanner.Mutation {
+	values, _ := ft.columnsToValues(FullTypeWritableColumns())
+	return spanner.Update("FullTypes", FullTypeWritableColumns(), values)
+}
+
+// InsertOrUpdate returns a Mutation to insert a row into a table. If the row
+// already exists, it updates it instead. Any column values not explicitly
+// written are preserved.
+func (ft *FullType) InsertOrUpdate(ctx context.Context) *spanner.Mutation {
+	values, _ := ft.columnsToValues(FullTypeWritableColumns())
+	return spanner.InsertOrUpdate("FullTypes", FullTypeWritableColumns(), values)
+}
+
+// FullTypesUpdate returns Mutations to update the rows in
+// 'FullTypes', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FullTypesUpdate(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// FullTypesInsertOrUpdate returns Mutations to insert or update the
+// rows in 'FullTypes', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func FullTypesInsertOrUpdate(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the FullType with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (ft *FullType) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{ft.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteFullTypes inserts or updates rows in 'FullTypes' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteFullTypes(ctx context.Context, client *spanner.Client, rows []*FullType) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteFullTypes", "FullTypes", groups)
+}
+
+// InsertOrUpdateFullTypeAtLeastOnce inserts or updates row in 'FullTypes' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateFullTypeAtLeastOnce(ctx context.Context, client *spanner.Client, row *FullType) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateFullTypeAtLeastOnce", "FullTypes", err)
+	}
+
+	return nil
+}
+
+// UpdateColumns returns a Mutation to update specified columns of a row in a table.
+func (ft *FullType) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
+	// add primary keys to columns to update by primary keys
+	colsWithPKeys := append(cols, FullTypePrimaryKeys()...)
+
+	values, err := ft.columnsToValues(colsWithPKeys)
+	if err != nil {
+		return nil, newErrorWithCode(codes.InvalidArgument, "FullType.UpdateColumns", "FullTypes", err)
+	}
+
+	return spanner.Update("FullTypes", colsWithPKeys, values), nil
+}
+
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'FullTypes' can be specified.
+func (ft *FullType) MutationForColumns(ctx context.Context, cols ...FullTypeColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return ft.UpdateColumns(ctx, names...)
+}
+
+// FullTypeTracker tracks the changes of a FullType, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type FullTypeTracker struct {
+	row  *FullType
+	orig FullType
+}
+
+// TrackFullType returns a FullTypeTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackFullType(row *FullType) *FullTypeTracker {
+	tr := &FullTypeTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked FullType.
+func (tr *FullTypeTracker) Row() *FullType {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *FullTypeTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.FTString = yoClone(tr.row.FTString)
+	tr.orig.FTStringNull = yoClone(tr.row.FTStringNull)
+	tr.orig.FTBool = yoClone(tr.row.FTBool)
+	tr.orig.FTBoolNull = yoClone(tr.row.FTBoolNull)
+	tr.orig.FTBytes = yoClone(tr.row.FTBytes)
+	tr.orig.FTBytesNull = yoClone(tr.row.FTBytesNull)
+	tr.orig.FTTimestamp = yoClone(tr.row.FTTimestamp)
+	tr.orig.FTTimestampNull = yoClone(tr.row.FTTimestampNull)
+	tr.orig.FTInt = yoClone(tr.row.FTInt)
+	tr.orig.FTIntNull = yoClone(tr.row.FTIntNull)
+	tr.orig.FTFloat = yoClone(tr.row.FTFloat)
+	tr.orig.FTFloatNull = yoClone(tr.row.FTFloatNull)
+	tr.orig.FTDate = yoClone(tr.row.FTDate)
+	tr.orig.FTDateNull = yoClone(tr.row.FTDateNull)
+	tr.orig.FTJSON = yoClone(tr.row.FTJSON)
+	tr.orig.FTJSONNull = yoClone(tr.row.FTJSONNull)
+	tr.orig.FTArrayStringNull = yoClone(tr.row.FTArrayStringNull)
+	tr.orig.FTArrayString = yoClone(tr.row.FTArrayString)
+	tr.orig.FTArrayBoolNull = yoClone(tr.row.FTArrayBoolNull)
+	tr.orig.FTArrayBool = yoClone(tr.row.FTArrayBool)
+	tr.orig.FTArrayBytesNull = yoClone(tr.row.FTArrayBytesNull)
+	tr.orig.FTArrayBytes = yoClone(tr.row.FTArrayBytes)
+	tr.orig.FTArrayTimestampNull = yoClone(tr.row.FTArrayTimestampNull)
+	tr.orig.FTArrayTimestamp = yoClone(tr.row.FTArrayTimestamp)
+	tr.orig.FTArrayIntNull = yoClone(tr.row.FTArrayIntNull)
+	tr.orig.FTArrayInt = yoClone(tr.row.FTArrayInt)
+	tr.orig.FTArrayFloatNull = yoClone(tr.row.FTArrayFloatNull)
+	tr.orig.FTArrayFloat = yoClone(tr.row.FTArrayFloat)
+	tr.orig.FTArrayDateNull = yoClone(tr.row.FTArrayDateNull)
+	tr.orig.FTArrayDate = yoClone(tr.row.FTArrayDate)
+	tr.orig.FTArrayJSONNull = yoClone(tr.row.FTArrayJSONNull)
+	tr.orig.FTArrayJSON = yoClone(tr.row.FTArrayJSON)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'FullTypes'. The primary key columns and the
+// generated columns are never included.
+func (tr *FullTypeTracker) Changed() []FullTypeColumn {
+	var cols []FullTypeColumn
+	if !reflect.DeepEqual(tr.orig.FTString, tr.row.FTString) {
+		cols = append(cols, FullTypeColumnFTString)
+	}
+	if !reflect.DeepEqual(tr.orig.FTStringNull, tr.row.FTStringNull) {
+		cols = append(cols, FullTypeColumnFTStringNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBool, tr.row.FTBool) {
+		cols = append(cols, FullTypeColumnFTBool)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBoolNull, tr.row.FTBoolNull) {
+		cols = append(cols, FullTypeColumnFTBoolNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBytes, tr.row.FTBytes) {
+		cols = append(cols, FullTypeColumnFTBytes)
+	}
+	if !reflect.DeepEqual(tr.orig.FTBytesNull, tr.row.FTBytesNull) {
+		cols = append(cols, FullTypeColumnFTBytesNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTTimestamp, tr.row.FTTimestamp) {
+		cols = append(cols, FullTypeColumnFTTimestamp)
+	}
+	if !reflect.DeepEqual(tr.orig.FTTimestampNull, tr.row.FTTimestampNull) {
+		cols = append(cols, FullTypeColumnFTTimestampNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTInt, tr.row.FTInt) {
+		cols = append(cols, FullTypeColumnFTInt)
+	}
+	if !reflect.DeepEqual(tr.orig.FTIntNull, tr.row.FTIntNull) {
+		cols = append(cols, FullTypeColumnFTIntNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTFloat, tr.row.FTFloat) {
+		cols = append(cols, FullTypeColumnFTFloat)
+	}
+	if !reflect.DeepEqual(tr.orig.FTFloatNull, tr.row.FTFloatNull) {
+		cols = append(cols, FullTypeColumnFTFloatNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTDate, tr.row.FTDate) {
+		cols = append(cols, FullTypeColumnFTDate)
+	}
+	if !reflect.DeepEqual(tr.orig.FTDateNull, tr.row.FTDateNull) {
+		cols = append(cols, FullTypeColumnFTDateNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTJSON, tr.row.FTJSON) {
+		cols = append(cols, FullTypeColumnFTJSON)
+	}
+	if !reflect.DeepEqual(tr.orig.FTJSONNull, tr.row.FTJSONNull) {
+		cols = append(cols, FullTypeColumnFTJSONNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayStringNull, tr.row.FTArrayStringNull) {
+		cols = append(cols, FullTypeColumnFTArrayStringNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayString, tr.row.FTArrayString) {
+		cols = append(cols, FullTypeColumnFTArrayString)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBoolNull, tr.row.FTArrayBoolNull) {
+		cols = append(cols, FullTypeColumnFTArrayBoolNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBool, tr.row.FTArrayBool) {
+		cols = append(cols, FullTypeColumnFTArrayBool)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBytesNull, tr.row.FTArrayBytesNull) {
+		cols = append(cols, FullTypeColumnFTArrayBytesNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayBytes, tr.row.FTArrayBytes) {
+		cols = append(cols, FullTypeColumnFTArrayBytes)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayTimestampNull, tr.row.FTArrayTimestampNull) {
+		cols = append(cols, FullTypeColumnFTArrayTimestampNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayTimestamp, tr.row.FTArrayTimestamp) {
+		cols = append(cols, FullTypeColumnFTArrayTimestamp)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayIntNull, tr.row.FTArrayIntNull) {
+		cols = append(cols, FullTypeColumnFTArrayIntNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayInt, tr.row.FTArrayInt) {
+		cols = append(cols, FullTypeColumnFTArrayInt)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayFloatNull, tr.row.FTArrayFloatNull) {
+		cols = append(cols, FullTypeColumnFTArrayFloatNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayFloat, tr.row.FTArrayFloat) {
+		cols = append(cols, FullTypeColumnFTArrayFloat)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayDateNull, tr.row.FTArrayDateNull) {
+		cols = append(cols, FullTypeColumnFTArrayDateNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayDate, tr.row.FTArrayDate) {
+		cols = append(cols, FullTypeColumnFTArrayDate)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayJSONNull, tr.row.FTArrayJSONNull) {
+		cols = append(cols, FullTypeColumnFTArrayJSONNull)
+	}
+	if !reflect.DeepEqual(tr.orig.FTArrayJSON, tr.row.FTArrayJSON) {
+		cols = append(cols, FullTypeColumnFTArrayJSON)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *FullTypeTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
+// FindFullType gets a FullType by primary key
+func FindFullType(ctx context.Context, db YORODB, pKey string, opts ...*spanner.ReadOptions) (*FullType, error) {
+	key := spanner.Key{pKey}
+	row, err := yoReadRow(ctx, db, "FullTypes", key, FullTypeColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFullType", "FullTypes", key, ErrFullTypeNotFound, nil)
+		}
+		return nil, newError("FindFullType", "FullTypes", err)
+	}
+
+	ft, err := ScanFullType(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullType", "FullTypes", err)
+	}
+
+	return ft, nil
+}
+
+// FullTypeExists reports whether the row of the primary key exists in
+// 'FullTypes', which reads only the primary key columns.
+func FullTypeExists(ctx context.Context, db YORODB, pKey string, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{pKey}
+	if _, err := yoReadRow(ctx, db, "FullTypes", key, FullTypePrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("FullTypeExists", "FullTypes", err)
+	}
+
+	return true, nil
+}
+
+// ReadFullType retrieves multiples rows from FullType by KeySet as a slice.
+func ReadFullType(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+	rows := yoRead(ctx, db, "FullTypes", "", keys, FullTypeColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullType", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// FullTypePrimaryKey is the primary key of 'FullTypes'.
+type FullTypePrimaryKey struct {
+	PKey string
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadFullType.
+func (k FullTypePrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.PKey}
+}
+
+// ParseFullTypePrimaryKey parses key of the columns of the primary key of
+// 'FullTypes' in order, such as the key of a mutation.
+func ParseFullTypePrimaryKey(key spanner.Key) (FullTypePrimaryKey, error) {
+	var k FullTypePrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'FullTypes' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(string)
+	if !ok {
+		return k, fmt.Errorf("column 'PKey' of the key of 'FullTypes' must be string, but got %T", key[0])
+	}
+	k.PKey = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of ft.
+func (ft *FullType) PrimaryKey() FullTypePrimaryKey {
+	return FullTypePrimaryKey{
+		PKey: ft.PKey,
+	}
+}
+
+// FindFullTypeByKey gets a FullType by the primary key of key.
+func FindFullTypeByKey(ctx context.Context, db YORODB, key FullTypePrimaryKey, opts ...*spanner.ReadOptions) (*FullType, error) {
+	row, err := yoReadRow(ctx, db, "FullTypes", key.ToSpannerKey(), FullTypeColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindFullTypeByKey", "FullTypes", key.ToSpannerKey(), ErrFullTypeNotFound, nil)
+		}
+		return nil, newError("FindFullTypeByKey", "FullTypes", err)
+	}
+
+	ft, err := ScanFullType(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypeByKey", "FullTypes", err)
+	}
+
+	return ft, nil
+}
+
+// DeleteFullTypeByKey returns a Mutation to delete the row of key from
+// 'FullTypes'.
+func DeleteFullTypeByKey(ctx context.Context, key FullTypePrimaryKey) *spanner.Mutation {
+	return spanner.Delete("FullTypes", key.ToSpannerKey())
+}
+
+// FindFullTypesByKeys retrieves the rows of keys from 'FullTypes' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindFullTypesByKeys(ctx context.Context, db YORODB, keys []FullTypePrimaryKey, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*FullType, len(keys))
+	rows := yoRead(ctx, db, "FullTypes", "", spanner.KeySets(keySets...), FullTypeColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		byKey[ft.primaryKey().String()] = ft
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypesByKeys", "FullTypes", err)
+	}
+
+	res := make([]*FullType, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if ft, ok := byKey[s]; ok {
+			res = append(res, ft)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListFullTypesPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListFullTypesPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesPage", "FullTypes", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes",
+		[]string{"PKey"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesPage", "FullTypes", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadFullTypeRange retrieves multiple rows from FullType whose
+// 'PKey' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadFullTypeRange(ctx context.Context, db YORODB, start, end string, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "FullTypes", "", keys, FullTypeColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypeRange", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// primaryKey returns the key of the FullType, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (ft *FullType) primaryKey() spanner.Key {
+	return spanner.Key{ft.PKey}
+}
+
+// Delete deletes the FullType from the database.
+func (ft *FullType) Delete(ctx context.Context) *spanner.Mutation {
+	return spanner.Delete("FullTypes", ft.primaryKey())
+}
+
+// FullTypesDelete returns Mutations to delete the rows from
+// 'FullTypes', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func FullTypesDelete(ctx context.Context, rows []*FullType) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// FullTypeDescriptor describes 'FullTypes' for YORepository.
+var FullTypeDescriptor = &YODescriptor[FullType, FullTypePrimaryKey]{
+	Table:       "FullTypes",
+	Columns:     FullTypeColumns(),
+	PrimaryKeys: FullTypePrimaryKeys(),
+	Key: func(row *FullType) FullTypePrimaryKey {
+		return FullTypePrimaryKey{
+			PKey: row.PKey,
+		}
+	},
+	SpannerKey: FullTypePrimaryKey.ToSpannerKey,
+	Scan:       ScanFullType,
+	Find:       FindFullTypeByKey,
+	Read:       ReadFullType,
+	Insert: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *FullType) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewFullTypeRepository returns a YORepository of 'FullTypes' by client.
+func NewFullTypeRepository(client *spanner.Client) *YORepository[FullType, FullTypePrimaryKey] {
+	return NewYORepository(client, FullTypeDescriptor)
+}
+
+// FindFullTypeByFTString retrieves a row from 'FullTypes' as a FullType.
+//
+// If no row is present with the given key, then an error is returned where
+// errors.Is(err, ErrNotFound) is true.
+//
+// Generated from unique index 'FullTypesByFTString' (FTString).
+func FindFullTypeByFTString(ctx context.Context, db YORODB, fTString string, opts ...*spanner.ReadOptions) (*FullType, error) {
+	const sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByFTString} " +
+		"WHERE FTString = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTString
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypeByFTString", stmt, fTString)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("FindFullTypeByFTString", "FullTypes", spanner.Key{fTString}, ErrFullTypeNotFound, nil)
+		}
+		return nil, newError("FindFullTypeByFTString", "FullTypes", err)
+	}
+
+	ft, err := ScanFullType(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypeByFTString", "FullTypes", err)
+	}
+
+	return ft, nil
+}
+
+// ReadFullTypeByFTString retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from unique index 'FullTypesByFTString' (FTString).
+func ReadFullTypeByFTString(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+	columns := []string{
+		"PKey",
+		"FTString",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByFTString", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypeByFTString", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// FullTypesByFTStringRow represents a row of index 'FullTypesByFTString' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByFTStringRow struct {
+	FTString string `spanner:"FTString" json:"FTString"` // FTString
+	PKey     string `spanner:"PKey" json:"PKey"`         // PKey
+}
+
+// ReadFullTypesByFTStringRows retrieves multiples rows from index 'FullTypesByFTString' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByFTStringRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByFTStringRow, error) {
+	var res []*FullTypesByFTStringRow
+	columns := []string{
+		"FTString",
+		"PKey",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByFTString", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByFTStringRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTStringRows", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// scanFullTypesByFTStringRow decodes row having the columns of FullTypesByFTStringRow in order.
+func scanFullTypesByFTStringRow(row *spanner.Row) (*FullTypesByFTStringRow, error) {
+	var r FullTypesByFTStringRow
+	if err := row.Columns(&r.FTString, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByFTStringRow retrieves a row from index 'FullTypesByFTString' as a
+// FullTypesByFTStringRow by the index key. It selects only the columns covered by the
+// index, so that the query never joins back to 'FullTypes'.
+//
+// If no row is present with the given key, then an error is returned where
+// errors.Is(err, ErrNotFound) is true.
+func FindFullTypesByFTStringRow(ctx context.Context, db YORODB, fTString string, opts ...*spanner.ReadOptions) (*FullTypesByFTStringRow, error) {
+	const sqlstr = "SELECT " +
+		"FTString, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByFTString} " +
+		"WHERE FTString = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTString
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByFTStringRow", stmt, fTString)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, newNotFoundError("FindFullTypesByFTStringRow", "FullTypes", spanner.Key{fTString}, ErrFullTypeNotFound, nil)
+		}
+		return nil, newError("FindFullTypesByFTStringRow", "FullTypes", err)
+	}
+
+	r, err := scanFullTypesByFTStringRow(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTStringRow", "FullTypes", err)
+	}
+
+	return r, nil
+}
+
+// ListFullTypesByFTStringPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByFTString' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTStringPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTStringPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 string
+		var k1 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTStringPage", "FullTypes", err)
+		}
+		key = []interface{}{k0, k1}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByFTString}",
+		[]string{"FTString", "PKey"},
+		[]bool{false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTStringPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesByFTStringPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTString, last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTStringPage", "FullTypes", err)
+	}
+
+	return res, token, nil
+}
+
+// FindFullTypesByFTIntFTTimestampNull retrieves multiple rows from 'FullTypes' as a slice of FullType.
+//
+// Generated from index 'FullTypesByInTimestampNull' (FTInt, FTTimestampNull).
+func FindFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
+
+	conds := make([]string, 2)
+	conds[0] = "FTInt = @param0"
+	if fTTimestampNull.IsNull() {
+		conds[1] = "FTTimestampNull IS NULL"
+	} else {
+		conds[1] = "FTTimestampNull = @param1"
+	}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestampNull
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByFTIntFTTimestampNull", stmt, fTInt, fTTimestampNull)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FullType{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+		}
+
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+		}
+
+		res = append(res, ft)
+	}
+
+	return res, nil
+}
+
+// CountFullTypesByFTIntFTTimestampNull returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTIntFTTimestampNull.
+func CountFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime, opts ...*spanner.ReadOptions) (int64, error) {
+	var sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
+
+	conds := make([]string, 2)
+	conds[0] = "FTInt = @param0"
+	if fTTimestampNull.IsNull() {
+		conds[1] = "FTTimestampNull IS NULL"
+	} else {
+		conds[1] = "FTTimestampNull = @param1"
+	}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestampNull
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTIntFTTimestampNull", stmt, fTInt, fTTimestampNull)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// ReadFullTypesByFTIntFTTimestampNull retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'FullTypesByInTimestampNull' (FTInt, FTTimestampNull).
+func ReadFullTypesByFTIntFTTimestampNull(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+	columns := []string{
+		"PKey",
+		"FTInt",
+		"FTTimestampNull",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByInTimestampNull", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTTimestampNull", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// FullTypesByInTimestampNullRow represents a row of index 'FullTypesByInTimestampNull' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByInTimestampNullRow struct {
+	FTInt           int64            `spanner:"FTInt" json:"FTInt"`                     // FTInt
+	FTTimestampNull spanner.NullTime `spanner:"FTTimestampNull" json:"FTTimestampNull"` // FTTimestampNull
+	PKey            string           `spanner:"PKey" json:"PKey"`                       // PKey
+}
+
+// ReadFullTypesByInTimestampNullRows retrieves multiples rows from index 'FullTypesByInTimestampNull' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByInTimestampNullRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByInTimestampNullRow, error) {
+	var res []*FullTypesByInTimestampNullRow
+	columns := []string{
+		"FTInt",
+		"FTTimestampNull",
+		"PKey",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByInTimestampNull", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByInTimestampNullRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByInTimestampNullRows", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// scanFullTypesByInTimestampNullRow decodes row having the columns of FullTypesByInTimestampNullRow in order.
+func scanFullTypesByInTimestampNullRow(row *spanner.Row) (*FullTypesByInTimestampNullRow, error) {
+	var r FullTypesByInTimestampNullRow
+	if err := row.Columns(&r.FTInt, &r.FTTimestampNull, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByInTimestampNullRows retrieves multiple rows from index 'FullTypesByInTimestampNull' as a
+// slice of FullTypesByInTimestampNullRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByInTimestampNullRows(ctx context.Context, db YORODB, fTInt int64, fTTimestampNull spanner.NullTime, opts ...*spanner.ReadOptions) ([]*FullTypesByInTimestampNullRow, error) {
+	var sqlstr = "SELECT " +
+		"FTInt, FTTimestampNull, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} "
+
+	conds := make([]string, 2)
+	conds[0] = "FTInt = @param0"
+	if fTTimestampNull.IsNull() {
+		conds[1] = "FTTimestampNull IS NULL"
+	} else {
+		conds[1] = "FTTimestampNull = @param1"
+	}
+	sqlstr += "WHERE " + strings.Join(conds, " AND ")
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestampNull
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByInTimestampNullRows", stmt, fTInt, fTTimestampNull)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*FullTypesByInTimestampNullRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByInTimestampNullRows", "FullTypes", err)
+		}
+
+		r, err := scanFullTypesByInTimestampNullRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByInTimestampNullRows", "FullTypes", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// FindFullTypesByFTInt retrieves multiple rows from 'FullTypes' as a slice of FullType.
+// The rows are ordered by the rest of the index key.
+//
+// Generated from a prefix of the key of index 'FullTypesByInTimestampNull' (FTInt, FTTimestampNull).
+func FindFullTypesByFTInt(ctx context.Context, db YORODB, fTInt int64, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	const sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} " +
+		"WHERE FTInt = @param0" +
+		" ORDER BY FTTimestampNull"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByFTInt", stmt, fTInt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FullType{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByFTInt", "FullTypes", err)
+		}
+
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTInt", "FullTypes", err)
+		}
+
+		res = append(res, ft)
+	}
+
+	return res, nil
+}
+
+// CountFullTypesByFTInt returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTInt.
+func CountFullTypesByFTInt(ctx context.Context, db YORODB, fTInt int64, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByInTimestampNull} " +
+		"WHERE FTInt = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTInt", stmt, fTInt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTInt", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// FindFullTypesByFTIntFTDate retrieves multiple rows from 'FullTypes' as a slice of FullType.
+//
+// Generated from index 'FullTypesByIntDate' (FTInt, FTDate).
+func FindFullTypesByFTIntFTDate(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	const sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
+		"WHERE FTInt = @param0 AND FTDate = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTDate
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByFTIntFTDate", stmt, fTInt, fTDate)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FullType{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByFTIntFTDate", "FullTypes", err)
+		}
+
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTDate", "FullTypes", err)
+		}
+
+		res = append(res, ft)
+	}
+
+	return res, nil
+}
+
+// CountFullTypesByFTIntFTDate returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTIntFTDate.
+func CountFullTypesByFTIntFTDate(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
+		"WHERE FTInt = @param0 AND FTDate = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTDate
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTIntFTDate", stmt, fTInt, fTDate)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTIntFTDate", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// ReadFullTypesByFTIntFTDate retrieves multiples rows from 'FullTypes' by KeySet as a slice.
+//
+// This does not retrieve all columns of 'FullTypes' because an index has only columns
+// used for primary key, index key and storing columns. If you need more columns, add storing
+// columns or Read by primary key or Query with join.
+//
+// Generated from index 'FullTypesByIntDate' (FTInt, FTDate).
+func ReadFullTypesByFTIntFTDate(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	var res []*FullType
+	columns := []string{
+		"PKey",
+		"FTInt",
+		"FTDate",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntDate", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ft)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByFTIntFTDate", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// FullTypesByIntDateRow represents a row of index 'FullTypesByIntDate' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByIntDateRow struct {
+	FTInt  int64      `spanner:"FTInt" json:"FTInt"`   // FTInt
+	FTDate civil.Date `spanner:"FTDate" json:"FTDate"` // FTDate
+	PKey   string     `spanner:"PKey" json:"PKey"`     // PKey
+}
+
+// ReadFullTypesByIntDateRows retrieves multiples rows from index 'FullTypesByIntDate' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByIntDateRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByIntDateRow, error) {
+	var res []*FullTypesByIntDateRow
+	columns := []string{
+		"FTInt",
+		"FTDate",
+		"PKey",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntDate", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByIntDateRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByIntDateRows", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// scanFullTypesByIntDateRow decodes row having the columns of FullTypesByIntDateRow in order.
+func scanFullTypesByIntDateRow(row *spanner.Row) (*FullTypesByIntDateRow, error) {
+	var r FullTypesByIntDateRow
+	if err := row.Columns(&r.FTInt, &r.FTDate, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByIntDateRows retrieves multiple rows from index 'FullTypesByIntDate' as a
+// slice of FullTypesByIntDateRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByIntDateRows(ctx context.Context, db YORODB, fTInt int64, fTDate civil.Date, opts ...*spanner.ReadOptions) ([]*FullTypesByIntDateRow, error) {
+	const sqlstr = "SELECT " +
+		"FTInt, FTDate, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate} " +
+		"WHERE FTInt = @param0 AND FTDate = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTDate
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByIntDateRows", stmt, fTInt, fTDate)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*FullTypesByIntDateRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByIntDateRows", "FullTypes", err)
+		}
+
+		r, err := scanFullTypesByIntDateRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByIntDateRows", "FullTypes", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListFullTypesByFTIntFTDatePage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByIntDate' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTIntFTDatePage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTDatePage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 civil.Date
+		var k2 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTDatePage", "FullTypes", err)
+		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByIntDate}",
+		[]string{"FTInt", "FTDate", "PKey"},
+		[]bool{false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTIntFTDatePage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesByFTIntFTDatePage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTInt, last.FTDate, last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTIntFTDatePage", "FullTypes", err)
+	}
+
+	return res, token, nil
+}
+
+// FindFullTypesByFTIntFTTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
+//
+// Generated from index 'FullTypesByIntTimestamp' (FTInt, FTTimestamp).
+func FindFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullType, error) {
+	const sqlstr = "SELECT " +
+		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
+		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestamp
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByFTIntFTTimestamp", stmt, fTInt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*FullType{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
+		}
+
+		ft, err := ScanFullType(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTIntFTTimestamp", "FullTypes", err)
+		}
+
+		res = append(res, ft)
+	}
+
+	return res, nil
+}
+
+// CountFullTypesByFTIntFTTimestamp returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTIntFTTimestamp.
+func CountFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
+		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestamp
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTIntFTTimestamp", stmt, fTInt, fTTimestamp)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTIntFTTimestamp", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
+// ReadFullTypesByFTIntFTTimestamp retrieves multiples rows from 'FullTypes' by KeySet as a slice.
 //
 // This does not retrieve all columns of 'FullTypes' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'FullTypesByIntTimestamp'.
-func ReadFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
+// Generated from index 'FullTypesByIntTimestamp' (FTInt, FTTimestamp).
+func ReadFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
 	var res []*FullType
 	columns := []string{
 		"PKey",
@@ -682,11 +2784,9 @@ func ReadFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, keys spanne
 		"FTTimestamp",
 	}
 
-	decoder := newFullType_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByIntTimestamp", keys, columns)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntTimestamp", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
 			return err
 		}
@@ -701,10 +2801,138 @@ func ReadFullTypesByFTIntFTTimestamp(ctx context.Context, db YORODB, keys spanne
 	return res, nil
 }
 
+// FullTypesByIntTimestampRow represents a row of index 'FullTypesByIntTimestamp' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByIntTimestampRow struct {
+	FTInt       int64     `spanner:"FTInt" json:"FTInt"`             // FTInt
+	FTTimestamp time.Time `spanner:"FTTimestamp" json:"FTTimestamp"` // FTTimestamp
+	PKey        string    `spanner:"PKey" json:"PKey"`               // PKey
+}
+
+// ReadFullTypesByIntTimestampRows retrieves multiples rows from index 'FullTypesByIntTimestamp' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByIntTimestampRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByIntTimestampRow, error) {
+	var res []*FullTypesByIntTimestampRow
+	columns := []string{
+		"FTInt",
+		"FTTimestamp",
+		"PKey",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByIntTimestamp", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByIntTimestampRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByIntTimestampRows", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// scanFullTypesByIntTimestampRow decodes row having the columns of FullTypesByIntTimestampRow in order.
+func scanFullTypesByIntTimestampRow(row *spanner.Row) (*FullTypesByIntTimestampRow, error) {
+	var r FullTypesByIntTimestampRow
+	if err := row.Columns(&r.FTInt, &r.FTTimestamp, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByIntTimestampRows retrieves multiple rows from index 'FullTypesByIntTimestamp' as a
+// slice of FullTypesByIntTimestampRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByIntTimestampRows(ctx context.Context, db YORODB, fTInt int64, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullTypesByIntTimestampRow, error) {
+	const sqlstr = "SELECT " +
+		"FTInt, FTTimestamp, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp} " +
+		"WHERE FTInt = @param0 AND FTTimestamp = @param1"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTInt
+	stmt.Params["param1"] = fTTimestamp
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByIntTimestampRows", stmt, fTInt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*FullTypesByIntTimestampRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByIntTimestampRows", "FullTypes", err)
+		}
+
+		r, err := scanFullTypesByIntTimestampRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByIntTimestampRows", "FullTypes", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListFullTypesByFTIntFTTimestampPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByIntTimestamp' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTIntFTTimestampPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTTimestampPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		var k1 time.Time
+		var k2 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1, &k2); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTIntFTTimestampPage", "FullTypes", err)
+		}
+		key = []interface{}{k0, k1, k2}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByIntTimestamp}",
+		[]string{"FTInt", "FTTimestamp", "PKey"},
+		[]bool{false, false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTIntFTTimestampPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesByFTIntFTTimestampPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTInt, last.FTTimestamp, last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTIntFTTimestampPage", "FullTypes", err)
+	}
+
+	return res, token, nil
+}
+
 // FindFullTypesByFTTimestamp retrieves multiple rows from 'FullTypes' as a slice of FullType.
 //
-// Generated from index 'FullTypesByTimestamp'.
-func FindFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time.Time) ([]*FullType, error) {
+// Generated from index 'FullTypesByTimestamp' (FTTimestamp).
+func FindFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullType, error) {
 	const sqlstr = "SELECT " +
 		"PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson " +
 		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
@@ -713,11 +2941,9 @@ func FindFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time
 	stmt := spanner.NewStatement(sqlstr)
 	stmt.Params["param0"] = fTTimestamp
 
-	decoder := newFullType_Decoder(FullTypeColumns())
-
 	// run query
-	YOLog(ctx, sqlstr, fTTimestamp)
-	iter := db.Query(ctx, stmt)
+	defer yoLogQuery(ctx, "FindFullTypesByFTTimestamp", stmt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
 	defer iter.Stop()
 
 	// load results
@@ -731,7 +2957,7 @@ func FindFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time
 			return nil, newError("FindFullTypesByFTTimestamp", "FullTypes", err)
 		}
 
-		ft, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
 			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByFTTimestamp", "FullTypes", err)
 		}
@@ -742,25 +2968,43 @@ func FindFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time
 	return res, nil
 }
 
+// CountFullTypesByFTTimestamp returns the number of the rows of 'FullTypes' found by
+// FindFullTypesByFTTimestamp.
+func CountFullTypesByFTTimestamp(ctx context.Context, db YORODB, fTTimestamp time.Time, opts ...*spanner.ReadOptions) (int64, error) {
+	const sqlstr = "SELECT COUNT(*) " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
+		"WHERE FTTimestamp = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTTimestamp
+
+	// run query
+	defer yoLogQuery(ctx, "CountFullTypesByFTTimestamp", stmt, fTTimestamp)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountFullTypesByFTTimestamp", "FullTypes", err)
+	}
+
+	return n, nil
+}
+
 // ReadFullTypesByFTTimestamp retrieves multiples rows from 'FullTypes' by KeySet as a slice.
 //
 // This does not retrieve all columns of 'FullTypes' because an index has only columns
 // used for primary key, index key and storing columns. If you need more columns, add storing
 // columns or Read by primary key or Query with join.
 //
-// Generated from unique index 'FullTypesByTimestamp'.
-func ReadFullTypesByFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*FullType, error) {
+// Generated from index 'FullTypesByTimestamp' (FTTimestamp).
+func ReadFullTypesByFTTimestamp(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullType, error) {
 	var res []*FullType
 	columns := []string{
 		"PKey",
 		"FTTimestamp",
 	}
 
-	decoder := newFullType_Decoder(columns)
-
-	rows := db.ReadUsingIndex(ctx, "FullTypes", "FullTypesByTimestamp", keys, columns)
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByTimestamp", keys, columns, opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		ft, err := decoder(row)
+		ft, err := ScanFullType(row)
 		if err != nil {
 			return err
 		}
@@ -774,3 +3018,127 @@ func ReadFullTypesByFTTimestamp(ctx context.Context, db YORODB, keys spanner.Key
 
 	return res, nil
 }
+
+// FullTypesByTimestampRow represents a row of index 'FullTypesByTimestamp' of 'FullTypes',
+// which has the index key, the storing columns and the primary key.
+type FullTypesByTimestampRow struct {
+	FTTimestamp time.Time `spanner:"FTTimestamp" json:"FTTimestamp"` // FTTimestamp
+	PKey        string    `spanner:"PKey" json:"PKey"`               // PKey
+}
+
+// ReadFullTypesByTimestampRows retrieves multiples rows from index 'FullTypesByTimestamp' by
+// KeySet as a slice. This reads only the index and never reads 'FullTypes'.
+func ReadFullTypesByTimestampRows(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*FullTypesByTimestampRow, error) {
+	var res []*FullTypesByTimestampRow
+	columns := []string{
+		"FTTimestamp",
+		"PKey",
+	}
+
+	rows := yoRead(ctx, db, "FullTypes", "FullTypesByTimestamp", keys, columns, opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		r, err := scanFullTypesByTimestampRow(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadFullTypesByTimestampRows", "FullTypes", err)
+	}
+
+	return res, nil
+}
+
+// scanFullTypesByTimestampRow decodes row having the columns of FullTypesByTimestampRow in order.
+func scanFullTypesByTimestampRow(row *spanner.Row) (*FullTypesByTimestampRow, error) {
+	var r FullTypesByTimestampRow
+	if err := row.Columns(&r.FTTimestamp, &r.PKey); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// FindFullTypesByTimestampRows retrieves multiple rows from index 'FullTypesByTimestamp' as a
+// slice of FullTypesByTimestampRow by the index key. It selects only the columns covered
+// by the index, so that the query never joins back to 'FullTypes'.
+func FindFullTypesByTimestampRows(ctx context.Context, db YORODB, fTTimestamp time.Time, opts ...*spanner.ReadOptions) ([]*FullTypesByTimestampRow, error) {
+	const sqlstr = "SELECT " +
+		"FTTimestamp, PKey " +
+		"FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp} " +
+		"WHERE FTTimestamp = @param0"
+
+	stmt := spanner.NewStatement(sqlstr)
+	stmt.Params["param0"] = fTTimestamp
+
+	// run query
+	defer yoLogQuery(ctx, "FindFullTypesByTimestampRows", stmt, fTTimestamp)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	res := []*FullTypesByTimestampRow{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("FindFullTypesByTimestampRows", "FullTypes", err)
+		}
+
+		r, err := scanFullTypesByTimestampRow(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "FindFullTypesByTimestampRows", "FullTypes", err)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ListFullTypesByFTTimestampPage retrieves a page of at most pageSize rows from 'FullTypes'
+// ordered by the key of index 'FullTypesByTimestamp' and the primary key. The
+// page starts after the row of pageToken, or at the first row if pageToken is
+// empty. The returned token reads the next page, and is empty at the last page.
+// The Limit of opts is ignored.
+func ListFullTypesByFTTimestampPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*FullType, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTTimestampPage", "FullTypes", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 time.Time
+		var k1 string
+		if err := yoDecodePageToken(pageToken, &k0, &k1); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListFullTypesByFTTimestampPage", "FullTypes", err)
+		}
+		key = []interface{}{k0, k1}
+	}
+	stmt := yoPageStatement("SELECT PKey, FTString, FTStringNull, FTBool, FTBoolNull, FTBytes, FTBytesNull, FTTimestamp, FTTimestampNull, FTInt, FTIntNull, FTFloat, FTFloatNull, FTDate, FTDateNull, FTJson, FTJsonNull, FTArrayStringNull, FTArrayString, FTArrayBoolNull, FTArrayBool, FTArrayBytesNull, FTArrayBytes, FTArrayTimestampNull, FTArrayTimestamp, FTArrayIntNull, FTArrayInt, FTArrayFloatNull, FTArrayFloat, FTArrayDateNull, FTArrayDate, FTArrayJsonNull, FTArrayJson FROM FullTypes@{FORCE_INDEX=FullTypesByTimestamp}",
+		[]string{"FTTimestamp", "PKey"},
+		[]bool{false, false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListFullTypesByFTTimestampPage", stmt)()
+	res, err := ScanFullTypes(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListFullTypesByFTTimestampPage", "FullTypes", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.FTTimestamp, last.PKey)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListFullTypesByFTTimestampPage", "FullTypes", err)
+	}
+
+	return res, token, nil
+}
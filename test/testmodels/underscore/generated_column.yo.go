@@ -4,9 +4,12 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 )
 
@@ -18,6 +21,14 @@ type GeneratedColumn struct {
 	FullName  string `spanner:"FullName" json:"FullName"`   // FullName
 }
 
+// GeneratedColumnTableName is the name of 'GeneratedColumns', which is qualified by the
+// schema if the table is in a named schema.
+const GeneratedColumnTableName = "GeneratedColumns"
+
+// ErrGeneratedColumnNotFound is the error matched by errors.Is when the row is not
+// found in 'GeneratedColumns'.
+var ErrGeneratedColumnNotFound = errors.New("yo: GeneratedColumns not found")
+
 func GeneratedColumnPrimaryKeys() []string {
 	return []string{
 		"ID",
@@ -33,6 +44,17 @@ func GeneratedColumnColumns() []string {
 	}
 }
 
+// GeneratedColumnColumn is a column of 'GeneratedColumns'.
+type GeneratedColumnColumn string
+
+// Columns of 'GeneratedColumns'.
+const (
+	GeneratedColumnColumnID        GeneratedColumnColumn = "ID"
+	GeneratedColumnColumnFirstName GeneratedColumnColumn = "FirstName"
+	GeneratedColumnColumnLastName  GeneratedColumnColumn = "LastName"
+	GeneratedColumnColumnFullName  GeneratedColumnColumn = "FullName"
+)
+
 func GeneratedColumnWritableColumns() []string {
 	return []string{
 		"ID",
@@ -105,6 +127,440 @@ func newGeneratedColumn_Decoder(cols []string) func(*spanner.Row) (*GeneratedCol
 	}
 }
 
+// ScanGeneratedColumn decodes row into GeneratedColumn. The row may have any subset of
+// the columns of 'GeneratedColumns', such as the result of a query or a read.
+func ScanGeneratedColumn(row *spanner.Row) (*GeneratedColumn, error) {
+	return newGeneratedColumn_Decoder(row.ColumnNames())(row)
+}
+
+// ScanGeneratedColumns decodes all the rows of iter into a slice of GeneratedColumn.
+// iter is stopped when it returns.
+func ScanGeneratedColumns(iter *spanner.RowIterator) ([]*GeneratedColumn, error) {
+	var res []*GeneratedColumn
+	var decoder func(*spanner.Row) (*GeneratedColumn, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newGeneratedColumn_Decoder(row.ColumnNames())
+		}
+
+		gc, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, gc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GeneratedColumnIterator iterates over the rows of a partition of 'GeneratedColumns'
+// decoded into GeneratedColumn.
+type GeneratedColumnIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*GeneratedColumn, error)
+}
+
+// ExecuteGeneratedColumnPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllGeneratedColumns or GeneratedColumnQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteGeneratedColumnPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *GeneratedColumnIterator {
+	return &GeneratedColumnIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *GeneratedColumnIterator) Next() (*GeneratedColumn, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("GeneratedColumnIterator.Next", "GeneratedColumns", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newGeneratedColumn_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "GeneratedColumnIterator.Next", "GeneratedColumns", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *GeneratedColumnIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldGeneratedColumnRows decodes the rows of rows into GeneratedColumn and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldGeneratedColumnRows(rows *spanner.RowIterator, method string, yield func(*GeneratedColumn, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*GeneratedColumn, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "GeneratedColumns", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newGeneratedColumn_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "GeneratedColumns", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountGeneratedColumns returns the number of the rows of 'GeneratedColumns'.
+func CountGeneratedColumns(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM GeneratedColumns")
+
+	defer yoLogQuery(ctx, "CountGeneratedColumns", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountGeneratedColumns", "GeneratedColumns", err)
+	}
+
+	return n, nil
+}
+
+// AllGeneratedColumnsSeq returns an iterator of all the rows of 'GeneratedColumns',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*GeneratedColumn, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllGeneratedColumnsSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*GeneratedColumn, error) bool) {
+	return func(yield func(*GeneratedColumn, error) bool) {
+		rows := yoRead(ctx, db, "GeneratedColumns", "", spanner.AllKeys(), GeneratedColumnColumns(), opts)
+		yieldGeneratedColumnRows(rows, "AllGeneratedColumnsSeq", yield)
+	}
+}
+
+// PartitionReadAllGeneratedColumns partitions the read of all the rows of
+// 'GeneratedColumns' in btx, so that the partitions are read in parallel by
+// ExecuteGeneratedColumnPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllGeneratedColumns(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "GeneratedColumns", spanner.AllKeys(), GeneratedColumnColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllGeneratedColumns", "GeneratedColumns", err)
+	}
+
+	return ps, nil
+}
+
+// GeneratedColumnQuery is a query builder for 'GeneratedColumns'. The conditions are
+// given by the predicates of GeneratedColumnWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of GeneratedColumnColumn.
+type GeneratedColumnQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewGeneratedColumnQuery returns a GeneratedColumnQuery filtered by preds.
+func NewGeneratedColumnQuery(preds ...YOPredicate) *GeneratedColumnQuery {
+	return &GeneratedColumnQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *GeneratedColumnQuery) Where(preds ...YOPredicate) *GeneratedColumnQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *GeneratedColumnQuery) OrderBy(col GeneratedColumnColumn) *GeneratedColumnQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *GeneratedColumnQuery) OrderByDesc(col GeneratedColumnColumn) *GeneratedColumnQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *GeneratedColumnQuery) Limit(n int) *GeneratedColumnQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *GeneratedColumnQuery) Statement() spanner.Statement {
+	return yoStatement("ID, FirstName, LastName, FullName", "GeneratedColumns", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *GeneratedColumnQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "GeneratedColumnQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*GeneratedColumn{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("GeneratedColumnQuery.Query", "GeneratedColumns", err)
+		}
+
+		v, err := ScanGeneratedColumn(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "GeneratedColumnQuery.Query", "GeneratedColumns", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *GeneratedColumnQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "GeneratedColumns", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "GeneratedColumnQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("GeneratedColumnQuery.Count", "GeneratedColumns", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*GeneratedColumn, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *GeneratedColumnQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*GeneratedColumn, error) bool) {
+	return func(yield func(*GeneratedColumn, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "GeneratedColumnQuery.Seq", stmt)()
+		yieldGeneratedColumnRows(yoQuery(ctx, db, stmt, opts), "GeneratedColumnQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteGeneratedColumnPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *GeneratedColumnQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "GeneratedColumnQuery.PartitionQuery", "GeneratedColumns", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "GeneratedColumnQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("GeneratedColumnQuery.PartitionQuery", "GeneratedColumns", err)
+	}
+
+	return ps, nil
+}
+
+// GeneratedColumnWhere has the typed predicate constructors of the columns of
+// 'GeneratedColumns'.
+var GeneratedColumnWhere = struct {
+	ID        GeneratedColumn_IDColumn
+	FirstName GeneratedColumn_FirstNameColumn
+	LastName  GeneratedColumn_LastNameColumn
+	FullName  GeneratedColumn_FullNameColumn
+}{}
+
+// GeneratedColumn_IDColumn has the predicate constructors of 'ID'.
+type GeneratedColumn_IDColumn struct{}
+
+// Eq returns a predicate that 'ID' is equal to v.
+func (GeneratedColumn_IDColumn) Eq(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'ID' is not equal to v.
+func (GeneratedColumn_IDColumn) Ne(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'ID' is less than v.
+func (GeneratedColumn_IDColumn) Lt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<", value: v}
+}
+
+// Le returns a predicate that 'ID' is less than or equal to v.
+func (GeneratedColumn_IDColumn) Le(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'ID' is greater than v.
+func (GeneratedColumn_IDColumn) Gt(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'ID' is greater than or equal to v.
+func (GeneratedColumn_IDColumn) Ge(v int64) YOPredicate {
+	return YOPredicate{column: "ID", op: ">=", value: v}
+}
+
+// In returns a predicate that 'ID' is equal to any of vs.
+func (GeneratedColumn_IDColumn) In(vs ...int64) YOPredicate {
+	return YOPredicate{column: "ID", op: "IN", value: vs}
+}
+
+// GeneratedColumn_FirstNameColumn has the predicate constructors of 'FirstName'.
+type GeneratedColumn_FirstNameColumn struct{}
+
+// Eq returns a predicate that 'FirstName' is equal to v.
+func (GeneratedColumn_FirstNameColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FirstName' is not equal to v.
+func (GeneratedColumn_FirstNameColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FirstName' is less than v.
+func (GeneratedColumn_FirstNameColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FirstName' is less than or equal to v.
+func (GeneratedColumn_FirstNameColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FirstName' is greater than v.
+func (GeneratedColumn_FirstNameColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FirstName' is greater than or equal to v.
+func (GeneratedColumn_FirstNameColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FirstName' is equal to any of vs.
+func (GeneratedColumn_FirstNameColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "FirstName", op: "IN", value: vs}
+}
+
+// GeneratedColumn_LastNameColumn has the predicate constructors of 'LastName'.
+type GeneratedColumn_LastNameColumn struct{}
+
+// Eq returns a predicate that 'LastName' is equal to v.
+func (GeneratedColumn_LastNameColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'LastName' is not equal to v.
+func (GeneratedColumn_LastNameColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'LastName' is less than v.
+func (GeneratedColumn_LastNameColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "<", value: v}
+}
+
+// Le returns a predicate that 'LastName' is less than or equal to v.
+func (GeneratedColumn_LastNameColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'LastName' is greater than v.
+func (GeneratedColumn_LastNameColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'LastName' is greater than or equal to v.
+func (GeneratedColumn_LastNameColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "LastName", op: ">=", value: v}
+}
+
+// In returns a predicate that 'LastName' is equal to any of vs.
+func (GeneratedColumn_LastNameColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "LastName", op: "IN", value: vs}
+}
+
+// GeneratedColumn_FullNameColumn has the predicate constructors of 'FullName'.
+type GeneratedColumn_FullNameColumn struct{}
+
+// Eq returns a predicate that 'FullName' is equal to v.
+func (GeneratedColumn_FullNameColumn) Eq(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'FullName' is not equal to v.
+func (GeneratedColumn_FullNameColumn) Ne(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'FullName' is less than v.
+func (GeneratedColumn_FullNameColumn) Lt(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "<", value: v}
+}
+
+// Le returns a predicate that 'FullName' is less than or equal to v.
+func (GeneratedColumn_FullNameColumn) Le(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'FullName' is greater than v.
+func (GeneratedColumn_FullNameColumn) Gt(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'FullName' is greater than or equal to v.
+func (GeneratedColumn_FullNameColumn) Ge(v string) YOPredicate {
+	return YOPredicate{column: "FullName", op: ">=", value: v}
+}
+
+// In returns a predicate that 'FullName' is equal to any of vs.
+func (GeneratedColumn_FullNameColumn) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "FullName", op: "IN", value: vs}
+}
+
 // Insert returns a Mutation to insert a row into a table. If the row already
 // exists, the write or transaction fails.
 func (gc *GeneratedColumn) Insert(ctx context.Context) *spanner.Mutation {
@@ -112,6 +568,31 @@ func (gc *GeneratedColumn) Insert(ctx context.Context) *spanner.Mutation {
 	return spanner.Insert("GeneratedColumns", GeneratedColumnWritableColumns(), values)
 }
 
+// InsertAllGeneratedColumns inserts rows into 'GeneratedColumns' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllGeneratedColumns(ctx context.Context, client *spanner.Client, rows []*GeneratedColumn) (int, error) {
+	ms := GeneratedColumnsInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(GeneratedColumnWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllGeneratedColumns", "GeneratedColumns", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// GeneratedColumnsInsert returns Mutations to insert the rows into
+// 'GeneratedColumns', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllGeneratedColumns, the Mutations are
+// not split into batches.
+func GeneratedColumnsInsert(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
 // Update returns a Mutation to update a row in a table. If the row does not
 // already exist, the write or transaction fails.
 func (gc *GeneratedColumn) Update(ctx context.Context) *spanner.Mutation {
@@ -127,6 +608,63 @@ func (gc *GeneratedColumn) InsertOrUpdate(ctx context.Context) *spanner.Mutation
 	return spanner.InsertOrUpdate("GeneratedColumns", GeneratedColumnWritableColumns(), values)
 }
 
+// GeneratedColumnsUpdate returns Mutations to update the rows in
+// 'GeneratedColumns', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func GeneratedColumnsUpdate(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Update(ctx)
+	}
+	return ms
+}
+
+// GeneratedColumnsInsertOrUpdate returns Mutations to insert or update the
+// rows in 'GeneratedColumns', one per row, so that they are applied together by a
+// single Apply or BufferWrite.
+func GeneratedColumnsInsertOrUpdate(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].InsertOrUpdate(ctx)
+	}
+	return ms
+}
+
+// MutationGroup returns a MutationGroup to insert or update the GeneratedColumn with
+// children, which are the mutations of the rows of the interleaved tables
+// under it, so that they are applied atomically by YOBatchWrite.
+func (gc *GeneratedColumn) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {
+	ms := append([]*spanner.Mutation{gc.InsertOrUpdate(ctx)}, children...)
+	return &spanner.MutationGroup{Mutations: ms}
+}
+
+// BatchWriteGeneratedColumns inserts or updates rows in 'GeneratedColumns' by the
+// BatchWrite API, where each row is applied atomically but the rows are not
+// applied together. It returns the errors of the rows which failed by the
+// indexes of the rows. The other rows are applied unless the error is
+// returned.
+func BatchWriteGeneratedColumns(ctx context.Context, client *spanner.Client, rows []*GeneratedColumn) (map[int]error, error) {
+	groups := make([]*spanner.MutationGroup, len(rows))
+	for i := range rows {
+		groups[i] = rows[i].MutationGroup(ctx)
+	}
+
+	return yoBatchWrite(ctx, client, "BatchWriteGeneratedColumns", "GeneratedColumns", groups)
+}
+
+// InsertOrUpdateGeneratedColumnAtLeastOnce inserts or updates row in 'GeneratedColumns' by
+// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the
+// lower latency. The write may be applied more than once when it is retried,
+// which is fine since inserting or updating the same values is idempotent.
+func InsertOrUpdateGeneratedColumnAtLeastOnce(ctx context.Context, client *spanner.Client, row *GeneratedColumn) error {
+	ms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}
+	if _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {
+		return newError("InsertOrUpdateGeneratedColumnAtLeastOnce", "GeneratedColumns", err)
+	}
+
+	return nil
+}
+
 // UpdateColumns returns a Mutation to update specified columns of a row in a table.
 func (gc *GeneratedColumn) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {
 	// add primary keys to columns to update by primary keys
@@ -140,16 +678,82 @@ func (gc *GeneratedColumn) UpdateColumns(ctx context.Context, cols ...string) (*
 	return spanner.Update("GeneratedColumns", colsWithPKeys, values), nil
 }
 
+// MutationForColumns returns a Mutation to update specified columns of a row
+// in a table. Unlike UpdateColumns, the columns are typed so that only columns
+// of 'GeneratedColumns' can be specified.
+func (gc *GeneratedColumn) MutationForColumns(ctx context.Context, cols ...GeneratedColumnColumn) (*spanner.Mutation, error) {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = string(col)
+	}
+
+	return gc.UpdateColumns(ctx, names...)
+}
+
+// GeneratedColumnTracker tracks the changes of a GeneratedColumn, so that Update writes
+// only the columns modified since the tracking started instead of the full
+// row, and does not overwrite the columns updated concurrently by others. The
+// values modified through pointers, such as of proto messages, are not
+// detected; assign new values instead.
+type GeneratedColumnTracker struct {
+	row  *GeneratedColumn
+	orig GeneratedColumn
+}
+
+// TrackGeneratedColumn returns a GeneratedColumnTracker of row, which records the current
+// values of row, such as right after reading it.
+func TrackGeneratedColumn(row *GeneratedColumn) *GeneratedColumnTracker {
+	tr := &GeneratedColumnTracker{row: row}
+	tr.Reset()
+	return tr
+}
+
+// Row returns the tracked GeneratedColumn.
+func (tr *GeneratedColumnTracker) Row() *GeneratedColumn {
+	return tr.row
+}
+
+// Reset records the current values of the row, such as after the Mutation
+// of Update is applied.
+func (tr *GeneratedColumnTracker) Reset() {
+	tr.orig = *tr.row
+	tr.orig.FirstName = yoClone(tr.row.FirstName)
+	tr.orig.LastName = yoClone(tr.row.LastName)
+}
+
+// Changed returns the columns modified since the tracking started, in the
+// order of the columns of 'GeneratedColumns'. The primary key columns and the
+// generated columns are never included.
+func (tr *GeneratedColumnTracker) Changed() []GeneratedColumnColumn {
+	var cols []GeneratedColumnColumn
+	if !reflect.DeepEqual(tr.orig.FirstName, tr.row.FirstName) {
+		cols = append(cols, GeneratedColumnColumnFirstName)
+	}
+	if !reflect.DeepEqual(tr.orig.LastName, tr.row.LastName) {
+		cols = append(cols, GeneratedColumnColumnLastName)
+	}
+	return cols
+}
+
+// Update returns a Mutation to update the columns modified since the tracking
+// started. If no column is modified, the Mutation updates no column, but still
+// fails if the row does not exist.
+func (tr *GeneratedColumnTracker) Update(ctx context.Context) (*spanner.Mutation, error) {
+	return tr.row.MutationForColumns(ctx, tr.Changed()...)
+}
+
 // FindGeneratedColumn gets a GeneratedColumn by primary key
-func FindGeneratedColumn(ctx context.Context, db YORODB, id int64) (*GeneratedColumn, error) {
+func FindGeneratedColumn(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (*GeneratedColumn, error) {
 	key := spanner.Key{id}
-	row, err := db.ReadRow(ctx, "GeneratedColumns", key, GeneratedColumnColumns())
+	row, err := yoReadRow(ctx, db, "GeneratedColumns", key, GeneratedColumnColumns(), opts)
 	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindGeneratedColumn", "GeneratedColumns", key, ErrGeneratedColumnNotFound, nil)
+		}
 		return nil, newError("FindGeneratedColumn", "GeneratedColumns", err)
 	}
 
-	decoder := newGeneratedColumn_Decoder(GeneratedColumnColumns())
-	gc, err := decoder(row)
+	gc, err := ScanGeneratedColumn(row)
 	if err != nil {
 		return nil, newErrorWithCode(codes.Internal, "FindGeneratedColumn", "GeneratedColumns", err)
 	}
@@ -157,15 +761,194 @@ func FindGeneratedColumn(ctx context.Context, db YORODB, id int64) (*GeneratedCo
 	return gc, nil
 }
 
+// GeneratedColumnExists reports whether the row of the primary key exists in
+// 'GeneratedColumns', which reads only the primary key columns.
+func GeneratedColumnExists(ctx context.Context, db YORODB, id int64, opts ...*spanner.ReadOptions) (bool, error) {
+	key := spanner.Key{id}
+	if _, err := yoReadRow(ctx, db, "GeneratedColumns", key, GeneratedColumnPrimaryKeys(), opts); err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, newError("GeneratedColumnExists", "GeneratedColumns", err)
+	}
+
+	return true, nil
+}
+
 // ReadGeneratedColumn retrieves multiples rows from GeneratedColumn by KeySet as a slice.
-func ReadGeneratedColumn(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*GeneratedColumn, error) {
+func ReadGeneratedColumn(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
 	var res []*GeneratedColumn
+	rows := yoRead(ctx, db, "GeneratedColumns", "", keys, GeneratedColumnColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		gc, err := ScanGeneratedColumn(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, gc)
 
-	decoder := newGeneratedColumn_Decoder(GeneratedColumnColumns())
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "ReadGeneratedColumn", "GeneratedColumns", err)
+	}
 
-	rows := db.Read(ctx, "GeneratedColumns", keys, GeneratedColumnColumns())
+	return res, nil
+}
+
+// GeneratedColumnPrimaryKey is the primary key of 'GeneratedColumns'.
+type GeneratedColumnPrimaryKey struct {
+	ID int64
+}
+
+// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet
+// of a row given to ReadGeneratedColumn.
+func (k GeneratedColumnPrimaryKey) ToSpannerKey() spanner.Key {
+	return spanner.Key{k.ID}
+}
+
+// ParseGeneratedColumnPrimaryKey parses key of the columns of the primary key of
+// 'GeneratedColumns' in order, such as the key of a mutation.
+func ParseGeneratedColumnPrimaryKey(key spanner.Key) (GeneratedColumnPrimaryKey, error) {
+	var k GeneratedColumnPrimaryKey
+	if len(key) != 1 {
+		return k, fmt.Errorf("key of 'GeneratedColumns' must have 1 columns, but got %d", len(key))
+	}
+	v0, ok := key[0].(int64)
+	if !ok {
+		return k, fmt.Errorf("column 'ID' of the key of 'GeneratedColumns' must be int64, but got %T", key[0])
+	}
+	k.ID = v0
+
+	return k, nil
+}
+
+// PrimaryKey returns the primary key of gc.
+func (gc *GeneratedColumn) PrimaryKey() GeneratedColumnPrimaryKey {
+	return GeneratedColumnPrimaryKey{
+		ID: gc.ID,
+	}
+}
+
+// FindGeneratedColumnByKey gets a GeneratedColumn by the primary key of key.
+func FindGeneratedColumnByKey(ctx context.Context, db YORODB, key GeneratedColumnPrimaryKey, opts ...*spanner.ReadOptions) (*GeneratedColumn, error) {
+	row, err := yoReadRow(ctx, db, "GeneratedColumns", key.ToSpannerKey(), GeneratedColumnColumns(), opts)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, newNotFoundError("FindGeneratedColumnByKey", "GeneratedColumns", key.ToSpannerKey(), ErrGeneratedColumnNotFound, nil)
+		}
+		return nil, newError("FindGeneratedColumnByKey", "GeneratedColumns", err)
+	}
+
+	gc, err := ScanGeneratedColumn(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindGeneratedColumnByKey", "GeneratedColumns", err)
+	}
+
+	return gc, nil
+}
+
+// DeleteGeneratedColumnByKey returns a Mutation to delete the row of key from
+// 'GeneratedColumns'.
+func DeleteGeneratedColumnByKey(ctx context.Context, key GeneratedColumnPrimaryKey) *spanner.Mutation {
+	return spanner.Delete("GeneratedColumns", key.ToSpannerKey())
+}
+
+// FindGeneratedColumnsByKeys retrieves the rows of keys from 'GeneratedColumns' by a
+// single read as a slice. The rows are in the order of keys, where the rows
+// which do not exist are skipped and the duplicate keys are read once.
+func FindGeneratedColumnsByKeys(ctx context.Context, db YORODB, keys []GeneratedColumnPrimaryKey, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keySets := make([]spanner.KeySet, len(keys))
+	for i, k := range keys {
+		keySets[i] = k.ToSpannerKey()
+	}
+
+	// the rows are read in the order of the primary key
+	byKey := make(map[string]*GeneratedColumn, len(keys))
+	rows := yoRead(ctx, db, "GeneratedColumns", "", spanner.KeySets(keySets...), GeneratedColumnColumns(), opts)
 	err := rows.Do(func(row *spanner.Row) error {
-		gc, err := decoder(row)
+		gc, err := ScanGeneratedColumn(row)
+		if err != nil {
+			return err
+		}
+		byKey[gc.primaryKey().String()] = gc
+
+		return nil
+	})
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "FindGeneratedColumnsByKeys", "GeneratedColumns", err)
+	}
+
+	res := make([]*GeneratedColumn, 0, len(byKey))
+	for _, k := range keys {
+		s := k.ToSpannerKey().String()
+		if gc, ok := byKey[s]; ok {
+			res = append(res, gc)
+			delete(byKey, s)
+		}
+	}
+
+	return res, nil
+}
+
+// ListGeneratedColumnsPage retrieves a page of at most pageSize rows from 'GeneratedColumns'
+// ordered by the primary key. The page starts after the row of pageToken, or at
+// the first row if pageToken is empty. The returned token reads the next page,
+// and is empty at the last page. The Limit of opts is ignored.
+func ListGeneratedColumnsPage(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, string, error) {
+	if pageSize <= 0 {
+		return nil, "", newErrorWithCode(codes.InvalidArgument, "ListGeneratedColumnsPage", "GeneratedColumns", fmt.Errorf("invalid page size %d", pageSize))
+	}
+
+	var key []interface{}
+	if pageToken != "" {
+		var k0 int64
+		if err := yoDecodePageToken(pageToken, &k0); err != nil {
+			return nil, "", newErrorWithCode(codes.InvalidArgument, "ListGeneratedColumnsPage", "GeneratedColumns", err)
+		}
+		key = []interface{}{k0}
+	}
+	stmt := yoPageStatement("SELECT ID, FirstName, LastName, FullName FROM GeneratedColumns",
+		[]string{"ID"},
+		[]bool{false},
+		key, pageSize)
+
+	// run query
+	defer yoLogQuery(ctx, "ListGeneratedColumnsPage", stmt)()
+	res, err := ScanGeneratedColumns(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))
+	if err != nil {
+		return nil, "", newError("ListGeneratedColumnsPage", "GeneratedColumns", err)
+	}
+	if len(res) < pageSize {
+		return res, "", nil
+	}
+
+	last := res[len(res)-1]
+	token, err := yoEncodePageToken(last.ID)
+	if err != nil {
+		return nil, "", newErrorWithCode(codes.Internal, "ListGeneratedColumnsPage", "GeneratedColumns", err)
+	}
+
+	return res, token, nil
+}
+
+// ReadGeneratedColumnRange retrieves multiple rows from GeneratedColumn whose
+// 'ID' is between start and end as a slice.
+// kind tells whether the bounds are included, such as spanner.ClosedOpen.
+func ReadGeneratedColumnRange(ctx context.Context, db YORODB, start, end int64, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*GeneratedColumn, error) {
+	var res []*GeneratedColumn
+
+	keys := spanner.KeyRange{
+		Start: spanner.Key{start},
+		End:   spanner.Key{end},
+		Kind:  kind,
+	}
+	rows := yoRead(ctx, db, "GeneratedColumns", "", keys, GeneratedColumnColumns(), opts)
+	err := rows.Do(func(row *spanner.Row) error {
+		gc, err := ScanGeneratedColumn(row)
 		if err != nil {
 			return err
 		}
@@ -174,14 +957,64 @@ func ReadGeneratedColumn(ctx context.Context, db YORODB, keys spanner.KeySet) ([
 		return nil
 	})
 	if err != nil {
-		return nil, newErrorWithCode(codes.Internal, "ReadGeneratedColumn", "GeneratedColumns", err)
+		return nil, newErrorWithCode(codes.Internal, "ReadGeneratedColumnRange", "GeneratedColumns", err)
 	}
 
 	return res, nil
 }
 
+// primaryKey returns the key of the GeneratedColumn, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (gc *GeneratedColumn) primaryKey() spanner.Key {
+	return spanner.Key{gc.ID}
+}
+
 // Delete deletes the GeneratedColumn from the database.
 func (gc *GeneratedColumn) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := gc.columnsToValues(GeneratedColumnPrimaryKeys())
-	return spanner.Delete("GeneratedColumns", spanner.Key(values))
+	return spanner.Delete("GeneratedColumns", gc.primaryKey())
+}
+
+// GeneratedColumnsDelete returns Mutations to delete the rows from
+// 'GeneratedColumns', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func GeneratedColumnsDelete(ctx context.Context, rows []*GeneratedColumn) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
+}
+
+// GeneratedColumnDescriptor describes 'GeneratedColumns' for YORepository.
+var GeneratedColumnDescriptor = &YODescriptor[GeneratedColumn, GeneratedColumnPrimaryKey]{
+	Table:       "GeneratedColumns",
+	Columns:     GeneratedColumnColumns(),
+	PrimaryKeys: GeneratedColumnPrimaryKeys(),
+	Key: func(row *GeneratedColumn) GeneratedColumnPrimaryKey {
+		return GeneratedColumnPrimaryKey{
+			ID: row.ID,
+		}
+	},
+	SpannerKey: GeneratedColumnPrimaryKey.ToSpannerKey,
+	Scan:       ScanGeneratedColumn,
+	Find:       FindGeneratedColumnByKey,
+	Read:       ReadGeneratedColumn,
+	Insert: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.Insert(ctx)
+	},
+	Update: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.Update(ctx)
+	},
+	InsertOrUpdate: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.InsertOrUpdate(ctx)
+	},
+	Delete: func(ctx context.Context, row *GeneratedColumn) *spanner.Mutation {
+		return row.Delete(ctx)
+	},
+}
+
+// NewGeneratedColumnRepository returns a YORepository of 'GeneratedColumns' by client.
+func NewGeneratedColumnRepository(client *spanner.Client) *YORepository[GeneratedColumn, GeneratedColumnPrimaryKey] {
+	return NewYORepository(client, GeneratedColumnDescriptor)
 }
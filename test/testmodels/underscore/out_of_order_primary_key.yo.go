@@ -4,9 +4,12 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
 )
 
 // OutOfOrderPrimaryKey represents a row from 'OutOfOrderPrimaryKeys'.
@@ -16,6 +19,14 @@ type OutOfOrderPrimaryKey struct {
 	PKey3 string `spanner:"PKey3" json:"PKey3"` // PKey3
 }
 
+// OutOfOrderPrimaryKeyTableName is the name of 'OutOfOrderPrimaryKeys', which is qualified by the
+// schema if the table is in a named schema.
+const OutOfOrderPrimaryKeyTableName = "OutOfOrderPrimaryKeys"
+
+// ErrOutOfOrderPrimaryKeyNotFound is the error matched by errors.Is when the row is not
+// found in 'OutOfOrderPrimaryKeys'.
+var ErrOutOfOrderPrimaryKeyNotFound = errors.New("yo: OutOfOrderPrimaryKeys not found")
+
 func OutOfOrderPrimaryKeyPrimaryKeys() []string {
 	return []string{
 		"PKey2",
@@ -32,6 +43,16 @@ func OutOfOrderPrimaryKeyColumns() []string {
 	}
 }
 
+// OutOfOrderPrimaryKeyColumn is a column of 'OutOfOrderPrimaryKeys'.
+type OutOfOrderPrimaryKeyColumn string
+
+// Columns of 'OutOfOrderPrimaryKeys'.
+const (
+	OutOfOrderPrimaryKeyColumnPKey1 OutOfOrderPrimaryKeyColumn = "PKey1"
+	OutOfOrderPrimaryKeyColumnPKey2 OutOfOrderPrimaryKeyColumn = "PKey2"
+	OutOfOrderPrimaryKeyColumnPKey3 OutOfOrderPrimaryKeyColumn = "PKey3"
+)
+
 func OutOfOrderPrimaryKeyWritableColumns() []string {
 	return []string{
 		"PKey1",
@@ -100,6 +121,401 @@ func newOutOfOrderPrimaryKey_Decoder(cols []string) func(*spanner.Row) (*OutOfOr
 	}
 }
 
+// ScanOutOfOrderPrimaryKey decodes row into OutOfOrderPrimaryKey. The row may have any subset of
+// the columns of 'OutOfOrderPrimaryKeys', such as the result of a query or a read.
+func ScanOutOfOrderPrimaryKey(row *spanner.Row) (*OutOfOrderPrimaryKey, error) {
+	return newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())(row)
+}
+
+// ScanOutOfOrderPrimaryKeys decodes all the rows of iter into a slice of OutOfOrderPrimaryKey.
+// iter is stopped when it returns.
+func ScanOutOfOrderPrimaryKeys(iter *spanner.RowIterator) ([]*OutOfOrderPrimaryKey, error) {
+	var res []*OutOfOrderPrimaryKey
+	var decoder func(*spanner.Row) (*OutOfOrderPrimaryKey, error)
+	err := iter.Do(func(row *spanner.Row) error {
+		if decoder == nil {
+			decoder = newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())
+		}
+
+		ooopk, err := decoder(row)
+		if err != nil {
+			return err
+		}
+		res = append(res, ooopk)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// OutOfOrderPrimaryKeyIterator iterates over the rows of a partition of 'OutOfOrderPrimaryKeys'
+// decoded into OutOfOrderPrimaryKey.
+type OutOfOrderPrimaryKeyIterator struct {
+	iter    *spanner.RowIterator
+	decoder func(*spanner.Row) (*OutOfOrderPrimaryKey, error)
+}
+
+// ExecuteOutOfOrderPrimaryKeyPartition returns an iterator of the rows of p, which is a
+// partition given by PartitionReadAllOutOfOrderPrimaryKeys or OutOfOrderPrimaryKeyQuery.PartitionQuery in btx. The
+// partitions may be executed by different processes with the same btx.
+func ExecuteOutOfOrderPrimaryKeyPartition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *OutOfOrderPrimaryKeyIterator {
+	return &OutOfOrderPrimaryKeyIterator{iter: btx.Execute(ctx, p)}
+}
+
+// Next returns the next row of the partition. It returns iterator.Done when
+// all the rows are returned.
+func (it *OutOfOrderPrimaryKeyIterator) Next() (*OutOfOrderPrimaryKey, error) {
+	row, err := it.iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return nil, err
+		}
+		return nil, newError("OutOfOrderPrimaryKeyIterator.Next", "OutOfOrderPrimaryKeys", err)
+	}
+
+	if it.decoder == nil {
+		it.decoder = newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())
+	}
+	v, err := it.decoder(row)
+	if err != nil {
+		return nil, newErrorWithCode(codes.Internal, "OutOfOrderPrimaryKeyIterator.Next", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return v, nil
+}
+
+// Stop terminates the iteration, which must be called when the iterator is
+// no longer used.
+func (it *OutOfOrderPrimaryKeyIterator) Stop() {
+	it.iter.Stop()
+}
+
+// yieldOutOfOrderPrimaryKeyRows decodes the rows of rows into OutOfOrderPrimaryKey and yields them
+// until yield returns false or an error is yielded. rows is stopped when it
+// returns.
+func yieldOutOfOrderPrimaryKeyRows(rows *spanner.RowIterator, method string, yield func(*OutOfOrderPrimaryKey, error) bool) {
+	defer rows.Stop()
+
+	var decoder func(*spanner.Row) (*OutOfOrderPrimaryKey, error)
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if err != iterator.Done {
+				yield(nil, newError(method, "OutOfOrderPrimaryKeys", err))
+			}
+			return
+		}
+
+		if decoder == nil {
+			decoder = newOutOfOrderPrimaryKey_Decoder(row.ColumnNames())
+		}
+		v, err := decoder(row)
+		if err != nil {
+			yield(nil, newErrorWithCode(codes.Internal, method, "OutOfOrderPrimaryKeys", err))
+			return
+		}
+		if !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// CountOutOfOrderPrimaryKeys returns the number of the rows of 'OutOfOrderPrimaryKeys'.
+func CountOutOfOrderPrimaryKeys(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := spanner.NewStatement("SELECT COUNT(*) FROM OutOfOrderPrimaryKeys")
+
+	defer yoLogQuery(ctx, "CountOutOfOrderPrimaryKeys", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("CountOutOfOrderPrimaryKeys", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// AllOutOfOrderPrimaryKeysSeq returns an iterator of all the rows of 'OutOfOrderPrimaryKeys',
+// which streams the rows without holding all of them in memory. It is an
+// iter.Seq2[*OutOfOrderPrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func AllOutOfOrderPrimaryKeysSeq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+	return func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+		rows := yoRead(ctx, db, "OutOfOrderPrimaryKeys", "", spanner.AllKeys(), OutOfOrderPrimaryKeyColumns(), opts)
+		yieldOutOfOrderPrimaryKeyRows(rows, "AllOutOfOrderPrimaryKeysSeq", yield)
+	}
+}
+
+// PartitionReadAllOutOfOrderPrimaryKeys partitions the read of all the rows of
+// 'OutOfOrderPrimaryKeys' in btx, so that the partitions are read in parallel by
+// ExecuteOutOfOrderPrimaryKeyPartition for bulk exports. The partitions are executed by
+// Data Boost if enabled by yopts.
+func PartitionReadAllOutOfOrderPrimaryKeys(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	ropts := spanner.ReadOptions{}
+	ropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionReadWithOptions(ctx, "OutOfOrderPrimaryKeys", spanner.AllKeys(), OutOfOrderPrimaryKeyColumns(), opts, ropts)
+	if err != nil {
+		return nil, newError("PartitionReadAllOutOfOrderPrimaryKeys", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return ps, nil
+}
+
+// OutOfOrderPrimaryKeyQuery is a query builder for 'OutOfOrderPrimaryKeys'. The conditions are
+// given by the predicates of OutOfOrderPrimaryKeyWhere, whose values are always bound to
+// query parameters, and the rows are sorted by the columns of OutOfOrderPrimaryKeyColumn.
+type OutOfOrderPrimaryKeyQuery struct {
+	preds  []YOPredicate
+	orders []string
+	limit  int
+}
+
+// NewOutOfOrderPrimaryKeyQuery returns a OutOfOrderPrimaryKeyQuery filtered by preds.
+func NewOutOfOrderPrimaryKeyQuery(preds ...YOPredicate) *OutOfOrderPrimaryKeyQuery {
+	return &OutOfOrderPrimaryKeyQuery{preds: preds}
+}
+
+// Where adds preds to the conditions which rows must satisfy.
+func (q *OutOfOrderPrimaryKeyQuery) Where(preds ...YOPredicate) *OutOfOrderPrimaryKeyQuery {
+	q.preds = append(q.preds, preds...)
+	return q
+}
+
+// OrderBy sorts the rows by col in ascending order after the columns given
+// before.
+func (q *OutOfOrderPrimaryKeyQuery) OrderBy(col OutOfOrderPrimaryKeyColumn) *OutOfOrderPrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col)))
+	return q
+}
+
+// OrderByDesc sorts the rows by col in descending order after the columns
+// given before.
+func (q *OutOfOrderPrimaryKeyQuery) OrderByDesc(col OutOfOrderPrimaryKeyColumn) *OutOfOrderPrimaryKeyQuery {
+	q.orders = append(q.orders, yoQuoteIdentifier(string(col))+" DESC")
+	return q
+}
+
+// Limit limits the number of the rows to n, which overrides the Limit of the
+// read options given to Query.
+func (q *OutOfOrderPrimaryKeyQuery) Limit(n int) *OutOfOrderPrimaryKeyQuery {
+	q.limit = n
+	return q
+}
+
+// Statement returns the parameterized statement of the query.
+func (q *OutOfOrderPrimaryKeyQuery) Statement() spanner.Statement {
+	return yoStatement("PKey1, PKey2, PKey3", "OutOfOrderPrimaryKeys", q.preds, q.orders, q.limit)
+}
+
+// Query runs the query and returns the matched rows as a slice.
+func (q *OutOfOrderPrimaryKeyQuery) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*OutOfOrderPrimaryKey, error) {
+	stmt := q.Statement()
+	if q.limit > 0 {
+		opts = yoWithoutLimit(opts)
+	}
+
+	// run query
+	defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.Query", stmt)()
+	iter := yoQuery(ctx, db, stmt, opts)
+	defer iter.Stop()
+
+	// load results
+	res := []*OutOfOrderPrimaryKey{}
+	for {
+		row, err := iter.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, newError("OutOfOrderPrimaryKeyQuery.Query", "OutOfOrderPrimaryKeys", err)
+		}
+
+		v, err := ScanOutOfOrderPrimaryKey(row)
+		if err != nil {
+			return nil, newErrorWithCode(codes.Internal, "OutOfOrderPrimaryKeyQuery.Query", "OutOfOrderPrimaryKeys", err)
+		}
+
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of the rows matched by the query regardless of
+// OrderBy and Limit.
+func (q *OutOfOrderPrimaryKeyQuery) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {
+	stmt := yoStatement("COUNT(*)", "OutOfOrderPrimaryKeys", q.preds, nil, 0)
+
+	defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.Count", stmt)()
+	n, err := yoCount(ctx, db, stmt, opts)
+	if err != nil {
+		return 0, newError("OutOfOrderPrimaryKeyQuery.Count", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return n, nil
+}
+
+// Seq returns an iterator of the rows matched by the query, which streams the
+// rows without holding all of them in memory. It is an
+// iter.Seq2[*OutOfOrderPrimaryKey, error] of range-over-func of Go 1.23 or later, which
+// ends after yielding an error.
+func (q *OutOfOrderPrimaryKeyQuery) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+	return func(yield func(*OutOfOrderPrimaryKey, error) bool) {
+		stmt := q.Statement()
+		if q.limit > 0 {
+			opts = yoWithoutLimit(opts)
+		}
+
+		defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.Seq", stmt)()
+		yieldOutOfOrderPrimaryKeyRows(yoQuery(ctx, db, stmt, opts), "OutOfOrderPrimaryKeyQuery.Seq", yield)
+	}
+}
+
+// PartitionQuery partitions the query in btx, so that the partitions are read
+// in parallel by ExecuteOutOfOrderPrimaryKeyPartition. The query must not have OrderBy or
+// Limit, which cannot be partitioned. The partitions are executed by Data
+// Boost if enabled by yopts.
+func (q *OutOfOrderPrimaryKeyQuery) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {
+	if len(q.orders) != 0 || q.limit > 0 {
+		return nil, newErrorWithCode(codes.InvalidArgument, "OutOfOrderPrimaryKeyQuery.PartitionQuery", "OutOfOrderPrimaryKeys", errors.New("ordered or limited query cannot be partitioned"))
+	}
+
+	stmt := q.Statement()
+	defer yoLogQuery(ctx, "OutOfOrderPrimaryKeyQuery.PartitionQuery", stmt)()
+	qopts := spanner.QueryOptions{}
+	qopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled
+	ps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)
+	if err != nil {
+		return nil, newError("OutOfOrderPrimaryKeyQuery.PartitionQuery", "OutOfOrderPrimaryKeys", err)
+	}
+
+	return ps, nil
+}
+
+// OutOfOrderPrimaryKeyWhere has the typed predicate constructors of the columns of
+// 'OutOfOrderPrimaryKeys'.
+var OutOfOrderPrimaryKeyWhere = struct {
+	PKey1 OutOfOrderPrimaryKey_PKey1Column
+	PKey2 OutOfOrderPrimaryKey_PKey2Column
+	PKey3 OutOfOrderPrimaryKey_PKey3Column
+}{}
+
+// OutOfOrderPrimaryKey_PKey1Column has the predicate constructors of 'PKey1'.
+type OutOfOrderPrimaryKey_PKey1Column struct{}
+
+// Eq returns a predicate that 'PKey1' is equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey1' is not equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey1' is less than v.
+func (OutOfOrderPrimaryKey_PKey1Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey1' is less than or equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey1' is greater than v.
+func (OutOfOrderPrimaryKey_PKey1Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey1' is greater than or equal to v.
+func (OutOfOrderPrimaryKey_PKey1Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey1' is equal to any of vs.
+func (OutOfOrderPrimaryKey_PKey1Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey1", op: "IN", value: vs}
+}
+
+// OutOfOrderPrimaryKey_PKey2Column has the predicate constructors of 'PKey2'.
+type OutOfOrderPrimaryKey_PKey2Column struct{}
+
+// Eq returns a predicate that 'PKey2' is equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey2' is not equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey2' is less than v.
+func (OutOfOrderPrimaryKey_PKey2Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey2' is less than or equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey2' is greater than v.
+func (OutOfOrderPrimaryKey_PKey2Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey2' is greater than or equal to v.
+func (OutOfOrderPrimaryKey_PKey2Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey2' is equal to any of vs.
+func (OutOfOrderPrimaryKey_PKey2Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey2", op: "IN", value: vs}
+}
+
+// OutOfOrderPrimaryKey_PKey3Column has the predicate constructors of 'PKey3'.
+type OutOfOrderPrimaryKey_PKey3Column struct{}
+
+// Eq returns a predicate that 'PKey3' is equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Eq(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "=", value: v}
+}
+
+// Ne returns a predicate that 'PKey3' is not equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Ne(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "!=", value: v}
+}
+
+// Lt returns a predicate that 'PKey3' is less than v.
+func (OutOfOrderPrimaryKey_PKey3Column) Lt(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "<", value: v}
+}
+
+// Le returns a predicate that 'PKey3' is less than or equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Le(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "<=", value: v}
+}
+
+// Gt returns a predicate that 'PKey3' is greater than v.
+func (OutOfOrderPrimaryKey_PKey3Column) Gt(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: ">", value: v}
+}
+
+// Ge returns a predicate that 'PKey3' is greater than or equal to v.
+func (OutOfOrderPrimaryKey_PKey3Column) Ge(v string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: ">=", value: v}
+}
+
+// In returns a predicate that 'PKey3' is equal to any of vs.
+func (OutOfOrderPrimaryKey_PKey3Column) In(vs ...string) YOPredicate {
+	return YOPredicate{column: "PKey3", op: "IN", value: vs}
+}
+
 // Insert returns a Mutation to insert a row into a table. If the row already
 // exists, the write or transaction fails.
 func (ooopk *OutOfOrderPrimaryKey) Insert(ctx context.Context) *spanner.Mutation {
@@ -107,8 +523,50 @@ func (ooopk *OutOfOrderPrimaryKey) Insert(ctx context.Context) *spanner.Mutation
 	return spanner.Insert("OutOfOrderPrimaryKeys", OutOfOrderPrimaryKeyWritableColumns(), values)
 }
 
+// InsertAllOutOfOrderPrimaryKeys inserts rows into 'OutOfOrderPrimaryKeys' in batches which are
+// committed separately to stay under YOMutationLimit. It returns the number of
+// rows written. If a batch fails, the preceding batches are already committed
+// and the error describes the failed batch.
+func InsertAllOutOfOrderPrimaryKeys(ctx context.Context, client *spanner.Client, rows []*OutOfOrderPrimaryKey) (int, error) {
+	ms := OutOfOrderPrimaryKeysInsert(ctx, rows)
+
+	// an inserted row costs a mutation per column of the table and its indexes
+	mutationsPerRow := len(OutOfOrderPrimaryKeyWritableColumns()) * (1 + 0)
+
+	return yoApplyInBatches(ctx, client, "InsertAllOutOfOrderPrimaryKeys", "OutOfOrderPrimaryKeys", ms, YOMutationLimit/mutationsPerRow)
+}
+
+// OutOfOrderPrimaryKeysInsert returns Mutations to insert the rows into
+// 'OutOfOrderPrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite. Unlike InsertAllOutOfOrderPrimaryKeys, the Mutations are
+// not split into batches.
+func OutOfOrderPrimaryKeysInsert(ctx context.Context, rows []*OutOfOrderPrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Insert(ctx)
+	}
+	return ms
+}
+
+// primaryKey returns the key of the OutOfOrderPrimaryKey, whose values are in the order
+// of the primary key columns. The keys of the interleaved tables begin with the
+// keys of their parents.
+func (ooopk *OutOfOrderPrimaryKey) primaryKey() spanner.Key {
+	return spanner.Key{ooopk.PKey2, ooopk.PKey1, ooopk.PKey3}
+}
+
 // Delete deletes the OutOfOrderPrimaryKey from the database.
 func (ooopk *OutOfOrderPrimaryKey) Delete(ctx context.Context) *spanner.Mutation {
-	values, _ := ooopk.columnsToValues(OutOfOrderPrimaryKeyPrimaryKeys())
-	return spanner.Delete("OutOfOrderPrimaryKeys", spanner.Key(values))
+	return spanner.Delete("OutOfOrderPrimaryKeys", ooopk.primaryKey())
+}
+
+// OutOfOrderPrimaryKeysDelete returns Mutations to delete the rows from
+// 'OutOfOrderPrimaryKeys', one per row, so that they are applied together by a single
+// Apply or BufferWrite.
+func OutOfOrderPrimaryKeysDelete(ctx context.Context, rows []*OutOfOrderPrimaryKey) []*spanner.Mutation {
+	ms := make([]*spanner.Mutation, len(rows))
+	for i := range rows {
+		ms[i] = rows[i].Delete(ctx)
+	}
+	return ms
 }
@@ -6,14 +6,22 @@ import (
 	"github.com/jessevdk/go-assets"
 )
 
-var _Assets35fa065605f72dabb3fd17747217ebb391a6a686 = "{{- $short := (shortname .Type.Name \"err\" \"sqlstr\" \"db\" \"q\" \"res\" \"YOLog\" .Fields) -}}\n{{- $table := (.Type.Table.TableName) -}}\n{{- if not .Index.IsUnique }}\n// Find{{ .FuncName }} retrieves multiple rows from '{{ $table }}' as a slice of {{ .Type.Name }}.\n//\n// Generated from index '{{ .Index.IndexName }}'.\nfunc Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}) ([]*{{ .Type.Name }}, error) {\n{{- else }}\n// Find{{ .FuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.\n//\n// If no row is present with the given key, then ReadRow returns an error where\n// spanner.ErrCode(err) is codes.NotFound.\n//\n// Generated from unique index '{{ .Index.IndexName }}'.\nfunc Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}) (*{{ .Type.Name }}, error) {\n{{- end }}\n\t{{- if not .NullableFields }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .Type.Fields }} \" +\n\t\t\"FROM {{ $table }}@{FORCE_INDEX={{ .Index.IndexName }}} \" +\n\t\t\"WHERE {{ colnamesquery .Fields \" AND \" }}\"\n\t{{- else }}\n\tvar sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .Type.Fields }} \" +\n\t\t\"FROM {{ $table }}@{FORCE_INDEX={{ .Index.IndexName }}} \"\n\n\tconds := make([]string, {{ columncount .Fields }})\n\t{{- range $i, $f := .Fields }}\n\t{{- if $f.Col.NotNull }}\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = @param{{ $i }}\"\n\t{{- else }}\n\tif {{ nullcheck $f }} {\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} IS NULL\"\n\t} else {\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = @param{{ $i }}\"\n\t}\n\t{{- end }}\n\t{{- end }}\n\tsqlstr += \"WHERE \" + strings.Join(conds, \" AND \")\n\t{{- end }}\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .Fields }}\n\t\t{{- if $f.CustomType }}\n\t\t\tstmt.Params[\"param{{ $i }}\"] = {{ $f.Type }}({{ goparamname $f.Name }})\n\t\t{{- else }}\n\t\t\tstmt.Params[\"param{{ $i }}\"] = {{ goparamname $f.Name }}\n\t\t{{- end }}\n\t{{- end}}\n\n\n\tdecoder := new{{ .Type.Name }}_Decoder({{ .Type.Name }}Columns())\n\n\t// run query\n\tYOLog(ctx, sqlstr{{ goparamlist .Fields true false }})\n{{- if .Index.IsUnique }}\n\titer := db.Query(ctx, stmt)\n\tdefer iter.Stop()\n\n\trow, err := iter.Next()\n\tif err != nil {\n\t\tif err == iterator.Done {\n\t\t\treturn nil, newErrorWithCode(codes.NotFound, \"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n\t\t}\n\t\treturn nil, newError(\"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n\t}\n\n\t{{ $short }}, err := decoder(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn {{ $short }}, nil\n{{- else }}\n\titer := db.Query(ctx, stmt)\n\tdefer iter.Stop()\n\n\t// load results\n\tres := []*{{ .Type.Name }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\t{{ $short }}, err := decoder(row)\n        if err != nil {\n            return nil, newErrorWithCode(codes.Internal, \"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n        }\n\n\t\tres = append(res, {{ $short }})\n\t}\n\n\treturn res, nil\n{{- end }}\n}\n\n\n// Read{{ .FuncName }} retrieves multiples rows from '{{ $table }}' by KeySet as a slice.\n//\n// This does not retrieve all columns of '{{ $table }}' because an index has only columns\n// used for primary key, index key and storing columns. If you need more columns, add storing\n// columns or Read by primary key or Query with join.\n//\n// Generated from unique index '{{ .Index.IndexName }}'.\nfunc Read{{ .FuncName }}(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*{{ .Type.Name }}, error) {\n\tvar res []*{{ .Type.Name }}\n    columns := []string{\n{{- range .Type.PrimaryKeyFields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n{{- range .Fields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n{{- range .StoringFields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n}\n\n\tdecoder := new{{ .Type.Name }}_Decoder(columns)\n\n\trows := db.ReadUsingIndex(ctx, \"{{ $table }}\", \"{{ .Index.IndexName }}\", keys, columns)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := decoder(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Read{{ .FuncName }}\", \"{{ $table }}\", err)\n\t}\n\n    return res, nil\n}\n\n"
-var _Assets7fd73945d69f17ee7478fe75c9ebb3a425327b99 = "{{- $short := (shortname .Name \"err\" \"res\" \"sqlstr\" \"db\" \"YOLog\") -}}\n{{- $table := (.Table.TableName) -}}\n// {{ .Name }} represents a row from '{{ $table }}'.\ntype {{ .Name }} struct {\n{{- range .Fields }}\n{{- if eq (.Col.DataType) (.Col.ColumnName) }}\n\t{{ .Name }} string `spanner:\"{{ .Col.ColumnName }}\" json:\"{{ .Col.ColumnName }}\"` // {{ .Col.ColumnName }} enum\n{{- else if .CustomType }}\n\t{{ .Name }} {{ retype .CustomType }} `spanner:\"{{ .Col.ColumnName }}\" json:\"{{ .Col.ColumnName }}\"` // {{ .Col.ColumnName }}\n{{- else }}\n\t{{ .Name }} {{ .Type }} `spanner:\"{{ .Col.ColumnName }}\" json:\"{{ .Col.ColumnName }}\"` // {{ .Col.ColumnName }}\n{{- end }}\n{{- end }}\n}\n\n{{ if .PrimaryKey }}\nfunc {{ .Name }}PrimaryKeys() []string {\n     return []string{\n{{- range .PrimaryKeyFields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n\t}\n}\n{{- end }}\n\nfunc {{ .Name }}Columns() []string {\n\treturn []string{\n{{- range .Fields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n\t}\n}\n\nfunc {{ .Name }}WritableColumns() []string {\n\treturn []string{\n{{- range .Fields }}\n\t{{- if not .Col.IsGenerated }}\n\t\t\"{{ colname .Col }}\",\n\t{{- end }}\n{{- end }}\n\t}\n}\n\nfunc ({{ $short }} *{{ .Name }}) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {\n\tret := make([]interface{}, 0, len(cols))\n\tfor _, col := range cols {\n\t\tif val, ok := customPtrs[col]; ok {\n\t\t\tret = append(ret, val)\n\t\t\tcontinue\n\t\t}\n\n\t\tswitch col {\n{{- range .Fields }}\n\t\tcase \"{{ colname .Col }}\":\n\t\t\tret = append(ret, &{{ $short }}.{{ .Name }})\n{{- end }}\n\t\tdefault:\n\t\t\treturn nil, fmt.Errorf(\"unknown column: %s\", col)\n\t\t}\n\t}\n\treturn ret, nil\n}\n\nfunc ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{}, error) {\n\tret := make([]interface{}, 0, len(cols))\n\tfor _, col := range cols {\n\t\tswitch col {\n{{- range .Fields }}\n\t\tcase \"{{ colname .Col }}\":\n\t\t\t{{- if .CustomType }}\n\t\t\tret = append(ret, {{ .Type }}({{ $short }}.{{ .Name }}))\n\t\t\t{{- else }}\n\t\t\tret = append(ret, {{ $short }}.{{ .Name }})\n\t\t\t{{- end }}\n{{- end }}\n\t\tdefault:\n\t\t\treturn nil, fmt.Errorf(\"unknown column: %s\", col)\n\t\t}\n\t}\n\n\treturn ret, nil\n}\n\n// new{{ .Name }}_Decoder returns a decoder which reads a row from *spanner.Row\n// into {{ .Name }}. The decoder is not goroutine-safe. Don't use it concurrently.\nfunc new{{ .Name }}_Decoder(cols []string) func(*spanner.Row) (*{{ .Name }}, error) {\n\t{{- range .Fields }}\n\t\t{{- if .CustomType }}\n\t\t\tvar {{ customtypeparam .Name }} {{ .Type }}\n\t\t{{- end }}\n\t{{- end }}\n\tcustomPtrs := map[string]interface{}{\n\t\t{{- range .Fields }}\n\t\t\t{{- if .CustomType }}\n\t\t\t\t\"{{ colname .Col }}\": &{{ customtypeparam .Name }},\n\t\t\t{{- end }}\n\t{{- end }}\n\t}\n\n\treturn func(row *spanner.Row) (*{{ .Name }}, error) {\n        var {{ $short }} {{ .Name }}\n        ptrs, err := {{ $short }}.columnsToPtrs(cols, customPtrs)\n        if err != nil {\n            return nil, err\n        }\n\n        if err := row.Columns(ptrs...); err != nil {\n            return nil, err\n        }\n        {{- range .Fields }}\n            {{- if .CustomType }}\n                {{ $short }}.{{ .Name }} = {{ retype .CustomType }}({{ customtypeparam .Name }})\n            {{- end }}\n        {{- end }}\n\n\n\t\treturn &{{ $short }}, nil\n\t}\n}\n\n// Insert returns a Mutation to insert a row into a table. If the row already\n// exists, the write or transaction fails.\nfunc ({{ $short }} *{{ .Name }}) Insert(ctx context.Context) *spanner.Mutation {\n\tvalues, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())\n\treturn spanner.Insert(\"{{ $table }}\", {{ .Name }}WritableColumns(), values)\n}\n\n{{ if ne (fieldnames .Fields $short .PrimaryKeyFields) \"\" }}\n// Update returns a Mutation to update a row in a table. If the row does not\n// already exist, the write or transaction fails.\nfunc ({{ $short }} *{{ .Name }}) Update(ctx context.Context) *spanner.Mutation {\n\tvalues, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())\n\treturn spanner.Update(\"{{ $table }}\", {{ .Name }}WritableColumns(), values)\n}\n\n// InsertOrUpdate returns a Mutation to insert a row into a table. If the row\n// already exists, it updates it instead. Any column values not explicitly\n// written are preserved.\nfunc ({{ $short }} *{{ .Name }}) InsertOrUpdate(ctx context.Context) *spanner.Mutation {\n\tvalues, _ := {{ $short }}.columnsToValues({{ .Name }}WritableColumns())\n\treturn spanner.InsertOrUpdate(\"{{ $table }}\", {{ .Name }}WritableColumns(), values)\n}\n\n// UpdateColumns returns a Mutation to update specified columns of a row in a table.\nfunc ({{ $short }} *{{ .Name }}) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {\n\t// add primary keys to columns to update by primary keys\n\tcolsWithPKeys := append(cols, {{ .Name }}PrimaryKeys()...)\n\n\tvalues, err := {{ $short }}.columnsToValues(colsWithPKeys)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"{{ .Name }}.UpdateColumns\", \"{{ $table }}\", err)\n\t}\n\n\treturn spanner.Update(\"{{ $table }}\", colsWithPKeys, values), nil\n}\n\n// Find{{ .Name }} gets a {{ .Name }} by primary key\nfunc Find{{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}) (*{{ .Name }}, error) {\n\tkey := spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }\n\trow, err := db.ReadRow(ctx, \"{{ $table }}\", key, {{ .Name }}Columns())\n\tif err != nil {\n\t\treturn nil, newError(\"Find{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\tdecoder := new{{ .Name }}_Decoder({{ .Name}}Columns())\n\t{{ $short }}, err := decoder(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Find{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn {{ $short }}, nil\n}\n\n// Read{{ .Name }} retrieves multiples rows from {{ .Name }} by KeySet as a slice.\nfunc Read{{ .Name }}(ctx context.Context, db YORODB, keys spanner.KeySet) ([]*{{ .Name }}, error) {\n\tvar res []*{{ .Name }}\n\n\tdecoder := new{{ .Name }}_Decoder({{ .Name}}Columns())\n\n\trows := db.Read(ctx, \"{{ $table }}\", keys, {{ .Name }}Columns())\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := decoder(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Read{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, nil\n}\n{{ end }}\n\n// Delete deletes the {{ .Name }} from the database.\nfunc ({{ $short }} *{{ .Name }}) Delete(ctx context.Context) *spanner.Mutation {\n\tvalues, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())\n\treturn spanner.Delete(\"{{ $table }}\", spanner.Key(values))\n}\n"
-var _Assets652b6e36fe11372d65bfc0531de888fa9f12e2c0 = "// YODB is the common interface for database operations.\ntype YODB interface {\n\tYORODB\n}\n\n// YORODB is the common interface for database operations.\ntype YORODB interface {\n\tReadRow(ctx context.Context, table string, key spanner.Key, columns []string) (*spanner.Row, error)\n\tRead(ctx context.Context, table string, keys spanner.KeySet, columns []string) *spanner.RowIterator\n\tReadUsingIndex(ctx context.Context, table, index string, keys spanner.KeySet, columns []string) (ri *spanner.RowIterator)\n\tQuery(ctx context.Context, statement spanner.Statement) *spanner.RowIterator\n}\n\n// YOLog provides the log func used by generated queries.\nvar YOLog = func(context.Context, string, ...interface{}) { }\n\nfunc newError(method, table string, err error) error {\n\tcode := spanner.ErrCode(err)\n\treturn newErrorWithCode(code, method, table, err)\n}\n\nfunc newErrorWithCode(code codes.Code, method, table string, err error) error {\n\treturn &yoError{\n\t\tmethod: method,\n\t\ttable:  table,\n\t\terr:    err,\n\t\tcode:   code,\n\t}\n}\n\ntype yoError struct {\n\terr    error\n\tmethod string\n\ttable  string\n\tcode   codes.Code\n}\n\nfunc (e yoError) Error() string {\n\treturn fmt.Sprintf(\"yo error in %s(%s): %v\", e.method, e.table, e.err)\n}\n\nfunc (e yoError) Unwrap() error {\n\treturn e.err\n}\n\nfunc (e yoError) DBTableName() string {\n\treturn e.table\n}\n\n// GRPCStatus implements a conversion to a gRPC status using `status.Convert(error)`.\n// If the error is originated from the Spanner library, this returns a gRPC status of\n// the original error. It may contain details of the status such as RetryInfo.\nfunc (e yoError) GRPCStatus() *status.Status {\n\tvar ae *apierror.APIError\n\tif errors.As(e.err, &ae) {\n\t\treturn status.Convert(ae)\n\t}\n\n\treturn status.New(e.code, e.Error())\n}\n\nfunc (e yoError) Timeout() bool { return e.code == codes.DeadlineExceeded }\nfunc (e yoError) Temporary() bool { return e.code == codes.DeadlineExceeded }\nfunc (e yoError) NotFound() bool { return e.code == codes.NotFound }\n"
-var _Assets2da36312f867e2e1a26f5a29c883fe2d56891890 = "// Code generated by yo. DO NOT EDIT.\n// Package {{ .Package }} contains the types.\npackage {{ .Package }}\n\nimport (\n\t\"context\"\n\t\"errors\"\n\t\"fmt\"\n\n\t\"cloud.google.com/go/spanner\"\n\t\"google.golang.org/api/iterator\"\n\t\"google.golang.org/grpc/codes\"\n\t\"google.golang.org/grpc/status\"\n)\n"
+var _Assets7efe6648cfe234bf2111b897bdbf3246856bcd6a = "// {{ .Name }}Consumer consumes the data change records of the change stream\n// '{{ .ChangeStream.Name }}' by the handlers of the tables. The records of the\n// tables without handlers are skipped.\ntype {{ .Name }}Consumer struct {\n{{- range .Types }}\n\t{{ .Name }} func(ctx context.Context, changes []*{{ .Name }}Change) error\n{{- end }}\n}\n\n// Consume decodes the data change record in JSON, and calls the handler of\n// the table of the record with the changes of the rows.\nfunc (c *{{ .Name }}Consumer) Consume(ctx context.Context, data []byte) error {\n\tvar rec YODataChangeRecord\n\tif err := json.Unmarshal(data, &rec); err != nil {\n\t\treturn newErrorWithCode(codes.InvalidArgument, \"{{ .Name }}Consumer.Consume\", \"{{ .ChangeStream.Name }}\", err)\n\t}\n\n\treturn c.ConsumeRecord(ctx, &rec)\n}\n\n// ConsumeRecord calls the handler of the table of the data change record with\n// the changes of the rows.\nfunc (c *{{ .Name }}Consumer) ConsumeRecord(ctx context.Context, rec *YODataChangeRecord) error {\n\tswitch rec.TableName {\n{{- range .Types }}\n\tcase \"{{ .Table.TableName }}\":\n\t\tif c.{{ .Name }} == nil {\n\t\t\treturn nil\n\t\t}\n\t\tchanges, err := Decode{{ .Name }}Changes(rec)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\treturn c.{{ .Name }}(ctx, changes)\n{{- end }}\n\t}\n\n\treturn nil\n}\n"
+var _Assets35fa065605f72dabb3fd17747217ebb391a6a686 = "{{- $short := (shortname .Type.Name \"err\" \"sqlstr\" \"db\" \"q\" \"res\" \"YOLog\" .Fields) -}}\n{{- $table := (.Type.Table.TableName) -}}\n{{- $softdelete := .Type.SoftDeleteField }}\n{{- $kind := \"index\" }}{{ if .Index.IsNullFiltered }}{{ $kind = \"null-filtered index\" }}{{ end -}}\n{{- if .IsPrefix }}\n// Find{{ .FuncName }} retrieves multiple rows from '{{ $table }}' as a slice of {{ .Type.Name }}.\n{{- if .OrderFields }}\n// The rows are ordered by the rest of the index key.\n{{- end }}\n//\n// Generated from a prefix of the key of {{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).\nfunc Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, error) {\n{{- else if not .Index.IsUnique }}\n// Find{{ .FuncName }} retrieves multiple rows from '{{ $table }}' as a slice of {{ .Type.Name }}.\n//\n// Generated from {{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).\nfunc Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, error) {\n{{- else }}\n// Find{{ .FuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.\n//\n// If no row is present with the given key, then an error is returned where\n// errors.Is(err, ErrNotFound) is true.\n//\n// Generated from unique {{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).\nfunc Find{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) (*{{ .Type.Name }}, error) {\n{{- end }}\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Find{{ .FuncName }}\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Find{{ .FuncName }}\", \"{{ $table }}\")()\n{{ end }}\n\t{{- if not (or .NullableFields $softdelete) }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .Type.Fields }} \" +\n\t\t\"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} \" +\n\t\t\"WHERE {{ colnamesquery .Fields \" AND \" }}\"\n\t{{- if .OrderFields }} +\n\t\t\" {{ orderby .OrderFields }}\"\n\t{{- end }}\n\t{{- else }}\n\tvar sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .Type.Fields }} \" +\n\t\t\"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} \"\n\n\tconds := make([]string, {{ columncount .Fields }})\n\t{{- range $i, $f := .Fields }}\n\t{{- if $f.Col.NotNull }}\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = {{ param $i }}\"\n\t{{- else }}\n\tif {{ nullcheck $f }} {\n\t\t{{- if and $.Index.IsNullFiltered (forceindex $.Index.IndexName) }}\n\t\tYOWarn(ctx, \"{{ colname $f.Col }} is NULL, but NULL is not in null-filtered index {{ $.Index.IndexName }}\")\n\t\t{{- end }}\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} IS NULL\"\n\t} else {\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = {{ param $i }}\"\n\t}\n\t{{- end }}\n\t{{- end }}\n\t{{- if $softdelete }}\n\tif !yoIncludesDeleted(ctx) {\n\t\tconds = append(conds, \"{{ escapedcolname $softdelete.Col }} IS NULL\")\n\t}\n\t{{- end }}\n\tsqlstr += \"WHERE \" + strings.Join(conds, \" AND \")\n\t{{- if .OrderFields }}\n\tsqlstr += \" {{ orderby .OrderFields }}\"\n\t{{- end }}\n\t{{- end }}\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .Fields }}\n\t\t{{- if $f.CustomType }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ spanvalue $f (goparamname $f.Name) }}\n\t\t{{- else }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ goparamname $f.Name }}\n\t\t{{- end }}\n\t{{- end}}\n\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"Find{{ .FuncName }}\", stmt{{ goparamlist .Fields true false }})()\n{{- if .Index.IsUnique }}\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\trow, err := iter.Next()\n\tif err != nil {\n\t\tif err == iterator.Done {\n\t\t\treturn nil, newNotFoundError(\"Find{{ .FuncName }}\", \"{{ $table }}\", spanner.Key{ {{ gocustomparamlist .Fields false false }} }, Err{{ .Type.Name }}NotFound, nil)\n\t\t}\n\t\treturn nil, newError(\"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n\t}\n\n\t{{ $short }}, err := Scan{{ .Type.Name }}(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn {{ $short }}, nil\n{{- else }}\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\t// load results\n\tres := []*{{ .Type.Name }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\t{{ $short }}, err := Scan{{ .Type.Name }}(row)\n        if err != nil {\n            return nil, newErrorWithCode(codes.Internal, \"Find{{ .FuncName }}\", \"{{ $table }}\", err)\n        }\n\n\t\tres = append(res, {{ $short }})\n\t}\n\n\treturn res, nil\n{{- end }}\n}\n{{- if $softdelete }}\n\n// Find{{ .FuncName }}IncludingDeleted is Find{{ .FuncName }} including the\n// soft-deleted rows.\nfunc Find{{ .FuncName }}IncludingDeleted(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ({{ if not .Index.IsUnique }}[]{{ end }}*{{ .Type.Name }}, error) {\n\treturn Find{{ .FuncName }}(YOIncludingDeleted(ctx), db{{ goparamlist .Fields true false }}, opts...)\n}\n{{- end }}\n{{- if not .Index.IsUnique }}\n\n// Count{{ .FuncName }} returns the number of the rows of '{{ $table }}' found by\n// Find{{ .FuncName }}.\nfunc Count{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) (int64, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Count{{ .FuncName }}\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Count{{ .FuncName }}\", \"{{ $table }}\")()\n{{ end }}\n\t{{- if not (or .NullableFields $softdelete) }}\n\tconst sqlstr = \"SELECT COUNT(*) \" +\n\t\t\"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} \" +\n\t\t\"WHERE {{ colnamesquery .Fields \" AND \" }}\"\n\t{{- else }}\n\tvar sqlstr = \"SELECT COUNT(*) \" +\n\t\t\"FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }} \"\n\n\tconds := make([]string, {{ columncount .Fields }})\n\t{{- range $i, $f := .Fields }}\n\t{{- if $f.Col.NotNull }}\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = {{ param $i }}\"\n\t{{- else }}\n\tif {{ nullcheck $f }} {\n\t\t{{- if and $.Index.IsNullFiltered (forceindex $.Index.IndexName) }}\n\t\tYOWarn(ctx, \"{{ colname $f.Col }} is NULL, but NULL is not in null-filtered index {{ $.Index.IndexName }}\")\n\t\t{{- end }}\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} IS NULL\"\n\t} else {\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = {{ param $i }}\"\n\t}\n\t{{- end }}\n\t{{- end }}\n\t{{- if $softdelete }}\n\tif !yoIncludesDeleted(ctx) {\n\t\tconds = append(conds, \"{{ escapedcolname $softdelete.Col }} IS NULL\")\n\t}\n\t{{- end }}\n\tsqlstr += \"WHERE \" + strings.Join(conds, \" AND \")\n\t{{- end }}\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .Fields }}\n\t\t{{- if $f.CustomType }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ spanvalue $f (goparamname $f.Name) }}\n\t\t{{- else }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ goparamname $f.Name }}\n\t\t{{- end }}\n\t{{- end}}\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"Count{{ .FuncName }}\", stmt{{ goparamlist .Fields true false }})()\n\tn, err := yoCount(ctx, db, stmt, opts)\n\tif err != nil {\n\t\treturn 0, newError(\"Count{{ .FuncName }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn n, nil\n}\n{{- end }}\n{{- if not .IsPrefix }}\n\n\n// Read{{ .FuncName }} retrieves multiples rows from '{{ $table }}' by KeySet as a slice.\n//\n// This does not retrieve all columns of '{{ $table }}' because an index has only columns\n// used for primary key, index key and storing columns. If you need more columns, add storing\n// columns or Read by primary key or Query with join.\n//\n// Generated from {{ if .Index.IsUnique }}unique {{ end }}{{ $kind }} '{{ .Index.IndexName }}' ({{ indexkey .KeyFields }}).\nfunc Read{{ .FuncName }}(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, error) {\n\tvar res []*{{ .Type.Name }}\n    columns := []string{\n{{- range .Type.PrimaryKeyFields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n{{- range .Fields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n{{- range .StoringFields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n}\n\n\trows := yoRead(ctx, db, \"{{ $table }}\", \"{{ .Index.IndexName }}\", keys, columns, opts)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := Scan{{ .Type.Name }}(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Read{{ .FuncName }}\", \"{{ $table }}\", err)\n\t}\n\n    return res, nil\n}\n\n// {{ .RowName }} represents a row of index '{{ .Index.IndexName }}' of '{{ $table }}',\n// which has the index key, the storing columns and the primary key.\ntype {{ .RowName }} struct {\n{{- range .RowFields }}\n{{- if .CustomType }}\n\t{{ .Name }} {{ retype .CustomType }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}\n{{- else }}\n\t{{ .Name }} {{ .Type }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}\n{{- end }}\n{{- end }}\n}\n\n// Read{{ .RowName }}s retrieves multiples rows from index '{{ .Index.IndexName }}' by\n// KeySet as a slice. This reads only the index and never reads '{{ $table }}'.\nfunc Read{{ .RowName }}s(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .RowName }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Read{{ .RowName }}s\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Read{{ .RowName }}s\", \"{{ $table }}\")()\n{{ end }}\n\tvar res []*{{ .RowName }}\n\tcolumns := []string{\n{{- range .RowFields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n\t}\n\n\trows := yoRead(ctx, db, \"{{ $table }}\", \"{{ .Index.IndexName }}\", keys, columns, opts)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\tr, err := scan{{ .RowName }}(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, r)\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Read{{ .RowName }}s\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, nil\n}\n\n// scan{{ .RowName }} decodes row having the columns of {{ .RowName }} in order.\nfunc scan{{ .RowName }}(row *spanner.Row) (*{{ .RowName }}, error) {\n\tvar r {{ .RowName }}\n\t{{- range .RowFields }}\n\t{{- if customjson . }}\n\tvar {{ customtypeparam .Name }} spanner.GenericColumnValue\n\t{{- else if .CustomType }}\n\tvar {{ customtypeparam .Name }} {{ .Type }}\n\t{{- end }}\n\t{{- end }}\n\tif err := row.Columns({{ range $i, $f := .RowFields }}{{ if $i }}, {{ end }}{{ if $f.CustomType }}&{{ customtypeparam $f.Name }}{{ else }}&r.{{ $f.Name }}{{ end }}{{ end }}); err != nil {\n\t\treturn nil, err\n\t}\n\t{{- range .RowFields }}\n\t{{- if customjson . }}\n\tif err := yoUnmarshalJSON({{ customtypeparam .Name }}, &r.{{ .Name }}); err != nil {\n\t\treturn nil, fmt.Errorf(\"failed to unmarshal column {{ colname .Col }}: %v\", err)\n\t}\n\t{{- else if .CustomType }}\n\tr.{{ .Name }} = {{ customvalue . (customtypeparam .Name) }}\n\t{{- end }}\n\t{{- end }}\n\n\treturn &r, nil\n}\n{{- $rowfunc := print \"Find\" .RowName }}{{ if not .Index.IsUnique }}{{ $rowfunc = print $rowfunc \"s\" }}{{ end }}\n\n{{- if .Index.IsUnique }}\n\n// {{ $rowfunc }} retrieves a row from index '{{ .Index.IndexName }}' as a\n// {{ .RowName }} by the index key. It selects only the columns covered by the\n// index, so that the query never joins back to '{{ $table }}'.\n//\n// If no row is present with the given key, then an error is returned where\n// errors.Is(err, ErrNotFound) is true.\nfunc {{ $rowfunc }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) (*{{ .RowName }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ $rowfunc }}\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ $rowfunc }}\", \"{{ $table }}\")()\n{{ end }}\n{{- else }}\n\n// {{ $rowfunc }} retrieves multiple rows from index '{{ .Index.IndexName }}' as a\n// slice of {{ .RowName }} by the index key. It selects only the columns covered\n// by the index, so that the query never joins back to '{{ $table }}'.\nfunc {{ $rowfunc }}(ctx context.Context, db YORODB{{ gocustomparamlist .Fields true true }}, opts ...*spanner.ReadOptions) ([]*{{ .RowName }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ $rowfunc }}\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ $rowfunc }}\", \"{{ $table }}\")()\n{{ end }}\n{{- end }}\n\t{{- if not .NullableFields }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .RowFields }} \" +\n\t\t\"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} \" +\n\t\t\"WHERE {{ colnamesquery .Fields \" AND \" }}\"\n\t{{- else }}\n\tvar sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .RowFields }} \" +\n\t\t\"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} \"\n\n\tconds := make([]string, {{ columncount .Fields }})\n\t{{- range $i, $f := .Fields }}\n\t{{- if $f.Col.NotNull }}\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = {{ param $i }}\"\n\t{{- else }}\n\tif {{ nullcheck $f }} {\n\t\t{{- if $.Index.IsNullFiltered }}\n\t\tYOWarn(ctx, \"{{ colname $f.Col }} is NULL, but NULL is not in null-filtered index {{ $.Index.IndexName }}\")\n\t\t{{- end }}\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} IS NULL\"\n\t} else {\n\t\tconds[{{ $i }}] = \"{{ escapedcolname $f.Col }} = {{ param $i }}\"\n\t}\n\t{{- end }}\n\t{{- end }}\n\tsqlstr += \"WHERE \" + strings.Join(conds, \" AND \")\n\t{{- end }}\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .Fields }}\n\t\t{{- if $f.CustomType }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ spanvalue $f (goparamname $f.Name) }}\n\t\t{{- else }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ goparamname $f.Name }}\n\t\t{{- end }}\n\t{{- end}}\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"{{ $rowfunc }}\", stmt{{ goparamlist .Fields true false }})()\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n{{- if .Index.IsUnique }}\n\n\trow, err := iter.Next()\n\tif err != nil {\n\t\tif err == iterator.Done {\n\t\t\treturn nil, newNotFoundError(\"{{ $rowfunc }}\", \"{{ $table }}\", spanner.Key{ {{ gocustomparamlist .Fields false false }} }, Err{{ .Type.Name }}NotFound, nil)\n\t\t}\n\t\treturn nil, newError(\"{{ $rowfunc }}\", \"{{ $table }}\", err)\n\t}\n\n\tr, err := scan{{ .RowName }}(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ $rowfunc }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn r, nil\n{{- else }}\n\n\tres := []*{{ .RowName }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"{{ $rowfunc }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tr, err := scan{{ .RowName }}(row)\n\t\tif err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ $rowfunc }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tres = append(res, r)\n\t}\n\n\treturn res, nil\n{{- end }}\n}\n{{- if .PageFields }}\n{{- $func := .PageFuncName }}\n\n// {{ $func }} retrieves a page of at most pageSize rows from '{{ $table }}'\n// ordered by the key of index '{{ .Index.IndexName }}' and the primary key. The\n// page starts after the row of pageToken, or at the first row if pageToken is\n// empty. The returned token reads the next page, and is empty at the last page.\n// The Limit of opts is ignored.\nfunc {{ $func }}(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*{{ .Type.Name }}, string, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ $func }}\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ $func }}\", \"{{ $table }}\")()\n{{ end }}\n\tif pageSize <= 0 {\n\t\treturn nil, \"\", newErrorWithCode(codes.InvalidArgument, \"{{ $func }}\", \"{{ $table }}\", fmt.Errorf(\"invalid page size %d\", pageSize))\n\t}\n\n\tvar key []interface{}\n\tif pageToken != \"\" {\n\t\t{{- range $i, $f := .PageFields }}\n\t\tvar k{{ $i }} {{ if $f.Field.CustomType }}{{ retype $f.Field.CustomType }}{{ else }}{{ $f.Field.Type }}{{ end }}\n\t\t{{- end }}\n\t\tif err := yoDecodePageToken(pageToken{{ range $i, $f := .PageFields }}, &k{{ $i }}{{ end }}); err != nil {\n\t\t\treturn nil, \"\", newErrorWithCode(codes.InvalidArgument, \"{{ $func }}\", \"{{ $table }}\", err)\n\t\t}\n\t\tkey = []interface{}{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ if $f.Field.CustomType }}{{ spanvalue $f.Field (print \"k\" $i) }}{{ else }}k{{ $i }}{{ end }}{{ end -}} }\n\t}\n\tstmt := yoPageStatement(\"SELECT {{ escapedcolnames .Type.Fields }} FROM {{ escapedtable $table }}{{ forceindex .Index.IndexName }}\",\n\t\t[]string{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}\"{{ escapedcolname $f.Field.Col }}\"{{ end -}} },\n\t\t[]bool{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ $f.Desc }}{{ end -}} },\n\t\tkey, pageSize)\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"{{ $func }}\", stmt)()\n\tres, err := Scan{{ pluralize .Type.Name }}(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))\n\tif err != nil {\n\t\treturn nil, \"\", newError(\"{{ $func }}\", \"{{ $table }}\", err)\n\t}\n\tif len(res) < pageSize {\n\t\treturn res, \"\", nil\n\t}\n\n\tlast := res[len(res)-1]\n\ttoken, err := yoEncodePageToken({{ range $i, $f := .PageFields }}{{ if $i }}, {{ end }}last.{{ $f.Field.Name }}{{ end }})\n\tif err != nil {\n\t\treturn nil, \"\", newErrorWithCode(codes.Internal, \"{{ $func }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, token, nil\n}\n{{- end }}\n{{- end }}\n"
+var _Assets7fd73945d69f17ee7478fe75c9ebb3a425327b99 = "{{- $short := (shortname .Name \"err\" \"res\" \"sqlstr\" \"db\" \"YOLog\") -}}\n{{- $table := (.Table.TableName) -}}\n{{- $committs := (committsfields .Fields) }}\n{{- $softdelete := .SoftDeleteField }}\n{{- $delete := \"Delete\" }}{{ if $softdelete }}{{ $delete = \"HardDelete\" }}{{ end }}\n{{- $deletedvalue := \"time.Now()\" }}{{ if autocommitts }}{{ range $committs }}{{ if and $softdelete (eq .Name $softdelete.Name) }}{{ $deletedvalue = \"spanner.CommitTimestamp\" }}{{ end }}{{ end }}{{ end }}\n{{- $values := \"columnsToValues\" }}{{ if and autocommitts $committs }}{{ $values = \"mutationValues\" }}{{ end }}\n{{- $identity := false }}{{ range .Fields }}{{ if or .Col.IsIdentity .Col.Sequence }}{{ $identity = true }}{{ end }}{{ end -}}\n// {{ .Name }} represents a row from '{{ $table }}'.\n{{- with .Table.RowDeletionPolicy }}\n// The rows are deleted by the row deletion policy of the table\n// {{ .NumDays }} days after {{ .ColumnName }}.\n{{- end }}\ntype {{ .Name }} struct {\n{{- range .Fields }}\n{{- if eq (.Col.DataType) (.Col.ColumnName) }}\n\t{{ .Name }} string `{{ fieldtag . }}` // {{ .Col.ColumnName }} enum\n{{- else if .CustomType }}\n\t{{ .Name }} {{ retype .CustomType }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}\n{{- else }}\n\t{{ .Name }} {{ .Type }} `{{ fieldtag . }}` // {{ .Col.ColumnName }}\n{{- end }}\n{{- end }}\n}\n{{- range $e := .Enums }}\n\n// {{ $e.Name }} is the values of '{{ colname $e.Field.Col }}' of '{{ $table }}'.\ntype {{ $e.Name }} string\n\n// Values of {{ $e.Name }}.\nconst (\n{{- range $e.Values }}\n\t{{ .Name }} {{ $e.Name }} = {{ printf \"%q\" .Value }}\n{{- end }}\n)\n{{- end }}\n{{- with .Table.RowDeletionPolicy }}\n\n// Row deletion policy of '{{ $table }}'. Cloud Spanner deletes the rows whose\n// {{ $.Name }}TTLColumn is older than {{ $.Name }}TTL in the background.\nconst (\n\t{{ $.Name }}TTLColumn = \"{{ .ColumnName }}\"\n\t{{ $.Name }}TTL       = {{ .NumDays }} * 24 * time.Hour\n)\n{{- end }}\n{{- with .TTLField }}\n\n// ExpiresAt returns the time when the row of {{ $short }} expires by the row\n// deletion policy of '{{ $table }}'.\n{{- if or (eq .Type \"spanner.NullTime\") (eq .Type \"*time.Time\") }} It returns false if {{ .Name }} is NULL,\n// where the row never expires.\n{{- else }} It always returns true because {{ .Name }}\n// is NOT NULL.\n{{- end }}\nfunc ({{ $short }} *{{ $.Name }}) ExpiresAt() (time.Time, bool) {\n{{- if eq .Type \"spanner.NullTime\" }}\n\tif !{{ $short }}.{{ .Name }}.Valid {\n\t\treturn time.Time{}, false\n\t}\n\treturn {{ $short }}.{{ .Name }}.Time.Add({{ $.Name }}TTL), true\n{{- else if eq .Type \"*time.Time\" }}\n\tif {{ $short }}.{{ .Name }} == nil {\n\t\treturn time.Time{}, false\n\t}\n\treturn {{ $short }}.{{ .Name }}.Add({{ $.Name }}TTL), true\n{{- else }}\n\treturn {{ $short }}.{{ .Name }}.Add({{ $.Name }}TTL), true\n{{- end }}\n}\n{{- end }}\n\n// {{ .Name }}TableName is the name of '{{ $table }}', which is qualified by the\n// schema if the table is in a named schema.\nconst {{ .Name }}TableName = \"{{ $table }}\"\n\n// Err{{ .Name }}NotFound is the error matched by errors.Is when the row is not\n// found in '{{ $table }}'.\nvar Err{{ .Name }}NotFound = errors.New(\"yo: {{ $table }} not found\")\n\n{{ if .PrimaryKey }}\nfunc {{ .Name }}PrimaryKeys() []string {\n     return []string{\n{{- range .PrimaryKeyFields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n\t}\n}\n{{- end }}\n\nfunc {{ .Name }}Columns() []string {\n\treturn []string{\n{{- range .Fields }}\n\t\t\"{{ colname .Col }}\",\n{{- end }}\n\t}\n}\n\n// {{ .Name }}Column is a column of '{{ $table }}'.\ntype {{ .Name }}Column string\n\n// Columns of '{{ $table }}'.\nconst (\n{{- range .Fields }}\n\t{{ $.Name }}Column{{ .Name }} {{ $.Name }}Column = \"{{ colname .Col }}\"\n{{- end }}\n)\n\n{{ if not .Table.IsView -}}\nfunc {{ .Name }}WritableColumns() []string {\n\treturn []string{\n{{- range .Fields }}\n\t{{- if not .Col.IsGenerated }}\n\t\t\"{{ colname .Col }}\",\n\t{{- end }}\n{{- end }}\n\t}\n}\n\n{{ if $identity -}}\n// {{ .Name }}InsertColumns returns the writable columns except the identity\n// columns and the columns defaulted by sequences, whose values are assigned by\n// Cloud Spanner.\nfunc {{ .Name }}InsertColumns() []string {\n\treturn []string{\n{{- range .Fields }}\n\t{{- if not (or .Col.IsGenerated .Col.IsIdentity .Col.Sequence) }}\n\t\t\"{{ colname .Col }}\",\n\t{{- end }}\n{{- end }}\n\t}\n}\n\n{{ end -}}\n{{ end -}}\nfunc ({{ $short }} *{{ .Name }}) columnsToPtrs(cols []string, customPtrs map[string]interface{}) ([]interface{}, error) {\n\tret := make([]interface{}, 0, len(cols))\n\tfor _, col := range cols {\n\t\tif val, ok := customPtrs[col]; ok {\n\t\t\tret = append(ret, val)\n\t\t\tcontinue\n\t\t}\n\n\t\tswitch col {\n{{- range .Fields }}\n\t\tcase \"{{ colname .Col }}\":\n\t\t\tret = append(ret, &{{ $short }}.{{ .Name }})\n{{- end }}\n\t\tdefault:\n\t\t\treturn nil, fmt.Errorf(\"unknown column: %s\", col)\n\t\t}\n\t}\n\treturn ret, nil\n}\n\n{{ if not .Table.IsView -}}\nfunc ({{ $short }} *{{ .Name }}) columnsToValues(cols []string) ([]interface{}, error) {\n\tret := make([]interface{}, 0, len(cols))\n\tfor _, col := range cols {\n\t\tswitch col {\n{{- range .Fields }}\n\t\tcase \"{{ colname .Col }}\":\n\t\t\t{{- if .CustomType }}\n\t\t\tret = append(ret, {{ spanvalue . (print $short \".\" .Name) }})\n\t\t\t{{- else }}\n\t\t\tret = append(ret, {{ $short }}.{{ .Name }})\n\t\t\t{{- end }}\n{{- end }}\n\t\tdefault:\n\t\t\treturn nil, fmt.Errorf(\"unknown column: %s\", col)\n\t\t}\n\t}\n\n\treturn ret, nil\n}\n{{- if and autocommitts $committs }}\n\n// mutationValues returns the values of cols to write. The values of the\n// commit timestamp columns are spanner.CommitTimestamp so that Cloud Spanner\n// writes the commit timestamps of the transactions into them.\nfunc ({{ $short }} *{{ .Name }}) mutationValues(cols []string) ([]interface{}, error) {\n\tret, err := {{ $short }}.columnsToValues(cols)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\tfor i, col := range cols {\n\t\tswitch col {\n\t\tcase {{ range $i, $f := $committs }}{{ if $i }}, {{ end }}\"{{ colname $f.Col }}\"{{ end }}:\n\t\t\tret[i] = spanner.CommitTimestamp\n\t\t}\n\t}\n\n\treturn ret, nil\n}\n{{- end }}\n\n{{ end -}}\n// new{{ .Name }}_Decoder returns a decoder which reads a row from *spanner.Row\n// into {{ .Name }}. The decoder is not goroutine-safe. Don't use it concurrently.\nfunc new{{ .Name }}_Decoder(cols []string) func(*spanner.Row) (*{{ .Name }}, error) {\n\t{{- range .Fields }}\n\t\t{{- if customjson . }}\n\t\t\tvar {{ customtypeparam .Name }} spanner.GenericColumnValue\n\t\t{{- else if .CustomType }}\n\t\t\tvar {{ customtypeparam .Name }} {{ .Type }}\n\t\t{{- end }}\n\t{{- end }}\n\tcustomPtrs := map[string]interface{}{\n\t\t{{- range .Fields }}\n\t\t\t{{- if .CustomType }}\n\t\t\t\t\"{{ colname .Col }}\": &{{ customtypeparam .Name }},\n\t\t\t{{- end }}\n\t{{- end }}\n\t}\n\n\treturn func(row *spanner.Row) (*{{ .Name }}, error) {\n        var {{ $short }} {{ .Name }}\n        ptrs, err := {{ $short }}.columnsToPtrs(cols, customPtrs)\n        if err != nil {\n            return nil, err\n        }\n\n        if err := row.Columns(ptrs...); err != nil {\n            return nil, err\n        }\n        {{- range .Fields }}\n            {{- if customjson . }}\n                if err := yoUnmarshalJSON({{ customtypeparam .Name }}, &{{ $short }}.{{ .Name }}); err != nil {\n                    return nil, fmt.Errorf(\"failed to unmarshal column {{ colname .Col }}: %v\", err)\n                }\n            {{- else if .CustomType }}\n                {{ $short }}.{{ .Name }} = {{ customvalue . (customtypeparam .Name) }}\n            {{- end }}\n        {{- end }}\n\n\n\t\treturn &{{ $short }}, nil\n\t}\n}\n\n// Scan{{ .Name }} decodes row into {{ .Name }}. The row may have any subset of\n// the columns of '{{ $table }}', such as the result of a query or a read.\nfunc Scan{{ .Name }}(row *spanner.Row) (*{{ .Name }}, error) {\n\treturn new{{ .Name }}_Decoder(row.ColumnNames())(row)\n}\n\n// Scan{{ pluralize .Name }} decodes all the rows of iter into a slice of {{ .Name }}.\n// iter is stopped when it returns.\nfunc Scan{{ pluralize .Name }}(iter *spanner.RowIterator) ([]*{{ .Name }}, error) {\n\tvar res []*{{ .Name }}\n\tvar decoder func(*spanner.Row) (*{{ .Name }}, error)\n\terr := iter.Do(func(row *spanner.Row) error {\n\t\tif decoder == nil {\n\t\t\tdecoder = new{{ .Name }}_Decoder(row.ColumnNames())\n\t\t}\n\n\t\t{{ $short }}, err := decoder(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\n\treturn res, nil\n}\n\n// {{ .Name }}Iterator iterates over the rows of a partition of '{{ $table }}'\n// decoded into {{ .Name }}.\ntype {{ .Name }}Iterator struct {\n\titer    *spanner.RowIterator\n\tdecoder func(*spanner.Row) (*{{ .Name }}, error)\n}\n\n// Execute{{ .Name }}Partition returns an iterator of the rows of p, which is a\n// partition given by {{ if not .Table.IsView }}PartitionReadAll{{ pluralize .Name }} or {{ end }}{{ .Name }}Query.PartitionQuery in btx. The\n// partitions may be executed by different processes with the same btx.\nfunc Execute{{ .Name }}Partition(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, p *spanner.Partition) *{{ .Name }}Iterator {\n\treturn &{{ .Name }}Iterator{iter: btx.Execute(ctx, p)}\n}\n\n// Next returns the next row of the partition. It returns iterator.Done when\n// all the rows are returned.\nfunc (it *{{ .Name }}Iterator) Next() (*{{ .Name }}, error) {\n\trow, err := it.iter.Next()\n\tif err != nil {\n\t\tif err == iterator.Done {\n\t\t\treturn nil, err\n\t\t}\n\t\treturn nil, newError(\"{{ .Name }}Iterator.Next\", \"{{ $table }}\", err)\n\t}\n\n\tif it.decoder == nil {\n\t\tit.decoder = new{{ .Name }}_Decoder(row.ColumnNames())\n\t}\n\tv, err := it.decoder(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ .Name }}Iterator.Next\", \"{{ $table }}\", err)\n\t}\n\n\treturn v, nil\n}\n\n// Stop terminates the iteration, which must be called when the iterator is\n// no longer used.\nfunc (it *{{ .Name }}Iterator) Stop() {\n\tit.iter.Stop()\n}\n\n// yield{{ .Name }}Rows decodes the rows of rows into {{ .Name }} and yields them\n// until yield returns false or an error is yielded. rows is stopped when it\n// returns.\nfunc yield{{ .Name }}Rows(rows *spanner.RowIterator, method string, yield func(*{{ .Name }}, error) bool) {\n\tdefer rows.Stop()\n\n\tvar decoder func(*spanner.Row) (*{{ .Name }}, error)\n\tfor {\n\t\trow, err := rows.Next()\n\t\tif err != nil {\n\t\t\tif err != iterator.Done {\n\t\t\t\tyield(nil, newError(method, \"{{ $table }}\", err))\n\t\t\t}\n\t\t\treturn\n\t\t}\n\n\t\tif decoder == nil {\n\t\t\tdecoder = new{{ .Name }}_Decoder(row.ColumnNames())\n\t\t}\n\t\tv, err := decoder(row)\n\t\tif err != nil {\n\t\t\tyield(nil, newErrorWithCode(codes.Internal, method, \"{{ $table }}\", err))\n\t\t\treturn\n\t\t}\n\t\tif !yield(v, nil) {\n\t\t\treturn\n\t\t}\n\t}\n}\n\n// Count{{ pluralize .Name }} returns the number of the rows of '{{ $table }}'.\nfunc Count{{ pluralize .Name }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Count{{ pluralize .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Count{{ pluralize .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tstmt := spanner.NewStatement(\"SELECT COUNT(*) FROM {{ escapedtable $table }}\")\n\t{{- if $softdelete }}\n\tif !yoIncludesDeleted(ctx) {\n\t\tstmt.SQL += \" WHERE {{ escapedcolname $softdelete.Col }} IS NULL\"\n\t}\n\t{{- end }}\n\n\tdefer yoLogQuery(ctx, \"Count{{ pluralize .Name }}\", stmt)()\n\tn, err := yoCount(ctx, db, stmt, opts)\n\tif err != nil {\n\t\treturn 0, newError(\"Count{{ pluralize .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn n, nil\n}\n\n// All{{ pluralize .Name }}Seq returns an iterator of all the rows of '{{ $table }}',\n// which streams the rows without holding all of them in memory. It is an\n// iter.Seq2[*{{ .Name }}, error] of range-over-func of Go 1.23 or later, which\n// ends after yielding an error.\nfunc All{{ pluralize .Name }}Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*{{ .Name }}, error) bool) {\n\treturn func(yield func(*{{ .Name }}, error) bool) {\n\t\t{{- if otel }}\n\t\tctx, span := yoStartSpan(ctx, \"All{{ pluralize .Name }}Seq\", \"{{ $table }}\", \"\")\n\t\tdefer span.End()\n{{ end }}\n\t\t{{- if metrics }}\n\t\tdefer yoObserve(\"All{{ pluralize .Name }}Seq\", \"{{ $table }}\")()\n{{ end }}\n\t{{- if or .Table.IsView (not .PrimaryKeyFields) }}\n\t\tstmt := spanner.NewStatement(\"SELECT {{ escapedcolnames .Fields }} FROM {{ escapedtable $table }}\")\n\t\tdefer yoLogQuery(ctx, \"All{{ pluralize .Name }}Seq\", stmt)()\n\t\tyield{{ .Name }}Rows(yoQuery(ctx, db, stmt, opts), \"All{{ pluralize .Name }}Seq\", yield)\n\t{{- else }}\n\t\t{{- if $softdelete }}\n\t\tif !yoIncludesDeleted(ctx) {\n\t\t\tnext := yield\n\t\t\tyield = func(v *{{ .Name }}, err error) bool {\n\t\t\t\tif err == nil && v.IsDeleted() {\n\t\t\t\t\treturn true\n\t\t\t\t}\n\t\t\t\treturn next(v, err)\n\t\t\t}\n\t\t}\n\t\t{{- end }}\n\t\trows := yoRead(ctx, db, \"{{ $table }}\", \"\", spanner.AllKeys(), {{ .Name }}Columns(), opts)\n\t\tyield{{ .Name }}Rows(rows, \"All{{ pluralize .Name }}Seq\", yield)\n\t{{- end }}\n\t}\n}\n{{- if not .Table.IsView }}\n\n// PartitionReadAll{{ pluralize .Name }} partitions the read of all the rows of\n// '{{ $table }}' in btx, so that the partitions are read in parallel by\n// Execute{{ .Name }}Partition for bulk exports.\n{{- if databoost }} The partitions are executed by\n// Data Boost if enabled by yopts.\n{{- end }}\nfunc PartitionReadAll{{ pluralize .Name }}(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"PartitionReadAll{{ pluralize .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"PartitionReadAll{{ pluralize .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tropts := spanner.ReadOptions{}\n\t{{- if databoost }}\n\tropts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled\n\t{{- end }}\n\tps, err := btx.PartitionReadWithOptions(ctx, \"{{ $table }}\", spanner.AllKeys(), {{ .Name }}Columns(), opts, ropts)\n\tif err != nil {\n\t\treturn nil, newError(\"PartitionReadAll{{ pluralize .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn ps, nil\n}\n{{- end }}\n\n// {{ .Name }}Query is a query builder for '{{ $table }}'. The conditions are\n// given by the predicates of {{ .Name }}Where, whose values are always bound to\n// query parameters, and the rows are sorted by the columns of {{ .Name }}Column.\ntype {{ .Name }}Query struct {\n\tpreds  []YOPredicate\n\torders []string\n\tlimit  int\n\t{{- if $softdelete }}\n\n\tincludeDeleted bool\n\t{{- end }}\n}\n\n// New{{ .Name }}Query returns a {{ .Name }}Query filtered by preds.\nfunc New{{ .Name }}Query(preds ...YOPredicate) *{{ .Name }}Query {\n\treturn &{{ .Name }}Query{preds: preds}\n}\n\n// Where adds preds to the conditions which rows must satisfy.\nfunc (q *{{ .Name }}Query) Where(preds ...YOPredicate) *{{ .Name }}Query {\n\tq.preds = append(q.preds, preds...)\n\treturn q\n}\n\n// OrderBy sorts the rows by col in ascending order after the columns given\n// before.\nfunc (q *{{ .Name }}Query) OrderBy(col {{ .Name }}Column) *{{ .Name }}Query {\n\tq.orders = append(q.orders, yoQuoteIdentifier(string(col)))\n\treturn q\n}\n\n// OrderByDesc sorts the rows by col in descending order after the columns\n// given before.\nfunc (q *{{ .Name }}Query) OrderByDesc(col {{ .Name }}Column) *{{ .Name }}Query {\n\tq.orders = append(q.orders, yoQuoteIdentifier(string(col))+\" DESC\")\n\treturn q\n}\n\n// Limit limits the number of the rows to n, which overrides the Limit of the\n// read options given to Query.\nfunc (q *{{ .Name }}Query) Limit(n int) *{{ .Name }}Query {\n\tq.limit = n\n\treturn q\n}\n\n{{ if $softdelete -}}\n// IncludingDeleted includes the soft-deleted rows, which are filtered out by\n// default.\nfunc (q *{{ .Name }}Query) IncludingDeleted() *{{ .Name }}Query {\n\tq.includeDeleted = true\n\treturn q\n}\n\n// conditions returns the predicates of the query, and the one filtering out\n// the soft-deleted rows unless they are included.\nfunc (q *{{ .Name }}Query) conditions() []YOPredicate {\n\tif q.includeDeleted {\n\t\treturn q.preds\n\t}\n\treturn append(q.preds[:len(q.preds):len(q.preds)], {{ .Name }}Where.{{ $softdelete.Name }}.IsNull())\n}\n\n// Statement returns the parameterized statement of the query.\nfunc (q *{{ .Name }}Query) Statement() spanner.Statement {\n\treturn yoStatement(\"{{ escapedcolnames .Fields }}\", \"{{ escapedtable $table }}\", q.conditions(), q.orders, q.limit)\n}\n{{- else -}}\n// Statement returns the parameterized statement of the query.\nfunc (q *{{ .Name }}Query) Statement() spanner.Statement {\n\treturn yoStatement(\"{{ escapedcolnames .Fields }}\", \"{{ escapedtable $table }}\", q.preds, q.orders, q.limit)\n}\n{{- end }}\n\n// Query runs the query and returns the matched rows as a slice.\nfunc (q *{{ .Name }}Query) Query(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ .Name }}Query.Query\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ .Name }}Query.Query\", \"{{ $table }}\")()\n{{ end }}\n\tstmt := q.Statement()\n\tif q.limit > 0 {\n\t\topts = yoWithoutLimit(opts)\n\t}\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"{{ .Name }}Query.Query\", stmt)()\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\t// load results\n\tres := []*{{ .Name }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"{{ .Name }}Query.Query\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tv, err := Scan{{ .Name }}(row)\n\t\tif err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ .Name }}Query.Query\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tres = append(res, v)\n\t}\n\n\treturn res, nil\n}\n\n// Count returns the number of the rows matched by the query regardless of\n// OrderBy and Limit.\nfunc (q *{{ .Name }}Query) Count(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (int64, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ .Name }}Query.Count\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ .Name }}Query.Count\", \"{{ $table }}\")()\n{{ end }}\n\tstmt := yoStatement(\"COUNT(*)\", \"{{ escapedtable $table }}\", {{ if $softdelete }}q.conditions(){{ else }}q.preds{{ end }}, nil, 0)\n\n\tdefer yoLogQuery(ctx, \"{{ .Name }}Query.Count\", stmt)()\n\tn, err := yoCount(ctx, db, stmt, opts)\n\tif err != nil {\n\t\treturn 0, newError(\"{{ .Name }}Query.Count\", \"{{ $table }}\", err)\n\t}\n\n\treturn n, nil\n}\n\n// Seq returns an iterator of the rows matched by the query, which streams the\n// rows without holding all of them in memory. It is an\n// iter.Seq2[*{{ .Name }}, error] of range-over-func of Go 1.23 or later, which\n// ends after yielding an error.\nfunc (q *{{ .Name }}Query) Seq(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) func(yield func(*{{ .Name }}, error) bool) {\n\treturn func(yield func(*{{ .Name }}, error) bool) {\n\t\t{{- if otel }}\n\t\tctx, span := yoStartSpan(ctx, \"{{ .Name }}Query.Seq\", \"{{ $table }}\", \"\")\n\t\tdefer span.End()\n{{ end }}\n\t\t{{- if metrics }}\n\t\tdefer yoObserve(\"{{ .Name }}Query.Seq\", \"{{ $table }}\")()\n{{ end }}\n\t\tstmt := q.Statement()\n\t\tif q.limit > 0 {\n\t\t\topts = yoWithoutLimit(opts)\n\t\t}\n\n\t\tdefer yoLogQuery(ctx, \"{{ .Name }}Query.Seq\", stmt)()\n\t\tyield{{ .Name }}Rows(yoQuery(ctx, db, stmt, opts), \"{{ .Name }}Query.Seq\", yield)\n\t}\n}\n\n// PartitionQuery partitions the query in btx, so that the partitions are read\n// in parallel by Execute{{ .Name }}Partition. The query must not have OrderBy or\n// Limit, which cannot be partitioned.\n{{- if databoost }} The partitions are executed by Data\n// Boost if enabled by yopts.\n{{- end }}\nfunc (q *{{ .Name }}Query) PartitionQuery(ctx context.Context, btx *spanner.BatchReadOnlyTransaction, opts spanner.PartitionOptions, yopts ...*YOPartitionOptions) ([]*spanner.Partition, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ .Name }}Query.PartitionQuery\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ .Name }}Query.PartitionQuery\", \"{{ $table }}\")()\n{{ end }}\n\tif len(q.orders) != 0 || q.limit > 0 {\n\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"{{ .Name }}Query.PartitionQuery\", \"{{ $table }}\", errors.New(\"ordered or limited query cannot be partitioned\"))\n\t}\n\n\tstmt := q.Statement()\n\tdefer yoLogQuery(ctx, \"{{ .Name }}Query.PartitionQuery\", stmt)()\n\tqopts := spanner.QueryOptions{}\n\t{{- if databoost }}\n\tqopts.DataBoostEnabled = yoPartitionOptions(yopts).DataBoostEnabled\n\t{{- end }}\n\tps, err := btx.PartitionQueryWithOptions(ctx, stmt, opts, qopts)\n\tif err != nil {\n\t\treturn nil, newError(\"{{ .Name }}Query.PartitionQuery\", \"{{ $table }}\", err)\n\t}\n\n\treturn ps, nil\n}\n\n// {{ .Name }}Where has the typed predicate constructors of the columns of\n// '{{ $table }}'.\nvar {{ .Name }}Where = struct {\n{{- range .Fields }}\n\t{{ .Name }} {{ $.Name }}_{{ .Name }}Column\n{{- end }}\n}{}\n{{- range .Fields }}\n{{- $col := (escapedcolname .Col) }}\n{{- $typ := .Type }}{{ if .CustomType }}{{ $typ = retype .CustomType }}{{ end }}\n\n// {{ $.Name }}_{{ .Name }}Column has the predicate constructors of '{{ colname .Col }}'.\ntype {{ $.Name }}_{{ .Name }}Column struct{}\n{{- if iscomparable . }}\n\n// Eq returns a predicate that '{{ colname .Col }}' is equal to v.\nfunc ({{ $.Name }}_{{ .Name }}Column) Eq(v {{ $typ }}) YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"=\", value: {{ if .CustomType }}{{ spanvalue . \"v\" }}{{ else }}v{{ end }}}\n}\n\n// Ne returns a predicate that '{{ colname .Col }}' is not equal to v.\nfunc ({{ $.Name }}_{{ .Name }}Column) Ne(v {{ $typ }}) YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"!=\", value: {{ if .CustomType }}{{ spanvalue . \"v\" }}{{ else }}v{{ end }}}\n}\n\n// Lt returns a predicate that '{{ colname .Col }}' is less than v.\nfunc ({{ $.Name }}_{{ .Name }}Column) Lt(v {{ $typ }}) YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"<\", value: {{ if .CustomType }}{{ spanvalue . \"v\" }}{{ else }}v{{ end }}}\n}\n\n// Le returns a predicate that '{{ colname .Col }}' is less than or equal to v.\nfunc ({{ $.Name }}_{{ .Name }}Column) Le(v {{ $typ }}) YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"<=\", value: {{ if .CustomType }}{{ spanvalue . \"v\" }}{{ else }}v{{ end }}}\n}\n\n// Gt returns a predicate that '{{ colname .Col }}' is greater than v.\nfunc ({{ $.Name }}_{{ .Name }}Column) Gt(v {{ $typ }}) YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \">\", value: {{ if .CustomType }}{{ spanvalue . \"v\" }}{{ else }}v{{ end }}}\n}\n\n// Ge returns a predicate that '{{ colname .Col }}' is greater than or equal to v.\nfunc ({{ $.Name }}_{{ .Name }}Column) Ge(v {{ $typ }}) YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \">=\", value: {{ if .CustomType }}{{ spanvalue . \"v\" }}{{ else }}v{{ end }}}\n}\n\n// In returns a predicate that '{{ colname .Col }}' is equal to any of vs.\nfunc ({{ $.Name }}_{{ .Name }}Column) In(vs ...{{ $typ }}) YOPredicate {\n\t{{- if .CustomType }}\n\tvalues := make([]{{ .Type }}, len(vs))\n\tfor i, v := range vs {\n\t\tvalues[i] = {{ spanvalue . \"v\" }}\n\t}\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"IN\", value: values}\n\t{{- else }}\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"IN\", value: vs}\n\t{{- end }}\n}\n{{- end }}\n{{- if not .Col.NotNull }}\n\n// IsNull returns a predicate that '{{ colname .Col }}' is NULL.\nfunc ({{ $.Name }}_{{ .Name }}Column) IsNull() YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"IS NULL\"}\n}\n\n// IsNotNull returns a predicate that '{{ colname .Col }}' is not NULL.\nfunc ({{ $.Name }}_{{ .Name }}Column) IsNotNull() YOPredicate {\n\treturn YOPredicate{column: \"{{ $col }}\", op: \"IS NOT NULL\"}\n}\n{{- end }}\n{{- if and dml (not $.Table.IsView) (not .Col.IsPrimaryKey) (not .Col.IsGenerated) }}\n\n// To returns an assignment of v to '{{ colname .Col }}' for UpdateAll{{ pluralize $.Name }}Where.\nfunc ({{ $.Name }}_{{ .Name }}Column) To(v {{ $typ }}) YOAssignment {\n\treturn YOAssignment{column: \"{{ $col }}\", value: {{ if .CustomType }}{{ spanvalue . \"v\" }}{{ else }}v{{ end }}}\n}\n{{- end }}\n{{- end }}\n{{- if and dml (not .Table.IsView) }}\n\n// {{ .Name }}Set has the typed setters of the columns of '{{ $table }}' which\n// are updated by UpdateAll{{ pluralize .Name }}Where.\nvar {{ .Name }}Set = struct {\n{{- range .Fields }}\n{{- if not (or .Col.IsPrimaryKey .Col.IsGenerated) }}\n\t{{ .Name }} {{ $.Name }}_{{ .Name }}Column\n{{- end }}\n{{- end }}\n}{}\n\n// DeleteAll{{ pluralize .Name }}Where deletes all the rows of '{{ $table }}' where all\n// preds are satisfied by partitioned DML, and returns the lower bound of the\n// number of the deleted rows. It deletes all the rows if preds is empty. It\n// is intended for bulk maintenance, and is not atomic across partitions.\nfunc DeleteAll{{ pluralize .Name }}Where(ctx context.Context, client *spanner.Client, preds ...YOPredicate) (int64, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"DeleteAll{{ pluralize .Name }}Where\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"DeleteAll{{ pluralize .Name }}Where\", \"{{ $table }}\")()\n{{ end }}\n\tstmt := yoDeleteWhereDML(\"{{ escapedtable $table }}\", preds)\n\n\tdefer yoLogQuery(ctx, \"DeleteAll{{ pluralize .Name }}Where\", stmt)()\n\tn, err := client.PartitionedUpdate(ctx, stmt)\n\tif err != nil {\n\t\treturn n, newError(\"DeleteAll{{ pluralize .Name }}Where\", \"{{ $table }}\", err)\n\t}\n\n\treturn n, nil\n}\n\n// UpdateAll{{ pluralize .Name }}Where updates the columns of sets in all the rows of\n// '{{ $table }}' where all preds are satisfied by partitioned DML, and returns\n// the lower bound of the number of the updated rows. The assignments are\n// given by {{ .Name }}Set. It is intended for bulk maintenance such as\n// backfills, and is not atomic across partitions.\nfunc UpdateAll{{ pluralize .Name }}Where(ctx context.Context, client *spanner.Client, sets []YOAssignment, preds ...YOPredicate) (int64, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"UpdateAll{{ pluralize .Name }}Where\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"UpdateAll{{ pluralize .Name }}Where\", \"{{ $table }}\")()\n{{ end }}\n\tif len(sets) == 0 {\n\t\treturn 0, newErrorWithCode(codes.InvalidArgument, \"UpdateAll{{ pluralize .Name }}Where\", \"{{ $table }}\", errors.New(\"no columns to update\"))\n\t}\n\n\tstmt := yoUpdateWhereDML(\"{{ escapedtable $table }}\", sets, preds)\n\n\tdefer yoLogQuery(ctx, \"UpdateAll{{ pluralize .Name }}Where\", stmt)()\n\tn, err := client.PartitionedUpdate(ctx, stmt)\n\tif err != nil {\n\t\treturn n, newError(\"UpdateAll{{ pluralize .Name }}Where\", \"{{ $table }}\", err)\n\t}\n\n\treturn n, nil\n}\n{{- end }}\n\n{{ if .Table.IsView }}\n{{- if .PrimaryKey }}\n// Find{{ .Name }} gets a {{ .Name }} by primary key by querying the view.\nfunc Find{{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Find{{ .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Find{{ .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .Fields }} \" +\n\t\t\"FROM {{ escapedtable $table }} \" +\n\t\t\"WHERE {{ colnamesquery .PrimaryKeyFields \" AND \" }}\"\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .PrimaryKeyFields }}\n\t\t{{- if $f.CustomType }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ spanvalue $f (goparamname $f.Name) }}\n\t\t{{- else }}\n\t\t\tstmt.Params[\"{{ paramname $i }}\"] = {{ goparamname $f.Name }}\n\t\t{{- end }}\n\t{{- end }}\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"Find{{ .Name }}\", stmt{{ goparamlist .PrimaryKeyFields true false }})()\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\trow, err := iter.Next()\n\tif err != nil {\n\t\tif err == iterator.Done {\n\t\t\treturn nil, newNotFoundError(\"Find{{ .Name }}\", \"{{ $table }}\", spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }, Err{{ .Name }}NotFound, nil)\n\t\t}\n\t\treturn nil, newError(\"Find{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\t{{ $short }}, err := Scan{{ .Name }}(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Find{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn {{ $short }}, nil\n}\n{{- end }}\n{{- else }}\n{{- if hooks }}\n// {{ .Name }}Hooks are the callbacks of the generated writes of {{ .Name }}, which\n// are set to {{ .Name }}WriteHooks. Embed {{ .Name }}NopHooks to implement some\n// of them.\ntype {{ .Name }}Hooks interface {\n\t// BeforeInsert is called with the row before a mutation or a DML\n\t// statement to insert it is built by Insert, InsertOrUpdate or InsertDML,\n\t// so that it can fill or normalize the values.\n\tBeforeInsert(ctx context.Context, row *{{ .Name }})\n\n\t// BeforeUpdate is called with the row before a mutation or a DML\n\t// statement to update it is built by Update, UpdateColumns or UpdateDML.\n\tBeforeUpdate(ctx context.Context, row *{{ .Name }})\n\n\t// BeforeDelete is called with the row before a mutation or a DML\n\t// statement to delete it is built by Delete or DeleteDML.\n\tBeforeDelete(ctx context.Context, row *{{ .Name }})\n\n\t// AfterInsert is called with the row after it is inserted by\n\t// RunInsertDML or InsertAll{{ pluralize .Name }}, which write the row by themselves.\n\tAfterInsert(ctx context.Context, row *{{ .Name }})\n\n\t// AfterUpdate is called with the row after it is updated by RunUpdateDML.\n\tAfterUpdate(ctx context.Context, row *{{ .Name }})\n}\n\n// {{ .Name }}NopHooks is {{ .Name }}Hooks doing nothing.\ntype {{ .Name }}NopHooks struct{}\n\nfunc ({{ .Name }}NopHooks) BeforeInsert(context.Context, *{{ .Name }}) {}\nfunc ({{ .Name }}NopHooks) BeforeUpdate(context.Context, *{{ .Name }}) {}\nfunc ({{ .Name }}NopHooks) BeforeDelete(context.Context, *{{ .Name }}) {}\nfunc ({{ .Name }}NopHooks) AfterInsert(context.Context, *{{ .Name }})  {}\nfunc ({{ .Name }}NopHooks) AfterUpdate(context.Context, *{{ .Name }})  {}\n\n// {{ .Name }}WriteHooks is the hooks called by the generated writes of\n// {{ .Name }}, which must not be nil.\nvar {{ .Name }}WriteHooks {{ .Name }}Hooks = {{ .Name }}NopHooks{}\n\n{{ end -}}\n{{- if $identity }}\n// Insert returns a Mutation to insert a row into a table. The identity columns\n// and the columns defaulted by sequences are omitted so that Cloud Spanner\n// assigns their values. If the row already exists, the write or transaction\n// fails.\nfunc ({{ $short }} *{{ .Name }}) Insert(ctx context.Context) *spanner.Mutation {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}InsertColumns())\n\treturn spanner.Insert(\"{{ $table }}\", {{ .Name }}InsertColumns(), values)\n}\n\n// InsertWithID returns a Mutation to insert a row into a table with the values\n// of the identity columns and the columns defaulted by sequences given by the\n// {{ .Name }}. If the row already exists, the write or transaction fails.\nfunc ({{ $short }} *{{ .Name }}) InsertWithID(ctx context.Context) *spanner.Mutation {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())\n\treturn spanner.Insert(\"{{ $table }}\", {{ .Name }}WritableColumns(), values)\n}\n{{- if dml }}\n\n// InsertDML returns a DML statement to insert a row into a table, which is run\n// by the Update of a read-write transaction. The identity columns and the\n// columns defaulted by sequences are omitted so that Cloud Spanner assigns\n// their values.\nfunc ({{ $short }} *{{ .Name }}) InsertDML(ctx context.Context) spanner.Statement {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}InsertColumns())\n\treturn yoInsertDML(\"{{ escapedtable $table }}\", {{ .Name }}InsertColumns(), values)\n}\n{{- end }}\n{{- else }}\n// Insert returns a Mutation to insert a row into a table. If the row already\n// exists, the write or transaction fails.\nfunc ({{ $short }} *{{ .Name }}) Insert(ctx context.Context) *spanner.Mutation {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())\n\treturn spanner.Insert(\"{{ $table }}\", {{ .Name }}WritableColumns(), values)\n}\n{{- if dml }}\n\n// InsertDML returns a DML statement to insert a row into a table, which is run\n// by the Update of a read-write transaction. If the row already exists, the\n// statement fails.\nfunc ({{ $short }} *{{ .Name }}) InsertDML(ctx context.Context) spanner.Statement {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())\n\treturn yoInsertDML(\"{{ escapedtable $table }}\", {{ .Name }}WritableColumns(), values)\n}\n{{- end }}\n{{- end }}\n{{- if dml }}\n\n// RunInsertDML inserts the {{ .Name }} by InsertDML in tx, and scans the row written back into\n// the {{ .Name }} by {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }}, so that the default values and the generated\n// columns are populated without a read. The commit timestamp columns are not\n// returned because they cannot be read in the transaction writing them. tx is\n// intended to be given by YORunInTransaction.\nfunc ({{ $short }} *{{ .Name }}) RunInsertDML(ctx context.Context, tx *spanner.ReadWriteTransaction) error {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"RunInsertDML\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"RunInsertDML\", \"{{ $table }}\")()\n{{ end }}\n\tstmt := {{ $short }}.InsertDML(ctx)\n\tstmt.SQL += \" {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }} {{ escapedcolnames .Fields $committs }}\"\n\n\tdefer yoLogQuery(ctx, \"RunInsertDML\", stmt)()\n\trow, err := yoRunDML(ctx, tx, stmt)\n\tif err != nil {\n\t\treturn newError(\"RunInsertDML\", \"{{ $table }}\", err)\n\t}\n\n\tres, err := Scan{{ .Name }}(row)\n\tif err != nil {\n\t\treturn newErrorWithCode(codes.Internal, \"RunInsertDML\", \"{{ $table }}\", err)\n\t}\n\t{{- range $committs }}\n\tres.{{ .Name }} = {{ $short }}.{{ .Name }}\n\t{{- end }}\n\t*{{ $short }} = *res\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.AfterInsert(ctx, {{ $short }})\n\t{{- end }}\n\n\treturn nil\n}\n{{- end }}\n\n// InsertAll{{ pluralize .Name }} inserts rows into '{{ $table }}' in batches which are\n// committed separately to stay under YOMutationLimit. It returns the number of\n// rows written. If a batch fails, the preceding batches are already committed\n// and the error describes the failed batch.\nfunc InsertAll{{ pluralize .Name }}(ctx context.Context, client *spanner.Client, rows []*{{ .Name }}) (int, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"InsertAll{{ pluralize .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"InsertAll{{ pluralize .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tms := {{ pluralize .Name }}Insert(ctx, rows)\n\n\t// an inserted row costs a mutation per column of the table and its indexes\n\tmutationsPerRow := len({{ .Name }}WritableColumns()) * (1 + {{ len .Indexes }})\n\n\t{{- if hooks }}\n\n\tn, err := yoApplyInBatches(ctx, client, \"InsertAll{{ pluralize .Name }}\", \"{{ $table }}\", ms, YOMutationLimit/mutationsPerRow)\n\tfor _, row := range rows[:n] {\n\t\t{{ .Name }}WriteHooks.AfterInsert(ctx, row)\n\t}\n\n\treturn n, err\n\t{{- else }}\n\n\treturn yoApplyInBatches(ctx, client, \"InsertAll{{ pluralize .Name }}\", \"{{ $table }}\", ms, YOMutationLimit/mutationsPerRow)\n\t{{- end }}\n}\n\n// {{ pluralize .Name }}Insert returns Mutations to insert the rows into\n// '{{ $table }}', one per row, so that they are applied together by a single\n// Apply or BufferWrite. Unlike InsertAll{{ pluralize .Name }}, the Mutations are\n// not split into batches.\nfunc {{ pluralize .Name }}Insert(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {\n\tms := make([]*spanner.Mutation, len(rows))\n\tfor i := range rows {\n\t\tms[i] = rows[i].Insert(ctx)\n\t}\n\treturn ms\n}\n{{- if .PrimaryKeyFields }}\n\n{{ if ne (fieldnames .Fields $short .PrimaryKeyFields) \"\" }}\n// Update returns a Mutation to update a row in a table. If the row does not\n// already exist, the write or transaction fails.\nfunc ({{ $short }} *{{ .Name }}) Update(ctx context.Context) *spanner.Mutation {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())\n\treturn spanner.Update(\"{{ $table }}\", {{ .Name }}WritableColumns(), values)\n}\n{{- if dml }}\n\n// UpdateDML returns a DML statement to update a row in a table, which is run\n// by the Update of a read-write transaction. No row is updated if the row does\n// not exist.\nfunc ({{ $short }} *{{ .Name }}) UpdateDML(ctx context.Context) spanner.Statement {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())\n\treturn yoUpdateDML(\"{{ escapedtable $table }}\", {{ .Name }}WritableColumns(), values, {{ .Name }}PrimaryKeys())\n}\n\n// RunUpdateDML updates the {{ .Name }} by UpdateDML in tx, and scans the row written back into\n// the {{ .Name }} by {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }}, so that the default values and the generated\n// columns are populated without a read. The commit timestamp columns are not\n// returned because they cannot be read in the transaction writing them. If the row does\n// not exist, an error is returned where errors.Is(err, ErrNotFound) is true. tx\n// is intended to be given by YORunInTransaction.\nfunc ({{ $short }} *{{ .Name }}) RunUpdateDML(ctx context.Context, tx *spanner.ReadWriteTransaction) error {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"RunUpdateDML\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"RunUpdateDML\", \"{{ $table }}\")()\n{{ end }}\n\tstmt := {{ $short }}.UpdateDML(ctx)\n\tstmt.SQL += \" {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }} {{ escapedcolnames .Fields $committs }}\"\n\n\tdefer yoLogQuery(ctx, \"RunUpdateDML\", stmt)()\n\trow, err := yoRunDML(ctx, tx, stmt)\n\tif err != nil {\n\t\tif err == iterator.Done {\n\t\t\treturn newNotFoundError(\"RunUpdateDML\", \"{{ $table }}\", {{ $short }}.primaryKey(), Err{{ .Name }}NotFound, nil)\n\t\t}\n\t\treturn newError(\"RunUpdateDML\", \"{{ $table }}\", err)\n\t}\n\n\tres, err := Scan{{ .Name }}(row)\n\tif err != nil {\n\t\treturn newErrorWithCode(codes.Internal, \"RunUpdateDML\", \"{{ $table }}\", err)\n\t}\n\t{{- range $committs }}\n\tres.{{ .Name }} = {{ $short }}.{{ .Name }}\n\t{{- end }}\n\t*{{ $short }} = *res\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.AfterUpdate(ctx, {{ $short }})\n\t{{- end }}\n\n\treturn nil\n}\n{{- if .VersionField }}\n{{- $version := .VersionField }}\n\n// UpdateWithVersionCheck updates the {{ .Name }} by a DML statement in tx only if\n// the version column '{{ colname $version.Col }}' still has the value of the {{ .Name }},\n// which is the one read, so that the changes by others since the read are\n// not overwritten. If the row is changed or deleted since the read, an error\n// is returned where errors.Is(err, ErrStaleWrite) is true. tx is intended to be\n// given by YORunInTransaction.\n{{- if eq $version.Type \"int64\" }}\n// The version of the {{ .Name }} is incremented by the update.\n{{- else }}\n// The version is written with the commit timestamp of tx, so that the\n// {{ .Name }} must be read again to be updated again.\n{{- end }}\nfunc ({{ $short }} *{{ .Name }}) UpdateWithVersionCheck(ctx context.Context, tx *spanner.ReadWriteTransaction) error {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"UpdateWithVersionCheck\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"UpdateWithVersionCheck\", \"{{ $table }}\")()\n{{ end }}\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})\n\t{{- end }}\n\tcols := {{ .Name }}WritableColumns()\n\tvalues, err := {{ $short }}.{{ $values }}(cols)\n\tif err != nil {\n\t\treturn newErrorWithCode(codes.InvalidArgument, \"UpdateWithVersionCheck\", \"{{ $table }}\", err)\n\t}\n\tfor i, col := range cols {\n\t\tif col == \"{{ colname $version.Col }}\" {\n\t\t\t{{- if eq $version.Type \"int64\" }}\n\t\t\tvalues[i] = {{ $short }}.{{ $version.Name }} + 1\n\t\t\t{{- else }}\n\t\t\tvalues[i] = spanner.CommitTimestamp\n\t\t\t{{- end }}\n\t\t}\n\t}\n\n\tstmt := yoUpdateDML(\"{{ escapedtable $table }}\", cols, values, {{ .Name }}PrimaryKeys())\n\tstmt.SQL += \" AND \" + yoQuoteIdentifier(\"{{ colname $version.Col }}\") + \" = \" + yoBindParam(stmt.Params, \"version\", {{ $short }}.{{ $version.Name }})\n\n\tdefer yoLogQuery(ctx, \"UpdateWithVersionCheck\", stmt)()\n\tn, err := tx.Update(ctx, stmt)\n\tif err != nil {\n\t\treturn newError(\"UpdateWithVersionCheck\", \"{{ $table }}\", err)\n\t}\n\tif n == 0 {\n\t\treturn newErrorWithCode(codes.FailedPrecondition, \"UpdateWithVersionCheck\", \"{{ $table }}\", ErrStaleWrite)\n\t}\n\t{{- if eq $version.Type \"int64\" }}\n\t{{ $short }}.{{ $version.Name }}++\n\t{{- end }}\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.AfterUpdate(ctx, {{ $short }})\n\t{{- end }}\n\n\treturn nil\n}\n{{- end }}\n{{- end }}\n\n// InsertOrUpdate returns a Mutation to insert a row into a table. If the row\n// already exists, it updates it instead. Any column values not explicitly\n// written are preserved.\nfunc ({{ $short }} *{{ .Name }}) InsertOrUpdate(ctx context.Context) *spanner.Mutation {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeInsert(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.{{ $values }}({{ .Name }}WritableColumns())\n\treturn spanner.InsertOrUpdate(\"{{ $table }}\", {{ .Name }}WritableColumns(), values)\n}\n\n// {{ pluralize .Name }}Update returns Mutations to update the rows in\n// '{{ $table }}', one per row, so that they are applied together by a single\n// Apply or BufferWrite.\nfunc {{ pluralize .Name }}Update(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {\n\tms := make([]*spanner.Mutation, len(rows))\n\tfor i := range rows {\n\t\tms[i] = rows[i].Update(ctx)\n\t}\n\treturn ms\n}\n\n// {{ pluralize .Name }}InsertOrUpdate returns Mutations to insert or update the\n// rows in '{{ $table }}', one per row, so that they are applied together by a\n// single Apply or BufferWrite.\nfunc {{ pluralize .Name }}InsertOrUpdate(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {\n\tms := make([]*spanner.Mutation, len(rows))\n\tfor i := range rows {\n\t\tms[i] = rows[i].InsertOrUpdate(ctx)\n\t}\n\treturn ms\n}\n{{- if batchwrite }}\n\n// MutationGroup returns a MutationGroup to insert or update the {{ .Name }} with\n// children, which are the mutations of the rows of the interleaved tables\n// under it, so that they are applied atomically by YOBatchWrite.\nfunc ({{ $short }} *{{ .Name }}) MutationGroup(ctx context.Context, children ...*spanner.Mutation) *spanner.MutationGroup {\n\tms := append([]*spanner.Mutation{ {{- $short }}.InsertOrUpdate(ctx)}, children...)\n\treturn &spanner.MutationGroup{Mutations: ms}\n}\n\n// BatchWrite{{ pluralize .Name }} inserts or updates rows in '{{ $table }}' by the\n// BatchWrite API, where each row is applied atomically but the rows are not\n// applied together. It returns the errors of the rows which failed by the\n// indexes of the rows. The other rows are applied unless the error is\n// returned.\nfunc BatchWrite{{ pluralize .Name }}(ctx context.Context, client *spanner.Client, rows []*{{ .Name }}) (map[int]error, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"BatchWrite{{ pluralize .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"BatchWrite{{ pluralize .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tgroups := make([]*spanner.MutationGroup, len(rows))\n\tfor i := range rows {\n\t\tgroups[i] = rows[i].MutationGroup(ctx)\n\t}\n\n\treturn yoBatchWrite(ctx, client, \"BatchWrite{{ pluralize .Name }}\", \"{{ $table }}\", groups)\n}\n{{- end }}\n\n// InsertOrUpdate{{ .Name }}AtLeastOnce inserts or updates row in '{{ $table }}' by\n// Apply with ApplyAtLeastOnce, which skips the read-write transaction for the\n// lower latency. The write may be applied more than once when it is retried,\n// which is fine since inserting or updating the same values is idempotent.\nfunc InsertOrUpdate{{ .Name }}AtLeastOnce(ctx context.Context, client *spanner.Client, row *{{ .Name }}) error {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"InsertOrUpdate{{ .Name }}AtLeastOnce\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"InsertOrUpdate{{ .Name }}AtLeastOnce\", \"{{ $table }}\")()\n{{ end }}\n\tms := []*spanner.Mutation{row.InsertOrUpdate(ctx)}\n\tif _, err := client.Apply(ctx, ms, spanner.ApplyAtLeastOnce()); err != nil {\n\t\treturn newError(\"InsertOrUpdate{{ .Name }}AtLeastOnce\", \"{{ $table }}\", err)\n\t}\n\n\treturn nil\n}\n\n// UpdateColumns returns a Mutation to update specified columns of a row in a table.\nfunc ({{ $short }} *{{ .Name }}) UpdateColumns(ctx context.Context, cols ...string) (*spanner.Mutation, error) {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeUpdate(ctx, {{ $short }})\n\t{{- end }}\n\t// add primary keys to columns to update by primary keys\n\tcolsWithPKeys := append(cols, {{ .Name }}PrimaryKeys()...)\n\n\tvalues, err := {{ $short }}.{{ $values }}(colsWithPKeys)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"{{ .Name }}.UpdateColumns\", \"{{ $table }}\", err)\n\t}\n\n\treturn spanner.Update(\"{{ $table }}\", colsWithPKeys, values), nil\n}\n\n// MutationForColumns returns a Mutation to update specified columns of a row\n// in a table. Unlike UpdateColumns, the columns are typed so that only columns\n// of '{{ $table }}' can be specified.\nfunc ({{ $short }} *{{ .Name }}) MutationForColumns(ctx context.Context, cols ...{{ .Name }}Column) (*spanner.Mutation, error) {\n\tnames := make([]string, len(cols))\n\tfor i, col := range cols {\n\t\tnames[i] = string(col)\n\t}\n\n\treturn {{ $short }}.UpdateColumns(ctx, names...)\n}\n\n// {{ .Name }}Tracker tracks the changes of a {{ .Name }}, so that Update writes\n// only the columns modified since the tracking started instead of the full\n// row, and does not overwrite the columns updated concurrently by others. The\n// values modified through pointers, such as of proto messages, are not\n// detected; assign new values instead.\ntype {{ .Name }}Tracker struct {\n\trow  *{{ .Name }}\n\torig {{ .Name }}\n}\n\n// Track{{ .Name }} returns a {{ .Name }}Tracker of row, which records the current\n// values of row, such as right after reading it.\nfunc Track{{ .Name }}(row *{{ .Name }}) *{{ .Name }}Tracker {\n\ttr := &{{ .Name }}Tracker{row: row}\n\ttr.Reset()\n\treturn tr\n}\n\n// Row returns the tracked {{ .Name }}.\nfunc (tr *{{ .Name }}Tracker) Row() *{{ .Name }} {\n\treturn tr.row\n}\n\n// Reset records the current values of the row, such as after the Mutation\n// of Update is applied.\nfunc (tr *{{ .Name }}Tracker) Reset() {\n\ttr.orig = *tr.row\n{{- range .Fields }}\n\t{{- if not (or .Col.IsPrimaryKey .Col.IsGenerated) }}\n\ttr.orig.{{ .Name }} = yoClone(tr.row.{{ .Name }})\n\t{{- end }}\n{{- end }}\n}\n\n// Changed returns the columns modified since the tracking started, in the\n// order of the columns of '{{ $table }}'. The primary key columns and the\n// generated columns are never included.\nfunc (tr *{{ .Name }}Tracker) Changed() []{{ .Name }}Column {\n\tvar cols []{{ .Name }}Column\n{{- range .Fields }}\n\t{{- if not (or .Col.IsPrimaryKey .Col.IsGenerated) }}\n\tif !reflect.DeepEqual(tr.orig.{{ .Name }}, tr.row.{{ .Name }}) {\n\t\tcols = append(cols, {{ $.Name }}Column{{ .Name }})\n\t}\n\t{{- end }}\n{{- end }}\n\treturn cols\n}\n\n// Update returns a Mutation to update the columns modified since the tracking\n// started. If no column is modified, the Mutation updates no column, but still\n// fails if the row does not exist.\nfunc (tr *{{ .Name }}Tracker) Update(ctx context.Context) (*spanner.Mutation, error) {\n\treturn tr.row.MutationForColumns(ctx, tr.Changed()...)\n}\n\n// Find{{ .Name }} gets a {{ .Name }} by primary key\nfunc Find{{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Find{{ .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Find{{ .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tkey := spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }\n\trow, err := yoReadRow(ctx, db, \"{{ $table }}\", key, {{ .Name }}Columns(), opts)\n\tif err != nil {\n\t\tif spanner.ErrCode(err) == codes.NotFound {\n\t\t\treturn nil, newNotFoundError(\"Find{{ .Name }}\", \"{{ $table }}\", key, Err{{ .Name }}NotFound, nil)\n\t\t}\n\t\treturn nil, newError(\"Find{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\t{{ $short }}, err := Scan{{ .Name }}(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Find{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\t{{- if $softdelete }}\n\tif {{ $short }}.IsDeleted() && !yoIncludesDeleted(ctx) {\n\t\treturn nil, newNotFoundError(\"Find{{ .Name }}\", \"{{ $table }}\", key, Err{{ .Name }}NotFound, errors.New(\"row is soft-deleted\"))\n\t}\n\t{{- end }}\n\n\treturn {{ $short }}, nil\n}\n{{- if $softdelete }}\n\n// Find{{ .Name }}IncludingDeleted gets a {{ .Name }} by primary key even if it is\n// soft-deleted.\nfunc Find{{ .Name }}IncludingDeleted(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {\n\treturn Find{{ .Name }}(YOIncludingDeleted(ctx), db{{ goparamlist .PrimaryKeyFields true false }}, opts...)\n}\n{{- end }}\n\n// {{ .Name }}Exists reports whether the row of the primary key exists in\n// '{{ $table }}', which reads only the primary key columns.\nfunc {{ .Name }}Exists(ctx context.Context, db YORODB{{ gocustomparamlist .PrimaryKeyFields true true }}, opts ...*spanner.ReadOptions) (bool, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ .Name }}Exists\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ .Name }}Exists\", \"{{ $table }}\")()\n{{ end }}\n\tkey := spanner.Key{ {{ gocustomparamlist .PrimaryKeyFields false false }} }\n\t{{- if $softdelete }}\n\trow, err := yoReadRow(ctx, db, \"{{ $table }}\", key, []string{\"{{ colname $softdelete.Col }}\"}, opts)\n\tif err != nil {\n\t\tif spanner.ErrCode(err) == codes.NotFound {\n\t\t\treturn false, nil\n\t\t}\n\t\treturn false, newError(\"{{ .Name }}Exists\", \"{{ $table }}\", err)\n\t}\n\n\tvar deletedAt spanner.NullTime\n\tif err := row.Column(0, &deletedAt); err != nil {\n\t\treturn false, newErrorWithCode(codes.Internal, \"{{ .Name }}Exists\", \"{{ $table }}\", err)\n\t}\n\n\treturn deletedAt.IsNull() || yoIncludesDeleted(ctx), nil\n\t{{- else }}\n\tif _, err := yoReadRow(ctx, db, \"{{ $table }}\", key, {{ .Name }}PrimaryKeys(), opts); err != nil {\n\t\tif spanner.ErrCode(err) == codes.NotFound {\n\t\t\treturn false, nil\n\t\t}\n\t\treturn false, newError(\"{{ .Name }}Exists\", \"{{ $table }}\", err)\n\t}\n\n\treturn true, nil\n\t{{- end }}\n}\n\n// Read{{ .Name }} retrieves multiples rows from {{ .Name }} by KeySet as a slice.\nfunc Read{{ .Name }}(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Read{{ .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Read{{ .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tvar res []*{{ .Name }}\n\n\t{{- if $softdelete }}\n\tincludeDeleted := yoIncludesDeleted(ctx)\n\t{{- end }}\n\trows := yoRead(ctx, db, \"{{ $table }}\", \"\", keys, {{ .Name }}Columns(), opts)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := Scan{{ .Name }}(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\t{{- if $softdelete }}\n\t\tif {{ $short }}.IsDeleted() && !includeDeleted {\n\t\t\treturn nil\n\t\t}\n\t\t{{- end }}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Read{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, nil\n}\n{{- if $softdelete }}\n\n// Read{{ .Name }}IncludingDeleted retrieves multiples rows from {{ .Name }} by KeySet\n// as a slice, including the soft-deleted rows.\nfunc Read{{ .Name }}IncludingDeleted(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {\n\treturn Read{{ .Name }}(YOIncludingDeleted(ctx), db, keys, opts...)\n}\n\n// IsDeleted reports whether the {{ .Name }} is soft-deleted, where\n// '{{ colname $softdelete.Col }}' is not NULL.\nfunc ({{ $short }} *{{ .Name }}) IsDeleted() bool {\n\treturn {{ if eq $softdelete.Type \"*time.Time\" }}{{ $short }}.{{ $softdelete.Name }} != nil{{ else }}!{{ $short }}.{{ $softdelete.Name }}.IsNull(){{ end }}\n}\n{{- end }}\n\n// {{ .Name }}PrimaryKey is the primary key of '{{ $table }}'.\ntype {{ .Name }}PrimaryKey struct {\n{{- range .PrimaryKeyFields }}\n\t{{ .Name }} {{ if .CustomType }}{{ retype .CustomType }}{{ else }}{{ .Type }}{{ end }}\n{{- end }}\n}\n\n// ToSpannerKey returns the spanner.Key of k, which is also a spanner.KeySet\n// of a row given to Read{{ .Name }}.\nfunc (k {{ .Name }}PrimaryKey) ToSpannerKey() spanner.Key {\n\treturn spanner.Key{ {{- fieldnames .PrimaryKeyFields \"k\" -}} }\n}\n\n// Parse{{ .Name }}PrimaryKey parses key of the columns of the primary key of\n// '{{ $table }}' in order, such as the key of a mutation.\nfunc Parse{{ .Name }}PrimaryKey(key spanner.Key) ({{ .Name }}PrimaryKey, error) {\n\tvar k {{ .Name }}PrimaryKey\n\tif len(key) != {{ len .PrimaryKeyFields }} {\n\t\treturn k, fmt.Errorf(\"key of '{{ $table }}' must have {{ len .PrimaryKeyFields }} columns, but got %d\", len(key))\n\t}\n{{- range $i, $f := .PrimaryKeyFields }}\n\tv{{ $i }}, ok := key[{{ $i }}].({{ $f.Type }})\n\tif !ok {\n\t\treturn k, fmt.Errorf(\"column '{{ colname $f.Col }}' of the key of '{{ $table }}' must be {{ $f.Type }}, but got %T\", key[{{ $i }}])\n\t}\n\tk.{{ $f.Name }} = {{ if $f.CustomType }}{{ customvalue $f (print \"v\" $i) }}{{ else }}v{{ $i }}{{ end }}\n{{- end }}\n\n\treturn k, nil\n}\n{{- if not (hasfield .Fields \"PrimaryKey\") }}\n\n// PrimaryKey returns the primary key of {{ $short }}.\nfunc ({{ $short }} *{{ .Name }}) PrimaryKey() {{ .Name }}PrimaryKey {\n\treturn {{ .Name }}PrimaryKey{\n{{- range .PrimaryKeyFields }}\n\t\t{{ .Name }}: {{ $short }}.{{ .Name }},\n{{- end }}\n\t}\n}\n{{- end }}\n\n// Find{{ .Name }}ByKey gets a {{ .Name }} by the primary key of key.\nfunc Find{{ .Name }}ByKey(ctx context.Context, db YORODB, key {{ .Name }}PrimaryKey, opts ...*spanner.ReadOptions) (*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Find{{ .Name }}ByKey\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Find{{ .Name }}ByKey\", \"{{ $table }}\")()\n{{ end }}\n\trow, err := yoReadRow(ctx, db, \"{{ $table }}\", key.ToSpannerKey(), {{ .Name }}Columns(), opts)\n\tif err != nil {\n\t\tif spanner.ErrCode(err) == codes.NotFound {\n\t\t\treturn nil, newNotFoundError(\"Find{{ .Name }}ByKey\", \"{{ $table }}\", key.ToSpannerKey(), Err{{ .Name }}NotFound, nil)\n\t\t}\n\t\treturn nil, newError(\"Find{{ .Name }}ByKey\", \"{{ $table }}\", err)\n\t}\n\n\t{{ $short }}, err := Scan{{ .Name }}(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Find{{ .Name }}ByKey\", \"{{ $table }}\", err)\n\t}\n\t{{- if $softdelete }}\n\tif {{ $short }}.IsDeleted() && !yoIncludesDeleted(ctx) {\n\t\treturn nil, newNotFoundError(\"Find{{ .Name }}ByKey\", \"{{ $table }}\", key.ToSpannerKey(), Err{{ .Name }}NotFound, errors.New(\"row is soft-deleted\"))\n\t}\n\t{{- end }}\n\n\treturn {{ $short }}, nil\n}\n\n{{- if $softdelete }}\n// Delete{{ .Name }}ByKey returns a Mutation to soft-delete the row of key from\n// '{{ $table }}' by setting '{{ colname $softdelete.Col }}'.\nfunc Delete{{ .Name }}ByKey(ctx context.Context, key {{ .Name }}PrimaryKey) *spanner.Mutation {\n\tcols := append({{ .Name }}PrimaryKeys(), \"{{ colname $softdelete.Col }}\")\n\tvalues := append([]interface{}(key.ToSpannerKey()), {{ $deletedvalue }})\n\treturn spanner.Update(\"{{ $table }}\", cols, values)\n}\n{{- else }}\n// Delete{{ .Name }}ByKey returns a Mutation to delete the row of key from\n// '{{ $table }}'.\nfunc Delete{{ .Name }}ByKey(ctx context.Context, key {{ .Name }}PrimaryKey) *spanner.Mutation {\n\treturn spanner.Delete(\"{{ $table }}\", key.ToSpannerKey())\n}\n{{- end }}\n\n// Find{{ pluralize .Name }}ByKeys retrieves the rows of keys from '{{ $table }}' by a\n// single read as a slice. The rows are in the order of keys, where the rows\n// which do not exist are skipped and the duplicate keys are read once.\nfunc Find{{ pluralize .Name }}ByKeys(ctx context.Context, db YORODB, keys []{{ .Name }}PrimaryKey, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Find{{ pluralize .Name }}ByKeys\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Find{{ pluralize .Name }}ByKeys\", \"{{ $table }}\")()\n{{ end }}\n\tif len(keys) == 0 {\n\t\treturn nil, nil\n\t}\n\n\tkeySets := make([]spanner.KeySet, len(keys))\n\tfor i, k := range keys {\n\t\tkeySets[i] = k.ToSpannerKey()\n\t}\n\n\t// the rows are read in the order of the primary key\n\tbyKey := make(map[string]*{{ .Name }}, len(keys))\n\t{{- if $softdelete }}\n\tincludeDeleted := yoIncludesDeleted(ctx)\n\t{{- end }}\n\trows := yoRead(ctx, db, \"{{ $table }}\", \"\", spanner.KeySets(keySets...), {{ .Name }}Columns(), opts)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := Scan{{ .Name }}(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\t{{- if $softdelete }}\n\t\tif {{ $short }}.IsDeleted() && !includeDeleted {\n\t\t\treturn nil\n\t\t}\n\t\t{{- end }}\n\t\tbyKey[{{ $short }}.primaryKey().String()] = {{ $short }}\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"Find{{ pluralize .Name }}ByKeys\", \"{{ $table }}\", err)\n\t}\n\n\tres := make([]*{{ .Name }}, 0, len(byKey))\n\tfor _, k := range keys {\n\t\ts := k.ToSpannerKey().String()\n\t\tif {{ $short }}, ok := byKey[s]; ok {\n\t\t\tres = append(res, {{ $short }})\n\t\t\tdelete(byKey, s)\n\t\t}\n\t}\n\n\treturn res, nil\n}\n{{- if .PageFields }}\n{{- $func := print \"List\" (pluralize .Name) \"Page\" }}\n\n// {{ $func }} retrieves a page of at most pageSize rows from '{{ $table }}'\n// ordered by the primary key. The page starts after the row of pageToken, or at\n// the first row if pageToken is empty. The returned token reads the next page,\n// and is empty at the last page. The Limit of opts is ignored.\nfunc {{ $func }}(ctx context.Context, db YORODB, pageSize int, pageToken string, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, string, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ $func }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ $func }}\", \"{{ $table }}\")()\n{{ end }}\n\tif pageSize <= 0 {\n\t\treturn nil, \"\", newErrorWithCode(codes.InvalidArgument, \"{{ $func }}\", \"{{ $table }}\", fmt.Errorf(\"invalid page size %d\", pageSize))\n\t}\n\n\tvar key []interface{}\n\tif pageToken != \"\" {\n\t\t{{- range $i, $f := .PageFields }}\n\t\tvar k{{ $i }} {{ if $f.Field.CustomType }}{{ retype $f.Field.CustomType }}{{ else }}{{ $f.Field.Type }}{{ end }}\n\t\t{{- end }}\n\t\tif err := yoDecodePageToken(pageToken{{ range $i, $f := .PageFields }}, &k{{ $i }}{{ end }}); err != nil {\n\t\t\treturn nil, \"\", newErrorWithCode(codes.InvalidArgument, \"{{ $func }}\", \"{{ $table }}\", err)\n\t\t}\n\t\tkey = []interface{}{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ if $f.Field.CustomType }}{{ spanvalue $f.Field (print \"k\" $i) }}{{ else }}k{{ $i }}{{ end }}{{ end -}} }\n\t}\n\tstmt := yoPageStatement(\"SELECT {{ escapedcolnames .Fields }} FROM {{ escapedtable $table }}\",\n\t\t[]string{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}\"{{ escapedcolname $f.Field.Col }}\"{{ end -}} },\n\t\t[]bool{ {{- range $i, $f := .PageFields }}{{ if $i }}, {{ end }}{{ $f.Desc }}{{ end -}} },\n\t\tkey, pageSize)\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"{{ $func }}\", stmt)()\n\tres, err := Scan{{ pluralize .Name }}(yoQuery(ctx, db, stmt, yoWithoutLimit(opts)))\n\tif err != nil {\n\t\treturn nil, \"\", newError(\"{{ $func }}\", \"{{ $table }}\", err)\n\t}\n\tif len(res) < pageSize {\n\t\treturn res, \"\", nil\n\t}\n\n\tlast := res[len(res)-1]\n\ttoken, err := yoEncodePageToken({{ range $i, $f := .PageFields }}{{ if $i }}, {{ end }}last.{{ $f.Field.Name }}{{ end }})\n\tif err != nil {\n\t\treturn nil, \"\", newErrorWithCode(codes.Internal, \"{{ $func }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, token, nil\n}\n{{- end }}\n{{- range (keyprefixes .PrimaryKeyFields) }}\n{{- $funcName := print \"Read\" $.Name \"By\" }}\n{{- range . }}{{ $funcName = print $funcName .Name }}{{ end }}\n\n// {{ $funcName }} retrieves multiples rows from {{ $.Name }} whose primary key\n// starts with the given key columns as a slice.\nfunc {{ $funcName }}(ctx context.Context, db YORODB{{ gocustomparamlist . true true }}, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ $funcName }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ $funcName }}\", \"{{ $table }}\")()\n{{ end }}\n\tvar res []*{{ $.Name }}\n\n\tkeys := spanner.Key{ {{ gocustomparamlist . false false }} }.AsPrefix()\n\trows := yoRead(ctx, db, \"{{ $table }}\", \"\", keys, {{ $.Name }}Columns(), opts)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := Scan{{ $.Name }}(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ $funcName }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, nil\n}\n{{- end }}\n{{- range (keyranges $) }}\n{{- $col := .Column.Field }}\n{{- $typ := retype $col.Type }}{{ if $col.CustomType }}{{ $typ = retype $col.CustomType }}{{ end }}\n\n// {{ .Name }} retrieves multiple rows from {{ $.Name }} whose\n// '{{ colname $col.Col }}' is between start and end as a slice.\n{{- if .Prefix }}\n// The rows are limited to the ones whose primary key starts with the given\n// key columns.\n{{- end }}\n// kind tells whether the bounds are included, such as spanner.ClosedOpen.\n{{- if .Column.Desc }}\n// The rows are in the descending order of '{{ colname $col.Col }}', so that\n// start must not be less than end.\n{{- end }}\nfunc {{ .Name }}(ctx context.Context, db YORODB{{ gocustomparamlist .Prefix true true }}, start, end {{ $typ }}, kind spanner.KeyRangeKind, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tvar res []*{{ $.Name }}\n\n\tkeys := spanner.KeyRange{\n\t\tStart: spanner.Key{ {{- gocustomparamlist .Prefix false false }}{{ if .Prefix }}, {{ end }}{{ if $col.CustomType }}{{ spanvalue $col \"start\" }}{{ else }}start{{ end -}} },\n\t\tEnd:   spanner.Key{ {{- gocustomparamlist .Prefix false false }}{{ if .Prefix }}, {{ end }}{{ if $col.CustomType }}{{ spanvalue $col \"end\" }}{{ else }}end{{ end -}} },\n\t\tKind:  kind,\n\t}\n\trows := yoRead(ctx, db, \"{{ $table }}\", \"\", keys, {{ $.Name }}Columns(), opts)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := Scan{{ $.Name }}(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, nil\n}\n{{- end }}\n{{ end }}\n\n// primaryKey returns the key of the {{ .Name }}, whose values are in the order\n// of the primary key columns. The keys of the interleaved tables begin with the\n// keys of their parents.\nfunc ({{ $short }} *{{ .Name }}) primaryKey() spanner.Key {\n\treturn spanner.Key{ {{- fieldnames .PrimaryKeyFields $short -}} }\n}\n\n{{ if $softdelete -}}\n// Delete soft-deletes the {{ .Name }} by setting '{{ colname $softdelete.Col }}', so that the\n// generated reads filter it out by default.\nfunc ({{ $short }} *{{ .Name }}) Delete(ctx context.Context) *spanner.Mutation {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})\n\t{{- end }}\n\tcols := append({{ .Name }}PrimaryKeys(), \"{{ colname $softdelete.Col }}\")\n\tvalues, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())\n\tvalues = append(values, {{ $deletedvalue }})\n\treturn spanner.Update(\"{{ $table }}\", cols, values)\n}\n{{- if dml }}\n\n// DeleteDML returns a DML statement to soft-delete the {{ .Name }} by setting\n// '{{ colname $softdelete.Col }}', which is run by the Update of a read-write transaction.\nfunc ({{ $short }} *{{ .Name }}) DeleteDML(ctx context.Context) spanner.Statement {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})\n\t{{- end }}\n\tcols := append({{ .Name }}PrimaryKeys(), \"{{ colname $softdelete.Col }}\")\n\tvalues, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())\n\tvalues = append(values, {{ $deletedvalue }})\n\treturn yoUpdateDML(\"{{ escapedtable $table }}\", cols, values, {{ .Name }}PrimaryKeys())\n}\n{{- end }}\n\n{{ end -}}\n// {{ $delete }} deletes the {{ .Name }} from the database.\nfunc ({{ $short }} *{{ .Name }}) {{ $delete }}(ctx context.Context) *spanner.Mutation {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})\n\t{{- end }}\n\treturn spanner.Delete(\"{{ $table }}\", {{ $short }}.primaryKey())\n}\n{{- if dml }}\n\n// {{ $delete }}DML returns a DML statement to delete the {{ .Name }}, which is run by\n// the Update of a read-write transaction.\nfunc ({{ $short }} *{{ .Name }}) {{ $delete }}DML(ctx context.Context) spanner.Statement {\n\t{{- if hooks }}\n\t{{ .Name }}WriteHooks.BeforeDelete(ctx, {{ $short }})\n\t{{- end }}\n\tvalues, _ := {{ $short }}.columnsToValues({{ .Name }}PrimaryKeys())\n\treturn yoDeleteDML(\"{{ escapedtable $table }}\", {{ .Name }}PrimaryKeys(), values)\n}\n{{- end }}\n\n// {{ pluralize .Name }}Delete returns Mutations to delete the rows from\n// '{{ $table }}', one per row, so that they are applied together by a single\n// Apply or BufferWrite.\nfunc {{ pluralize .Name }}Delete(ctx context.Context, rows []*{{ .Name }}) []*spanner.Mutation {\n\tms := make([]*spanner.Mutation, len(rows))\n\tfor i := range rows {\n\t\tms[i] = rows[i].Delete(ctx)\n\t}\n\treturn ms\n}\n{{- if .InterleavedTables }}\n\n// DeleteKeyRange deletes the {{ .Name }} by the key range of its primary key.\n// If includeChildren is true, the rows of the interleaved tables under the\n// {{ .Name }} are deleted explicitly as well.\nfunc ({{ $short }} *{{ .Name }}) DeleteKeyRange(ctx context.Context, includeChildren bool) []*spanner.Mutation {\n\tkey := {{ $short }}.primaryKey()\n\tkr := spanner.KeyRange{\n\t\tStart: key,\n\t\tEnd:   key,\n\t\tKind:  spanner.ClosedClosed,\n\t}\n\n\tvar ms []*spanner.Mutation\n\tif includeChildren {\n\t\tms = append(ms,\n{{- range .InterleavedTables }}\n\t\t\tspanner.Delete(\"{{ . }}\", kr),\n{{- end }}\n\t\t)\n\t}\n\treturn append(ms, spanner.Delete(\"{{ $table }}\", kr))\n}\n{{- end }}\n{{- if .Parent }}\n{{- $parent := .Parent.Name }}\n{{- $pshort := (shortname $parent \"err\" \"res\" \"sqlstr\" \"db\" \"YOLog\") }}\n\n// {{ pluralize .Name }}KeyRange returns the key range of the rows of\n// '{{ $table }}' interleaved in the {{ $parent }}.\nfunc ({{ $pshort }} *{{ $parent }}) {{ pluralize .Name }}KeyRange() spanner.KeyRange {\n\treturn {{ $pshort }}.primaryKey().AsPrefix()\n}\n\n// List{{ pluralize .Name }} retrieves the rows of '{{ $table }}' interleaved in\n// the {{ $parent }} as a slice.\nfunc ({{ $pshort }} *{{ $parent }}) List{{ pluralize .Name }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"List{{ pluralize .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"List{{ pluralize .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tvar res []*{{ .Name }}\n\n\trows := yoRead(ctx, db, \"{{ $table }}\", \"\", {{ $pshort }}.{{ pluralize .Name }}KeyRange(), {{ .Name }}Columns(), opts)\n\terr := rows.Do(func(row *spanner.Row) error {\n\t\t{{ $short }}, err := Scan{{ .Name }}(row)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n\t\tres = append(res, {{ $short }})\n\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"List{{ pluralize .Name }}\", \"{{ $table }}\", err)\n\t}\n\n\treturn res, nil\n}\n{{- end }}\n{{- end }}\n{{- end }}\n{{- range .ForeignKeys }}\n{{- $ref := .RefType.Name }}\n{{- $reftable := .RefType.Table.TableName }}\n{{- $rshort := (shortname $ref \"err\" \"res\" \"sqlstr\" \"db\" \"YOLog\") }}\n{{- $origin := print \"foreign key (\" (colnames .Fields) \")\" }}\n{{- if .ForeignKey.ConstraintName }}{{ $origin = print \"foreign key '\" .ForeignKey.ConstraintName \"'\" }}{{ end }}\n\n// {{ .FetchName }} retrieves the row of '{{ $reftable }}' referenced by the\n// {{ $.Name }} as a {{ $ref }}.\n//\n// If no row is present, including when the referencing columns are NULL, then\n// an error is returned where errors.Is(err, ErrNotFound) is true.\n//\n// Generated from {{ $origin }}.\nfunc ({{ $short }} *{{ $.Name }}) {{ .FetchName }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) (*{{ $ref }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ .FetchName }}\", \"{{ $reftable }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ .FetchName }}\", \"{{ $reftable }}\")()\n{{ end }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .RefType.Fields }} \" +\n\t\t\"FROM {{ escapedtable $reftable }} \" +\n\t\t\"WHERE {{ colnamesquery .RefFields \" AND \" }}\"\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .Fields }}\n\t{{- if $f.CustomType }}\n\tstmt.Params[\"{{ paramname $i }}\"] = {{ spanvalue $f (print $short \".\" $f.Name) }}\n\t{{- else }}\n\tstmt.Params[\"{{ paramname $i }}\"] = {{ $short }}.{{ $f.Name }}\n\t{{- end }}\n\t{{- end }}\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"{{ .FetchName }}\", stmt{{ range .Fields }}, {{ $short }}.{{ .Name }}{{ end }})()\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\trow, err := iter.Next()\n\tif err != nil {\n\t\tif err == iterator.Done {\n\t\t\treturn nil, newNotFoundError(\"{{ .FetchName }}\", \"{{ $reftable }}\", spanner.Key{ {{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}{{ $short }}.{{ $f.Name }}{{ end -}} }, Err{{ $ref }}NotFound, nil)\n\t\t}\n\t\treturn nil, newError(\"{{ .FetchName }}\", \"{{ $reftable }}\", err)\n\t}\n\n\tres, err := Scan{{ $ref }}(row)\n\tif err != nil {\n\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ .FetchName }}\", \"{{ $reftable }}\", err)\n\t}\n\n\treturn res, nil\n}\n\n// {{ .ListName }} retrieves the rows of '{{ $table }}' referencing the\n// {{ $ref }} as a slice.\n//\n// Generated from {{ $origin }}.\nfunc ({{ $rshort }} *{{ $ref }}) {{ .ListName }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"{{ .ListName }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"{{ .ListName }}\", \"{{ $table }}\")()\n{{ end }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames $.Fields }} \" +\n\t\t\"FROM {{ escapedtable $table }} \" +\n\t\t\"WHERE {{ colnamesquery .Fields \" AND \" }}\"\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .RefFields }}\n\t{{- if $f.CustomType }}\n\tstmt.Params[\"{{ paramname $i }}\"] = {{ spanvalue $f (print $rshort \".\" $f.Name) }}\n\t{{- else }}\n\tstmt.Params[\"{{ paramname $i }}\"] = {{ $rshort }}.{{ $f.Name }}\n\t{{- end }}\n\t{{- end }}\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"{{ .ListName }}\", stmt{{ range .RefFields }}, {{ $rshort }}.{{ .Name }}{{ end }})()\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\t// load results\n\tres := []*{{ $.Name }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"{{ .ListName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\t{{ $short }}, err := Scan{{ $.Name }}(row)\n\t\tif err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.Internal, \"{{ .ListName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tres = append(res, {{ $short }})\n\t}\n\n\treturn res, nil\n}\n{{- end }}\n{{- if or .Table.IsView (not .PrimaryKeyFields) }}\n\n// QueryRead{{ .Name }} retrieves all rows from '{{ $table }}' as a slice.\nfunc QueryRead{{ .Name }}(ctx context.Context, db YORODB, opts ...*spanner.ReadOptions) ([]*{{ .Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"QueryRead{{ .Name }}\", \"{{ $table }}\", \"\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"QueryRead{{ .Name }}\", \"{{ $table }}\")()\n{{ end }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames .Fields }} \" +\n\t\t\"FROM {{ escapedtable $table }}\"\n\n\tstmt := spanner.NewStatement(sqlstr)\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"QueryRead{{ .Name }}\", stmt)()\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\t// load results\n\tres := []*{{ .Name }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"QueryRead{{ .Name }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\t{{ $short }}, err := Scan{{ .Name }}(row)\n\t\tif err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.Internal, \"QueryRead{{ .Name }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tres = append(res, {{ $short }})\n\t}\n\n\treturn res, nil\n}\n{{- end }}\n{{- range .SearchIndexes }}\n\n// Search{{ .FuncName }} retrieves rows from '{{ $table }}' whose {{ .Column }} matches\n// the search query as a slice of {{ $.Name }}. The query is in the raw search\n// query syntax of SEARCH.\n{{- if .Score }}\n// The rows are ordered by the relevance to the query given by SCORE.\n{{- end }}\n//\n// Generated from search index '{{ .Index.IndexName }}'.\nfunc Search{{ .FuncName }}(ctx context.Context, db YORODB{{ gocustomparamlist .PartitionFields true true }}, query string, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Search{{ .FuncName }}\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Search{{ .FuncName }}\", \"{{ $table }}\")()\n{{ end }}\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames $.Fields }} \" +\n\t\t\"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} \" +\n\t\t\"WHERE {{ if .PartitionFields }}{{ colnamesquery .PartitionFields \" AND \" }} AND {{ end }}SEARCH({{ .Column }}, @query)\"{{ if .Score }} +\n\t\t\" ORDER BY SCORE({{ .Column }}, @query) DESC\"{{ end }}\n\n\tstmt := spanner.NewStatement(sqlstr)\n\t{{- range $i, $f := .PartitionFields }}\n\t\t{{- if $f.CustomType }}\n\tstmt.Params[\"{{ paramname $i }}\"] = {{ spanvalue $f (goparamname $f.Name) }}\n\t\t{{- else }}\n\tstmt.Params[\"{{ paramname $i }}\"] = {{ goparamname $f.Name }}\n\t\t{{- end }}\n\t{{- end }}\n\tstmt.Params[\"query\"] = query\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"Search{{ .FuncName }}\", stmt{{ goparamlist .PartitionFields true false }}, query)()\n\titer := yoQuery(ctx, db, stmt, opts)\n\tdefer iter.Stop()\n\n\t// load results\n\tres := []*{{ $.Name }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"Search{{ .FuncName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\t{{ $short }}, err := Scan{{ $.Name }}(row)\n\t\tif err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.Internal, \"Search{{ .FuncName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tres = append(res, {{ $short }})\n\t}\n\n\treturn res, nil\n}\n{{- end }}\n{{- range .VectorIndexes }}\n\n// Nearest{{ .FuncName }} retrieves the k rows from '{{ $table }}' whose {{ .Index.ColumnName }}\n// is approximately nearest to vector by {{ .DistanceFunc }} as a slice of\n// {{ $.Name }}, nearest first. The Limit of opts is ignored.\n//\n// Generated from vector index '{{ .Index.IndexName }}'.\nfunc Nearest{{ .FuncName }}(ctx context.Context, db YORODB, vector {{ .VectorType }}, k int, opts ...*spanner.ReadOptions) ([]*{{ $.Name }}, error) {\n\t{{- if otel }}\n\tctx, span := yoStartSpan(ctx, \"Nearest{{ .FuncName }}\", \"{{ $table }}\", \"{{ .Index.IndexName }}\")\n\tdefer span.End()\n{{ end }}\n\t{{- if metrics }}\n\tdefer yoObserve(\"Nearest{{ .FuncName }}\", \"{{ $table }}\")()\n{{ end }}\n\t{{- if .Field.Col.VectorLength }}\n\tif len(vector) != {{ .Field.Col.VectorLength }} {\n\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"Nearest{{ .FuncName }}\", \"{{ $table }}\", fmt.Errorf(\"vector length %d, must be {{ .Field.Col.VectorLength }}\", len(vector)))\n\t}\n\t{{- end }}\n\tif k <= 0 {\n\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"Nearest{{ .FuncName }}\", \"{{ $table }}\", fmt.Errorf(\"k %d, must be positive\", k))\n\t}\n\n\tconst sqlstr = \"SELECT \" +\n\t\t\"{{ escapedcolnames $.Fields }} \" +\n\t\t\"FROM {{ escapedtable $table }}{{ indexhint .Index.IndexName }} \" +\n\t\t{{- if not .Field.Col.NotNull }}\n\t\t\"WHERE {{ .Index.ColumnName }} IS NOT NULL \" +\n\t\t{{- end }}\n\t\t\"ORDER BY {{ .DistanceFunc }}({{ .Index.ColumnName }}, @vector, options => JSON '{\\\"num_leaves_to_search\\\": {{ .NumLeavesToSearch }}}'){{ if .Desc }} DESC{{ end }}\"\n\n\tstmt := spanner.NewStatement(sqlstr)\n\tstmt.Params[\"vector\"] = vector\n\n\t// the rows are limited to k by LIMIT following ORDER BY\n\to := &spanner.ReadOptions{Limit: k}\n\tif ro := yoReadOptions(opts); ro != nil {\n\t\to.Priority, o.RequestTag = ro.Priority, ro.RequestTag\n\t}\n\n\t// run query\n\tdefer yoLogQuery(ctx, \"Nearest{{ .FuncName }}\", stmt, vector, k)()\n\titer := yoQuery(ctx, db, stmt, []*spanner.ReadOptions{o})\n\tdefer iter.Stop()\n\n\t// load results\n\tres := []*{{ $.Name }}{}\n\tfor {\n\t\trow, err := iter.Next()\n\t\tif err != nil {\n\t\t\tif err == iterator.Done {\n\t\t\t\tbreak\n\t\t\t}\n\t\t\treturn nil, newError(\"Nearest{{ .FuncName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\t{{ $short }}, err := Scan{{ $.Name }}(row)\n\t\tif err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.Internal, \"Nearest{{ .FuncName }}\", \"{{ $table }}\", err)\n\t\t}\n\n\t\tres = append(res, {{ $short }})\n\t}\n\n\treturn res, nil\n}\n{{- end }}\n{{- if .ChangeStreams }}\n\n// {{ .Name }}Change is a change of a row of '{{ $table }}' in a data change\n// record of a change stream. Keys has only the primary key. NewValues and\n// OldValues have the primary key and the columns captured by the value capture\n// type of the change stream, and they are nil if no value is captured.\ntype {{ .Name }}Change struct {\n\tModType         string\n\tCommitTimestamp time.Time\n\tKeys            *{{ .Name }}\n\tNewValues       *{{ .Name }}\n\tOldValues       *{{ .Name }}\n}\n\n// changeTypes{{ .Name }} is the Spanner types of the columns of '{{ $table }}',\n// which decode the values in the data change records.\nvar changeTypes{{ .Name }} = map[string]string{\n{{- range .Fields }}\n\t\"{{ .Col.ColumnName }}\": \"{{ .Col.DataType }}\",\n{{- end }}\n}\n\n// Decode{{ .Name }}Changes decodes the changes of the rows in the data change\n// record of '{{ $table }}'. It returns an error if the record is of another\n// table.\nfunc Decode{{ .Name }}Changes(rec *YODataChangeRecord) ([]*{{ .Name }}Change, error) {\n\tif rec.TableName != \"{{ $table }}\" {\n\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"Decode{{ .Name }}Changes\", \"{{ $table }}\", fmt.Errorf(\"data change record of table %s\", rec.TableName))\n\t}\n\n\tres := make([]*{{ .Name }}Change, 0, len(rec.Mods))\n\tfor _, mod := range rec.Mods {\n\t\tchange := &{{ .Name }}Change{ModType: rec.ModType, CommitTimestamp: rec.CommitTimestamp}\n\n\t\tvar err error\n\t\tif change.Keys, err = decode{{ .Name }}Change(mod.Keys, nil); err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"Decode{{ .Name }}Changes\", \"{{ $table }}\", err)\n\t\t}\n\t\tif change.NewValues, err = decode{{ .Name }}Change(mod.NewValues, mod.Keys); err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"Decode{{ .Name }}Changes\", \"{{ $table }}\", err)\n\t\t}\n\t\tif change.OldValues, err = decode{{ .Name }}Change(mod.OldValues, mod.Keys); err != nil {\n\t\t\treturn nil, newErrorWithCode(codes.InvalidArgument, \"Decode{{ .Name }}Changes\", \"{{ $table }}\", err)\n\t\t}\n\t\tres = append(res, change)\n\t}\n\n\treturn res, nil\n}\n\n// decode{{ .Name }}Change decodes the column values of a mod merged with the\n// keys. It returns nil if no value is captured.\nfunc decode{{ .Name }}Change(values, keys json.RawMessage) (*{{ .Name }}, error) {\n\trow, err := yoChangeRow(changeTypes{{ .Name }}, values, keys)\n\tif err != nil || row == nil {\n\t\treturn nil, err\n\t}\n\n\treturn Scan{{ .Name }}(row)\n}\n{{- end }}\n{{- if and (not .Table.IsView) .PrimaryKeyFields (ne (fieldnames .Fields $short .PrimaryKeyFields) \"\") }}\n\n// {{ .Name }}Descriptor describes '{{ $table }}' for YORepository.\nvar {{ .Name }}Descriptor = &YODescriptor[{{ .Name }}, {{ .Name }}PrimaryKey]{\n\tTable:       \"{{ $table }}\",\n\tColumns:     {{ .Name }}Columns(),\n\tPrimaryKeys: {{ .Name }}PrimaryKeys(),\n\tKey: func(row *{{ .Name }}) {{ .Name }}PrimaryKey {\n\t\treturn {{ .Name }}PrimaryKey{\n{{- range .PrimaryKeyFields }}\n\t\t\t{{ .Name }}: row.{{ .Name }},\n{{- end }}\n\t\t}\n\t},\n\tSpannerKey: {{ .Name }}PrimaryKey.ToSpannerKey,\n\tScan:       Scan{{ .Name }},\n\tFind:       Find{{ .Name }}ByKey,\n\tRead:       Read{{ .Name }},\n\tInsert: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {\n\t\treturn row.Insert(ctx)\n\t},\n\tUpdate: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {\n\t\treturn row.Update(ctx)\n\t},\n\tInsertOrUpdate: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {\n\t\treturn row.InsertOrUpdate(ctx)\n\t},\n\tDelete: func(ctx context.Context, row *{{ .Name }}) *spanner.Mutation {\n\t\treturn row.Delete(ctx)\n\t},\n}\n\n// New{{ .Name }}Repository returns a YORepository of '{{ $table }}' by client.\nfunc New{{ .Name }}Repository(client *spanner.Client) *YORepository[{{ .Name }}, {{ .Name }}PrimaryKey] {\n\treturn NewYORepository(client, {{ .Name }}Descriptor)\n}\n{{- end }}\n{{- if and stores (not .Table.IsView) .PrimaryKeyFields (ne (fieldnames .Fields $short .PrimaryKeyFields) \"\") }}\n\n// {{ .Name }}Store is the reads and writes of the rows of '{{ $table }}', which\n// are implemented by {{ .Name }}ClientStore. Services can depend on it instead of\n// the generated functions to mock it in their tests.\ntype {{ .Name }}Store interface {\n\t// Find gets a {{ .Name }} by primary key.\n\tFind(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (*{{ .Name }}, error)\n\n\t// Read retrieves multiple rows by keys.\n\tRead(ctx context.Context, keys spanner.KeySet) ([]*{{ .Name }}, error)\n\n\t// Exists reports whether the row of the primary key exists.\n\tExists(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (bool, error)\n{{- range .Indexes }}\n\n\t// Find{{ .FuncName }} is Find{{ .FuncName }} of index '{{ .Index.IndexName }}'.\n\t{{- if .Index.IsUnique }}\n\tFind{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) (*{{ $.Name }}, error)\n\t{{- else }}\n\tFind{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) ([]*{{ $.Name }}, error)\n\t{{- end }}\n{{- end }}\n\n\t// Insert inserts row.\n\tInsert(ctx context.Context, row *{{ .Name }}) error\n\n\t// Update updates row.\n\tUpdate(ctx context.Context, row *{{ .Name }}) error\n\n\t// InsertOrUpdate inserts row, or updates it if it already exists.\n\tInsertOrUpdate(ctx context.Context, row *{{ .Name }}) error\n\n\t// Delete deletes row.\n\tDelete(ctx context.Context, row *{{ .Name }}) error\n}\n\n// {{ .Name }}ClientStore is {{ .Name }}Store by a Spanner client. The reads are\n// strong reads by single-use read-only transactions, and the writes are\n// applied by single mutations.\ntype {{ .Name }}ClientStore struct {\n\tclient *spanner.Client\n}\n\nvar _ {{ .Name }}Store = (*{{ .Name }}ClientStore)(nil)\n\n// New{{ .Name }}Store returns {{ .Name }}ClientStore by client.\nfunc New{{ .Name }}Store(client *spanner.Client) *{{ .Name }}ClientStore {\n\treturn &{{ .Name }}ClientStore{client: client}\n}\n\nfunc (store *{{ .Name }}ClientStore) Find(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (*{{ .Name }}, error) {\n\treturn Find{{ .Name }}(ctx, store.client.Single(){{ goparamlist .PrimaryKeyFields true false }})\n}\n\nfunc (store *{{ .Name }}ClientStore) Read(ctx context.Context, keys spanner.KeySet) ([]*{{ .Name }}, error) {\n\treturn Read{{ .Name }}(ctx, store.client.Single(), keys)\n}\n\nfunc (store *{{ .Name }}ClientStore) Exists(ctx context.Context{{ gocustomparamlist .PrimaryKeyFields true true }}) (bool, error) {\n\treturn {{ .Name }}Exists(ctx, store.client.Single(){{ goparamlist .PrimaryKeyFields true false }})\n}\n{{- range .Indexes }}\n\n{{- if .Index.IsUnique }}\n\nfunc (store *{{ $.Name }}ClientStore) Find{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) (*{{ $.Name }}, error) {\n{{- else }}\n\nfunc (store *{{ $.Name }}ClientStore) Find{{ .FuncName }}(ctx context.Context{{ gocustomparamlist .Fields true true }}) ([]*{{ $.Name }}, error) {\n{{- end }}\n\treturn Find{{ .FuncName }}(ctx, store.client.Single(){{ goparamlist .Fields true false }})\n}\n{{- end }}\n\nfunc (store *{{ .Name }}ClientStore) Insert(ctx context.Context, row *{{ .Name }}) error {\n\treturn store.apply(ctx, \"Insert\", row.Insert(ctx))\n}\n\nfunc (store *{{ .Name }}ClientStore) Update(ctx context.Context, row *{{ .Name }}) error {\n\treturn store.apply(ctx, \"Update\", row.Update(ctx))\n}\n\nfunc (store *{{ .Name }}ClientStore) InsertOrUpdate(ctx context.Context, row *{{ .Name }}) error {\n\treturn store.apply(ctx, \"InsertOrUpdate\", row.InsertOrUpdate(ctx))\n}\n\nfunc (store *{{ .Name }}ClientStore) Delete(ctx context.Context, row *{{ .Name }}) error {\n\treturn store.apply(ctx, \"Delete\", row.Delete(ctx))\n}\n\n// apply applies m by the client, and returns the error of method if any.\nfunc (store *{{ .Name }}ClientStore) apply(ctx context.Context, method string, m *spanner.Mutation) error {\n\tif _, err := store.client.Apply(ctx, []*spanner.Mutation{m}); err != nil {\n\t\treturn newError(method, \"{{ $table }}\", err)\n\t}\n\n\treturn nil\n}\n{{- end }}\n"
+var _Assets3f01ada0d180c86a27ca7d58872b447c5cf4d063 = "{{- if and (not .Table.IsView) .PrimaryKeyFields -}}\n{{- $short := (shortname .Name \"err\" \"res\" \"sqlstr\" \"db\" \"YOLog\" \"t\" \"client\" \"ctx\" \"ms\" \"dels\" \"key\" \"row\" \"read\" \"cols\" \"want\" \"got\" \"i\" \"col\" \"p\" \"values\") -}}\n{{- $table := (.Table.TableName) -}}\n{{- $committs := (committsfields .Fields) }}\n{{- $identity := false }}{{ range .Fields }}{{ if or .Col.IsIdentity .Col.Sequence }}{{ $identity = true }}{{ end }}{{ end -}}\n// testValue{{ .Name }} returns a {{ .Name }} whose columns are filled by\n// non-null values except the generated columns and the custom types.\nfunc testValue{{ .Name }}() *{{ .Name }} {\n\treturn &{{ .Name }}{\n{{- range .Fields }}\n{{- $value := testvalue . }}\n{{- if $value }}\n\t\t{{ .Name }}: {{ $value }},\n{{- end }}\n{{- end }}\n\t}\n}\n{{ if not (orphan .) }}\n// testRoundTrip{{ .Name }} inserts {{ $short }} into '{{ $table }}' by its Insert mutation\n// and asserts that the columns are read back as they are written. The rows\n// are deleted at the end of the test. It can be called by fuzz tests with\n// arbitrary values.\n{{- if ancestors . }}\n//\n// The rows of the tables which '{{ $table }}' is interleaved in are inserted\n// by the test values with the primary key of {{ $short }}.\n{{- end }}\nfunc testRoundTrip{{ .Name }}(t *testing.T, client *spanner.Client, {{ $short }} *{{ .Name }}) {\n\tt.Helper()\n\tctx := context.Background()\n\n\t// dels deletes the interleaved rows before their parents\n\tvar ms, dels []*spanner.Mutation\n{{- range ancestors . }}\n\t{\n\t\tp := testValue{{ .Name }}()\n{{- range .PrimaryKeyFields }}\n\t\tp.{{ .Name }} = {{ $short }}.{{ .Name }}\n{{- end }}\n\t\tvalues, _ := p.columnsToValues({{ .Name }}WritableColumns())\n\t\tms = append(ms, spanner.Insert(\"{{ .Table.TableName }}\", {{ .Name }}WritableColumns(), values))\n\t\tdels = append([]*spanner.Mutation{p.Delete(ctx)}, dels...)\n\t}\n{{- end }}\n\tms = append(ms, {{ $short }}.Insert{{ if $identity }}WithID{{ end }}(ctx))\n\tdels = append([]*spanner.Mutation{ {{- $short }}.Delete(ctx)}, dels...)\n\tif _, err := client.Apply(ctx, ms); err != nil {\n\t\tt.Fatalf(\"failed to insert into '{{ $table }}': %v\", err)\n\t}\n\tt.Cleanup(func() {\n\t\tif _, err := client.Apply(ctx, dels); err != nil {\n\t\t\tt.Errorf(\"failed to delete from '{{ $table }}': %v\", err)\n\t\t}\n\t})\n\n\tkey := {{ $short }}.primaryKey()\n\trow, err := client.Single().ReadRow(ctx, \"{{ $table }}\", key, {{ .Name }}Columns())\n\tif err != nil {\n\t\tt.Fatalf(\"failed to read from '{{ $table }}': %v\", err)\n\t}\n\tread, err := Scan{{ .Name }}(row)\n\tif err != nil {\n\t\tt.Fatalf(\"failed to decode the row of '{{ $table }}': %v\", err)\n\t}\n\n\t// the generated columns are not compared\n\tcols := {{ .Name }}WritableColumns()\n\twant, err := {{ $short }}.columnsToValues(cols)\n\tif err != nil {\n\t\tt.Fatal(err)\n\t}\n\tgot, err := read.columnsToValues(cols)\n\tif err != nil {\n\t\tt.Fatal(err)\n\t}\n\tfor i, col := range cols {\n{{- if and autocommitts $committs }}\n\t\tswitch col {\n\t\tcase {{ range $i, $f := $committs }}{{ if $i }}, {{ end }}\"{{ colname $f.Col }}\"{{ end }}:\n\t\t\t// the commit timestamps are written by Cloud Spanner\n\t\t\tcontinue\n\t\t}\n{{- end }}\n\t\tif !yoTestEqual(want[i], got[i]) {\n\t\t\tt.Errorf(\"column %s of '{{ $table }}': want %v, but got %v\", col, want[i], got[i])\n\t\t}\n\t}\n}\n{{ end }}\nfunc Test{{ .Name }}RoundTrip(t *testing.T) {\n{{- if orphan . }}\n\tt.Skip(\"a table which '{{ $table }}' is interleaved in is not generated in this package\")\n{{- else }}\n\tclient := yoTestClient(t)\n\ttestRoundTrip{{ .Name }}(t, client, testValue{{ .Name }}())\n{{- end }}\n}\n{{- end }}\n"
+var _Assets652b6e36fe11372d65bfc0531de888fa9f12e2c0 = "// YODB is the common interface for database operations.\ntype YODB interface {\n\tYORODB\n}\n\n// YORODB is the reader which all generated read functions take. It is\n// satisfied by *spanner.ReadOnlyTransaction, *spanner.ReadWriteTransaction\n// and *spanner.BatchReadOnlyTransaction, and by fakes of them in tests.\ntype YORODB interface {\n\tReadRow(ctx context.Context, table string, key spanner.Key, columns []string) (*spanner.Row, error)\n\tRead(ctx context.Context, table string, keys spanner.KeySet, columns []string) *spanner.RowIterator\n\tReadUsingIndex(ctx context.Context, table, index string, keys spanner.KeySet, columns []string) (ri *spanner.RowIterator)\n\tQuery(ctx context.Context, statement spanner.Statement) *spanner.RowIterator\n}\n\n// yoOptionsRODB is the reader of YORODB taking the read and the query\n// options, which the generated read functions use when the options are given.\n// The transactions of the client satisfy it, but a YORODB such as a fake in\n// tests may not, and then the options are ignored except Limit.\ntype yoOptionsRODB interface {\n\tReadWithOptions(ctx context.Context, table string, keys spanner.KeySet, columns []string, opts *spanner.ReadOptions) (ri *spanner.RowIterator)\n\tQueryWithOptions(ctx context.Context, statement spanner.Statement, opts spanner.QueryOptions) *spanner.RowIterator\n}\n\nvar (\n\t_ YORODB = (*spanner.ReadOnlyTransaction)(nil)\n\t_ YORODB = (*spanner.ReadWriteTransaction)(nil)\n\t_ YORODB = (*spanner.BatchReadOnlyTransaction)(nil)\n\n\t_ yoOptionsRODB = (*spanner.ReadOnlyTransaction)(nil)\n\t_ yoOptionsRODB = (*spanner.ReadWriteTransaction)(nil)\n\t_ yoOptionsRODB = (*spanner.BatchReadOnlyTransaction)(nil)\n)\n\n// YOStaleRead returns a single-use read-only transaction of client reading at\n// bound, such as spanner.ExactStaleness or spanner.MaxStaleness, which is\n// given to a generated read function as db. Its Timestamp returns the read\n// timestamp after the read.\nfunc YOStaleRead(client *spanner.Client, bound spanner.TimestampBound) *spanner.ReadOnlyTransaction {\n\treturn client.Single().WithTimestampBound(bound)\n}\n\n// yoReadOptions returns the options given to a generated reader, or nil if no\n// options are given. Only the first options are used.\nfunc yoReadOptions(opts []*spanner.ReadOptions) *spanner.ReadOptions {\n\tif len(opts) == 0 {\n\t\treturn nil\n\t}\n\treturn opts[0]\n}\n\n// YOPartitionOptions are the options of the generated partitioned reads and\n// queries in addition to spanner.PartitionOptions.\n{{- if not databoost }} The version of\n// cloud.google.com/go/spanner given to yo supports none of them.\n{{- end }}\ntype YOPartitionOptions struct {\n{{- if databoost }}\n\t// DataBoostEnabled executes the partitions by Data Boost, which runs\n\t// analytical scans on independent compute resources instead of the\n\t// provisioned ones of the instance.\n\tDataBoostEnabled bool\n{{- end }}\n}\n\n// yoPartitionOptions returns the options given to a generated partitioned\n// read or query, or the zero value if no options are given. Only the first\n// options are used.\nfunc yoPartitionOptions(opts []*YOPartitionOptions) YOPartitionOptions {\n\tif len(opts) == 0 || opts[0] == nil {\n\t\treturn YOPartitionOptions{}\n\t}\n\treturn *opts[0]\n}\n\n// yoRead reads rows from table, or from index of table if index is not empty,\n// with opts if given.\nfunc yoRead(ctx context.Context, db YORODB, table, index string, keys spanner.KeySet, columns []string, opts []*spanner.ReadOptions) *spanner.RowIterator {\n\to := yoReadOptions(opts)\n\todb, ok := db.(yoOptionsRODB)\n\tif o == nil || !ok {\n\t\tif index == \"\" {\n\t\t\treturn db.Read(ctx, table, keys, columns)\n\t\t}\n\t\treturn db.ReadUsingIndex(ctx, table, index, keys, columns)\n\t}\n\n\tro := *o\n\tro.Index = index\n\treturn odb.ReadWithOptions(ctx, table, keys, columns, &ro)\n}\n\n// yoReadRow reads a row of key from table with opts if given. The error is\n// codes.NotFound if the row does not exist.\nfunc yoReadRow(ctx context.Context, db YORODB, table string, key spanner.Key, columns []string, opts []*spanner.ReadOptions) (*spanner.Row, error) {\n\tif yoReadOptions(opts) == nil {\n\t\treturn db.ReadRow(ctx, table, key, columns)\n\t}\n\n\titer := yoRead(ctx, db, table, \"\", key, columns, opts)\n\tdefer iter.Stop()\n\n\trow, err := iter.Next()\n\tif err == iterator.Done {\n\t\treturn nil, status.Errorf(codes.NotFound, \"row not found(Table: %v, PrimaryKey: %v)\", table, key)\n\t}\n\treturn row, err\n}\n\n// yoQueryOptionsKey is the context key of the query options given by\n// YOWithQueryOptions.\ntype yoQueryOptionsKey struct{}\n\n// YOWithQueryOptions returns a context whose generated queries are run with\n// opts, such as the optimizer version and statistics package of Options,\n// which are not in spanner.ReadOptions.\n{{- if databoost }} The Priority, the RequestTag and the\n// DataBoostEnabled of the read options given to the functions take\n// precedence over the ones of opts.\n{{- else }} The Priority and the RequestTag of the\n// read options given to the functions take precedence over the ones of opts.\n{{- end }}\nfunc YOWithQueryOptions(ctx context.Context, opts spanner.QueryOptions) context.Context {\n\treturn context.WithValue(ctx, yoQueryOptionsKey{}, opts)\n}\n{{- if hassoftdelete .TableMap }}\n\n// yoIncludingDeletedKey is the context key of YOIncludingDeleted.\ntype yoIncludingDeletedKey struct{}\n\n// YOIncludingDeleted returns a context whose generated reads include the\n// soft-deleted rows of the tables having soft delete columns, which are\n// filtered out by default.\nfunc YOIncludingDeleted(ctx context.Context) context.Context {\n\treturn context.WithValue(ctx, yoIncludingDeletedKey{}, true)\n}\n\n// yoIncludesDeleted reports whether the soft-deleted rows are read in ctx.\nfunc yoIncludesDeleted(ctx context.Context) bool {\n\tincluded, _ := ctx.Value(yoIncludingDeletedKey{}).(bool)\n\treturn included\n}\n{{- end }}\n\n// yoQuery runs stmt with opts if given. The Limit of opts limits the number\n// of rows, and the Priority\n{{- if databoost }}, the RequestTag and the DataBoostEnabled are\n// passed to the query along with the query options of ctx.\n{{- else }} and the RequestTag are passed to the query along\n// with the query options of ctx.\n{{- end }}\nfunc yoQuery(ctx context.Context, db YORODB, stmt spanner.Statement, opts []*spanner.ReadOptions) *spanner.RowIterator {\n\to := yoReadOptions(opts)\n\tif o != nil && o.Limit > 0 {\n\t\tstmt.SQL += fmt.Sprintf(\" LIMIT %d\", o.Limit)\n\t}\n\tqo, hasQueryOptions := ctx.Value(yoQueryOptionsKey{}).(spanner.QueryOptions)\n\todb, ok := db.(yoOptionsRODB)\n\tif !ok || o == nil && !hasQueryOptions {\n\t\treturn db.Query(ctx, stmt)\n\t}\n\n\tif o != nil {\n\t\tif o.Priority != 0 {\n\t\t\tqo.Priority = o.Priority\n\t\t}\n\t\tif o.RequestTag != \"\" {\n\t\t\tqo.RequestTag = o.RequestTag\n\t\t}\n\t\t{{- if databoost }}\n\t\tif o.DataBoostEnabled {\n\t\t\tqo.DataBoostEnabled = true\n\t\t}\n\t\t{{- end }}\n\t}\n\treturn odb.QueryWithOptions(ctx, stmt, qo)\n}\n\n{{ if otel -}}\n// yoStartSpan starts a span of method of the generated code on table, and on\n// index of table if not empty. The spans of the RPCs by the client library\n// become its children.\nfunc yoStartSpan(ctx context.Context, method, table, index string) (context.Context, trace.Span) {\n\tattrs := []attribute.KeyValue{\n\t\tattribute.String(\"db.system\", \"spanner\"),\n\t\tattribute.String(\"db.collection.name\", table),\n\t\tattribute.String(\"db.operation.name\", method),\n\t}\n\tif index != \"\" {\n\t\tattrs = append(attrs, attribute.String(\"yo.index\", index))\n\t}\n\n\treturn otel.Tracer(\"go.mercari.io/yo\").Start(ctx, \"yo.\"+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))\n}\n\n{{ end -}}\n{{ if metrics -}}\n// YOMetrics receives the metrics of the reads and writes of generated code.\n// The method is the name of the generated function or method, such as\n// \"FindExample\" or \"ExampleQuery.Query\".\ntype YOMetrics interface {\n\t// ObserveCall reports that method on table finished in latency.\n\tObserveCall(method, table string, latency time.Duration)\n\n\t// ObserveError reports that method on table returned an error of code.\n\tObserveError(method, table string, code codes.Code)\n}\n\n// YOMetricsRecorder is the YOMetrics the generated functions report into. It\n// is nil by default, which disables the metrics.\nvar YOMetricsRecorder YOMetrics\n\n// yoObserve returns the func reporting the latency of method on table since\n// the call. It is deferred by generated functions.\nfunc yoObserve(method, table string) func() {\n\tm := YOMetricsRecorder\n\tif m == nil {\n\t\treturn func() {}\n\t}\n\n\tstart := time.Now()\n\treturn func() {\n\t\tm.ObserveCall(method, table, time.Since(start))\n\t}\n}\n\n// YOPrometheusMetrics is the YOMetrics of Prometheus. It has the counter of\n// the calls, the counter of the errors by the code and the histogram of the\n// latencies, labeled by the table and the method.\ntype YOPrometheusMetrics struct {\n\tcalls     *prometheus.CounterVec\n\terrors    *prometheus.CounterVec\n\tlatencies *prometheus.HistogramVec\n}\n\n// NewYOPrometheusMetrics registers the metrics of generated code to reg, and\n// returns the YOMetrics reporting to them. The metrics already registered by\n// another generated package are shared.\nfunc NewYOPrometheusMetrics(reg prometheus.Registerer) (*YOPrometheusMetrics, error) {\n\tm := &YOPrometheusMetrics{\n\t\tcalls: prometheus.NewCounterVec(prometheus.CounterOpts{\n\t\t\tNamespace: \"yo\",\n\t\t\tName:      \"calls_total\",\n\t\t\tHelp:      \"Number of the calls of the reads and writes of generated code.\",\n\t\t}, []string{\"table\", \"method\"}),\n\t\terrors: prometheus.NewCounterVec(prometheus.CounterOpts{\n\t\t\tNamespace: \"yo\",\n\t\t\tName:      \"errors_total\",\n\t\t\tHelp:      \"Number of the errors returned by the reads and writes of generated code.\",\n\t\t}, []string{\"table\", \"method\", \"code\"}),\n\t\tlatencies: prometheus.NewHistogramVec(prometheus.HistogramOpts{\n\t\t\tNamespace: \"yo\",\n\t\t\tName:      \"call_duration_seconds\",\n\t\t\tHelp:      \"Latencies of the calls of the reads and writes of generated code.\",\n\t\t\tBuckets:   prometheus.DefBuckets,\n\t\t}, []string{\"table\", \"method\"}),\n\t}\n\n\tvar err error\n\tif m.calls, err = yoRegisterPrometheus(reg, m.calls); err != nil {\n\t\treturn nil, err\n\t}\n\tif m.errors, err = yoRegisterPrometheus(reg, m.errors); err != nil {\n\t\treturn nil, err\n\t}\n\tif m.latencies, err = yoRegisterPrometheus(reg, m.latencies); err != nil {\n\t\treturn nil, err\n\t}\n\n\treturn m, nil\n}\n\n// yoRegisterPrometheus registers c to reg, and returns the collector already\n// registered if any.\nfunc yoRegisterPrometheus[T prometheus.Collector](reg prometheus.Registerer, c T) (T, error) {\n\tif err := reg.Register(c); err != nil {\n\t\tvar are prometheus.AlreadyRegisteredError\n\t\tif errors.As(err, &are) {\n\t\t\tif existing, ok := are.ExistingCollector.(T); ok {\n\t\t\t\treturn existing, nil\n\t\t\t}\n\t\t}\n\t\treturn c, fmt.Errorf(\"register yo metrics: %w\", err)\n\t}\n\treturn c, nil\n}\n\n// ObserveCall implements YOMetrics.\nfunc (m *YOPrometheusMetrics) ObserveCall(method, table string, latency time.Duration) {\n\tm.calls.WithLabelValues(table, method).Inc()\n\tm.latencies.WithLabelValues(table, method).Observe(latency.Seconds())\n}\n\n// ObserveError implements YOMetrics.\nfunc (m *YOPrometheusMetrics) ObserveError(method, table string, code codes.Code) {\n\tm.errors.WithLabelValues(table, method, code.String()).Inc()\n}\n\n{{ end -}}\n// yoClone returns a copy of v if it is a slice, so that the elements of v\n// modified in place are detected by comparing v with the copy.\nfunc yoClone[T any](v T) T {\n\trv := reflect.ValueOf(v)\n\tif rv.Kind() != reflect.Slice || rv.IsNil() {\n\t\treturn v\n\t}\n\n\tc := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())\n\treflect.Copy(c, rv)\n\treturn c.Interface().(T)\n}\n\n// YOLog provides the log func used by generated queries.\nvar YOLog = func(context.Context, string, ...interface{}) { }\n\n// YOWarn provides the warning func used by generated finders, such as when\n// NULL is given to a finder of a null-filtered index, which never has the rows\n// having NULL in its key.\nvar YOWarn = func(context.Context, string, ...interface{}) { }\n\n// YOLogger is the structured logger of the statements run by generated\n// functions. Each statement is logged at debug level with the method, the SQL,\n// the parameters and the latency after it finishes. It is nil by default,\n// which disables the logging.\nvar YOLogger *slog.Logger\n\n// YORedactParams replaces the values of the parameters logged by YOLogger\n// with \"REDACTED\", such as when the values contain personal data.\nvar YORedactParams = false\n\n// yoLogQuery logs stmt by YOLog, and returns the func logging stmt by YOLogger\n// with the latency since the call. It is deferred by generated functions.\nfunc yoLogQuery(ctx context.Context, method string, stmt spanner.Statement, args ...interface{}) func() {\n\tYOLog(ctx, stmt.SQL, args...)\n\n\tlogger := YOLogger\n\tif logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {\n\t\treturn func() {}\n\t}\n\n\tstart := time.Now()\n\treturn func() {\n\t\tnames := make([]string, 0, len(stmt.Params))\n\t\tfor name := range stmt.Params {\n\t\t\tnames = append(names, name)\n\t\t}\n\t\tsort.Strings(names)\n\n\t\tparams := make([]any, 0, len(names))\n\t\tfor _, name := range names {\n\t\t\tif YORedactParams {\n\t\t\t\tparams = append(params, slog.String(name, \"REDACTED\"))\n\t\t\t} else {\n\t\t\t\tparams = append(params, slog.Any(name, stmt.Params[name]))\n\t\t\t}\n\t\t}\n\n\t\tlogger.LogAttrs(ctx, slog.LevelDebug, \"yo: run statement\",\n\t\t\tslog.String(\"method\", method),\n\t\t\tslog.String(\"sql\", stmt.SQL),\n\t\t\tslog.Group(\"params\", params...),\n\t\t\tslog.Duration(\"latency\", time.Since(start)),\n\t\t)\n\t}\n}\n\n// yoQuoteIdentifier quotes name as an identifier of a query, so that the\n// reserved keywords can be used as the names of columns.\nfunc yoQuoteIdentifier(name string) string {\n{{- if postgresql }}\n\treturn `\"` + strings.ReplaceAll(name, `\"`, `\"\"`) + `\"`\n{{- else }}\n\treturn \"`\" + name + \"`\"\n{{- end }}\n}\n\n// yoBindParam binds v to params, and returns the placeholder of it in a query.\n{{- if postgresql }}\n// The parameters are numbered in the order of the bindings as $1, $2, ...\n// whose names are p1, p2, ... regardless of name.\nfunc yoBindParam(params map[string]interface{}, name string, v interface{}) string {\n\tn := len(params) + 1\n\tparams[fmt.Sprintf(\"p%d\", n)] = v\n\treturn fmt.Sprintf(\"$%d\", n)\n}\n{{- else }}\nfunc yoBindParam(params map[string]interface{}, name string, v interface{}) string {\n\tparams[name] = v\n\treturn \"@\" + name\n}\n{{- end }}\n\n// YOPredicate is a condition on a column used by generated query builders.\n// It is created only by the typed predicate constructors of the columns, so\n// that the column name is always valid and the value is always passed as a\n// query parameter.\ntype YOPredicate struct {\n\tcolumn string\n\top     string\n\tvalue  interface{}\n}\n\n// yoStatement builds a statement to select cols from table where all preds\n// are satisfied, sorted by orders and limited to limit rows if limit is\n// positive. The values of preds are bound to the parameters in the same manner\n// as the generated finders.\nfunc yoStatement(cols, table string, preds []YOPredicate, orders []string, limit int) spanner.Statement {\n\tsqlstr := \"SELECT \" + cols + \" FROM \" + table\n\tparams := make(map[string]interface{}, len(preds))\n\n\tconds := yoConditions(preds, params)\n\tif len(conds) != 0 {\n\t\tsqlstr += \" WHERE \" + strings.Join(conds, \" AND \")\n\t}\n\tif len(orders) != 0 {\n\t\tsqlstr += \" ORDER BY \" + strings.Join(orders, \", \")\n\t}\n\tif limit > 0 {\n\t\tsqlstr += fmt.Sprintf(\" LIMIT %d\", limit)\n\t}\n\n\treturn spanner.Statement{SQL: sqlstr, Params: params}\n}\n\n// yoConditions returns the conditions of preds, and binds their values to\n// param0, param1, ... in params.\nfunc yoConditions(preds []YOPredicate, params map[string]interface{}) []string {\n\tconds := make([]string, 0, len(preds))\n\tfor i, p := range preds {\n\t\tname := fmt.Sprintf(\"param%d\", i)\n\t\tswitch p.op {\n\t\tcase \"IS NULL\", \"IS NOT NULL\":\n\t\t\tconds = append(conds, p.column+\" \"+p.op)\n\t\tcase \"IN\":\n\t\t\t{{- if postgresql }}\n\t\t\tconds = append(conds, p.column+\" = ANY(\"+yoBindParam(params, name, p.value)+\")\")\n\t\t\t{{- else }}\n\t\t\tconds = append(conds, p.column+\" IN UNNEST(\"+yoBindParam(params, name, p.value)+\")\")\n\t\t\t{{- end }}\n\t\tdefault:\n\t\t\tconds = append(conds, p.column+\" \"+p.op+\" \"+yoBindParam(params, name, p.value))\n\t\t}\n\t}\n\n\treturn conds\n}\n\n// yoCount runs stmt selecting COUNT(*) and returns the count. The Limit of\n// opts is ignored.\nfunc yoCount(ctx context.Context, db YORODB, stmt spanner.Statement, opts []*spanner.ReadOptions) (int64, error) {\n\titer := yoQuery(ctx, db, stmt, yoWithoutLimit(opts))\n\tdefer iter.Stop()\n\n\trow, err := iter.Next()\n\tif err != nil {\n\t\treturn 0, err\n\t}\n\n\tvar n int64\n\tif err := row.Columns(&n); err != nil {\n\t\treturn 0, err\n\t}\n\treturn n, nil\n}\n\n// yoWithoutLimit returns opts whose Limit is cleared, which is overridden by\n// the LIMIT clause of a query builder.\nfunc yoWithoutLimit(opts []*spanner.ReadOptions) []*spanner.ReadOptions {\n\to := yoReadOptions(opts)\n\tif o == nil || o.Limit == 0 {\n\t\treturn opts\n\t}\n\tro := *o\n\tro.Limit = 0\n\treturn []*spanner.ReadOptions{&ro}\n}\n\n// yoPageStatement builds the statement of a page of at most pageSize rows of\n// the query sqlstr, which are sorted by cols in the directions of desc. The\n// page starts after key, which is the values of cols of the last row of the\n// previous page, or at the first row if key is nil.\nfunc yoPageStatement(sqlstr string, cols []string, desc []bool, key []interface{}, pageSize int) spanner.Statement {\n\tparams := make(map[string]interface{}, len(key)+1)\n\n\tif key != nil {\n\t\tkeys := make([]string, len(cols))\n\t\tfor i := range cols {\n\t\t\tkeys[i] = yoBindParam(params, fmt.Sprintf(\"key%d\", i), key[i])\n\t\t}\n\n\t\t// (c0 > @key0) OR (c0 = @key0 AND c1 > @key1) OR ...\n\t\tconds := make([]string, len(cols))\n\t\tfor i, col := range cols {\n\t\t\tterms := make([]string, 0, i+1)\n\t\t\tfor j := 0; j < i; j++ {\n\t\t\t\tterms = append(terms, fmt.Sprintf(\"%s = %s\", cols[j], keys[j]))\n\t\t\t}\n\t\t\top := \">\"\n\t\t\tif desc[i] {\n\t\t\t\top = \"<\"\n\t\t\t}\n\t\t\tterms = append(terms, fmt.Sprintf(\"%s %s %s\", col, op, keys[i]))\n\t\t\tconds[i] = \"(\" + strings.Join(terms, \" AND \") + \")\"\n\t\t}\n\t\tsqlstr += \" WHERE \" + strings.Join(conds, \" OR \")\n\t}\n\n\torders := make([]string, len(cols))\n\tfor i, col := range cols {\n\t\torders[i] = col\n\t\tif desc[i] {\n\t\t\torders[i] += \" DESC\"\n\t\t}\n\t}\n\tsqlstr += \" ORDER BY \" + strings.Join(orders, \", \") + \" LIMIT \" + yoBindParam(params, \"limit\", int64(pageSize))\n\n\treturn spanner.Statement{SQL: sqlstr, Params: params}\n}\n\n// yoEncodePageToken encodes the key of the last row of a page into the opaque\n// token of the next page.\nfunc yoEncodePageToken(key ...interface{}) (string, error) {\n\tb, err := json.Marshal(key)\n\tif err != nil {\n\t\treturn \"\", err\n\t}\n\treturn base64.RawURLEncoding.EncodeToString(b), nil\n}\n\n// yoDecodePageToken decodes the key of token encoded by yoEncodePageToken into\n// ptrs.\nfunc yoDecodePageToken(token string, ptrs ...interface{}) error {\n\tb, err := base64.RawURLEncoding.DecodeString(token)\n\tif err != nil {\n\t\treturn fmt.Errorf(\"invalid page token: %v\", err)\n\t}\n\tvar key []json.RawMessage\n\tif err := json.Unmarshal(b, &key); err != nil || len(key) != len(ptrs) {\n\t\treturn errors.New(\"invalid page token\")\n\t}\n\tfor i, v := range key {\n\t\tif err := json.Unmarshal(v, ptrs[i]); err != nil {\n\t\t\treturn fmt.Errorf(\"invalid page token: %v\", err)\n\t\t}\n\t}\n\treturn nil\n}\n\n// YOMutationLimit is the maximum number of mutations applied in a commit by\n// the generated bulk insert functions. Spanner limits the number of mutations\n// per commit, which counts the inserted columns and the index entries.\nvar YOMutationLimit = 80000\n\n// yoApplyInBatches applies ms in batches of batchSize mutations, committing\n// each batch separately. It returns the number of mutations applied.\nfunc yoApplyInBatches(ctx context.Context, client *spanner.Client, method, table string, ms []*spanner.Mutation, batchSize int) (int, error) {\n\tif batchSize < 1 {\n\t\tbatchSize = 1\n\t}\n\n\twritten := 0\n\tfor start := 0; start < len(ms); start += batchSize {\n\t\tend := start + batchSize\n\t\tif end > len(ms) {\n\t\t\tend = len(ms)\n\t\t}\n\n\t\tif _, err := client.Apply(ctx, ms[start:end]); err != nil {\n\t\t\treturn written, newErrorWithCode(spanner.ErrCode(err), method, table,\n\t\t\t\tfmt.Errorf(\"batch %d (rows %d to %d) failed after %d rows written: %w\", start/batchSize, start, end-1, written, err))\n\t\t}\n\t\twritten += end - start\n\t}\n\n\treturn written, nil\n}\n{{- if batchwrite }}\n\n// YOBatchWrite applies groups by the BatchWrite API, such as the groups of the\n// rows with their interleaved rows. Each group is applied atomically, but the\n// groups are applied independently in any order, so that it is intended for\n// high-throughput ingestion which does not need the atomicity of all the\n// groups. It returns the errors of the groups which failed by the indexes of\n// the groups. The other groups are applied unless the error is returned.\nfunc YOBatchWrite(ctx context.Context, client *spanner.Client, groups []*spanner.MutationGroup) (map[int]error, error) {\n\treturn yoBatchWrite(ctx, client, \"YOBatchWrite\", \"\", groups)\n}\n\nfunc yoBatchWrite(ctx context.Context, client *spanner.Client, method, table string, groups []*spanner.MutationGroup) (map[int]error, error) {\n\tfailed := make(map[int]error)\n\terr := client.BatchWrite(ctx, groups).Do(func(res *spannerpb.BatchWriteResponse) error {\n\t\tst := res.GetStatus()\n\t\tif code := codes.Code(st.GetCode()); code != codes.OK {\n\t\t\tfor _, i := range res.GetIndexes() {\n\t\t\t\tfailed[int(i)] = newErrorWithCode(code, method, table, errors.New(st.GetMessage()))\n\t\t\t}\n\t\t}\n\t\treturn nil\n\t})\n\tif err != nil {\n\t\treturn failed, newError(method, table, err)\n\t}\n\n\treturn failed, nil\n}\n{{- end }}\n\n// YOTransactionTimeout bounds a transaction of YORunInTransaction including its\n// retries, unless the context already has an earlier deadline. Spanner retries\n// an aborted transaction until the context is done, so that a transaction\n// aborted repeatedly by contention would run forever without it.\nvar YOTransactionTimeout = time.Minute\n\n// YOTransactionRetried is called with the transaction tag and the number of\n// the attempts so far, when a transaction of YORunInTransaction is aborted\n// and is retried, so that the aborts can be counted by the metrics. It is\n// nil by default.\nvar YOTransactionRetried func(ctx context.Context, tag string, attempt int)\n\n// YORunInTransaction runs f in a read-write transaction of client tagged by\n// tag, and returns the commit timestamp. It is the intended entry point of the\n// generated functions taking a *spanner.ReadWriteTransaction. f is run again\n// when the transaction is aborted, so it must not have side effects other than\n// the writes to tx. The retries are reported to YOTransactionRetried, and are\n// logged by YOLogger at warn level.\nfunc YORunInTransaction(ctx context.Context, client *spanner.Client, tag string, f func(ctx context.Context, tx *spanner.ReadWriteTransaction) error) (time.Time, error) {\n\tif YOTransactionTimeout > 0 {\n\t\tvar cancel context.CancelFunc\n\t\tctx, cancel = context.WithTimeout(ctx, YOTransactionTimeout)\n\t\tdefer cancel()\n\t}\n\n\tattempt := 0\n\tresp, err := client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {\n\t\tattempt++\n\t\tif attempt > 1 {\n\t\t\tif YOTransactionRetried != nil {\n\t\t\t\tYOTransactionRetried(ctx, tag, attempt)\n\t\t\t}\n\t\t\tif logger := YOLogger; logger != nil {\n\t\t\t\tlogger.WarnContext(ctx, \"yo: retry aborted transaction\", slog.String(\"tag\", tag), slog.Int(\"attempt\", attempt))\n\t\t\t}\n\t\t}\n\t\treturn f(ctx, tx)\n\t}, spanner.TransactionOptions{TransactionTag: tag})\n\tif err != nil {\n\t\treturn time.Time{}, err\n\t}\n\n\treturn resp.CommitTs, nil\n}\n\n// YODescriptor describes a table for YORepository, which is generated as\n// XXXDescriptor for each table having a primary key. T is the struct of the\n// rows and K is the typed primary key of the table.\ntype YODescriptor[T any, K any] struct {\n\t// Table is the name of the table.\n\tTable string\n\n\t// Columns is the names of the columns read into T.\n\tColumns []string\n\n\t// PrimaryKeys is the names of the columns of the primary key.\n\tPrimaryKeys []string\n\n\t// Key returns the primary key of a row.\n\tKey func(row *T) K\n\n\t// SpannerKey returns the spanner.Key of a primary key.\n\tSpannerKey func(key K) spanner.Key\n\n\t// Scan scans a row of Columns into T.\n\tScan func(row *spanner.Row) (*T, error)\n\n\t// Find gets a row by the primary key.\n\tFind func(ctx context.Context, db YORODB, key K, opts ...*spanner.ReadOptions) (*T, error)\n\n\t// Read retrieves the rows of keys.\n\tRead func(ctx context.Context, db YORODB, keys spanner.KeySet, opts ...*spanner.ReadOptions) ([]*T, error)\n\n\t// Insert, Update, InsertOrUpdate and Delete return the Mutations to write\n\t// a row.\n\tInsert         func(ctx context.Context, row *T) *spanner.Mutation\n\tUpdate         func(ctx context.Context, row *T) *spanner.Mutation\n\tInsertOrUpdate func(ctx context.Context, row *T) *spanner.Mutation\n\tDelete         func(ctx context.Context, row *T) *spanner.Mutation\n}\n\n// YORepository is the CRUD of the rows of a table described by a\n// YODescriptor, which is shared by the tables. The reads are strong reads by\n// single-use read-only transactions of the client, and the writes are applied\n// by the client. The generated functions of the table are still available.\ntype YORepository[T any, K any] struct {\n\tclient *spanner.Client\n\tdesc   *YODescriptor[T, K]\n}\n\n// NewYORepository returns a YORepository of the table described by desc, such\n// as XXXDescriptor.\nfunc NewYORepository[T any, K any](client *spanner.Client, desc *YODescriptor[T, K]) *YORepository[T, K] {\n\treturn &YORepository[T, K]{client: client, desc: desc}\n}\n\n// Descriptor returns the YODescriptor of the table.\nfunc (r *YORepository[T, K]) Descriptor() *YODescriptor[T, K] {\n\treturn r.desc\n}\n\n// Get gets the row of key.\nfunc (r *YORepository[T, K]) Get(ctx context.Context, key K) (*T, error) {\n\treturn r.desc.Find(ctx, r.client.Single(), key)\n}\n\n// GetMulti gets the rows of keys. The rows not found are omitted.\nfunc (r *YORepository[T, K]) GetMulti(ctx context.Context, keys []K) ([]*T, error) {\n\tks := make([]spanner.KeySet, len(keys))\n\tfor i, k := range keys {\n\t\tks[i] = r.desc.SpannerKey(k)\n\t}\n\n\treturn r.desc.Read(ctx, r.client.Single(), spanner.KeySets(ks...))\n}\n\n// Insert inserts rows.\nfunc (r *YORepository[T, K]) Insert(ctx context.Context, rows ...*T) error {\n\treturn r.apply(ctx, \"Insert\", r.desc.Insert, rows)\n}\n\n// Update updates rows.\nfunc (r *YORepository[T, K]) Update(ctx context.Context, rows ...*T) error {\n\treturn r.apply(ctx, \"Update\", r.desc.Update, rows)\n}\n\n// InsertOrUpdate inserts rows, or updates them if they already exist.\nfunc (r *YORepository[T, K]) InsertOrUpdate(ctx context.Context, rows ...*T) error {\n\treturn r.apply(ctx, \"InsertOrUpdate\", r.desc.InsertOrUpdate, rows)\n}\n\n// Delete deletes rows.\nfunc (r *YORepository[T, K]) Delete(ctx context.Context, rows ...*T) error {\n\treturn r.apply(ctx, \"Delete\", r.desc.Delete, rows)\n}\n\n// apply applies the Mutations of rows built by mutation in a commit.\nfunc (r *YORepository[T, K]) apply(ctx context.Context, method string, mutation func(context.Context, *T) *spanner.Mutation, rows []*T) error {\n\tms := make([]*spanner.Mutation, len(rows))\n\tfor i, row := range rows {\n\t\tms[i] = mutation(ctx, row)\n\t}\n\n\tif _, err := r.client.Apply(ctx, ms); err != nil {\n\t\treturn newError(method, r.desc.Table, err)\n\t}\n\n\treturn nil\n}\n{{- if dml }}\n\n// yoPendingCommitTimestamp is the function writing the commit timestamp in DML\n// statements.\nconst yoPendingCommitTimestamp = \"{{ if postgresql }}spanner.pending_commit_timestamp(){{ else }}PENDING_COMMIT_TIMESTAMP(){{ end }}\"\n\n// yoIsCommitTimestamp reports whether v is spanner.CommitTimestamp, which is\n// written by yoPendingCommitTimestamp in DML statements instead of a query\n// parameter.\nfunc yoIsCommitTimestamp(v interface{}) bool {\n\tt, ok := v.(time.Time)\n\treturn ok && t == spanner.CommitTimestamp\n}\n\n// yoInsertDML builds an INSERT statement of the values of cols into table.\n// The values are bound to param0, param1, ... in the order of cols.\nfunc yoInsertDML(table string, cols []string, values []interface{}) spanner.Statement {\n\tparams := make(map[string]interface{}, len(cols))\n\tnames := make([]string, len(cols))\n\tquoted := make([]string, len(cols))\n\tfor i, c := range cols {\n\t\tquoted[i] = yoQuoteIdentifier(c)\n\t\tif yoIsCommitTimestamp(values[i]) {\n\t\t\tnames[i] = yoPendingCommitTimestamp\n\t\t\tcontinue\n\t\t}\n\t\tnames[i] = yoBindParam(params, fmt.Sprintf(\"param%d\", i), values[i])\n\t}\n\n\tsqlstr := \"INSERT INTO \" + table + \" (\" + strings.Join(quoted, \", \") + \") VALUES (\" + strings.Join(names, \", \") + \")\"\n\treturn spanner.Statement{SQL: sqlstr, Params: params}\n}\n\n// yoUpdateDML builds an UPDATE statement which sets the values of cols other\n// than keys in the row of table identified by the values of keys. keys must be\n// included in cols.\nfunc yoUpdateDML(table string, cols []string, values []interface{}, keys []string) spanner.Statement {\n\tisKey := make(map[string]bool, len(keys))\n\tfor _, k := range keys {\n\t\tisKey[k] = true\n\t}\n\n\tparams := make(map[string]interface{}, len(cols))\n\tvar sets, conds []string\n\tfor i, c := range cols {\n\t\tif !isKey[c] && yoIsCommitTimestamp(values[i]) {\n\t\t\tsets = append(sets, yoQuoteIdentifier(c)+\" = \"+yoPendingCommitTimestamp)\n\t\t\tcontinue\n\t\t}\n\t\tparam := yoBindParam(params, fmt.Sprintf(\"param%d\", i), values[i])\n\t\tif isKey[c] {\n\t\t\tconds = append(conds, yoQuoteIdentifier(c)+\" = \"+param)\n\t\t} else {\n\t\t\tsets = append(sets, yoQuoteIdentifier(c)+\" = \"+param)\n\t\t}\n\t}\n\n\tsqlstr := \"UPDATE \" + table + \" SET \" + strings.Join(sets, \", \") + \" WHERE \" + strings.Join(conds, \" AND \")\n\treturn spanner.Statement{SQL: sqlstr, Params: params}\n}\n\n// yoRunDML runs the DML statement having {{ if postgresql }}RETURNING{{ else }}THEN RETURN{{ end }} in tx, and returns the\n// row written. It returns iterator.Done if no row is written. The rows are\n// read up to the end so that the statement runs to completion.\nfunc yoRunDML(ctx context.Context, tx *spanner.ReadWriteTransaction, stmt spanner.Statement) (*spanner.Row, error) {\n\titer := tx.Query(ctx, stmt)\n\tdefer iter.Stop()\n\n\trow, err := iter.Next()\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\tif _, err := iter.Next(); err != iterator.Done {\n\t\tif err == nil {\n\t\t\terr = errors.New(\"more than one row is written\")\n\t\t}\n\t\treturn nil, err\n\t}\n\n\treturn row, nil\n}\n\n// yoDeleteDML builds a DELETE statement of the row of table identified by the\n// values of keys.\nfunc yoDeleteDML(table string, keys []string, values []interface{}) spanner.Statement {\n\tparams := make(map[string]interface{}, len(keys))\n\tconds := make([]string, len(keys))\n\tfor i, k := range keys {\n\t\tconds[i] = yoQuoteIdentifier(k) + \" = \" + yoBindParam(params, fmt.Sprintf(\"param%d\", i), values[i])\n\t}\n\n\tsqlstr := \"DELETE FROM \" + table + \" WHERE \" + strings.Join(conds, \" AND \")\n\treturn spanner.Statement{SQL: sqlstr, Params: params}\n}\n\n// YOAssignment is a new value of a column set by generated partitioned\n// updates. It is created only by the typed setters of the columns.\ntype YOAssignment struct {\n\tcolumn string\n\tvalue  interface{}\n}\n\n// yoWhereDML returns the WHERE clause of preds for DML statements, which\n// matches all rows if preds is empty because DML statements require it.\nfunc yoWhereDML(preds []YOPredicate, params map[string]interface{}) string {\n\tconds := yoConditions(preds, params)\n\tif len(conds) == 0 {\n\t\treturn \" WHERE true\"\n\t}\n\treturn \" WHERE \" + strings.Join(conds, \" AND \")\n}\n\n// yoDeleteWhereDML builds a DELETE statement of the rows of table where all\n// preds are satisfied.\nfunc yoDeleteWhereDML(table string, preds []YOPredicate) spanner.Statement {\n\tparams := make(map[string]interface{}, len(preds))\n\tsqlstr := \"DELETE FROM \" + table + yoWhereDML(preds, params)\n\treturn spanner.Statement{SQL: sqlstr, Params: params}\n}\n\n// yoUpdateWhereDML builds an UPDATE statement which sets the values of sets in\n// the rows of table where all preds are satisfied. The values of sets are\n// bound to set0, set1, ... not to conflict with the ones of preds.\nfunc yoUpdateWhereDML(table string, sets []YOAssignment, preds []YOPredicate) spanner.Statement {\n\tparams := make(map[string]interface{}, len(sets)+len(preds))\n\texprs := make([]string, len(sets))\n\tfor i, s := range sets {\n\t\tif yoIsCommitTimestamp(s.value) {\n\t\t\texprs[i] = s.column + \" = \" + yoPendingCommitTimestamp\n\t\t\tcontinue\n\t\t}\n\t\texprs[i] = s.column + \" = \" + yoBindParam(params, fmt.Sprintf(\"set%d\", i), s.value)\n\t}\n\n\tsqlstr := \"UPDATE \" + table + \" SET \" + strings.Join(exprs, \", \") + yoWhereDML(preds, params)\n\treturn spanner.Statement{SQL: sqlstr, Params: params}\n}\n{{- end }}\n{{- if .ChangeStreams }}\n\n// YODataChangeRecord is a data change record of a change stream in JSON,\n// whose fields are named as the ones of the change stream queries.\ntype YODataChangeRecord struct {\n\tCommitTimestamp                      time.Time `json:\"commit_timestamp\"`\n\tRecordSequence                       string    `json:\"record_sequence\"`\n\tServerTransactionID                  string    `json:\"server_transaction_id\"`\n\tIsLastRecordInTransactionInPartition bool      `json:\"is_last_record_in_transaction_in_partition\"`\n\tTableName                            string    `json:\"table_name\"`\n\tMods                                 []*YOMod  `json:\"mods\"`\n\tModType                              string    `json:\"mod_type\"`\n\tValueCaptureType                     string    `json:\"value_capture_type\"`\n\tTransactionTag                       string    `json:\"transaction_tag\"`\n\tIsSystemTransaction                  bool      `json:\"is_system_transaction\"`\n}\n\n// YOMod is a change of a row in a data change record. Keys, NewValues and\n// OldValues are JSON objects of the column values, or JSON strings of the\n// objects.\ntype YOMod struct {\n\tKeys      json.RawMessage `json:\"keys\"`\n\tNewValues json.RawMessage `json:\"new_values\"`\n\tOldValues json.RawMessage `json:\"old_values\"`\n}\n\n// yoChangeRow builds a row of the column values of a mod merged with the keys.\n// The values are typed by types, which maps the columns to the Spanner types,\n// and the columns not in types are ignored. It returns nil if no value is\n// captured.\nfunc yoChangeRow(types map[string]string, values, keys json.RawMessage) (*spanner.Row, error) {\n\tvals, err := yoChangeValues(values)\n\tif err != nil || len(vals) == 0 {\n\t\treturn nil, err\n\t}\n\tks, err := yoChangeValues(keys)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\tfor col, v := range ks {\n\t\tvals[col] = v\n\t}\n\n\tcols := make([]string, 0, len(vals))\n\tfor col := range vals {\n\t\tif _, ok := types[col]; ok {\n\t\t\tcols = append(cols, col)\n\t\t}\n\t}\n\tsort.Strings(cols)\n\n\tgcvs := make([]interface{}, len(cols))\n\tfor i, col := range cols {\n\t\ttyp, err := yoSpannerType(types[col])\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t\tv := &structpb.Value{}\n\t\tif typ.Code == spannerpb.TypeCode_JSON && !bytes.HasPrefix(bytes.TrimSpace(vals[col]), []byte(`\"`)) {\n\t\t\t// JSON values are encoded as strings\n\t\t\tv = structpb.NewStringValue(string(vals[col]))\n\t\t} else if err := v.UnmarshalJSON(vals[col]); err != nil {\n\t\t\treturn nil, fmt.Errorf(\"invalid value of column %s: %v\", col, err)\n\t\t}\n\t\tgcvs[i] = spanner.GenericColumnValue{Type: typ, Value: v}\n\t}\n\n\treturn spanner.NewRow(cols, gcvs)\n}\n\n// yoChangeValues decodes the JSON object of the column values, which may be\n// encoded as a JSON string.\nfunc yoChangeValues(data json.RawMessage) (map[string]json.RawMessage, error) {\n\tif len(data) == 0 {\n\t\treturn nil, nil\n\t}\n\tvar s string\n\tif err := json.Unmarshal(data, &s); err == nil {\n\t\tif s == \"\" {\n\t\t\treturn nil, nil\n\t\t}\n\t\tdata = json.RawMessage(s)\n\t}\n\n\tvar vals map[string]json.RawMessage\n\tif err := json.Unmarshal(data, &vals); err != nil {\n\t\treturn nil, err\n\t}\n\treturn vals, nil\n}\n\n// yoSpannerType parses the Spanner type of a column such as ARRAY<STRING(MAX)>.\nfunc yoSpannerType(typ string) (*spannerpb.Type, error) {\n\tif strings.HasPrefix(typ, \"ARRAY<\") && strings.HasSuffix(typ, \">\") {\n\t\telem, err := yoSpannerType(typ[len(\"ARRAY<\") : len(typ)-1])\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t\treturn &spannerpb.Type{Code: spannerpb.TypeCode_ARRAY, ArrayElementType: elem}, nil\n\t}\n\tif i := strings.IndexAny(typ, \"( \"); i >= 0 {\n\t\ttyp = typ[:i]\n\t}\n\tif i := strings.IndexByte(typ, '<'); i >= 0 {\n\t\t// PROTO<name> and ENUM<name> are decoded by the Go types\n\t\ttyp = typ[:i]\n\t}\n\n\tcode, ok := spannerpb.TypeCode_value[typ]\n\tif !ok {\n\t\treturn nil, fmt.Errorf(\"unsupported type %s\", typ)\n\t}\n\treturn &spannerpb.Type{Code: spannerpb.TypeCode(code)}, nil\n}\n{{- end }}\n\n// ErrNotFound is the error matched by errors.Is when the row is not found.\nvar ErrNotFound = errors.New(\"yo: not found\")\n\n// ErrStaleWrite is the error matched by errors.Is when an update with a\n// version check finds the row changed or deleted since it was read.\nvar ErrStaleWrite = errors.New(\"yo: stale write\")\n\n// NotFoundError is the error of a row not found in a table, which is wrapped\n// by the errors of the generated functions. It is matched by errors.Is with\n// ErrNotFound and the ErrXXXNotFound of the table, and can be retrieved by\n// errors.As to get the table and the key of the row.\ntype NotFoundError struct {\n\tTable string\n\tKey   spanner.Key\n\tCode  codes.Code\n\n\tsentinel error\n\terr      error\n}\n\nfunc (e *NotFoundError) Error() string {\n\tif e.err != nil {\n\t\treturn fmt.Sprintf(\"row not found(Table: %v, Key: %v): %v\", e.Table, e.Key, e.err)\n\t}\n\treturn fmt.Sprintf(\"row not found(Table: %v, Key: %v)\", e.Table, e.Key)\n}\n\nfunc (e *NotFoundError) Unwrap() error {\n\treturn e.err\n}\n\n// Is reports whether target is ErrNotFound or the ErrXXXNotFound of the table.\nfunc (e *NotFoundError) Is(target error) bool {\n\treturn target == ErrNotFound || target == e.sentinel\n}\n\n// newNotFoundError returns the error of method where the row of key is not\n// found in table. sentinel is the ErrXXXNotFound of the table, and err is the\n// reason if any.\nfunc newNotFoundError(method, table string, key spanner.Key, sentinel, err error) error {\n\treturn newErrorWithCode(codes.NotFound, method, table, &NotFoundError{\n\t\tTable:    table,\n\t\tKey:      key,\n\t\tCode:     codes.NotFound,\n\t\tsentinel: sentinel,\n\t\terr:      err,\n\t})\n}\n\nfunc newError(method, table string, err error) error {\n\tcode := spanner.ErrCode(err)\n\treturn newErrorWithCode(code, method, table, err)\n}\n\nfunc newErrorWithCode(code codes.Code, method, table string, err error) error {\n\t{{- if metrics }}\n\t// the errors of the nested calls are already reported\n\tif _, ok := err.(*yoError); !ok && YOMetricsRecorder != nil {\n\t\tYOMetricsRecorder.ObserveError(method, table, code)\n\t}\n{{ end }}\n\treturn &yoError{\n\t\tmethod: method,\n\t\ttable:  table,\n\t\terr:    err,\n\t\tcode:   code,\n\t}\n}\n\ntype yoError struct {\n\terr    error\n\tmethod string\n\ttable  string\n\tcode   codes.Code\n}\n\nfunc (e yoError) Error() string {\n\treturn fmt.Sprintf(\"yo error in %s(%s): %v\", e.method, e.table, e.err)\n}\n\nfunc (e yoError) Unwrap() error {\n\treturn e.err\n}\n\n// Is reports whether the error is ErrNotFound by the code of the error.\nfunc (e yoError) Is(target error) bool {\n\treturn target == ErrNotFound && e.code == codes.NotFound\n}\n\nfunc (e yoError) DBTableName() string {\n\treturn e.table\n}\n\n// GRPCStatus implements a conversion to a gRPC status using `status.Convert(error)`.\n// If the error is originated from the Spanner library, this returns a gRPC status of\n// the original error. It may contain details of the status such as RetryInfo.\nfunc (e yoError) GRPCStatus() *status.Status {\n\tvar ae *apierror.APIError\n\tif errors.As(e.err, &ae) {\n\t\treturn status.Convert(ae)\n\t}\n\n\treturn status.New(e.code, e.Error())\n}\n\nfunc (e yoError) Timeout() bool { return e.code == codes.DeadlineExceeded }\nfunc (e yoError) Temporary() bool { return e.code == codes.DeadlineExceeded }\nfunc (e yoError) NotFound() bool { return e.code == codes.NotFound }\n{{- if hasjsontypes .TableMap }}\n\n// yoUnmarshalJSON unmarshals the JSON value of v into dst. dst is left as it\n// is if the value is NULL.\nfunc yoUnmarshalJSON(v spanner.GenericColumnValue, dst interface{}) error {\n\ts := v.Value.GetStringValue()\n\tif s == \"\" {\n\t\treturn nil\n\t}\n\treturn json.Unmarshal([]byte(s), dst)\n}\n{{- end }}\n{{- if hasconversions .TableMap }}\n\n// yoDateToTime converts d into the time at midnight UTC.\nfunc yoDateToTime(d civil.Date) time.Time {\n\treturn d.In(time.UTC)\n}\n\n// yoTimeToDate converts t into the date of t in the location of t.\nfunc yoTimeToDate(t time.Time) civil.Date {\n\treturn civil.DateOf(t)\n}\n\nfunc yoNullDateToTime(d spanner.NullDate) spanner.NullTime {\n\tif !d.Valid {\n\t\treturn spanner.NullTime{}\n\t}\n\treturn spanner.NullTime{Time: yoDateToTime(d.Date), Valid: true}\n}\n\nfunc yoNullTimeToDate(t spanner.NullTime) spanner.NullDate {\n\tif !t.Valid {\n\t\treturn spanner.NullDate{}\n\t}\n\treturn spanner.NullDate{Date: yoTimeToDate(t.Time), Valid: true}\n}\n\nfunc yoDatePtrToTime(d *civil.Date) *time.Time {\n\tif d == nil {\n\t\treturn nil\n\t}\n\tt := yoDateToTime(*d)\n\treturn &t\n}\n\nfunc yoTimePtrToDate(t *time.Time) *civil.Date {\n\tif t == nil {\n\t\treturn nil\n\t}\n\td := yoTimeToDate(*t)\n\treturn &d\n}\n\nfunc yoDatesToTimes(ds []civil.Date) []time.Time {\n\tif ds == nil {\n\t\treturn nil\n\t}\n\tts := make([]time.Time, len(ds))\n\tfor i, d := range ds {\n\t\tts[i] = yoDateToTime(d)\n\t}\n\treturn ts\n}\n\nfunc yoTimesToDates(ts []time.Time) []civil.Date {\n\tif ts == nil {\n\t\treturn nil\n\t}\n\tds := make([]civil.Date, len(ts))\n\tfor i, t := range ts {\n\t\tds[i] = yoTimeToDate(t)\n\t}\n\treturn ds\n}\n\nfunc yoNullDatesToTimes(ds []spanner.NullDate) []spanner.NullTime {\n\tif ds == nil {\n\t\treturn nil\n\t}\n\tts := make([]spanner.NullTime, len(ds))\n\tfor i, d := range ds {\n\t\tts[i] = yoNullDateToTime(d)\n\t}\n\treturn ts\n}\n\nfunc yoNullTimesToDates(ts []spanner.NullTime) []spanner.NullDate {\n\tif ts == nil {\n\t\treturn nil\n\t}\n\tds := make([]spanner.NullDate, len(ts))\n\tfor i, t := range ts {\n\t\tds[i] = yoNullTimeToDate(t)\n\t}\n\treturn ds\n}\n{{- end }}\n"
+var _Assets9b17ed1dbcb38acf95f4ede6be11eb0937786d5e = "// yoTestNewClient creates the client of the round-trip tests if it is set by\n// a test file of the package. The client is created from the database given\n// by YO_TEST_DATABASE otherwise.\nvar yoTestNewClient func(ctx context.Context) (*spanner.Client, error)\n\n// yoTestClient returns a client of the database for the round-trip tests. The\n// test is skipped if no database is configured. SPANNER_EMULATOR_HOST is\n// respected to test against the emulator.\nfunc yoTestClient(t *testing.T) *spanner.Client {\n\tt.Helper()\n\tctx := context.Background()\n\n\tnewClient := yoTestNewClient\n\tif newClient == nil {\n\t\tdb := os.Getenv(\"YO_TEST_DATABASE\")\n\t\tif db == \"\" {\n\t\t\tt.Skip(\"YO_TEST_DATABASE is not set\")\n\t\t}\n\t\tnewClient = func(ctx context.Context) (*spanner.Client, error) {\n\t\t\treturn spanner.NewClient(ctx, db)\n\t\t}\n\t}\n\n\tclient, err := newClient(ctx)\n\tif err != nil {\n\t\tt.Fatalf(\"failed to create client: %v\", err)\n\t}\n\tt.Cleanup(client.Close)\n\n\treturn client\n}\n\n// yoTestEqual reports whether the column values written and read back are\n// equal. Times, intervals and numbers are compared by their values rather than their\n// representations.\nfunc yoTestEqual(want, got interface{}) bool {\n\tswitch w := want.(type) {\n\tcase time.Time:\n\t\tg, ok := got.(time.Time)\n\t\treturn ok && w.Equal(g)\n\tcase spanner.NullTime:\n\t\tg, ok := got.(spanner.NullTime)\n\t\treturn ok && w.Valid == g.Valid && w.Time.Equal(g.Time)\n\tcase big.Rat:\n\t\tg, ok := got.(big.Rat)\n\t\treturn ok && w.Cmp(&g) == 0\n\tcase spanner.NullNumeric:\n\t\tg, ok := got.(spanner.NullNumeric)\n\t\treturn ok && w.Valid == g.Valid && w.Numeric.Cmp(&g.Numeric) == 0\n\tcase spanner.Interval:\n\t\tg, ok := got.(spanner.Interval)\n\t\treturn ok && w.String() == g.String()\n\tcase spanner.NullInterval:\n\t\tg, ok := got.(spanner.NullInterval)\n\t\treturn ok && w.Valid == g.Valid && w.Interval.String() == g.Interval.String()\n\t}\n\n\twv, gv := reflect.ValueOf(want), reflect.ValueOf(got)\n\tif wv.Kind() == reflect.Ptr && gv.Kind() == reflect.Ptr && wv.Type() == gv.Type() {\n\t\t// the nullable columns of pointers\n\t\tif wv.IsNil() || gv.IsNil() {\n\t\t\treturn wv.IsNil() == gv.IsNil()\n\t\t}\n\t\treturn yoTestEqual(wv.Elem().Interface(), gv.Elem().Interface())\n\t}\n\tif wv.Kind() == reflect.Slice && gv.Kind() == reflect.Slice && wv.Type() == gv.Type() {\n\t\t// an empty array may be read back as nil\n\t\tif wv.Len() != gv.Len() {\n\t\t\treturn false\n\t\t}\n\t\tfor i := 0; i < wv.Len(); i++ {\n\t\t\tif !yoTestEqual(wv.Index(i).Interface(), gv.Index(i).Interface()) {\n\t\t\t\treturn false\n\t\t\t}\n\t\t}\n\t\treturn true\n\t}\n\n\treturn reflect.DeepEqual(want, got)\n}\n"
+var _Assets2da36312f867e2e1a26f5a29c883fe2d56891890 = "{{- if .Header -}}\n{{ .Header }}\n\n{{ else -}}\n// Code generated by yo. DO NOT EDIT.\n// Package {{ .Package }} contains the types.\n{{ end -}}\npackage {{ .Package }}\n\nimport (\n{{- if .ChangeStreams }}\n\t\"bytes\"\n{{- end }}\n\t\"context\"\n{{- if .ChangeStreams }}\n\t\"encoding/json\"\n{{- end }}\n\t\"errors\"\n\t\"fmt\"\n\t\"log/slog\"\n\t\"reflect\"\n\t\"sort\"\n\t\"time\"\n\n\t\"cloud.google.com/go/spanner\"\n{{- if or batchwrite .ChangeStreams }}\n\t\"cloud.google.com/go/spanner/apiv1/spannerpb\"\n{{- end }}\n{{- if metrics }}\n\t\"github.com/prometheus/client_golang/prometheus\"\n{{- end }}\n{{- if otel }}\n\t\"go.opentelemetry.io/otel\"\n\t\"go.opentelemetry.io/otel/attribute\"\n\t\"go.opentelemetry.io/otel/trace\"\n{{- end }}\n\t\"google.golang.org/api/iterator\"\n\t\"google.golang.org/grpc/codes\"\n\t\"google.golang.org/grpc/status\"\n{{- if .ChangeStreams }}\n\t\"google.golang.org/protobuf/types/known/structpb\"\n{{- end }}\n{{- if .Imports }}\n{{ range .Imports }}\n\t{{ .Alias }} \"{{ .Path }}\"\n{{- end }}\n{{- end }}\n)\n"
 
 // Assets returns go-assets FileSystem
 var Assets = assets.NewFileSystem(map[string][]string{}, map[string]*assets.File{
-	"index.go.tpl": &assets.File{
+	"changestream.go.tpl": &assets.File{
+		Path:     "changestream.go.tpl",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1651070675, 1651070675000000000),
+		Data:     []byte(_Assets7efe6648cfe234bf2111b897bdbf3246856bcd6a),
+	}, "index.go.tpl": &assets.File{
 		Path:     "index.go.tpl",
 		FileMode: 0x1a4,
 		Mtime:    time.Unix(1651070675, 1651070675000000000),
@@ -23,11 +31,21 @@ var Assets = assets.NewFileSystem(map[string][]string{}, map[string]*assets.File
 		FileMode: 0x1a4,
 		Mtime:    time.Unix(1651070675, 1651070675000000000),
 		Data:     []byte(_Assets7fd73945d69f17ee7478fe75c9ebb3a425327b99),
+	}, "type_test.go.tpl": &assets.File{
+		Path:     "type_test.go.tpl",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1651070675, 1651070675000000000),
+		Data:     []byte(_Assets3f01ada0d180c86a27ca7d58872b447c5cf4d063),
 	}, "yo_db.go.tpl": &assets.File{
 		Path:     "yo_db.go.tpl",
 		FileMode: 0x1a4,
 		Mtime:    time.Unix(1651070675, 1651070675000000000),
 		Data:     []byte(_Assets652b6e36fe11372d65bfc0531de888fa9f12e2c0),
+	}, "yo_db_test.go.tpl": &assets.File{
+		Path:     "yo_db_test.go.tpl",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1651070675, 1651070675000000000),
+		Data:     []byte(_Assets9b17ed1dbcb38acf95f4ede6be11eb0937786d5e),
 	}, "yo_package.go.tpl": &assets.File{
 		Path:     "yo_package.go.tpl",
 		FileMode: 0x1a4,
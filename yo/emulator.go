@@ -0,0 +1,129 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yo
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	instance "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
+	"go.mercari.io/yo/loaders"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// emulatorHostEnv is the environment variable of the host of the Spanner
+// emulator, which is connected instead of Cloud Spanner if set.
+const emulatorHostEnv = "SPANNER_EMULATOR_HOST"
+
+// emulatorOptions returns the options of the clients connecting to the
+// emulator of host, which requires no credentials.
+func emulatorOptions(host string) []option.ClientOption {
+	return []option.ClientOption{
+		option.WithEndpoint(host),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	}
+}
+
+// setupEmulatorDatabase creates the instance of c on the emulator unless it
+// exists, and recreates the database of c by the ddl of EmulatorDDLPaths, so
+// that the schema is loaded from the database end-to-end such as in CI.
+func setupEmulatorDatabase(ctx context.Context, c *Config) error {
+	host := os.Getenv(emulatorHostEnv)
+	if host == "" {
+		// the database is recreated only on the emulator not to drop the
+		// database of Cloud Spanner
+		return fmt.Errorf("--emulator-ddl requires %s", emulatorHostEnv)
+	}
+
+	ddl, _, err := loaders.ReadDDLFiles(c.EmulatorDDLPaths...)
+	if err != nil {
+		return err
+	}
+
+	projectName := "projects/" + c.Project
+	instanceName := projectName + "/instances/" + c.Instance
+	databaseName := instanceName + "/databases/" + c.Database
+
+	instanceAdmin, err := instance.NewInstanceAdminClient(ctx, emulatorOptions(host)...)
+	if err != nil {
+		return err
+	}
+	defer instanceAdmin.Close()
+
+	_, err = instanceAdmin.GetInstance(ctx, &instancepb.GetInstanceRequest{Name: instanceName})
+	if status.Code(err) == codes.NotFound {
+		op, err := instanceAdmin.CreateInstance(ctx, &instancepb.CreateInstanceRequest{
+			Parent:     projectName,
+			InstanceId: c.Instance,
+			Instance: &instancepb.Instance{
+				Config:      projectName + "/instanceConfigs/emulator-config",
+				DisplayName: c.Instance,
+				NodeCount:   1,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("create instance failed: %v", err)
+		}
+		if _, err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("create instance failed: %v", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("get instance failed: %v", err)
+	}
+
+	databaseAdmin, err := database.NewDatabaseAdminClient(ctx, emulatorOptions(host)...)
+	if err != nil {
+		return err
+	}
+	defer databaseAdmin.Close()
+
+	_, err = databaseAdmin.GetDatabase(ctx, &databasepb.GetDatabaseRequest{Name: databaseName})
+	if err == nil {
+		// the database is recreated because the ddl defines the whole schema
+		if err := databaseAdmin.DropDatabase(ctx, &databasepb.DropDatabaseRequest{Database: databaseName}); err != nil {
+			return fmt.Errorf("drop database failed: %v", err)
+		}
+	} else if status.Code(err) != codes.NotFound {
+		return fmt.Errorf("get database failed: %v", err)
+	}
+
+	op, err := databaseAdmin.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
+		Parent:          instanceName,
+		CreateStatement: "CREATE DATABASE `" + c.Database + "`",
+		ExtraStatements: loaders.SplitDDLStatements(ddl),
+	})
+	if err != nil {
+		return fmt.Errorf("create database failed: %v", err)
+	}
+	if _, err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("create database failed: %v", err)
+	}
+
+	return nil
+}
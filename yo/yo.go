@@ -0,0 +1,447 @@
+// Copyright (c) 2020 Mercari, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yo
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	pathpkg "path"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"go.mercari.io/yo/generator"
+	"go.mercari.io/yo/internal"
+	"go.mercari.io/yo/loaders"
+	"google.golang.org/api/option"
+)
+
+// DefaultSuffix is the suffix of the generated files unless Suffix is given.
+const DefaultSuffix = ".yo.go"
+
+// FormatJSON is the format of the schema written by Introspect in JSON, whose
+// structure is loaders.JSONSchema.
+const FormatJSON = "json"
+
+// Config is the configuration of the generation, whose fields are the flags
+// of the yo command such as Out, Package and DML. The schema is loaded from
+// DDLFilepath by the loader registered as Loader if it is given, the ddl files
+// of DDLFilepath and DDLPaths if FromDDL is true, or the database of Project,
+// Instance and Database otherwise.
+type Config = internal.ArgType
+
+// Result is the result of the generation.
+type Result struct {
+	// Tables is the names of the generated tables and views, which are
+	// sorted.
+	Tables []string
+}
+
+// Generate generates the Go code of the tables of the schema by c, as the yo
+// command does with the flags of c.
+func Generate(ctx context.Context, c Config) (*Result, error) {
+	if err := Prepare(&c); err != nil {
+		return nil, err
+	}
+
+	inflector, err := internal.NewInflector(c.InflectionRuleFile, c.InflectionRules...)
+	if err != nil {
+		return nil, fmt.Errorf("load inflection rule failed: %v", err)
+	}
+	initialisms, err := internal.NewInitialisms(c.Initialisms)
+	if err != nil {
+		return nil, err
+	}
+	protoTypes, err := loaders.NewProtoTypes(c.ProtoDescriptorsFile, c.ProtoPackages)
+	if err != nil {
+		return nil, fmt.Errorf("load proto descriptors failed: %v", err)
+	}
+	l, closeLoader, err := newLoader(ctx, &c, protoTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer closeLoader()
+	loader := internal.NewTypeLoader(l, inflector)
+
+	loader.Hook = c.Hook
+	loader.Initialisms = initialisms
+
+	// load custom type definitions
+	loader.CustomTypes = c.CustomTypes
+	if c.CustomTypesFile != "" {
+		if err := loader.LoadCustomTypes(c.CustomTypesFile); err != nil {
+			return nil, fmt.Errorf("load custom types file failed: %v", err)
+		}
+	}
+
+	// load defs into type map
+	tableMap, ixMap, err := loader.LoadSchema(&c)
+	if err != nil {
+		return nil, fmt.Errorf("error: %v", err)
+	}
+
+	if c.TemplatePath != "" {
+		unknown, err := generator.UnknownTemplates(c.TemplatePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range unknown {
+			fmt.Fprintf(os.Stderr, "warning: template %s in the template path overrides no built-in template\n", name)
+		}
+	}
+
+	g := generator.NewGenerator(loader, inflector, generator.GeneratorOption{
+		PackageName:        c.Package,
+		Tags:               c.Tags,
+		Header:             c.Header,
+		Source:             sourceName(&c),
+		TemplatePath:       c.TemplatePath,
+		CustomTypePackage:  c.CustomTypePackage,
+		CustomTypeImports:  c.CustomTypeImports,
+		FilenameSuffix:     c.Suffix,
+		SingleFile:         c.SingleFile,
+		Filename:           c.Filename,
+		FilenameUnderscore: c.FilenameUnderscore,
+		Tests:              c.Tests,
+		DML:                c.DML,
+		Hooks:              c.Hooks,
+		OTel:               c.OTel,
+		Metrics:            c.Metrics,
+		Stores:             c.Stores,
+		NoCommitTimestamp:  c.NoCommitTimestamp,
+		NoForceIndex:       c.NoForceIndex,
+		Groups:             c.Groups,
+		GroupBySchema:      c.GroupBySchema,
+		Dialect:            c.Dialect,
+		ClientVersion:      c.SpannerClientVersion,
+		TemplateFuncs:      c.TemplateFuncs,
+		Path:               c.Path,
+		JSONTagCase:        c.JSONTagCase,
+		Initialisms:        initialisms,
+	})
+	if err := g.Generate(tableMap, ixMap); err != nil {
+		return nil, fmt.Errorf("error: %v", err)
+	}
+
+	res := &Result{Tables: make([]string, 0, len(tableMap))}
+	for name := range tableMap {
+		res.Tables = append(res.Tables, name)
+	}
+	sort.Strings(res.Tables)
+
+	return res, nil
+}
+
+// Prepare validates c, and sets the fields of c derived from the others, such
+// as the package name and the output file of Out. Generate prepares c by
+// itself, so a program calls it only to report the errors of c earlier.
+func Prepare(c *Config) error {
+	if err := prepareSource(c); err != nil {
+		return err
+	}
+
+	if err := generator.ValidateJSONTagCase(c.JSONTagCase); err != nil {
+		return err
+	}
+
+	if err := generator.ValidateCustomTypeImports(c.CustomTypeImports); err != nil {
+		return err
+	}
+
+	if err := internal.ValidateColumnTypes(c.ColumnTypes); err != nil {
+		return err
+	}
+
+	if err := internal.ValidateNames(c.Names); err != nil {
+		return err
+	}
+
+	if _, err := internal.NewInitialisms(c.Initialisms); err != nil {
+		return err
+	}
+
+	if err := generator.ValidateGroups(c.Groups); err != nil {
+		return err
+	}
+
+	if err := internal.ValidateDateType(c.DateType); err != nil {
+		return err
+	}
+
+	path := ""
+	filename := ""
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	// determine out path
+	if c.Out == "" {
+		path = cwd
+	} else {
+		// determine what to do with Out
+		fi, err := os.Stat(c.Out)
+		if err == nil && fi.IsDir() {
+			// out is directory
+			path = c.Out
+		} else if err == nil && !fi.IsDir() {
+			// file exists (will truncate later)
+			path = pathpkg.Dir(c.Out)
+			filename = pathpkg.Base(c.Out)
+
+			// error if not split was set, but destination is not a directory
+			if !c.SingleFile {
+				return fmt.Errorf("output path is not directory")
+			}
+		} else if _, ok := err.(*os.PathError); ok {
+			// path error (ie, file doesn't exist yet)
+			path = pathpkg.Dir(c.Out)
+			filename = pathpkg.Base(c.Out)
+
+			// error if split was set, but dest doesn't exist
+			if !c.SingleFile {
+				return fmt.Errorf("output path must be a directory and already exist when not writing to a single file")
+			}
+		} else {
+			return err
+		}
+	}
+
+	// load header template
+	if c.HeaderFile != "" {
+		if c.Header != "" {
+			return fmt.Errorf("--header and --header-file cannot be specified at the same time")
+		}
+		b, err := ioutil.ReadFile(c.HeaderFile)
+		if err != nil {
+			return err
+		}
+		c.Header = string(b)
+		c.HeaderFile = ""
+	}
+
+	// check template path
+	if c.TemplatePath != "" {
+		info, err := os.Stat(c.TemplatePath)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("template path is not directory")
+		}
+	}
+
+	// fix path
+	if path == "." {
+		path = cwd
+	}
+
+	// determine package name
+	if c.Package == "" {
+		c.Package = pathpkg.Base(path)
+	}
+	if !token.IsIdentifier(c.Package) {
+		return fmt.Errorf("invalid package name '%s', specify it by --package", c.Package)
+	}
+
+	if c.Suffix == "" {
+		c.Suffix = DefaultSuffix
+	}
+
+	// determine filename if not previously set
+	if filename == "" {
+		filename = c.Package + c.Suffix
+	}
+
+	c.Path = path
+	c.Filename = filename
+
+	return nil
+}
+
+// prepareSource validates the source of the schema of c, which is the loader,
+// the ddl files or the database, and its dialect.
+func prepareSource(c *Config) error {
+	if len(c.DDLPaths) != 0 {
+		c.FromDDL = true
+	}
+
+	if c.Dialect == "" {
+		c.Dialect = internal.DialectGoogleSQL
+	}
+	if err := internal.ValidateDialect(c.Dialect); err != nil {
+		return err
+	}
+	if c.Dialect == internal.DialectPostgreSQL {
+		if c.FromDDL {
+			return fmt.Errorf("--dialect %s cannot be used with the ddl", c.Dialect)
+		}
+		if len(c.EmulatorDDLPaths) != 0 {
+			return fmt.Errorf("--emulator-ddl cannot be used with --dialect %s", c.Dialect)
+		}
+	}
+
+	if err := loaders.ValidateClientVersion(c.SpannerClientVersion); err != nil {
+		return err
+	}
+
+	if c.Loader != "" {
+		if c.FromDDL || len(c.EmulatorDDLPaths) != 0 {
+			return fmt.Errorf("--loader cannot be used with the ddl")
+		}
+		if _, err := loaders.Lookup(c.Loader); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Introspect writes the schema of c to w in the format of c, which is loaded
+// as Generate does but before the hook, the filters and the naming rules of
+// c apply. The schema in JSON is loaded back by the loader "json".
+func Introspect(ctx context.Context, c Config, format string, w io.Writer) error {
+	if format != FormatJSON {
+		return fmt.Errorf("unknown format '%s', must be %s", format, FormatJSON)
+	}
+	if err := prepareSource(&c); err != nil {
+		return err
+	}
+
+	protoTypes, err := loaders.NewProtoTypes(c.ProtoDescriptorsFile, c.ProtoPackages)
+	if err != nil {
+		return fmt.Errorf("load proto descriptors failed: %v", err)
+	}
+	l, closeLoader, err := newLoader(ctx, &c, protoTypes)
+	if err != nil {
+		return err
+	}
+	defer closeLoader()
+
+	if err := loaders.DumpJSON(w, l); err != nil {
+		return fmt.Errorf("introspect schema failed: %v", err)
+	}
+	return nil
+}
+
+// newLoader returns the loader of the schema of c and the function closing
+// its client.
+func newLoader(ctx context.Context, c *Config, protoTypes *loaders.ProtoTypes) (loaders.Loader, func(), error) {
+	if c.Loader != "" {
+		factory, err := loaders.Lookup(c.Loader)
+		if err != nil {
+			return nil, nil, err
+		}
+		l, err := factory(c.DDLFilepath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error: %v", err)
+		}
+		if v, ok := l.(interface{ SetClientVersion(string) }); ok {
+			v.SetClientVersion(c.SpannerClientVersion)
+		}
+		if p, ok := l.(interface{ SetProtoTypes(*loaders.ProtoTypes) }); ok {
+			p.SetProtoTypes(protoTypes)
+		}
+		if d, ok := l.(interface{ Dialect() string }); ok {
+			c.Dialect = d.Dialect()
+		}
+		return l, func() {}, nil
+	}
+
+	if c.FromDDL {
+		spannerLoader, err := loaders.NewSpannerLoaderFromDDLFiles(ddlPaths(c)...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error: %v", err)
+		}
+		spannerLoader.SetClientVersion(c.SpannerClientVersion)
+		spannerLoader.SetProtoTypes(protoTypes)
+		return spannerLoader, func() {}, nil
+	}
+
+	if len(c.EmulatorDDLPaths) != 0 {
+		if err := setupEmulatorDatabase(ctx, c); err != nil {
+			return nil, nil, fmt.Errorf("error: %v", err)
+		}
+	}
+	spannerClient, err := NewSpannerClient(ctx, c)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error: %v", err)
+	}
+	return newDatabaseLoader(spannerClient, c, protoTypes), spannerClient.Close, nil
+}
+
+// NewSpannerClient returns the client of the database of c, which is on the
+// Spanner emulator of SPANNER_EMULATOR_HOST if it is set.
+func NewSpannerClient(ctx context.Context, c *Config) (*spanner.Client, error) {
+	databaseName := fmt.Sprintf("projects/%s/instances/%s/databases/%s",
+		c.Project, c.Instance, c.Database)
+	var opts []option.ClientOption
+	if host := os.Getenv(emulatorHostEnv); host != "" {
+		opts = emulatorOptions(host)
+	}
+	spannerClient, err := spanner.NewClient(ctx, databaseName, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return spannerClient, nil
+}
+
+// newDatabaseLoader returns the loader of the schema of the database of client,
+// which is read in the dialect of c.
+func newDatabaseLoader(client *spanner.Client, c *Config, protoTypes *loaders.ProtoTypes) loaders.Loader {
+	if c.Dialect == internal.DialectPostgreSQL {
+		spannerLoader := loaders.NewSpannerPGLoader(client)
+		spannerLoader.SetClientVersion(c.SpannerClientVersion)
+		return spannerLoader
+	}
+
+	spannerLoader := loaders.NewSpannerLoader(client)
+	spannerLoader.SetClientVersion(c.SpannerClientVersion)
+	spannerLoader.SetProtoTypes(protoTypes)
+	return spannerLoader
+}
+
+// ddlPaths returns the paths of the ddl files of c, which are DDLFilepath and
+// DDLPaths.
+func ddlPaths(c *Config) []string {
+	var paths []string
+	if c.DDLFilepath != "" {
+		paths = append(paths, c.DDLFilepath)
+	}
+	return append(paths, c.DDLPaths...)
+}
+
+// sourceName returns the name of the source of the schema of c, which is the
+// source of the loader, the paths of the ddl files or the database.
+func sourceName(c *Config) string {
+	if c.Loader != "" {
+		return c.DDLFilepath
+	}
+	if c.FromDDL {
+		return strings.Join(ddlPaths(c), ", ")
+	}
+	return fmt.Sprintf("projects/%s/instances/%s/databases/%s", c.Project, c.Instance, c.Database)
+}
@@ -0,0 +1,177 @@
+package yo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.mercari.io/yo/loaders"
+	"go.mercari.io/yo/models"
+)
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	ddl := filepath.Join(dir, "schema.sql")
+	schema := "CREATE TABLE Users (\n  UserID STRING(36) NOT NULL,\n  Name STRING(MAX),\n) PRIMARY KEY(UserID);\n"
+	if err := ioutil.WriteFile(ddl, []byte(schema), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := filepath.Join(dir, "models")
+	if err := os.Mkdir(out, 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res, err := Generate(context.Background(), Config{FromDDL: true, DDLFilepath: ddl, Out: out})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"Users"}; fmt.Sprint(res.Tables) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, res.Tables)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(out, "user.yo.go"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"package models", "type User struct"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("expect %q in the generated code", want)
+		}
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name   string
+		config Config
+		errMsg string
+	}{
+		{
+			name:   "invalid package",
+			config: Config{Out: dir, Package: "my-models"},
+			errMsg: "invalid package name 'my-models'",
+		},
+		{
+			name:   "postgresql ddl",
+			config: Config{Out: dir, DDLPaths: []string{"schema.sql"}, Dialect: "postgresql"},
+			errMsg: "cannot be used with the ddl",
+		},
+		{
+			name:   "missing out",
+			config: Config{Out: filepath.Join(dir, "missing", "models")},
+			errMsg: "output path must be a directory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Prepare(&tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("expect error %q, but got %v", tt.errMsg, err)
+			}
+		})
+	}
+}
+
+// testLoader is a loader of a table Users.
+type testLoader struct{}
+
+func (l *testLoader) ParamN(n int) string { return fmt.Sprintf("@param%d", n) }
+func (l *testLoader) MaskFunc() string    { return "?" }
+func (l *testLoader) ParseType(dt string, nullable bool) (int, string, string) {
+	return loaders.SpanParseType(dt, nullable)
+}
+func (l *testLoader) ValidCustomType(string, string) bool { return true }
+func (l *testLoader) TableList() ([]*models.Table, error) {
+	return []*models.Table{{TableName: "Users", Type: "BASE TABLE", ManualPk: true}}, nil
+}
+func (l *testLoader) ViewList() ([]*models.Table, error) { return nil, nil }
+func (l *testLoader) ColumnList(string) ([]*models.Column, error) {
+	return []*models.Column{
+		{FieldOrdinal: 1, ColumnName: "UserID", DataType: "STRING(36)", NotNull: true, IsPrimaryKey: true},
+		{FieldOrdinal: 2, ColumnName: "Name", DataType: "STRING(MAX)"},
+	}, nil
+}
+func (l *testLoader) IndexList(string) ([]*models.Index, error) { return nil, nil }
+func (l *testLoader) IndexColumnList(_ string, index string) ([]*models.IndexColumn, error) {
+	if index == "PRIMARY_KEY" {
+		return []*models.IndexColumn{{SeqNo: 1, ColumnName: "UserID"}}, nil
+	}
+	return nil, nil
+}
+
+func TestGenerateLoader(t *testing.T) {
+	var source string
+	loaders.Register("test", func(s string) (loaders.Loader, error) {
+		source = s
+		return &testLoader{}, nil
+	})
+
+	out := t.TempDir()
+	res, err := Generate(context.Background(), Config{Loader: "test", DDLFilepath: "schema.yaml", Out: out, Package: "models"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source != "schema.yaml" {
+		t.Errorf("error. want:schema.yaml got:%v", source)
+	}
+	if want := []string{"Users"}; fmt.Sprint(res.Tables) != fmt.Sprint(want) {
+		t.Errorf("error. want:%v got:%v", want, res.Tables)
+	}
+	if _, err := os.Stat(filepath.Join(out, "user.yo.go")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := Generate(context.Background(), Config{Loader: "unknown", Out: out}); err == nil || !strings.Contains(err.Error(), "unknown loader 'unknown'") {
+		t.Errorf("expect error of unknown loader, but got %v", err)
+	}
+}
+
+func TestIntrospect(t *testing.T) {
+	loaders.Register("introspect", func(string) (loaders.Loader, error) {
+		return &testLoader{}, nil
+	})
+
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.json")
+	var buf bytes.Buffer
+	if err := Introspect(context.Background(), Config{Loader: "introspect"}, FormatJSON, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(schema, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the code generated from the dumped schema is the same as the schema
+	// it was dumped from
+	var files []string
+	for _, c := range []Config{
+		{Loader: "introspect", DDLFilepath: schema},
+		{Loader: "json", DDLFilepath: schema},
+	} {
+		c.Out = t.TempDir()
+		c.Package = "models"
+		if _, err := Generate(context.Background(), c); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		b, err := ioutil.ReadFile(filepath.Join(c.Out, "user.yo.go"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		files = append(files, string(b))
+	}
+	if files[0] != files[1] {
+		t.Errorf("error. want:%v got:%v", files[0], files[1])
+	}
+
+	if err := Introspect(context.Background(), Config{Loader: "introspect"}, "yaml", &buf); err == nil || !strings.Contains(err.Error(), "unknown format 'yaml'") {
+		t.Errorf("expect error of unknown format, but got %v", err)
+	}
+}